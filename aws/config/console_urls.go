@@ -0,0 +1,82 @@
+package awsconfig
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ConsoleURLTemplates are the AWS web console deep links per resource type, in which
+// {region}, {id}, {name} and {arn} are replaced with the values of the resource
+var ConsoleURLTemplates = map[string]string{
+	"instance":            "https://console.aws.amazon.com/ec2/v2/home?region={region}#Instances:instanceId={id}",
+	"image":               "https://console.aws.amazon.com/ec2/v2/home?region={region}#Images:visibility=owned-by-me;imageId={id}",
+	"volume":              "https://console.aws.amazon.com/ec2/v2/home?region={region}#Volumes:volumeId={id}",
+	"snapshot":            "https://console.aws.amazon.com/ec2/v2/home?region={region}#Snapshots:snapshotId={id}",
+	"spotrequest":         "https://console.aws.amazon.com/ec2sp/v1/spot/home?region={region}#",
+	"reservedinstance":    "https://console.aws.amazon.com/ec2/v2/home?region={region}#ReservedInstances:reservedInstancesId={id}",
+	"placementgroup":      "https://console.aws.amazon.com/ec2/v2/home?region={region}#PlacementGroups:search={id}",
+	"host":                "https://console.aws.amazon.com/ec2/v2/home?region={region}#Hosts:hostId={id}",
+	"vpcendpoint":         "https://console.aws.amazon.com/vpc/home?region={region}#Endpoints:vpcEndpointId={id}",
+	"securitygroup":       "https://console.aws.amazon.com/ec2/v2/home?region={region}#SecurityGroups:groupId={id}",
+	"keypair":             "https://console.aws.amazon.com/ec2/v2/home?region={region}#KeyPairs:keyName={id}",
+	"elasticip":           "https://console.aws.amazon.com/ec2/v2/home?region={region}#Addresses:search={id}",
+	"loadbalancer":        "https://console.aws.amazon.com/ec2/v2/home?region={region}#LoadBalancers:search={name}",
+	"classicloadbalancer": "https://console.aws.amazon.com/ec2/v2/home?region={region}#LoadBalancers:search={name}",
+	"targetgroup":         "https://console.aws.amazon.com/ec2/v2/home?region={region}#TargetGroups:search={name}",
+	"scalinggroup":        "https://console.aws.amazon.com/ec2/autoscaling/home?region={region}#AutoScalingGroups:id={name}",
+	"vpc":                 "https://console.aws.amazon.com/vpc/home?region={region}#vpcs:search={id}",
+	"subnet":              "https://console.aws.amazon.com/vpc/home?region={region}#subnets:search={id}",
+	"routetable":          "https://console.aws.amazon.com/vpc/home?region={region}#routetables:search={id}",
+	"natgateway":          "https://console.aws.amazon.com/vpc/home?region={region}#NatGateways:search={id}",
+	"database":            "https://console.aws.amazon.com/rds/home?region={region}#dbinstance:id={id}",
+	"cachecluster":        "https://console.aws.amazon.com/elasticache/home?region={region}#cache-clusters:id={id}",
+	"bucket":              "https://s3.console.aws.amazon.com/s3/buckets/{id}/?region={region}",
+	"user":                "https://console.aws.amazon.com/iam/home#/users/{name}",
+	"role":                "https://console.aws.amazon.com/iam/home#/roles/{name}",
+	"group":               "https://console.aws.amazon.com/iam/home#/groups/{name}",
+	"policy":              "https://console.aws.amazon.com/iam/home#/policies/{arn}",
+	"function":            "https://console.aws.amazon.com/lambda/home?region={region}#/functions/{name}",
+	"topic":               "https://console.aws.amazon.com/sns/v2/home?region={region}#/topics/{arn}",
+	"queue":               "https://console.aws.amazon.com/sqs/home?region={region}#queue-browser:selected={id}",
+	"alarm":               "https://console.aws.amazon.com/cloudwatch/home?region={region}#alarm:alarmFilter=ANY;name={name}",
+	"loggroup":            "https://console.aws.amazon.com/cloudwatch/home?region={region}#logStream:group={id}",
+	"stack":               "https://console.aws.amazon.com/cloudformation/home?region={region}#/stacks?filter={name}",
+	"distribution":        "https://console.aws.amazon.com/cloudfront/home#distribution-settings:{id}",
+	"repository":          "https://console.aws.amazon.com/ecs/home?region={region}#/repositories/{name}",
+	"certificate":         "https://console.aws.amazon.com/acm/home?region={region}#/?id={id}",
+}
+
+var consoleURLFieldRegex = regexp.MustCompile(`\{[a-z]+\}`)
+
+// ConsoleURL returns the web console link of a resource, failing when the
+// link of its type is unknown or needs a value of the resource that is not set
+func ConsoleURL(resType, region string, values map[string]string) (string, error) {
+	tpl, ok := ConsoleURLTemplates[resType]
+	if !ok {
+		var known []string
+		for t := range ConsoleURLTemplates {
+			known = append(known, t)
+		}
+		sort.Strings(known)
+		return "", fmt.Errorf("no console link known for %s (known for: %s)", resType, strings.Join(known, ", "))
+	}
+
+	fields := map[string]string{"region": region}
+	for k, v := range values {
+		fields[k] = v
+	}
+
+	var err error
+	link := consoleURLFieldRegex.ReplaceAllStringFunc(tpl, func(field string) string {
+		key := field[1 : len(field)-1]
+		v, ok := fields[key]
+		if !ok || v == "" {
+			err = fmt.Errorf("console link of %s: missing %s", resType, key)
+			return field
+		}
+		return v
+	})
+	return link, err
+}
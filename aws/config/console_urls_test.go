@@ -0,0 +1,48 @@
+package awsconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConsoleURL(t *testing.T) {
+	tcases := []struct {
+		resType      string
+		values       map[string]string
+		expURL       string
+		expErrSubstr string
+	}{
+		{resType: "instance", values: map[string]string{"id": "i-1234"}, expURL: "https://console.aws.amazon.com/ec2/v2/home?region=eu-west-1#Instances:instanceId=i-1234"},
+		{resType: "bucket", values: map[string]string{"id": "my-bucket"}, expURL: "https://s3.console.aws.amazon.com/s3/buckets/my-bucket/?region=eu-west-1"},
+		{resType: "user", values: map[string]string{"id": "AIDA1234", "name": "jsmith"}, expURL: "https://console.aws.amazon.com/iam/home#/users/jsmith"},
+		{resType: "role", values: map[string]string{"id": "AROA1234"}, expErrSubstr: "console link of role: missing name"},
+		{resType: "region", values: map[string]string{"id": "eu-west-1"}, expErrSubstr: "no console link known for region"},
+	}
+	for i, tcase := range tcases {
+		link, err := ConsoleURL(tcase.resType, "eu-west-1", tcase.values)
+		if tcase.expErrSubstr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.expErrSubstr) {
+				t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErrSubstr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := link, tcase.expURL; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestConsoleURLTemplatesFields(t *testing.T) {
+	for resType, tpl := range ConsoleURLTemplates {
+		for _, field := range consoleURLFieldRegex.FindAllString(tpl, -1) {
+			switch field {
+			case "{region}", "{id}", "{name}", "{arn}":
+			default:
+				t.Errorf("%s: unknown field %s", resType, field)
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package awsconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Previous generation instance types, still available but superseded by current ones
+// offering a better price/performance (see https://aws.amazon.com/ec2/previous-generation/)
+var DeprecatedInstanceTypes = []string{
+	"t1.micro",
+	"m1.small", "m1.medium", "m1.large", "m1.xlarge",
+	"m2.xlarge", "m2.2xlarge", "m2.4xlarge",
+	"m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge",
+	"c1.medium", "c1.xlarge",
+	"c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge",
+	"cc2.8xlarge", "cg1.4xlarge", "cr1.8xlarge", "hi1.4xlarge", "hs1.8xlarge",
+	"g2.2xlarge", "g2.8xlarge",
+	"i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge",
+	"r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge",
+}
+
+func ParseInstanceTypes(i string) (interface{}, error) {
+	var types []string
+	for _, t := range strings.Split(i, ",") {
+		if t = strings.TrimSpace(t); t == "" {
+			continue
+		}
+		if !isValidInstanceType(t) {
+			return i, fmt.Errorf("'%s' is not a valid instance type", t)
+		}
+		types = append(types, t)
+	}
+	return strings.Join(types, ","), nil
+}
@@ -0,0 +1,39 @@
+package awsconfig
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var roleARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::(\d{12}):role/.+$`)
+
+// AccountOfRoleARN returns the id of the account of an IAM role ARN
+// (ex: 123456789012 for arn:aws:iam::123456789012:role/audit)
+func AccountOfRoleARN(arn string) (string, error) {
+	matches := roleARNRegex.FindStringSubmatch(arn)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("'%s' is not a valid IAM role ARN", arn)
+	}
+	return matches[1], nil
+}
+
+func ParseRoleARNs(i string) (interface{}, error) {
+	var arns []string
+	accounts := make(map[string]string)
+	for _, arn := range strings.Split(i, ",") {
+		if arn = strings.TrimSpace(arn); arn == "" {
+			continue
+		}
+		account, err := AccountOfRoleARN(arn)
+		if err != nil {
+			return i, err
+		}
+		if other, dup := accounts[account]; dup {
+			return i, fmt.Errorf("roles '%s' and '%s' are in the same account %s", other, arn, account)
+		}
+		accounts[account] = arn
+		arns = append(arns, arn)
+	}
+	return strings.Join(arns, ","), nil
+}
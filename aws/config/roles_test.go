@@ -0,0 +1,37 @@
+package awsconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRoleARNs(t *testing.T) {
+	tcases := []struct {
+		in, out, err string
+	}{
+		{in: "arn:aws:iam::123456789012:role/audit", out: "arn:aws:iam::123456789012:role/audit"},
+		{in: " arn:aws:iam::123456789012:role/audit, arn:aws-cn:iam::210987654321:role/path/audit ,", out: "arn:aws:iam::123456789012:role/audit,arn:aws-cn:iam::210987654321:role/path/audit"},
+		{in: "arn:aws:iam::123456789012:user/audit", err: "not a valid IAM role ARN"},
+		{in: "arn:aws:iam::1234:role/audit", err: "not a valid IAM role ARN"},
+		{in: "arn:aws:iam::123456789012:role/audit,arn:aws:iam::123456789012:role/other", err: "same account 123456789012"},
+	}
+	for _, tcase := range tcases {
+		out, err := ParseRoleARNs(tcase.in)
+		if tcase.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.err) {
+				t.Fatalf("%s: got %v, want error with '%s'", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.in, err)
+		}
+		if got, want := out, tcase.out; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+
+	if account, err := AccountOfRoleARN("arn:aws:iam::123456789012:role/audit"); err != nil || account != "123456789012" {
+		t.Fatalf("got %s, %v", account, err)
+	}
+}
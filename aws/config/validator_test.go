@@ -45,6 +45,33 @@ func TestInstanceTypeValid(t *testing.T) {
 	}
 }
 
+func TestParseInstanceTypes(t *testing.T) {
+	tcases := []struct {
+		in, exp string
+		expErr  bool
+	}{
+		{in: "", exp: ""},
+		{in: "t1.micro", exp: "t1.micro"},
+		{in: " m1.small, m3.large ,", exp: "m1.small,m3.large"},
+		{in: "m1.small,micro", expErr: true},
+	}
+	for _, tcase := range tcases {
+		got, err := ParseInstanceTypes(tcase.in)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%q: expected error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: %s", tcase.in, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%q: got %q, want %q", tcase.in, got, tcase.exp)
+		}
+	}
+}
+
 func stringInSlice(s string, slice []string) bool {
 	for _, v := range slice {
 		if v == s {
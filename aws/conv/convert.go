@@ -24,24 +24,38 @@ import (
 	"hash/adler32"
 	"net"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
@@ -81,11 +95,25 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		} else {
 			res = graph.InitResource(cloud.ElasticIP, awssdk.StringValue(ss.PublicIp))
 		}
+	case *ec2.NetworkInterface:
+		res = graph.InitResource(cloud.NetworkInterface, awssdk.StringValue(ss.NetworkInterfaceId))
 	case *ec2.Snapshot:
 		res = graph.InitResource(cloud.Snapshot, awssdk.StringValue(ss.SnapshotId))
+	case *ec2.SpotInstanceRequest:
+		res = graph.InitResource(cloud.SpotRequest, awssdk.StringValue(ss.SpotInstanceRequestId))
+	case *ec2.ReservedInstances:
+		res = graph.InitResource(cloud.ReservedInstance, awssdk.StringValue(ss.ReservedInstancesId))
+	case *ec2.PlacementGroup:
+		res = graph.InitResource(cloud.PlacementGroup, awssdk.StringValue(ss.GroupName))
+	case *ec2.Host:
+		res = graph.InitResource(cloud.Host, awssdk.StringValue(ss.HostId))
+	case *ec2.VpcEndpoint:
+		res = graph.InitResource(cloud.VpcEndpoint, awssdk.StringValue(ss.VpcEndpointId))
 	// Loadbalancer
 	case *elbv2.LoadBalancer:
 		res = graph.InitResource(cloud.LoadBalancer, awssdk.StringValue(ss.LoadBalancerArn))
+	case *elb.LoadBalancerDescription:
+		res = graph.InitResource(cloud.ClassicLoadBalancer, awssdk.StringValue(ss.LoadBalancerName))
 	case *elbv2.TargetGroup:
 		res = graph.InitResource(cloud.TargetGroup, awssdk.StringValue(ss.TargetGroupArn))
 	case *elbv2.Listener:
@@ -95,6 +123,10 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Database, awssdk.StringValue(ss.DBInstanceIdentifier))
 	case *rds.DBSubnetGroup:
 		res = graph.InitResource(cloud.DbSubnetGroup, awssdk.StringValue(ss.DBSubnetGroupArn))
+	case *elasticache.CacheCluster:
+		res = graph.InitResource(cloud.CacheCluster, awssdk.StringValue(ss.CacheClusterId))
+	case *elasticache.CacheSubnetGroup:
+		res = graph.InitResource(cloud.CacheSubnetGroup, awssdk.StringValue(ss.CacheSubnetGroupName))
 		// Autoscaling
 	case *autoscaling.LaunchConfiguration:
 		res = graph.InitResource(cloud.LaunchConfiguration, awssdk.StringValue(ss.LaunchConfigurationARN))
@@ -113,6 +145,37 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Container, awssdk.StringValue(ss.ContainerArn))
 	case *ecs.ContainerInstance:
 		res = graph.InitResource(cloud.ContainerInstance, awssdk.StringValue(ss.ContainerInstanceArn))
+	// Firewall
+	case *wafv2.WebACL:
+		res = graph.InitResource(cloud.WebACL, awssdk.StringValue(ss.ARN))
+	// Certificates
+	case *acm.CertificateDetail:
+		res = graph.InitResource(cloud.Certificate, awssdk.StringValue(ss.CertificateArn))
+	// Beanstalk
+	case *elasticbeanstalk.ApplicationDescription:
+		res = graph.InitResource(cloud.BeanstalkApplication, awssdk.StringValue(ss.ApplicationName))
+	case *elasticbeanstalk.EnvironmentDescription:
+		res = graph.InitResource(cloud.BeanstalkEnvironment, awssdk.StringValue(ss.EnvironmentId))
+	// API Gateway
+	case *apigateway.RestApi:
+		res = graph.InitResource(cloud.RestApi, awssdk.StringValue(ss.Id))
+	case *apigatewayv2.Api:
+		res = graph.InitResource(cloud.RestApi, awssdk.StringValue(ss.ApiId))
+	case *apigateway.Stage:
+		id := HashFields(awssdk.StringValue(ss.DeploymentId), awssdk.StringValue(ss.StageName))
+		res = graph.InitResource(cloud.ApiStage, id)
+	// SSM
+	case *ssm.ParameterMetadata:
+		res = graph.InitResource(cloud.Parameter, awssdk.StringValue(ss.Name))
+	// KMS
+	case *kms.KeyMetadata:
+		res = graph.InitResource(cloud.KMSKey, awssdk.StringValue(ss.KeyId))
+	// GuardDuty
+	case *guardduty.Finding:
+		res = graph.InitResource(cloud.Finding, awssdk.StringValue(ss.Id))
+	// Secrets Manager
+	case *secretsmanager.SecretListEntry:
+		res = graph.InitResource(cloud.Secret, awssdk.StringValue(ss.Name))
 	// IAM
 	case *iam.User:
 		res = graph.InitResource(cloud.User, awssdk.StringValue(ss.UserId))
@@ -135,6 +198,8 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Bucket, awssdk.StringValue(ss.Name))
 	case *s3.Object:
 		res = graph.InitResource(cloud.S3Object, awssdk.StringValue(ss.Key))
+	case *dynamodb.TableDescription:
+		res = graph.InitResource(cloud.Table, awssdk.StringValue(ss.TableArn))
 	//SNS
 	case *sns.Subscription:
 		res = graph.InitResource(cloud.Subscription, awssdk.StringValue(ss.Endpoint))
@@ -155,6 +220,8 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Metric, id)
 	case *cloudwatch.MetricAlarm:
 		res = graph.InitResource(cloud.Alarm, awssdk.StringValue(ss.AlarmArn))
+	case *cloudwatchlogs.LogGroup:
+		res = graph.InitResource(cloud.LogGroup, awssdk.StringValue(ss.LogGroupName))
 		// cdn
 	case *cloudfront.DistributionSummary:
 		res = graph.InitResource(cloud.Distribution, awssdk.StringValue(ss.Id))
@@ -305,6 +372,18 @@ var extractTimeWithZSuffixFn = func(i interface{}) (interface{}, error) {
 	return nil, fmt.Errorf("extract time: expected time pointer, got: %T", i)
 }
 
+// Extract time given in milliseconds since epoch (i.e. CloudWatch Logs)
+var extractMillisecondsTimeFn = func(i interface{}) (interface{}, error) {
+	ms, ok := i.(*int64)
+	if !ok {
+		return nil, fmt.Errorf("extract milliseconds time: expected int64 pointer, got: %T", i)
+	}
+	if ms == nil {
+		return nil, nil
+	}
+	return time.Unix(0, *ms*int64(time.Millisecond)).UTC(), nil
+}
+
 var extractIpPermissionSliceFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ec2.IpPermission); !ok {
 		return nil, fmt.Errorf("extract ip permission: not a permission slice but a %T", i)
@@ -542,6 +621,56 @@ var extractHasATrueBoolInStructSliceFn = func(key string) transformFn {
 	}
 }
 
+var extractSliceLenFn = func(i interface{}) (interface{}, error) {
+	value := reflect.ValueOf(i)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("extract slice length: not a slice but a %T", i)
+	}
+	return value.Len(), nil
+}
+
+// Sum the instance capacity of a dedicated host over its instance types
+var extractHostInstanceCapacityFn = func(total bool) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		capacity, ok := i.(*ec2.AvailableCapacity)
+		if !ok {
+			return nil, fmt.Errorf("extract host instance capacity: not an available capacity but a %T", i)
+		}
+		if capacity == nil {
+			return nil, nil
+		}
+		var sum int64
+		for _, c := range capacity.AvailableInstanceCapacity {
+			if total {
+				sum += awssdk.Int64Value(c.TotalCapacity)
+			} else {
+				sum += awssdk.Int64Value(c.AvailableCapacity)
+			}
+		}
+		return int(sum), nil
+	}
+}
+
+// Number of addresses usable in a subnet CIDR block, AWS reserving 5 of them in each subnet
+var extractSubnetCapacityFn = func(i interface{}) (interface{}, error) {
+	cidr, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("extract subnet capacity: not a string pointer but a %T", i)
+	}
+	if cidr == nil {
+		return nil, nil
+	}
+	_, ipnet, err := net.ParseCIDR(awssdk.StringValue(cidr))
+	if err != nil {
+		return nil, fmt.Errorf("extract subnet capacity: %s", err)
+	}
+	ones, bits := ipnet.Mask.Size()
+	if bits != 32 || ones > 28 {
+		return nil, nil
+	}
+	return 1<<uint(bits-ones) - 5, nil
+}
+
 var extractDistributionOriginFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.(*cloudfront.Origins); !ok {
 		return nil, fmt.Errorf("extract origins: not a origins pointer but a %T", i)
@@ -602,6 +731,105 @@ var extractContainersImagesFn = func(i interface{}) (interface{}, error) {
 	return keyVals, nil
 }
 
+// Listeners of a classic load balancer are given as 'HTTP:80->HTTP:8080',
+// from the load balancer protocol and port to the instance ones
+var extractClassicListenersFn = func(i interface{}) (interface{}, error) {
+	listeners, ok := i.([]*elb.ListenerDescription)
+	if !ok {
+		return nil, fmt.Errorf("extract classic listeners: not a listener description slice, but a %T", i)
+	}
+	var out []string
+	for _, l := range listeners {
+		if l.Listener == nil {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s:%d->%s:%d", awssdk.StringValue(l.Listener.Protocol), awssdk.Int64Value(l.Listener.LoadBalancerPort),
+			awssdk.StringValue(l.Listener.InstanceProtocol), awssdk.Int64Value(l.Listener.InstancePort)))
+	}
+	return out, nil
+}
+
+// An address is associated to an instance (EC2-Classic addresses only have an InstanceId)
+// or to a network interface, may it be the one of an instance or of a NAT gateway
+var fetchAddressAssociatedFn = func(i interface{}) (interface{}, error) {
+	addr, ok := i.(*ec2.Address)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	return notEmpty(addr.AssociationId) || notEmpty(addr.InstanceId) || notEmpty(addr.NetworkInterfaceId), nil
+}
+
+var fetchCertificateInUseFn = func(i interface{}) (interface{}, error) {
+	cert, ok := i.(*acm.CertificateDetail)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	return len(cert.InUseBy) > 0, nil
+}
+
+// The key schema is kept as a single value as the graph does not preserve the order
+// of list values, the hash key coming first (ex: "id:HASH,date:RANGE")
+var extractKeySchemaFn = func(i interface{}) (interface{}, error) {
+	keys, ok := i.([]*dynamodb.KeySchemaElement)
+	if !ok {
+		return nil, fmt.Errorf("extract key schema: not a key schema slice, but a %T", i)
+	}
+	var out []string
+	for _, k := range keys {
+		out = append(out, fmt.Sprintf("%s:%s", awssdk.StringValue(k.AttributeName), awssdk.StringValue(k.KeyType)))
+	}
+	return strings.Join(out, ","), nil
+}
+
+// GuardDuty rates findings from 1.0 to 8.9 and labels them from their range
+var extractFindingSeverityFn = func(i interface{}) (interface{}, error) {
+	severity, ok := i.(*float64)
+	if !ok {
+		return nil, fmt.Errorf("extract finding severity: not a float pointer, but a %T", i)
+	}
+	switch s := awssdk.Float64Value(severity); {
+	case s >= 7:
+		return "High", nil
+	case s >= 4:
+		return "Medium", nil
+	default:
+		return "Low", nil
+	}
+}
+
+// WAFv2 sets either an allow or a block default action on a web ACL
+var extractWebACLDefaultActionFn = func(i interface{}) (interface{}, error) {
+	action, ok := i.(*wafv2.DefaultAction)
+	if !ok {
+		return nil, fmt.Errorf("extract web acl default action: not a default action, but a %T", i)
+	}
+	switch {
+	case action.Allow != nil:
+		return "ALLOW", nil
+	case action.Block != nil:
+		return "BLOCK", nil
+	default:
+		return nil, nil
+	}
+}
+
+// Tables without provisioned capacity are billed per request (on-demand)
+var fetchTableBillingModeFn = func(i interface{}) (interface{}, error) {
+	table, ok := i.(*dynamodb.TableDescription)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	if tp := table.ProvisionedThroughput; tp != nil && (awssdk.Int64Value(tp.ReadCapacityUnits) > 0 || awssdk.Int64Value(tp.WriteCapacityUnits) > 0) {
+		return TableProvisionedBillingMode, nil
+	}
+	return TableOnDemandBillingMode, nil
+}
+
+const (
+	TableProvisionedBillingMode = "PROVISIONED"
+	TableOnDemandBillingMode    = "PAY_PER_REQUEST"
+)
+
 func notEmpty(str *string) bool {
 	return awssdk.StringValue(str) != ""
 }
@@ -336,4 +336,29 @@ func TestTransformFunctions(t *testing.T) {
 			t.Fatalf("got %t, want %t", got, want)
 		}
 	})
+
+	t.Run("extractSubnetCapacity", func(t *testing.T) {
+		t.Parallel()
+		tcases := []struct {
+			cidr     *string
+			expected interface{}
+		}{
+			{cidr: awssdk.String("10.0.0.0/24"), expected: 251},
+			{cidr: awssdk.String("10.0.0.0/16"), expected: 65531},
+			{cidr: awssdk.String("10.0.0.0/28"), expected: 11},
+			{cidr: nil, expected: nil},
+		}
+		for _, tc := range tcases {
+			val, err := extractSubnetCapacityFn(tc.cidr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := val, tc.expected; got != want {
+				t.Fatalf("%s: got %v, want %v", awssdk.StringValue(tc.cidr), got, want)
+			}
+		}
+		if _, err := extractSubnetCapacityFn(awssdk.String("10.0.0.0")); err == nil {
+			t.Fatal("expected error on invalid CIDR")
+		}
+	})
 }
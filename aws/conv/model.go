@@ -63,6 +63,8 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.CIDR:             {name: "CidrBlock", transform: extractValueFn},
 		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
 		properties.Default:          {name: "DefaultForAz", transform: extractValueFn},
+		properties.AvailableIPs:     {name: "AvailableIpAddressCount", transform: extractValueFn},
+		properties.TotalIPs:         {name: "CidrBlock", transform: extractSubnetCapacityFn},
 		properties.Tags:             {name: "Tags", transform: extractTagsFn},
 	},
 	cloud.SecurityGroup: {
@@ -99,6 +101,54 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Volume:      {name: "VolumeId", transform: extractValueFn},
 		properties.Tags:        {name: "Tags", transform: extractTagsFn},
 	},
+	cloud.SpotRequest: {
+		properties.Name:             {name: "Tags", transform: extractTagFn("Name")},
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.StateMessage:     {name: "Status", transform: extractFieldFn("Message")},
+		properties.SpotPrice:        {name: "SpotPrice", transform: extractValueFn},
+		properties.Type:             {name: "LaunchSpecification", transform: extractFieldFn("InstanceType")},
+		properties.Image:            {name: "LaunchSpecification", transform: extractFieldFn("ImageId")},
+		properties.Instance:         {name: "InstanceId", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "LaunchedAvailabilityZone", transform: extractValueFn},
+		properties.Platform:         {name: "ProductDescription", transform: extractValueFn},
+		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
+		properties.Expires:          {name: "ValidUntil", transform: extractTimeFn},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.ReservedInstance: {
+		properties.State:            {name: "State", transform: extractValueFn},
+		properties.Type:             {name: "InstanceType", transform: extractValueFn},
+		properties.InstanceCount:    {name: "InstanceCount", transform: extractValueFn},
+		properties.OfferingType:     {name: "OfferingType", transform: extractValueFn},
+		properties.FixedPrice:       {name: "FixedPrice", transform: extractValueAsStringFn},
+		properties.UsagePrice:       {name: "UsagePrice", transform: extractValueAsStringFn},
+		properties.Scope:            {name: "Scope", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Platform:         {name: "ProductDescription", transform: extractValueFn},
+		properties.Launched:         {name: "Start", transform: extractTimeFn},
+		properties.Expires:          {name: "End", transform: extractTimeFn},
+		properties.Tags:             {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.PlacementGroup: {
+		properties.Name:     {name: "GroupName", transform: extractValueFn},
+		properties.State:    {name: "State", transform: extractValueFn},
+		properties.Strategy: {name: "Strategy", transform: extractValueFn},
+	},
+	cloud.Host: {
+		properties.State:             {name: "State", transform: extractValueFn},
+		properties.Type:              {name: "HostProperties", transform: extractFieldFn("InstanceType")},
+		properties.AvailabilityZone:  {name: "AvailabilityZone", transform: extractValueFn},
+		properties.Capacity:          {name: "AvailableCapacity", transform: extractHostInstanceCapacityFn(true)},
+		properties.AvailableCapacity: {name: "AvailableCapacity", transform: extractHostInstanceCapacityFn(false)},
+		properties.InstanceCount:     {name: "Instances", transform: extractSliceLenFn},
+	},
+	cloud.VpcEndpoint: {
+		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
+		properties.ServiceName: {name: "ServiceName", transform: extractValueFn},
+		properties.State:       {name: "State", transform: extractValueFn},
+		properties.RouteTables: {name: "RouteTableIds", transform: extractStringPointerSliceValues},
+		properties.Created:     {name: "CreationTimestamp", transform: extractTimeFn},
+	},
 	cloud.Image: {
 		properties.Name:           {name: "Name", transform: extractValueFn},
 		properties.Architecture:   {name: "Architecture", transform: extractValueFn},
@@ -153,10 +203,33 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Messages: {name: "Messages", transform: extractStringSliceValues("Message")},
 	},
 	cloud.ElasticIP: {
-		properties.Name:        {name: "PublicIp", transform: extractValueFn},
-		properties.PublicIP:    {name: "PublicIp", transform: extractValueFn},
-		properties.PrivateIP:   {name: "PrivateIpAddress", transform: extractValueFn},
-		properties.Association: {name: "AssociationId", transform: extractValueFn},
+		properties.Name:             {name: "PublicIp", transform: extractValueFn},
+		properties.PublicIP:         {name: "PublicIp", transform: extractValueFn},
+		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.Association:      {name: "AssociationId", transform: extractValueFn},
+		properties.Associated:       {fetch: fetchAddressAssociatedFn},
+		properties.Instance:         {name: "InstanceId", transform: extractValueFn},
+		properties.NetworkInterface: {name: "NetworkInterfaceId", transform: extractValueFn},
+	},
+	cloud.NetworkInterface: {
+		properties.Name:             {name: "TagSet", transform: extractTagFn("Name")},
+		properties.Description:      {name: "Description", transform: extractValueFn},
+		properties.Type:             {name: "InterfaceType", transform: extractValueFn},
+		properties.State:            {name: "Status", transform: extractValueFn},
+		properties.Subnet:           {name: "SubnetId", transform: extractValueFn},
+		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
+		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
+		properties.PrivateIP:        {name: "PrivateIpAddress", transform: extractValueFn},
+		properties.PrivateIPs:       {name: "PrivateIpAddresses", transform: extractStringSliceValues("PrivateIpAddress")},
+		properties.PublicIP:         {name: "Association", transform: extractFieldFn("PublicIp")},
+		properties.PublicDNS:        {name: "Association", transform: extractFieldFn("PublicDnsName")},
+		properties.MACAddress:       {name: "MacAddress", transform: extractValueFn},
+		properties.SecurityGroups:   {name: "Groups", transform: extractStringSliceValues("GroupId")},
+		properties.Attachment:       {name: "Attachment", transform: extractFieldFn("AttachmentId")},
+		properties.Instance:         {name: "Attachment", transform: extractFieldFn("InstanceId")},
+		properties.Requester:        {name: "RequesterId", transform: extractValueFn},
+		properties.Owner:            {name: "OwnerId", transform: extractValueFn},
+		properties.Tags:             {name: "TagSet", transform: extractTagsFn},
 	},
 	// LoadBalancer
 	cloud.LoadBalancer: {
@@ -173,6 +246,23 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Type:              {name: "Type", transform: extractValueFn},
 		properties.Vpc:               {name: "VpcId", transform: extractValueFn},
 	},
+	cloud.ClassicLoadBalancer: {
+		properties.Name:                    {name: "LoadBalancerName", transform: extractValueFn},
+		properties.AvailabilityZones:       {name: "AvailabilityZones", transform: extractStringPointerSliceValues},
+		properties.Subnets:                 {name: "Subnets", transform: extractStringPointerSliceValues},
+		properties.SecurityGroups:          {name: "SecurityGroups", transform: extractStringPointerSliceValues},
+		properties.Zone:                    {name: "CanonicalHostedZoneNameID", transform: extractValueFn},
+		properties.Created:                 {name: "CreatedTime", transform: extractTimeFn},
+		properties.PublicDNS:               {name: "DNSName", transform: extractValueFn},
+		properties.Scheme:                  {name: "Scheme", transform: extractValueFn},
+		properties.Vpc:                     {name: "VPCId", transform: extractValueFn},
+		properties.Listeners:               {name: "ListenerDescriptions", transform: extractClassicListenersFn},
+		properties.HealthCheck:             {name: "HealthCheck", transform: extractFieldFn("Target")},
+		properties.CheckInterval:           {name: "HealthCheck", transform: extractFieldFn("Interval")},
+		properties.CheckTimeout:            {name: "HealthCheck", transform: extractFieldFn("Timeout")},
+		properties.HealthyThresholdCount:   {name: "HealthCheck", transform: extractFieldFn("HealthyThreshold")},
+		properties.UnhealthyThresholdCount: {name: "HealthCheck", transform: extractFieldFn("UnhealthyThreshold")},
+	},
 	cloud.TargetGroup: {
 		properties.Name:                    {name: "TargetGroupName", transform: extractValueFn},
 		properties.Arn:                     {name: "TargetGroupArn", transform: extractValueFn},
@@ -246,6 +336,28 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
 		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
 	},
+	//Cache
+	cloud.CacheCluster: {
+		properties.Name:                     {name: "CacheClusterId", transform: extractValueFn},
+		properties.AutoUpgrade:              {name: "AutoMinorVersionUpgrade", transform: extractValueFn},
+		properties.Created:                  {name: "CacheClusterCreateTime", transform: extractTimeFn},
+		properties.State:                    {name: "CacheClusterStatus", transform: extractValueFn},
+		properties.Class:                    {name: "CacheNodeType", transform: extractValueFn},
+		properties.CacheSubnetGroup:         {name: "CacheSubnetGroupName", transform: extractValueFn},
+		properties.Engine:                   {name: "Engine", transform: extractValueFn},
+		properties.EngineVersion:            {name: "EngineVersion", transform: extractValueFn},
+		properties.NodeCount:                {name: "NumCacheNodes", transform: extractValueFn},
+		properties.AvailabilityZone:         {name: "PreferredAvailabilityZone", transform: extractValueFn},
+		properties.PreferredMaintenanceDate: {name: "PreferredMaintenanceWindow", transform: extractValueFn},
+		properties.ReplicationGroup:         {name: "ReplicationGroupId", transform: extractValueFn},
+		properties.SecurityGroups:           {name: "SecurityGroups", transform: extractStringSliceValues("SecurityGroupId")},
+	},
+	cloud.CacheSubnetGroup: {
+		properties.Name:        {name: "CacheSubnetGroupName", transform: extractValueFn},
+		properties.Description: {name: "CacheSubnetGroupDescription", transform: extractValueFn},
+		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
+		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		properties.Name:           {name: "LaunchConfigurationName", transform: extractValueFn},
@@ -329,6 +441,103 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.AgentVersion:      {name: "VersionInfo", transform: extractFieldFn("AgentVersion")},
 		properties.DockerVersion:     {name: "VersionInfo", transform: extractFieldFn("DockerVersion")},
 	},
+	// Firewall
+	cloud.WebACL: {
+		properties.Name:          {name: "Name", transform: extractValueFn},
+		properties.Arn:           {name: "ARN", transform: extractValueFn},
+		properties.Description:   {name: "Description", transform: extractValueFn},
+		properties.MetricName:    {name: "VisibilityConfig", transform: extractFieldFn("MetricName")},
+		properties.DefaultAction: {name: "DefaultAction", transform: extractWebACLDefaultActionFn},
+		properties.RuleCount:     {name: "Rules", transform: extractSliceLenFn},
+	},
+	// Certificates
+	cloud.Certificate: {
+		properties.Arn:            {name: "CertificateArn", transform: extractValueFn},
+		properties.Name:           {name: "DomainName", transform: extractValueFn},
+		properties.AlternateNames: {name: "SubjectAlternativeNames", transform: extractStringPointerSliceValues},
+		properties.State:          {name: "Status", transform: extractValueFn},
+		properties.Type:           {name: "Type", transform: extractValueFn},
+		properties.Issuer:         {name: "Issuer", transform: extractValueFn},
+		properties.Created:        {name: "CreatedAt", transform: extractTimeFn},
+		properties.Expires:        {name: "NotAfter", transform: extractTimeFn},
+		properties.InUse:          {fetch: fetchCertificateInUseFn},
+	},
+	// Beanstalk
+	cloud.BeanstalkApplication: {
+		properties.Name:        {name: "ApplicationName", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Versions:    {name: "Versions", transform: extractStringPointerSliceValues},
+		properties.Created:     {name: "DateCreated", transform: extractTimeFn},
+		properties.Modified:    {name: "DateUpdated", transform: extractTimeFn},
+	},
+	cloud.BeanstalkEnvironment: {
+		properties.Name:         {name: "EnvironmentName", transform: extractValueFn},
+		properties.Application:  {name: "ApplicationName", transform: extractValueFn},
+		properties.Description:  {name: "Description", transform: extractValueFn},
+		properties.State:        {name: "Status", transform: extractValueFn},
+		properties.Health:       {name: "Health", transform: extractValueFn},
+		properties.HealthStatus: {name: "HealthStatus", transform: extractValueFn},
+		properties.Version:      {name: "VersionLabel", transform: extractValueFn},
+		properties.Platform:     {name: "SolutionStackName", transform: extractValueFn},
+		properties.Tier:         {name: "Tier", transform: extractFieldFn("Name")},
+		properties.PublicDNS:    {name: "CNAME", transform: extractValueFn},
+		properties.Endpoint:     {name: "EndpointURL", transform: extractValueFn},
+		properties.Created:      {name: "DateCreated", transform: extractTimeFn},
+		properties.Modified:     {name: "DateUpdated", transform: extractTimeFn},
+	},
+	// API Gateway
+	cloud.RestApi: {
+		properties.Name:        {name: "Name", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Version:     {name: "Version", transform: extractValueFn},
+		properties.Created:     {name: "CreatedDate", transform: extractTimeFn},
+		// HTTP and WebSocket APIs only: the protocol of REST APIs is set when fetched
+		properties.Protocol: {name: "ProtocolType", transform: extractValueFn},
+		properties.Endpoint: {name: "ApiEndpoint", transform: extractValueFn},
+	},
+	cloud.ApiStage: {
+		properties.Name:        {name: "StageName", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Deployment:  {name: "DeploymentId", transform: extractValueFn},
+		properties.Created:     {name: "CreatedDate", transform: extractTimeFn},
+		properties.Modified:    {name: "LastUpdatedDate", transform: extractTimeFn},
+	},
+	// SSM
+	cloud.Parameter: {
+		properties.Name:        {name: "Name", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Type:        {name: "Type", transform: extractValueFn},
+		properties.KMSKey:      {name: "KeyId", transform: extractValueFn},
+		properties.Modified:    {name: "LastModifiedDate", transform: extractTimeFn},
+		properties.ModifiedBy:  {name: "LastModifiedUser", transform: extractValueFn},
+	},
+	// KMS
+	cloud.KMSKey: {
+		properties.Arn:         {name: "Arn", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Enabled:     {name: "Enabled", transform: extractValueFn},
+		properties.State:       {name: "KeyState", transform: extractValueFn},
+		properties.Created:     {name: "CreationDate", transform: extractTimeFn},
+	},
+	// GuardDuty
+	cloud.Finding: {
+		properties.Name:        {name: "Title", transform: extractValueFn},
+		properties.Type:        {name: "Type", transform: extractValueFn},
+		properties.Severity:    {name: "Severity", transform: extractFindingSeverityFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Arn:         {name: "Arn", transform: extractValueFn},
+		properties.Created:     {name: "CreatedAt", transform: extractTimeWithZSuffixFn},
+		properties.Modified:    {name: "UpdatedAt", transform: extractTimeWithZSuffixFn},
+	},
+	// Secrets Manager
+	cloud.Secret: {
+		properties.Name:        {name: "Name", transform: extractValueFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Arn:         {name: "ARN", transform: extractValueFn},
+		properties.KMSKey:      {name: "KmsKeyId", transform: extractValueFn},
+		properties.Created:     {name: "CreatedDate", transform: extractTimeFn},
+		properties.Modified:    {name: "LastChangedDate", transform: extractTimeFn},
+	},
 	//IAM
 	cloud.User: {
 		properties.Name:             {name: "UserName", transform: extractValueFn},
@@ -377,6 +586,20 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Size:     {name: "Size", transform: extractValueFn},
 		properties.Class:    {name: "StorageClass", transform: extractValueFn},
 	},
+	cloud.Table: {
+		properties.Arn:           {name: "TableArn", transform: extractValueFn},
+		properties.Name:          {name: "TableName", transform: extractValueFn},
+		properties.State:         {name: "TableStatus", transform: extractValueFn},
+		properties.Created:       {name: "CreationDateTime", transform: extractTimeFn},
+		properties.KeySchema:     {name: "KeySchema", transform: extractKeySchemaFn},
+		properties.BillingMode:   {fetch: fetchTableBillingModeFn},
+		properties.ReadCapacity:  {name: "ProvisionedThroughput", transform: extractFieldFn("ReadCapacityUnits")},
+		properties.WriteCapacity: {name: "ProvisionedThroughput", transform: extractFieldFn("WriteCapacityUnits")},
+		properties.ItemCount:     {name: "ItemCount", transform: extractValueFn},
+		properties.Size:          {name: "TableSizeBytes", transform: extractValueFn},
+		properties.StreamEnabled: {name: "StreamSpecification", transform: extractFieldFn("StreamEnabled")},
+		properties.Stream:        {name: "LatestStreamArn", transform: extractValueFn},
+	},
 	//Notification
 	cloud.Subscription: {
 		properties.Endpoint: {name: "Endpoint", transform: extractValueFn},
@@ -445,6 +668,13 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Updated:                 {name: "StateUpdatedTimestamp", transform: extractValueFn},
 		properties.State:                   {name: "StateValue", transform: extractValueFn},
 	},
+	cloud.LogGroup: {
+		properties.Name:        {name: "LogGroupName", transform: extractValueFn},
+		properties.Arn:         {name: "Arn", transform: extractValueFn},
+		properties.Retention:   {name: "RetentionInDays", transform: extractValueFn},
+		properties.StoredBytes: {name: "StoredBytes", transform: extractValueFn},
+		properties.Created:     {name: "CreationTime", transform: extractMillisecondsTimeFn},
+	},
 	// CDN
 	cloud.Distribution: {
 		properties.Arn:                {name: "ARN", transform: extractValueFn},
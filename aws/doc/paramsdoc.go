@@ -448,4 +448,39 @@ var manualParamsDoc = map[string]map[string]string{
 		"ttl":     "The resource record cache time to live (TTL), in seconds",
 		"comment": "Any comments you want to include about a change batch request",
 	},
+	"waitdatabase": {
+		"id":      "The ID of the RDS Database to wait for",
+		"state":   "The state of the RDS Database to wait for (available | backing-up | creating | deleting | failed | maintenance | modifying | rebooting | renaming | resetting-master-credentials | restore-error | storage-full | upgrading | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitdistribution": {
+		"id":      "The ID of the CloudFront Distribution to wait for",
+		"state":   "The state of the CloudFront Distribution to wait for (Deployed | InProgress | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitinstance": {
+		"id":      "The ID of the EC2 Instance to wait for",
+		"state":   "The state of the EC2 Instance to wait for (pending | running | shutting-down | terminated | stopping | stopped | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitloadbalancer": {
+		"id":      "The ID of the ELBv2 Loadbalancer to wait for",
+		"state":   "The state of the ELBv2 Loadbalancer to wait for (provisioning | active | failed | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitnatgateway": {
+		"id":      "The ID of the NAT Gateway to wait for",
+		"state":   "The state of the NAT Gateway to wait for (provisioning | active | failed | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitsecuritygroup": {
+		"id":      "The ID of the EC2 Security Group to wait for",
+		"state":   "The state of the EC2 Security Group to wait for (unused)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
+	"waitvolume": {
+		"id":      "The ID of the EC2 Volume to wait for",
+		"state":   "The state of the EC2 Volume to wait for (available | in-use | not-found)",
+		"timeout": "The time (in seconds) after which the wait fails the run (default: 180)",
+	},
 }
@@ -0,0 +1,85 @@
+package awsdoc
+
+import "sort"
+
+type scaffold struct {
+	description, text string
+}
+
+func Scaffold(name string) (string, bool) {
+	s, ok := scaffolds[name]
+	return s.text, ok
+}
+
+func ScaffoldDescription(name string) (string, bool) {
+	s, ok := scaffolds[name]
+	return s.description, ok
+}
+
+func ScaffoldNames() (names []string) {
+	for name := range scaffolds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
+
+var scaffolds = map[string]scaffold{
+	"vpc-stack": {
+		description: "VPC with a public subnet routed to the internet through an internet gateway",
+		text: `# Create a VPC with a public subnet routed to the internet.
+#
+# Holes (ex: {vpc.cidr}) are prompted for at run time or can be given inline:
+#   awless run vpc-stack.awls vpc.cidr=10.0.0.0/16 subnet.cidr=10.0.0.0/24
+
+# The VPC: pick a private range, 10.0.0.0/16 leaving room for many subnets
+vpc = create vpc cidr={vpc.cidr} name={vpc.name}
+
+# A subnet within the VPC range. Instances launched in it get a public IP
+subnet = create subnet cidr={subnet.cidr} vpc=$vpc name={subnet.name}
+update subnet id=$subnet public=true
+
+# The internet gateway gives the VPC access to the internet
+gateway = create internetgateway
+attach internetgateway id=$gateway vpc=$vpc
+
+# Route all outbound traffic of the subnet through the gateway
+rtable = create routetable vpc=$vpc
+attach routetable id=$rtable subnet=$subnet
+create route cidr=0.0.0.0/0 gateway=$gateway table=$rtable
+`,
+	},
+	"instance": {
+		description: "Instance reachable through SSH with its own keypair and security group",
+		text: `# Create an instance reachable through SSH.
+#
+# Holes (ex: {instance.subnet}) are prompted for at run time or can be given inline.
+# Image, type and count holes are filled with your awless defaults (see 'awless config').
+
+# Restrict the SSH access to your own IP range rather than 0.0.0.0/0 when possible
+sgroup = create securitygroup vpc={instance.vpc} description={securitygroup.description} name={securitygroup.name}
+update securitygroup id=$sgroup inbound=authorize protocol=tcp cidr={securitygroup.cidr} portrange=22
+
+# The private key is stored locally, keep it safe
+keypair = create keypair name={keypair.name}
+
+create instance subnet={instance.subnet} image={instance.image} type={instance.type} count={instance.count} name={instance.name} keypair=$keypair securitygroup=$sgroup
+`,
+	},
+	"user": {
+		description: "IAM user with readonly access to a service and an access key",
+		text: `# Create an IAM user with a readonly access to a given service.
+#
+# Holes (ex: {user.name}) are prompted for at run time or can be given inline:
+#   awless run user.awls user.name=jsmith policy.service=ec2
+
+create user name={user.name}
+
+# Grant the least privilege needed: 'readonly' rather than 'full' access
+attach policy user={user.name} service={policy.service} access=readonly
+
+# The secret of the access key is only displayed once
+create accesskey user={user.name}
+`,
+	},
+}
@@ -0,0 +1,38 @@
+package awsdoc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/template"
+)
+
+func TestScaffoldsAreValidTemplates(t *testing.T) {
+	for _, name := range ScaffoldNames() {
+		text, _ := Scaffold(name)
+		tpl, err := template.Parse(text)
+		if err != nil {
+			t.Fatalf("scaffold '%s': %s", name, err)
+		}
+
+		env := template.NewEnv()
+		env.DefLookupFunc = awsdriver.AWSLookupDefinitions
+		env.MissingHolesFunc = func(hole string) interface{} {
+			switch {
+			case strings.HasSuffix(hole, ".cidr"):
+				return "10.0.0.0/16"
+			case strings.HasSuffix(hole, ".count"):
+				return 1
+			}
+			return "dummy"
+		}
+		if _, _, err = template.Compile(tpl, env); err != nil {
+			t.Fatalf("scaffold '%s': %s", name, err)
+		}
+
+		if desc, ok := ScaffoldDescription(name); !ok || desc == "" {
+			t.Fatalf("missing description for scaffold '%s'", name)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package awsdriver
+
+// Entities whose create result is an EC2 resource id, that 'create tag' can tag
+var AutoTagEntities = map[string]bool{
+	"vpc":             true,
+	"subnet":          true,
+	"instance":        true,
+	"securitygroup":   true,
+	"volume":          true,
+	"snapshot":        true,
+	"internetgateway": true,
+	"routetable":      true,
+}
+
+func AutoTagEntityFunc(entity string) bool {
+	return AutoTagEntities[entity]
+}
@@ -1198,6 +1198,26 @@ func (d *Ec2Driver) Check_Instance_DryRun(ctx driver.Context, params map[string]
 }
 
 func (d *Ec2Driver) Check_Instance(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.instanceChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *Ec2Driver) Wait_Instance_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Instance_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *Ec2Driver) Wait_Instance(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.instanceChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *Ec2Driver) instanceChecker(params map[string]interface{}) (*checker, error) {
 	input := &ec2.DescribeInstancesInput{}
 
 	// Required params
@@ -1235,7 +1255,7 @@ func (d *Ec2Driver) Check_Instance(ctx driver.Context, params map[string]interfa
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Ec2Driver) Check_Securitygroup_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1267,6 +1287,26 @@ func (d *Ec2Driver) Check_Securitygroup_DryRun(ctx driver.Context, params map[st
 }
 
 func (d *Ec2Driver) Check_Securitygroup(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.securitygroupChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *Ec2Driver) Wait_Securitygroup_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Securitygroup_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *Ec2Driver) Wait_Securitygroup(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.securitygroupChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *Ec2Driver) securitygroupChecker(params map[string]interface{}) (*checker, error) {
 	input := &ec2.DescribeNetworkInterfacesInput{
 		Filters: []*ec2.Filter{
 			{Name: aws.String("group-id"), Values: []*string{aws.String(fmt.Sprint(params["id"]))}},
@@ -1294,7 +1334,7 @@ func (d *Ec2Driver) Check_Securitygroup(ctx driver.Context, params map[string]in
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Ec2Driver) Check_Volume_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1328,6 +1368,26 @@ func (d *Ec2Driver) Check_Volume_DryRun(ctx driver.Context, params map[string]in
 }
 
 func (d *Ec2Driver) Check_Volume(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.volumeChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *Ec2Driver) Wait_Volume_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Volume_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *Ec2Driver) Wait_Volume(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.volumeChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *Ec2Driver) volumeChecker(params map[string]interface{}) (*checker, error) {
 	input := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(fmt.Sprint(params["id"]))},
 	}
@@ -1358,7 +1418,7 @@ func (d *Ec2Driver) Check_Volume(ctx driver.Context, params map[string]interface
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Ec2Driver) Check_Natgateway_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1392,6 +1452,26 @@ func (d *Ec2Driver) Check_Natgateway_DryRun(ctx driver.Context, params map[strin
 }
 
 func (d *Ec2Driver) Check_Natgateway(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.natgatewayChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *Ec2Driver) Wait_Natgateway_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Natgateway_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *Ec2Driver) Wait_Natgateway(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.natgatewayChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *Ec2Driver) natgatewayChecker(params map[string]interface{}) (*checker, error) {
 	input := &ec2.DescribeNatGatewaysInput{}
 
 	// Required params
@@ -1425,7 +1505,7 @@ func (d *Ec2Driver) Check_Natgateway(ctx driver.Context, params map[string]inter
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *RdsDriver) Check_Database_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1471,6 +1551,26 @@ func (d *RdsDriver) Check_Database_DryRun(ctx driver.Context, params map[string]
 }
 
 func (d *RdsDriver) Check_Database(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.databaseChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *RdsDriver) Wait_Database_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Database_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *RdsDriver) Wait_Database(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.databaseChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *RdsDriver) databaseChecker(params map[string]interface{}) (*checker, error) {
 	input := &rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(fmt.Sprint(params["id"])),
 	}
@@ -1503,7 +1603,7 @@ func (d *RdsDriver) Check_Database(ctx driver.Context, params map[string]interfa
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Elbv2Driver) Check_Loadbalancer_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1535,6 +1635,26 @@ func (d *Elbv2Driver) Check_Loadbalancer_DryRun(ctx driver.Context, params map[s
 }
 
 func (d *Elbv2Driver) Check_Loadbalancer(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.loadbalancerChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *Elbv2Driver) Wait_Loadbalancer_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Loadbalancer_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *Elbv2Driver) Wait_Loadbalancer(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.loadbalancerChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *Elbv2Driver) loadbalancerChecker(params map[string]interface{}) (*checker, error) {
 	input := &elbv2.DescribeLoadBalancersInput{}
 
 	// Required params
@@ -1568,7 +1688,7 @@ func (d *Elbv2Driver) Check_Loadbalancer(ctx driver.Context, params map[string]i
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Elbv2Driver) Update_Targetgroup_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -1778,6 +1898,26 @@ func (d *CloudfrontDriver) Check_Distribution_DryRun(ctx driver.Context, params
 }
 
 func (d *CloudfrontDriver) Check_Distribution(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.distributionChecker(params)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.check()
+}
+
+func (d *CloudfrontDriver) Wait_Distribution_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	return d.Check_Distribution_DryRun(ctx, withDefaultWaitTimeout(params))
+}
+
+func (d *CloudfrontDriver) Wait_Distribution(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+	c, err := d.distributionChecker(withDefaultWaitTimeout(params))
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.wait()
+}
+
+func (d *CloudfrontDriver) distributionChecker(params map[string]interface{}) (*checker, error) {
 	input := &cloudfront.GetDistributionInput{}
 
 	// Required params
@@ -1807,7 +1947,7 @@ func (d *CloudfrontDriver) Check_Distribution(ctx driver.Context, params map[str
 		expect: fmt.Sprint(params["state"]),
 		logger: d.logger,
 	}
-	return nil, c.check()
+	return c, nil
 }
 
 func (d *Ec2Driver) Create_Tag_DryRun(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
@@ -3011,7 +3151,54 @@ type checker struct {
 	checkName   string
 }
 
+// Timeout in seconds of wait statements given without explicit timeout
+const defaultWaitTimeout = 180
+
+var errCheckTimeout = errors.New("check timeout")
+
+func withDefaultWaitTimeout(params map[string]interface{}) map[string]interface{} {
+	if _, ok := params["timeout"]; ok {
+		return params
+	}
+	withTimeout := make(map[string]interface{})
+	for k, v := range params {
+		withTimeout[k] = v
+	}
+	withTimeout["timeout"] = defaultWaitTimeout
+	return withTimeout
+}
+
 func (c *checker) check() error {
+	_, err := c.poll(func(got string, _ time.Duration) {
+		c.logger.Infof("%s %s '%s', expect '%s', retry in %s (timeout %s).", c.description, c.checkName, got, c.expect, c.frequency, c.timeout)
+	})
+	switch {
+	case err == errCheckTimeout:
+		return fmt.Errorf("timeout of %s expired", c.timeout)
+	case err != nil:
+		return fmt.Errorf("check %s: %s", c.description, err)
+	}
+	c.logger.Infof("check %s %s '%s' done", c.description, c.checkName, c.expect)
+	return nil
+}
+
+func (c *checker) wait() error {
+	elapsed, err := c.poll(func(got string, elapsed time.Duration) {
+		c.logger.Infof("waiting for %s %s '%s' (currently '%s'): %s elapsed, retry in %s (timeout %s)", c.description, c.checkName, c.expect, got, elapsed, c.frequency, c.timeout)
+	})
+	switch {
+	case err == errCheckTimeout:
+		return fmt.Errorf("wait %s: %s '%s' not reached after %s", c.description, c.checkName, c.expect, elapsed)
+	case err != nil:
+		return fmt.Errorf("wait %s: %s", c.description, err)
+	}
+	c.logger.Infof("%s %s '%s' reached after %s", c.description, c.checkName, c.expect, elapsed)
+	return nil
+}
+
+func (c *checker) poll(onRetry func(got string, elapsed time.Duration)) (time.Duration, error) {
+	start := time.Now()
+	elapsed := func() time.Duration { return (time.Since(start) + time.Second/2) / time.Second * time.Second }
 	timer := time.NewTimer(c.timeout)
 	if c.checkName == "" {
 		c.checkName = "status"
@@ -3020,18 +3207,17 @@ func (c *checker) check() error {
 	for {
 		select {
 		case <-timer.C:
-			return fmt.Errorf("timeout of %s expired", c.timeout)
+			return elapsed(), errCheckTimeout
 		default:
 		}
 		got, err := c.fetchFunc()
 		if err != nil {
-			return fmt.Errorf("check %s: %s", c.description, err)
+			return elapsed(), err
 		}
 		if got == c.expect {
-			c.logger.Infof("check %s %s '%s' done", c.description, c.checkName, c.expect)
-			return nil
+			return elapsed(), nil
 		}
-		c.logger.Infof("%s %s '%s', expect '%s', retry in %s (timeout %s).", c.description, c.checkName, got, c.expect, c.frequency, c.timeout)
+		onRetry(got, elapsed())
 		time.Sleep(c.frequency)
 	}
 }
@@ -18,9 +18,12 @@ package awsdriver
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -30,6 +33,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
 )
 
@@ -53,6 +57,16 @@ func TestLookupFn(t *testing.T) {
 			t.Fatalf("got %v, want %v", got, want)
 		}
 	})
+	t.Run("Wait function", func(t *testing.T) {
+		driv.SetDryRun(false)
+		driverFn, err := driv.Lookup("wait", "instance")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := reflect.ValueOf(driverFn).Pointer(), reflect.ValueOf(driv.(*Ec2Driver).Wait_Instance).Pointer(); got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
 	t.Run("unKnown function", func(t *testing.T) {
 		_, err := driv.Lookup("unknown", "function")
 		if got, want := err, driver.ErrDriverFnNotFound; got != want {
@@ -157,6 +171,64 @@ func TestDriver(t *testing.T) {
 	})
 }
 
+func TestChecker(t *testing.T) {
+	newChecker := func(states ...string) *checker {
+		var calls int
+		return &checker{
+			description: "instance i-1234",
+			timeout:     50 * time.Millisecond,
+			frequency:   time.Millisecond,
+			fetchFunc: func() (string, error) {
+				if calls >= len(states) {
+					return states[len(states)-1], nil
+				}
+				calls++
+				return states[calls-1], nil
+			},
+			expect: "running",
+			logger: logger.DiscardLogger,
+		}
+	}
+
+	t.Run("state reached", func(t *testing.T) {
+		if err := newChecker("pending", "pending", "running").check(); err != nil {
+			t.Fatal(err)
+		}
+		if err := newChecker("pending", "pending", "running").wait(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("timeout", func(t *testing.T) {
+		err := newChecker("pending").check()
+		if err == nil {
+			t.Fatal("expected error got none")
+		}
+		if got, want := err.Error(), "timeout of 50ms expired"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		err = newChecker("pending").wait()
+		if err == nil {
+			t.Fatal("expected error got none")
+		}
+		if got, want := err.Error(), "wait instance i-1234: status 'running' not reached after"; !strings.HasPrefix(got, want) {
+			t.Fatalf("got %s, want prefix %s", got, want)
+		}
+	})
+	t.Run("default wait timeout", func(t *testing.T) {
+		params := map[string]interface{}{"id": "i-1234", "state": "running"}
+		if got, want := withDefaultWaitTimeout(params)["timeout"], 180; got != want {
+			t.Fatalf("got %v, want %d", got, want)
+		}
+		if _, ok := params["timeout"]; ok {
+			t.Fatal("expected given params to be left untouched")
+		}
+		params["timeout"] = 20
+		if got, want := withDefaultWaitTimeout(params)["timeout"], 20; got != want {
+			t.Fatalf("got %v, want %d", got, want)
+		}
+	})
+}
+
 func TestBuildIpPermissionsFromParams(t *testing.T) {
 	params := map[string]interface{}{
 		"protocol":  "tcp",
@@ -295,5 +367,5 @@ func (m *mockEc2) CreateTags(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput,
 }
 
 func (m *mockEc2) CreateTagsRequest(input *ec2.CreateTagsInput) (*request.Request, *ec2.CreateTagsOutput) {
-	return &request.Request{Error: m.verifyTagInput(input)}, &ec2.CreateTagsOutput{}
+	return &request.Request{Error: m.verifyTagInput(input), HTTPRequest: &http.Request{}}, &ec2.CreateTagsOutput{}
 }
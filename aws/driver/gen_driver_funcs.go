@@ -3604,7 +3604,7 @@ func (d *RdsDriver) Delete_Database(ctx driver.Context, params map[string]interf
 		}
 	}
 	if _, ok := params["snapshot"]; ok {
-		err = setFieldWithType(params["snapshot"], input, "FinalDBSnapshotIdentifier", awsbool, ctx)
+		err = setFieldWithType(params["snapshot"], input, "FinalDBSnapshotIdentifier", awsstr, ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -20,23 +20,36 @@ package awsdriver
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
 )
@@ -122,6 +135,12 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Check_Instance, nil
 
+	case "waitinstance":
+		if d.dryRun {
+			return d.Wait_Instance_DryRun, nil
+		}
+		return d.Wait_Instance, nil
+
 	case "attachinstanceprofile":
 		if d.dryRun {
 			return d.Attach_Instanceprofile_DryRun, nil
@@ -158,6 +177,12 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Check_Securitygroup, nil
 
+	case "waitsecuritygroup":
+		if d.dryRun {
+			return d.Wait_Securitygroup_DryRun, nil
+		}
+		return d.Wait_Securitygroup, nil
+
 	case "attachsecuritygroup":
 		if d.dryRun {
 			return d.Attach_Securitygroup_DryRun, nil
@@ -200,6 +225,12 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Check_Volume, nil
 
+	case "waitvolume":
+		if d.dryRun {
+			return d.Wait_Volume_DryRun, nil
+		}
+		return d.Wait_Volume, nil
+
 	case "deletevolume":
 		if d.dryRun {
 			return d.Delete_Volume_DryRun, nil
@@ -278,6 +309,12 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Check_Natgateway, nil
 
+	case "waitnatgateway":
+		if d.dryRun {
+			return d.Wait_Natgateway_DryRun, nil
+		}
+		return d.Wait_Natgateway, nil
+
 	case "createroutetable":
 		if d.dryRun {
 			return d.Create_Routetable_DryRun, nil
@@ -400,6 +437,12 @@ func (d *Elbv2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err e
 		}
 		return d.Check_Loadbalancer, nil
 
+	case "waitloadbalancer":
+		if d.dryRun {
+			return d.Wait_Loadbalancer_DryRun, nil
+		}
+		return d.Wait_Loadbalancer, nil
+
 	case "createlistener":
 		if d.dryRun {
 			return d.Create_Listener_DryRun, nil
@@ -548,6 +591,12 @@ func (d *RdsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Check_Database, nil
 
+	case "waitdatabase":
+		if d.dryRun {
+			return d.Wait_Database_DryRun, nil
+		}
+		return d.Wait_Database, nil
+
 	case "createdbsubnetgroup":
 		if d.dryRun {
 			return d.Create_Dbsubnetgroup_DryRun, nil
@@ -691,6 +740,226 @@ func (d *StsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 	}
 }
 
+type Wafv2Driver struct {
+	dryRun bool
+	logger *logger.Logger
+	wafv2iface.WAFV2API
+}
+
+func (d *Wafv2Driver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *Wafv2Driver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewWafv2Driver(api wafv2iface.WAFV2API) driver.Driver {
+	return &Wafv2Driver{false, logger.DiscardLogger, api}
+}
+
+func (d *Wafv2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type AcmDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	acmiface.ACMAPI
+}
+
+func (d *AcmDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *AcmDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewAcmDriver(api acmiface.ACMAPI) driver.Driver {
+	return &AcmDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *AcmDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type ElasticbeanstalkDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	elasticbeanstalkiface.ElasticBeanstalkAPI
+}
+
+func (d *ElasticbeanstalkDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *ElasticbeanstalkDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewElasticbeanstalkDriver(api elasticbeanstalkiface.ElasticBeanstalkAPI) driver.Driver {
+	return &ElasticbeanstalkDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *ElasticbeanstalkDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type ApigatewayDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	apigatewayiface.APIGatewayAPI
+}
+
+func (d *ApigatewayDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *ApigatewayDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewApigatewayDriver(api apigatewayiface.APIGatewayAPI) driver.Driver {
+	return &ApigatewayDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *ApigatewayDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type Apigatewayv2Driver struct {
+	dryRun bool
+	logger *logger.Logger
+	apigatewayv2iface.ApiGatewayV2API
+}
+
+func (d *Apigatewayv2Driver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *Apigatewayv2Driver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewApigatewayv2Driver(api apigatewayv2iface.ApiGatewayV2API) driver.Driver {
+	return &Apigatewayv2Driver{false, logger.DiscardLogger, api}
+}
+
+func (d *Apigatewayv2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type ElbDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	elbiface.ELBAPI
+}
+
+func (d *ElbDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *ElbDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewElbDriver(api elbiface.ELBAPI) driver.Driver {
+	return &ElbDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *ElbDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type ElasticacheDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	elasticacheiface.ElastiCacheAPI
+}
+
+func (d *ElasticacheDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *ElasticacheDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewElasticacheDriver(api elasticacheiface.ElastiCacheAPI) driver.Driver {
+	return &ElasticacheDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *ElasticacheDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type SsmDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	ssmiface.SSMAPI
+}
+
+func (d *SsmDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *SsmDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewSsmDriver(api ssmiface.SSMAPI) driver.Driver {
+	return &SsmDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *SsmDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type KmsDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	kmsiface.KMSAPI
+}
+
+func (d *KmsDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *KmsDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewKmsDriver(api kmsiface.KMSAPI) driver.Driver {
+	return &KmsDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *KmsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type GuarddutyDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	guarddutyiface.GuardDutyAPI
+}
+
+func (d *GuarddutyDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *GuarddutyDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewGuarddutyDriver(api guarddutyiface.GuardDutyAPI) driver.Driver {
+	return &GuarddutyDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *GuarddutyDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type SecretsmanagerDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	secretsmanageriface.SecretsManagerAPI
+}
+
+func (d *SecretsmanagerDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *SecretsmanagerDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewSecretsmanagerDriver(api secretsmanageriface.SecretsManagerAPI) driver.Driver {
+	return &SecretsmanagerDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *SecretsmanagerDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
 type IamDriver struct {
 	dryRun bool
 	logger *logger.Logger
@@ -893,6 +1162,26 @@ func (d *S3Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err erro
 	}
 }
 
+type DynamodbDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	dynamodbiface.DynamoDBAPI
+}
+
+func (d *DynamodbDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *DynamodbDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewDynamodbDriver(api dynamodbiface.DynamoDBAPI) driver.Driver {
+	return &DynamodbDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *DynamodbDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
 type SnsDriver struct {
 	dryRun bool
 	logger *logger.Logger
@@ -1107,6 +1396,26 @@ func (d *CloudwatchDriver) Lookup(lookups ...string) (driverFn driver.DriverFn,
 	}
 }
 
+type CloudwatchlogsDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	cloudwatchlogsiface.CloudWatchLogsAPI
+}
+
+func (d *CloudwatchlogsDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *CloudwatchlogsDriver) SetLogger(l *logger.Logger) { d.logger = l }
+func NewCloudwatchlogsDriver(api cloudwatchlogsiface.CloudWatchLogsAPI) driver.Driver {
+	return &CloudwatchlogsDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *CloudwatchlogsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
 type CloudfrontDriver struct {
 	dryRun bool
 	logger *logger.Logger
@@ -1134,6 +1443,12 @@ func (d *CloudfrontDriver) Lookup(lookups ...string) (driverFn driver.DriverFn,
 		}
 		return d.Check_Distribution, nil
 
+	case "waitdistribution":
+		if d.dryRun {
+			return d.Wait_Distribution_DryRun, nil
+		}
+		return d.Wait_Distribution, nil
+
 	case "updatedistribution":
 		if d.dryRun {
 			return d.Update_Distribution_DryRun, nil
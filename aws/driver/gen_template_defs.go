@@ -33,12 +33,14 @@ var APIPerTemplateDefName = map[string]string{
 	"startinstance":             "ec2",
 	"stopinstance":              "ec2",
 	"checkinstance":             "ec2",
+	"waitinstance":              "ec2",
 	"attachinstanceprofile":     "ec2",
 	"detachinstanceprofile":     "ec2",
 	"createsecuritygroup":       "ec2",
 	"updatesecuritygroup":       "ec2",
 	"deletesecuritygroup":       "ec2",
 	"checksecuritygroup":        "ec2",
+	"waitsecuritygroup":         "ec2",
 	"attachsecuritygroup":       "ec2",
 	"detachsecuritygroup":       "ec2",
 	"copyimage":                 "ec2",
@@ -46,6 +48,7 @@ var APIPerTemplateDefName = map[string]string{
 	"deleteimage":               "ec2",
 	"createvolume":              "ec2",
 	"checkvolume":               "ec2",
+	"waitvolume":                "ec2",
 	"deletevolume":              "ec2",
 	"attachvolume":              "ec2",
 	"detachvolume":              "ec2",
@@ -59,6 +62,7 @@ var APIPerTemplateDefName = map[string]string{
 	"createnatgateway":          "ec2",
 	"deletenatgateway":          "ec2",
 	"checknatgateway":           "ec2",
+	"waitnatgateway":            "ec2",
 	"createroutetable":          "ec2",
 	"deleteroutetable":          "ec2",
 	"attachroutetable":          "ec2",
@@ -76,6 +80,7 @@ var APIPerTemplateDefName = map[string]string{
 	"createloadbalancer":        "elbv2",
 	"deleteloadbalancer":        "elbv2",
 	"checkloadbalancer":         "elbv2",
+	"waitloadbalancer":          "elbv2",
 	"createlistener":            "elbv2",
 	"deletelistener":            "elbv2",
 	"createtargetgroup":         "elbv2",
@@ -94,6 +99,7 @@ var APIPerTemplateDefName = map[string]string{
 	"createdatabase":            "rds",
 	"deletedatabase":            "rds",
 	"checkdatabase":             "rds",
+	"waitdatabase":              "rds",
 	"createdbsubnetgroup":       "rds",
 	"deletedbsubnetgroup":       "rds",
 	"createrepository":          "ecr",
@@ -155,6 +161,7 @@ var APIPerTemplateDefName = map[string]string{
 	"detachalarm":               "cloudwatch",
 	"createdistribution":        "cloudfront",
 	"checkdistribution":         "cloudfront",
+	"waitdistribution":          "cloudfront",
 	"updatedistribution":        "cloudfront",
 	"deletedistribution":        "cloudfront",
 	"createstack":               "cloudformation",
@@ -166,6 +173,152 @@ var APIPerTemplateDefName = map[string]string{
 	"deleteappscalingpolicy":    "applicationautoscaling",
 }
 
+// IAM actions invoked by the template definitions not manually defined
+var IAMActionPerTemplateDefName = map[string]string{
+	"createvpc":                 "ec2:CreateVpc",
+	"deletevpc":                 "ec2:DeleteVpc",
+	"createsubnet":              "ec2:CreateSubnet",
+	"updatesubnet":              "ec2:ModifySubnetAttribute",
+	"deletesubnet":              "ec2:DeleteSubnet",
+	"createinstance":            "ec2:RunInstances",
+	"updateinstance":            "ec2:ModifyInstanceAttribute",
+	"deleteinstance":            "ec2:TerminateInstances",
+	"startinstance":             "ec2:StartInstances",
+	"stopinstance":              "ec2:StopInstances",
+	"createsecuritygroup":       "ec2:CreateSecurityGroup",
+	"deletesecuritygroup":       "ec2:DeleteSecurityGroup",
+	"copyimage":                 "ec2:CopyImage",
+	"importimage":               "ec2:ImportImage",
+	"createvolume":              "ec2:CreateVolume",
+	"deletevolume":              "ec2:DeleteVolume",
+	"attachvolume":              "ec2:AttachVolume",
+	"detachvolume":              "ec2:DetachVolume",
+	"createsnapshot":            "ec2:CreateSnapshot",
+	"deletesnapshot":            "ec2:DeleteSnapshot",
+	"copysnapshot":              "ec2:CopySnapshot",
+	"createinternetgateway":     "ec2:CreateInternetGateway",
+	"deleteinternetgateway":     "ec2:DeleteInternetGateway",
+	"attachinternetgateway":     "ec2:AttachInternetGateway",
+	"detachinternetgateway":     "ec2:DetachInternetGateway",
+	"createnatgateway":          "ec2:CreateNatGateway",
+	"deletenatgateway":          "ec2:DeleteNatGateway",
+	"createroutetable":          "ec2:CreateRouteTable",
+	"deleteroutetable":          "ec2:DeleteRouteTable",
+	"attachroutetable":          "ec2:AssociateRouteTable",
+	"detachroutetable":          "ec2:DisassociateRouteTable",
+	"createroute":               "ec2:CreateRoute",
+	"deleteroute":               "ec2:DeleteRoute",
+	"deletekeypair":             "ec2:DeleteKeyPair",
+	"createelasticip":           "ec2:AllocateAddress",
+	"deleteelasticip":           "ec2:ReleaseAddress",
+	"attachelasticip":           "ec2:AssociateAddress",
+	"detachelasticip":           "ec2:DisassociateAddress",
+	"createloadbalancer":        "elasticloadbalancing:CreateLoadBalancer",
+	"deleteloadbalancer":        "elasticloadbalancing:DeleteLoadBalancer",
+	"createlistener":            "elasticloadbalancing:CreateListener",
+	"deletelistener":            "elasticloadbalancing:DeleteListener",
+	"createtargetgroup":         "elasticloadbalancing:CreateTargetGroup",
+	"deletetargetgroup":         "elasticloadbalancing:DeleteTargetGroup",
+	"attachinstance":            "elasticloadbalancing:RegisterTargets",
+	"detachinstance":            "elasticloadbalancing:DeregisterTargets",
+	"createlaunchconfiguration": "autoscaling:CreateLaunchConfiguration",
+	"deletelaunchconfiguration": "autoscaling:DeleteLaunchConfiguration",
+	"createscalinggroup":        "autoscaling:CreateAutoScalingGroup",
+	"updatescalinggroup":        "autoscaling:UpdateAutoScalingGroup",
+	"deletescalinggroup":        "autoscaling:DeleteAutoScalingGroup",
+	"createscalingpolicy":       "autoscaling:PutScalingPolicy",
+	"deletescalingpolicy":       "autoscaling:DeletePolicy",
+	"createdatabase":            "rds:CreateDBInstance",
+	"deletedatabase":            "rds:DeleteDBInstance",
+	"createdbsubnetgroup":       "rds:CreateDBSubnetGroup",
+	"deletedbsubnetgroup":       "rds:DeleteDBSubnetGroup",
+	"createrepository":          "ecr:CreateRepository",
+	"deleterepository":          "ecr:DeleteRepository",
+	"createcontainercluster":    "ecs:CreateCluster",
+	"deletecontainercluster":    "ecs:DeleteCluster",
+	"updatecontainertask":       "ecs:UpdateService",
+	"createuser":                "iam:CreateUser",
+	"deleteuser":                "iam:DeleteUser",
+	"attachuser":                "iam:AddUserToGroup",
+	"detachuser":                "iam:RemoveUserFromGroup",
+	"deleteaccesskey":           "iam:DeleteAccessKey",
+	"createloginprofile":        "iam:CreateLoginProfile",
+	"updateloginprofile":        "iam:UpdateLoginProfile",
+	"deleteloginprofile":        "iam:DeleteLoginProfile",
+	"creategroup":               "iam:CreateGroup",
+	"deletegroup":               "iam:DeleteGroup",
+	"attachrole":                "iam:AddRoleToInstanceProfile",
+	"detachrole":                "iam:RemoveRoleFromInstanceProfile",
+	"createinstanceprofile":     "iam:CreateInstanceProfile",
+	"deleteinstanceprofile":     "iam:DeleteInstanceProfile",
+	"deletepolicy":              "iam:DeletePolicy",
+	"createbucket":              "s3:CreateBucket",
+	"deletebucket":              "s3:DeleteBucket",
+	"updates3object":            "s3:PutObjectAcl",
+	"deletes3object":            "s3:DeleteObject",
+	"createtopic":               "sns:CreateTopic",
+	"deletetopic":               "sns:DeleteTopic",
+	"createsubscription":        "sns:Subscribe",
+	"deletesubscription":        "sns:Unsubscribe",
+	"createqueue":               "sqs:CreateQueue",
+	"deletequeue":               "sqs:DeleteQueue",
+	"createzone":                "route53:CreateHostedZone",
+	"deletezone":                "route53:DeleteHostedZone",
+	"createfunction":            "lambda:CreateFunction",
+	"deletefunction":            "lambda:DeleteFunction",
+	"createalarm":               "cloudwatch:PutMetricAlarm",
+	"deletealarm":               "cloudwatch:DeleteAlarms",
+	"startalarm":                "cloudwatch:EnableAlarmActions",
+	"stopalarm":                 "cloudwatch:DisableAlarmActions",
+	"createstack":               "cloudformation:CreateStack",
+	"updatestack":               "cloudformation:UpdateStack",
+	"deletestack":               "cloudformation:DeleteStack",
+	"createappscalingtarget":    "application-autoscaling:RegisterScalableTarget",
+	"deleteappscalingtarget":    "application-autoscaling:DeregisterScalableTarget",
+	"createappscalingpolicy":    "application-autoscaling:PutScalingPolicy",
+	"deleteappscalingpolicy":    "application-autoscaling:DeleteScalingPolicy",
+}
+
+// Template definitions whose dry run invokes the AWS API with DryRun,
+// hence checking the permissions of the caller
+var APIDryRunTemplateDefNames = map[string]bool{
+	"createvpc":             true,
+	"deletevpc":             true,
+	"createsubnet":          true,
+	"deletesubnet":          true,
+	"createinstance":        true,
+	"updateinstance":        true,
+	"deleteinstance":        true,
+	"startinstance":         true,
+	"stopinstance":          true,
+	"createsecuritygroup":   true,
+	"deletesecuritygroup":   true,
+	"copyimage":             true,
+	"importimage":           true,
+	"createvolume":          true,
+	"deletevolume":          true,
+	"attachvolume":          true,
+	"detachvolume":          true,
+	"createsnapshot":        true,
+	"deletesnapshot":        true,
+	"copysnapshot":          true,
+	"createinternetgateway": true,
+	"deleteinternetgateway": true,
+	"attachinternetgateway": true,
+	"detachinternetgateway": true,
+	"createroutetable":      true,
+	"deleteroutetable":      true,
+	"attachroutetable":      true,
+	"detachroutetable":      true,
+	"createroute":           true,
+	"deleteroute":           true,
+	"deletekeypair":         true,
+	"createelasticip":       true,
+	"deleteelasticip":       true,
+	"attachelasticip":       true,
+	"detachelasticip":       true,
+}
+
 var AWSTemplatesDefinitions = map[string]template.Definition{
 	"createvpc": {
 		Action:         "create",
@@ -173,6 +326,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr"},
 		ExtraParams:    []string{"name"},
+		ParamTypes:     map[string]template.ParamType{"cidr": {Kind: "cidr"}},
 	},
 	"deletevpc": {
 		Action:         "delete",
@@ -187,6 +341,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "vpc"},
 		ExtraParams:    []string{"availabilityzone", "name"},
+		ParamTypes:     map[string]template.ParamType{"cidr": {Kind: "cidr"}},
 	},
 	"updatesubnet": {
 		Action:         "update",
@@ -194,6 +349,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"public"},
+		ParamTypes:     map[string]template.ParamType{"public": {Kind: "bool"}},
 	},
 	"deletesubnet": {
 		Action:         "delete",
@@ -208,6 +364,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"count", "image", "name", "subnet", "type"},
 		ExtraParams:    []string{"ip", "keypair", "lock", "role", "securitygroup", "userdata"},
+		ParamTypes:     map[string]template.ParamType{"count": {Kind: "int"}, "lock": {Kind: "bool"}},
 	},
 	"updateinstance": {
 		Action:         "update",
@@ -215,6 +372,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"lock", "type"},
+		ParamTypes:     map[string]template.ParamType{"lock": {Kind: "bool"}},
 	},
 	"deleteinstance": {
 		Action:         "delete",
@@ -244,6 +402,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitinstance": {
+		Action:         "wait",
+		Entity:         "instance",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"attachinstanceprofile": {
 		Action:         "attach",
 		Entity:         "instanceprofile",
@@ -271,6 +436,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "id", "protocol"},
 		ExtraParams:    []string{"inbound", "outbound", "portrange"},
+		ParamTypes:     map[string]template.ParamType{"cidr": {Kind: "cidr"}, "inbound": {Kind: "enum", Values: []string{"authorize", "revoke"}}, "outbound": {Kind: "enum", Values: []string{"authorize", "revoke"}}},
 	},
 	"deletesecuritygroup": {
 		Action:         "delete",
@@ -286,6 +452,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitsecuritygroup": {
+		Action:         "wait",
+		Entity:         "securitygroup",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"attachsecuritygroup": {
 		Action:         "attach",
 		Entity:         "securitygroup",
@@ -306,6 +479,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"name", "source-id", "source-region"},
 		ExtraParams:    []string{"description", "encrypted"},
+		ParamTypes:     map[string]template.ParamType{"encrypted": {Kind: "bool"}},
 	},
 	"importimage": {
 		Action:         "import",
@@ -327,6 +501,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"availabilityzone", "size"},
 		ExtraParams:    []string{},
+		ParamTypes:     map[string]template.ParamType{"size": {Kind: "int"}},
 	},
 	"checkvolume": {
 		Action:         "check",
@@ -335,6 +510,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitvolume": {
+		Action:         "wait",
+		Entity:         "volume",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"deletevolume": {
 		Action:         "delete",
 		Entity:         "volume",
@@ -355,6 +537,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"device", "id", "instance"},
 		ExtraParams:    []string{"force"},
+		ParamTypes:     map[string]template.ParamType{"force": {Kind: "bool"}},
 	},
 	"createsnapshot": {
 		Action:         "create",
@@ -376,6 +559,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"source-id", "source-region"},
 		ExtraParams:    []string{"description", "encrypted"},
+		ParamTypes:     map[string]template.ParamType{"encrypted": {Kind: "bool"}},
 	},
 	"createinternetgateway": {
 		Action:         "create",
@@ -426,6 +610,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitnatgateway": {
+		Action:         "wait",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"createroutetable": {
 		Action:         "create",
 		Entity:         "routetable",
@@ -460,6 +651,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "gateway", "table"},
 		ExtraParams:    []string{},
+		ParamTypes:     map[string]template.ParamType{"cidr": {Kind: "cidr"}},
 	},
 	"deleteroute": {
 		Action:         "delete",
@@ -467,6 +659,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "table"},
 		ExtraParams:    []string{},
+		ParamTypes:     map[string]template.ParamType{"cidr": {Kind: "cidr"}},
 	},
 	"createtag": {
 		Action:         "create",
@@ -516,6 +709,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"allow-reassociation", "instance", "networkinterface", "privateip"},
+		ParamTypes:     map[string]template.ParamType{"allow-reassociation": {Kind: "bool"}},
 	},
 	"detachelasticip": {
 		Action:         "detach",
@@ -545,12 +739,20 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitloadbalancer": {
+		Action:         "wait",
+		Entity:         "loadbalancer",
+		Api:            "elbv2",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"createlistener": {
 		Action:         "create",
 		Entity:         "listener",
 		Api:            "elbv2",
 		RequiredParams: []string{"actiontype", "loadbalancer", "port", "protocol", "targetgroup"},
 		ExtraParams:    []string{"certificate", "sslpolicy"},
+		ParamTypes:     map[string]template.ParamType{"port": {Kind: "int"}},
 	},
 	"deletelistener": {
 		Action:         "delete",
@@ -565,6 +767,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"name", "port", "protocol", "vpc"},
 		ExtraParams:    []string{"healthcheckinterval", "healthcheckpath", "healthcheckport", "healthcheckprotocol", "healthchecktimeout", "healthythreshold", "matcher", "unhealthythreshold"},
+		ParamTypes:     map[string]template.ParamType{"healthcheckinterval": {Kind: "int"}, "healthchecktimeout": {Kind: "int"}, "healthythreshold": {Kind: "int"}, "port": {Kind: "int"}, "unhealthythreshold": {Kind: "int"}},
 	},
 	"updatetargetgroup": {
 		Action:         "update",
@@ -572,6 +775,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "elbv2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"deregistrationdelay", "healthcheckinterval", "healthcheckpath", "healthcheckport", "healthcheckprotocol", "healthchecktimeout", "healthythreshold", "matcher", "stickiness", "stickinessduration", "unhealthythreshold"},
+		ParamTypes:     map[string]template.ParamType{"healthcheckinterval": {Kind: "int"}, "healthchecktimeout": {Kind: "int"}, "healthythreshold": {Kind: "int"}, "unhealthythreshold": {Kind: "int"}},
 	},
 	"deletetargetgroup": {
 		Action:         "delete",
@@ -600,6 +804,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "autoscaling",
 		RequiredParams: []string{"image", "name", "type"},
 		ExtraParams:    []string{"keypair", "public", "role", "securitygroups", "spotprice", "userdata"},
+		ParamTypes:     map[string]template.ParamType{"public": {Kind: "bool"}},
 	},
 	"deletelaunchconfiguration": {
 		Action:         "delete",
@@ -614,6 +819,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "autoscaling",
 		RequiredParams: []string{"launchconfiguration", "max-size", "min-size", "name", "subnets"},
 		ExtraParams:    []string{"cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "new-instances-protected", "targetgroups"},
+		ParamTypes:     map[string]template.ParamType{"cooldown": {Kind: "int"}, "desired-capacity": {Kind: "int"}, "healthcheck-grace-period": {Kind: "int"}, "max-size": {Kind: "int"}, "min-size": {Kind: "int"}, "new-instances-protected": {Kind: "bool"}},
 	},
 	"updatescalinggroup": {
 		Action:         "update",
@@ -621,6 +827,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "autoscaling",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "launchconfiguration", "max-size", "min-size", "new-instances-protected", "subnets"},
+		ParamTypes:     map[string]template.ParamType{"cooldown": {Kind: "int"}, "desired-capacity": {Kind: "int"}, "healthcheck-grace-period": {Kind: "int"}, "max-size": {Kind: "int"}, "min-size": {Kind: "int"}, "new-instances-protected": {Kind: "bool"}},
 	},
 	"deletescalinggroup": {
 		Action:         "delete",
@@ -628,6 +835,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "autoscaling",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"force"},
+		ParamTypes:     map[string]template.ParamType{"force": {Kind: "bool"}},
 	},
 	"checkscalinggroup": {
 		Action:         "check",
@@ -642,6 +850,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "autoscaling",
 		RequiredParams: []string{"adjustment-scaling", "adjustment-type", "name", "scalinggroup"},
 		ExtraParams:    []string{"adjustment-magnitude", "cooldown"},
+		ParamTypes:     map[string]template.ParamType{"adjustment-magnitude": {Kind: "int"}, "adjustment-scaling": {Kind: "int"}, "cooldown": {Kind: "int"}},
 	},
 	"deletescalingpolicy": {
 		Action:         "delete",
@@ -656,6 +865,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "rds",
 		RequiredParams: []string{"engine", "id", "password", "size", "type", "username"},
 		ExtraParams:    []string{"autoupgrade", "availabilityzone", "backupretention", "backupwindow", "cluster", "dbname", "dbsecuritygroups", "domain", "encrypted", "iamrole", "iops", "license", "maintenancewindow", "multiaz", "optiongroup", "parametergroup", "port", "public", "storagetype", "subnetgroup", "timezone", "version", "vpcsecuritygroups"},
+		ParamTypes:     map[string]template.ParamType{"autoupgrade": {Kind: "bool"}, "backupretention": {Kind: "int"}, "encrypted": {Kind: "bool"}, "iops": {Kind: "int"}, "multiaz": {Kind: "bool"}, "port": {Kind: "int"}, "public": {Kind: "bool"}, "size": {Kind: "int"}},
 	},
 	"deletedatabase": {
 		Action:         "delete",
@@ -663,6 +873,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "rds",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"skip-snapshot", "snapshot"},
+		ParamTypes:     map[string]template.ParamType{"skip-snapshot": {Kind: "bool"}},
 	},
 	"checkdatabase": {
 		Action:         "check",
@@ -671,6 +882,13 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitdatabase": {
+		Action:         "wait",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"createdbsubnetgroup": {
 		Action:         "create",
 		Entity:         "dbsubnetgroup",
@@ -698,6 +916,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ecr",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"account", "force"},
+		ParamTypes:     map[string]template.ParamType{"force": {Kind: "bool"}},
 	},
 	"authenticateregistry": {
 		Action:         "authenticate",
@@ -740,6 +959,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "ecs",
 		RequiredParams: []string{"cluster", "deployment-name"},
 		ExtraParams:    []string{"desired-count", "name"},
+		ParamTypes:     map[string]template.ParamType{"desired-count": {Kind: "int"}},
 	},
 	"attachcontainertask": {
 		Action:         "attach",
@@ -810,6 +1030,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"password", "username"},
 		ExtraParams:    []string{"password-reset"},
+		ParamTypes:     map[string]template.ParamType{"password-reset": {Kind: "bool"}},
 	},
 	"updateloginprofile": {
 		Action:         "update",
@@ -817,6 +1038,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "iam",
 		RequiredParams: []string{"password", "username"},
 		ExtraParams:    []string{"password-reset"},
+		ParamTypes:     map[string]template.ParamType{"password-reset": {Kind: "bool"}},
 	},
 	"deleteloginprofile": {
 		Action:         "delete",
@@ -999,6 +1221,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "route53",
 		RequiredParams: []string{"callerreference", "name"},
 		ExtraParams:    []string{"comment", "delegationsetid", "isprivate", "vpcid", "vpcregion"},
+		ParamTypes:     map[string]template.ParamType{"isprivate": {Kind: "bool"}},
 	},
 	"deletezone": {
 		Action:         "delete",
@@ -1034,6 +1257,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "lambda",
 		RequiredParams: []string{"handler", "name", "role", "runtime"},
 		ExtraParams:    []string{"bucket", "description", "memory", "object", "objectversion", "publish", "timeout", "zipfile"},
+		ParamTypes:     map[string]template.ParamType{"memory": {Kind: "int"}, "publish": {Kind: "bool"}, "timeout": {Kind: "int"}},
 	},
 	"deletefunction": {
 		Action:         "delete",
@@ -1048,6 +1272,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "cloudwatch",
 		RequiredParams: []string{"evaluation-periods", "metric", "name", "namespace", "operator", "period", "statistic-function", "threshold"},
 		ExtraParams:    []string{"alarm-actions", "description", "dimensions", "enabled", "insufficientdata-actions", "ok-actions", "unit"},
+		ParamTypes:     map[string]template.ParamType{"enabled": {Kind: "bool"}, "evaluation-periods": {Kind: "int"}, "period": {Kind: "int"}},
 	},
 	"deletealarm": {
 		Action:         "delete",
@@ -1098,12 +1323,20 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 	},
+	"waitdistribution": {
+		Action:         "wait",
+		Entity:         "distribution",
+		Api:            "cloudfront",
+		RequiredParams: []string{"id", "state"},
+		ExtraParams:    []string{"timeout"},
+	},
 	"updatedistribution": {
 		Action:         "update",
 		Entity:         "distribution",
 		Api:            "cloudfront",
 		RequiredParams: []string{"enable", "id"},
 		ExtraParams:    []string{},
+		ParamTypes:     map[string]template.ParamType{"enable": {Kind: "bool"}},
 	},
 	"deletedistribution": {
 		Action:         "delete",
@@ -1118,6 +1351,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "cloudformation",
 		RequiredParams: []string{"name", "template-file"},
 		ExtraParams:    []string{"capabilities", "disable-rollback", "notifications", "on-failure", "parameters", "policy-file", "resource-types", "role", "timeout"},
+		ParamTypes:     map[string]template.ParamType{"disable-rollback": {Kind: "bool"}, "timeout": {Kind: "int"}},
 	},
 	"updatestack": {
 		Action:         "update",
@@ -1125,6 +1359,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "cloudformation",
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"capabilities", "notifications", "parameters", "policy-file", "policy-update-file", "resource-types", "role", "template-file", "use-previous-template"},
+		ParamTypes:     map[string]template.ParamType{"use-previous-template": {Kind: "bool"}},
 	},
 	"deletestack": {
 		Action:         "delete",
@@ -1139,6 +1374,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "applicationautoscaling",
 		RequiredParams: []string{"dimension", "max-capacity", "min-capacity", "resource", "role", "service-namespace"},
 		ExtraParams:    []string{},
+		ParamTypes:     map[string]template.ParamType{"max-capacity": {Kind: "int"}, "min-capacity": {Kind: "int"}},
 	},
 	"deleteappscalingtarget": {
 		Action:         "delete",
@@ -1153,6 +1389,7 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 		Api:            "applicationautoscaling",
 		RequiredParams: []string{"dimension", "name", "resource", "service-namespace", "stepscaling-adjustment-type", "stepscaling-adjustments", "type"},
 		ExtraParams:    []string{"stepscaling-aggregation-type", "stepscaling-cooldown", "stepscaling-min-adjustment-magnitude"},
+		ParamTypes:     map[string]template.ParamType{"stepscaling-cooldown": {Kind: "int"}, "stepscaling-min-adjustment-magnitude": {Kind: "int"}},
 	},
 	"deleteappscalingpolicy": {
 		Action:         "delete",
@@ -1176,12 +1413,14 @@ func DriverSupportedActions() map[string][]string {
 	supported["start"] = append(supported["start"], "instance")
 	supported["stop"] = append(supported["stop"], "instance")
 	supported["check"] = append(supported["check"], "instance")
+	supported["wait"] = append(supported["wait"], "instance")
 	supported["attach"] = append(supported["attach"], "instanceprofile")
 	supported["detach"] = append(supported["detach"], "instanceprofile")
 	supported["create"] = append(supported["create"], "securitygroup")
 	supported["update"] = append(supported["update"], "securitygroup")
 	supported["delete"] = append(supported["delete"], "securitygroup")
 	supported["check"] = append(supported["check"], "securitygroup")
+	supported["wait"] = append(supported["wait"], "securitygroup")
 	supported["attach"] = append(supported["attach"], "securitygroup")
 	supported["detach"] = append(supported["detach"], "securitygroup")
 	supported["copy"] = append(supported["copy"], "image")
@@ -1189,6 +1428,7 @@ func DriverSupportedActions() map[string][]string {
 	supported["delete"] = append(supported["delete"], "image")
 	supported["create"] = append(supported["create"], "volume")
 	supported["check"] = append(supported["check"], "volume")
+	supported["wait"] = append(supported["wait"], "volume")
 	supported["delete"] = append(supported["delete"], "volume")
 	supported["attach"] = append(supported["attach"], "volume")
 	supported["detach"] = append(supported["detach"], "volume")
@@ -1202,6 +1442,7 @@ func DriverSupportedActions() map[string][]string {
 	supported["create"] = append(supported["create"], "natgateway")
 	supported["delete"] = append(supported["delete"], "natgateway")
 	supported["check"] = append(supported["check"], "natgateway")
+	supported["wait"] = append(supported["wait"], "natgateway")
 	supported["create"] = append(supported["create"], "routetable")
 	supported["delete"] = append(supported["delete"], "routetable")
 	supported["attach"] = append(supported["attach"], "routetable")
@@ -1219,6 +1460,7 @@ func DriverSupportedActions() map[string][]string {
 	supported["create"] = append(supported["create"], "loadbalancer")
 	supported["delete"] = append(supported["delete"], "loadbalancer")
 	supported["check"] = append(supported["check"], "loadbalancer")
+	supported["wait"] = append(supported["wait"], "loadbalancer")
 	supported["create"] = append(supported["create"], "listener")
 	supported["delete"] = append(supported["delete"], "listener")
 	supported["create"] = append(supported["create"], "targetgroup")
@@ -1237,6 +1479,7 @@ func DriverSupportedActions() map[string][]string {
 	supported["create"] = append(supported["create"], "database")
 	supported["delete"] = append(supported["delete"], "database")
 	supported["check"] = append(supported["check"], "database")
+	supported["wait"] = append(supported["wait"], "database")
 	supported["create"] = append(supported["create"], "dbsubnetgroup")
 	supported["delete"] = append(supported["delete"], "dbsubnetgroup")
 	supported["create"] = append(supported["create"], "repository")
@@ -1298,6 +1541,7 @@ func DriverSupportedActions() map[string][]string {
 	supported["detach"] = append(supported["detach"], "alarm")
 	supported["create"] = append(supported["create"], "distribution")
 	supported["check"] = append(supported["check"], "distribution")
+	supported["wait"] = append(supported["wait"], "distribution")
 	supported["update"] = append(supported["update"], "distribution")
 	supported["delete"] = append(supported["delete"], "distribution")
 	supported["create"] = append(supported["create"], "stack")
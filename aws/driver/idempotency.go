@@ -0,0 +1,32 @@
+package awsdriver
+
+import "github.com/wallix/awless/cloud/properties"
+
+// Params identifying an existing resource when creating it idempotently.
+// Only entities for which the create result is the resource id are listed,
+// so that references to an existing resource stay valid for later statements
+var IdempotencyKeys = map[string][]string{
+	"vpc":           {"name"},
+	"subnet":        {"name", "vpc"},
+	"securitygroup": {"name", "vpc"},
+	"keypair":       {"name"},
+	"instance":      {"name", "subnet"},
+	"user":          {"name"},
+	"group":         {"name"},
+	"bucket":        {"name"},
+	"topic":         {"name"},
+	"queue":         {"name"},
+}
+
+// Resource properties against which identifying params are matched
+var IdempotencyProperties = map[string]string{
+	"name":             properties.Name,
+	"vpc":              properties.Vpc,
+	"subnet":           properties.Subnet,
+	"cidr":             properties.CIDR,
+	"availabilityzone": properties.AvailabilityZone,
+}
+
+func IdempotencyKeysFunc(entity string) []string {
+	return IdempotencyKeys[entity]
+}
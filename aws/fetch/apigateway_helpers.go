@@ -0,0 +1,105 @@
+package awsfetch
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/wallix/awless/fetch"
+)
+
+// Maximum number of items API Gateway returns per page
+const apigatewayPageLimit = 500
+
+type restApisStages struct {
+	apis   []*apigateway.RestApi
+	stages map[string][]*apigateway.Stage
+}
+
+func getRestApisStages(cache fetch.Cache, api apigatewayiface.APIGatewayAPI) (*restApisStages, error) {
+	val, err := cache.Get("getRestApisStages", func() (interface{}, error) {
+		apis, err := getRestApis(api)
+		if err != nil {
+			return nil, err
+		}
+		result := &restApisStages{apis: apis, stages: make(map[string][]*apigateway.Stage)}
+		for _, restApi := range apis {
+			out, err := api.GetStages(&apigateway.GetStagesInput{RestApiId: restApi.Id})
+			if err != nil {
+				return nil, err
+			}
+			result.stages[awssdk.StringValue(restApi.Id)] = out.Item
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := val.(*restApisStages); ok {
+		return v, nil
+	}
+	return &restApisStages{stages: make(map[string][]*apigateway.Stage)}, nil
+}
+
+// getRestApis pages with the 'position' token. API Gateway may return it
+// on the last page too: paging stops on an empty page or an unchanged position
+func getRestApis(api apigatewayiface.APIGatewayAPI) ([]*apigateway.RestApi, error) {
+	var apis []*apigateway.RestApi
+	var position *string
+	for {
+		out, err := api.GetRestApis(&apigateway.GetRestApisInput{Limit: awssdk.Int64(apigatewayPageLimit), Position: position})
+		if err != nil {
+			return apis, err
+		}
+		apis = append(apis, out.Items...)
+		next := awssdk.StringValue(out.Position)
+		if next == "" || len(out.Items) == 0 || next == awssdk.StringValue(position) {
+			return apis, nil
+		}
+		position = out.Position
+	}
+}
+
+// getHttpApisStages lists the HTTP and WebSocket APIs with the names of their stages.
+// Paging stops on an empty next token, that may be given on the last page
+func getHttpApisStages(api apigatewayv2iface.ApiGatewayV2API) ([]*apigatewayv2.Api, map[string][]string, error) {
+	var apis []*apigatewayv2.Api
+	stages := make(map[string][]string)
+	var token *string
+	for {
+		out, err := api.GetApis(&apigatewayv2.GetApisInput{NextToken: token})
+		if err != nil {
+			return apis, stages, err
+		}
+		apis = append(apis, out.Items...)
+		if awssdk.StringValue(out.NextToken) == "" {
+			break
+		}
+		token = out.NextToken
+	}
+
+	for _, httpApi := range apis {
+		var stageToken *string
+		for {
+			out, err := api.GetStages(&apigatewayv2.GetStagesInput{ApiId: httpApi.ApiId, NextToken: stageToken})
+			if err != nil {
+				return apis, stages, err
+			}
+			for _, stage := range out.Items {
+				stages[awssdk.StringValue(httpApi.ApiId)] = append(stages[awssdk.StringValue(httpApi.ApiId)], awssdk.StringValue(stage.StageName))
+			}
+			if awssdk.StringValue(out.NextToken) == "" {
+				break
+			}
+			stageToken = out.NextToken
+		}
+	}
+	return apis, stages, nil
+}
+
+func restApiEndpoint(id, region string) string {
+	return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com", id, region)
+}
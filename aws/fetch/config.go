@@ -3,23 +3,36 @@ package awsfetch
 import (
 	"reflect"
 
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 
 	"github.com/wallix/awless/logger"
 )
@@ -28,22 +41,45 @@ type AWSAPI struct {
 	Iam                    iamiface.IAMAPI
 	Ec2                    ec2iface.EC2API
 	Elbv2                  elbv2iface.ELBV2API
+	Elb                    elbiface.ELBAPI
 	Rds                    rdsiface.RDSAPI
+	Elasticache            elasticacheiface.ElastiCacheAPI
 	Autoscaling            autoscalingiface.AutoScalingAPI
 	Ecr                    ecriface.ECRAPI
 	Ecs                    ecsiface.ECSAPI
 	Applicationautoscaling applicationautoscalingiface.ApplicationAutoScalingAPI
+	Wafv2                  wafv2iface.WAFV2API
+	CloudFrontWafv2        CloudFrontWafv2
+	Acm                    acmiface.ACMAPI
+	Elasticbeanstalk       elasticbeanstalkiface.ElasticBeanstalkAPI
+	Apigateway             apigatewayiface.APIGatewayAPI
+	Apigatewayv2           apigatewayv2iface.ApiGatewayV2API
+	Ssm                    ssmiface.SSMAPI
+	Kms                    kmsiface.KMSAPI
+	Guardduty              guarddutyiface.GuardDutyAPI
+	Secretsmanager         secretsmanageriface.SecretsManagerAPI
 	Sts                    stsiface.STSAPI
 	S3                     s3iface.S3API
+	Dynamodb               dynamodbiface.DynamoDBAPI
 	Sns                    snsiface.SNSAPI
 	Sqs                    sqsiface.SQSAPI
 	Route53                route53iface.Route53API
 	Lambda                 lambdaiface.LambdaAPI
 	Cloudwatch             cloudwatchiface.CloudWatchAPI
+	Cloudwatchlogs         cloudwatchlogsiface.CloudWatchLogsAPI
 	Cloudfront             cloudfrontiface.CloudFrontAPI
 	Cloudformation         cloudformationiface.CloudFormationAPI
 }
 
+// Region serving the WAFv2 web ACLs of CloudFront distributions
+const CloudFrontRegion = "us-east-1"
+
+// CloudFrontWafv2 is the WAFv2 client of the CloudFront region, calling it
+// from the service of any region. Its type tells it apart from the regional client
+type CloudFrontWafv2 struct {
+	wafv2iface.WAFV2API
+}
+
 type Config struct {
 	Log   *logger.Logger
 	Extra map[string]interface{}
@@ -71,6 +107,21 @@ func (c *Config) getBoolDefaultTrue(key string) bool {
 	return true
 }
 
+func (c *Config) getBoolDefaultFalse(key string) bool {
+	if b, ok := c.Extra[key].(bool); ok {
+		return b
+	}
+
+	return false
+}
+
+func (c *Config) region() string {
+	if region, ok := c.Extra["aws.region"].(string); ok {
+		return region
+	}
+	return ""
+}
+
 func assignAPIs(c *Config, apis ...interface{}) {
 	c.APIs = new(AWSAPI)
 	val := reflect.ValueOf(c.APIs).Elem()
@@ -81,13 +132,22 @@ func assignAPIs(c *Config, apis ...interface{}) {
 			continue
 		}
 
+		// an API can satisfy several interfaces (ex: the CloudFront WAFv2 client also satisfies WAFv2):
+		// it is assigned to the field of its own type, or else to the most specific interface
 		apiType := reflect.TypeOf(api)
+		field := -1
 		for i := 0; i < stru.NumField(); i++ {
 			fieldType := stru.Field(i).Type
-			if apiType.AssignableTo(fieldType) {
-				val.Field(i).Set(reflect.ValueOf(api))
+			if apiType == fieldType {
+				field = i
 				break
 			}
+			if apiType.AssignableTo(fieldType) && (field < 0 || fieldType.NumMethod() > stru.Field(field).Type.NumMethod()) {
+				field = i
+			}
+		}
+		if field > -1 {
+			val.Field(field).Set(reflect.ValueOf(api))
 		}
 	}
 }
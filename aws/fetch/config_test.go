@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 )
 
 func TestAssignAPIs(t *testing.T) {
@@ -31,4 +32,17 @@ func TestAssignAPIs(t *testing.T) {
 	if conf.APIs.Ecr != nil {
 		t.Fatal("expected nil")
 	}
+
+	type wafv2Mock struct {
+		wafv2iface.WAFV2API
+	}
+	regional, cloudfront := &wafv2Mock{}, &wafv2Mock{}
+
+	conf = NewConfig(CloudFrontWafv2{cloudfront}, regional)
+	if conf.APIs.Wafv2 != regional {
+		t.Fatalf("got %p, want the regional wafv2 mock", conf.APIs.Wafv2)
+	}
+	if conf.APIs.CloudFrontWafv2.WAFV2API != cloudfront {
+		t.Fatalf("got %p, want the cloudfront wafv2 mock", conf.APIs.CloudFrontWafv2.WAFV2API)
+	}
 }
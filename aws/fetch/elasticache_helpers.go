@@ -0,0 +1,96 @@
+package awsfetch
+
+import (
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/wallix/awless/fetch"
+)
+
+// getReplicationGroupsEndpoints returns the endpoint of each replication group by id:
+// the configuration endpoint in cluster mode, the primary endpoint of its node group otherwise
+func getReplicationGroupsEndpoints(api elasticacheiface.ElastiCacheAPI) (map[string]*elasticache.Endpoint, error) {
+	endpoints := make(map[string]*elasticache.Endpoint)
+	err := api.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(out *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		for _, group := range out.ReplicationGroups {
+			switch {
+			case group.ConfigurationEndpoint != nil:
+				endpoints[awssdk.StringValue(group.ReplicationGroupId)] = group.ConfigurationEndpoint
+			case len(group.NodeGroups) > 0 && group.NodeGroups[0].PrimaryEndpoint != nil:
+				endpoints[awssdk.StringValue(group.ReplicationGroupId)] = group.NodeGroups[0].PrimaryEndpoint
+			}
+		}
+		return out.Marker != nil
+	})
+	return endpoints, err
+}
+
+// cacheClusterEndpoint returns the endpoint clients connect to: the one of the memcached
+// cluster, of its replication group or of its only node for a standalone redis cluster
+func cacheClusterEndpoint(cluster *elasticache.CacheCluster, groupsEndpoints map[string]*elasticache.Endpoint) *elasticache.Endpoint {
+	if cluster.ConfigurationEndpoint != nil {
+		return cluster.ConfigurationEndpoint
+	}
+	if endpoint, ok := groupsEndpoints[awssdk.StringValue(cluster.ReplicationGroupId)]; ok {
+		return endpoint
+	}
+	if len(cluster.CacheNodes) == 1 {
+		return cluster.CacheNodes[0].Endpoint
+	}
+	return nil
+}
+
+// getAccountID returns the account of the caller, needed to build the ARN of ElastiCache clusters
+func getAccountID(cache fetch.Cache, api stsiface.STSAPI) (string, error) {
+	val, err := cache.Get("getAccountID", func() (interface{}, error) {
+		out, err := api.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+		if err != nil {
+			return "", err
+		}
+		return awssdk.StringValue(out.Account), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	account, _ := val.(string)
+	return account, nil
+}
+
+// regionPartition returns the partition of the region (ex: aws-cn for cn-north-1),
+// guessed from its prefix when the region is unknown to the vendored SDK
+func regionPartition(region string) string {
+	for _, p := range endpoints.DefaultResolver().(endpoints.EnumPartitions).Partitions() {
+		if _, ok := p.Regions()[region]; ok {
+			return p.ID()
+		}
+	}
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	}
+	return "aws"
+}
+
+func cacheClusterArn(region, account, id string) string {
+	return fmt.Sprintf("arn:%s:elasticache:%s:%s:cluster:%s", regionPartition(region), region, account, id)
+}
+
+func getCacheClusterTags(api elasticacheiface.ElastiCacheAPI, arn string) ([]string, error) {
+	out, err := api.ListTagsForResource(&elasticache.ListTagsForResourceInput{ResourceName: awssdk.String(arn)})
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	for _, t := range out.TagList {
+		tags = append(tags, fmt.Sprintf("%s=%s", awssdk.StringValue(t.Key), awssdk.StringValue(t.Value)))
+	}
+	return tags, nil
+}
@@ -0,0 +1,21 @@
+package awsfetch
+
+import "testing"
+
+func TestCacheClusterArn(t *testing.T) {
+	t.Parallel()
+	tcases := []struct {
+		region, exp string
+	}{
+		{region: "eu-west-1", exp: "arn:aws:elasticache:eu-west-1:123456789012:cluster:cc_1"},
+		{region: "cn-north-1", exp: "arn:aws-cn:elasticache:cn-north-1:123456789012:cluster:cc_1"},
+		{region: "us-gov-west-1", exp: "arn:aws-us-gov:elasticache:us-gov-west-1:123456789012:cluster:cc_1"},
+		{region: "cn-northwest-1", exp: "arn:aws-cn:elasticache:cn-northwest-1:123456789012:cluster:cc_1"},
+		{region: "eu-south-1", exp: "arn:aws:elasticache:eu-south-1:123456789012:cluster:cc_1"},
+	}
+	for _, tcase := range tcases {
+		if got, want := cacheClusterArn(tcase.region, "123456789012", "cc_1"), tcase.exp; got != want {
+			t.Fatalf("%s: got %s, want %s", tcase.region, got, want)
+		}
+	}
+}
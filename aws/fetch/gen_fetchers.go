@@ -28,8 +28,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -396,6 +400,32 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["networkinterface"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.NetworkInterface
+
+		if !conf.getBoolDefaultTrue("aws.infra.networkinterface.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[networkinterface]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.NetworkInterfaces {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
 	funcs["snapshot"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*ec2.Snapshot
@@ -427,6 +457,84 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, badResErr
 	}
 
+	funcs["spotrequest"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.SpotInstanceRequest
+
+		if !conf.getBoolDefaultTrue("aws.infra.spotrequest.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[spotrequest]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.SpotInstanceRequests {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["reservedinstance"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.ReservedInstances
+
+		if !conf.getBoolDefaultTrue("aws.infra.reservedinstance.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[reservedinstance]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeReservedInstances(&ec2.DescribeReservedInstancesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.ReservedInstances {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["placementgroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.PlacementGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.placementgroup.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[placementgroup]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.PlacementGroups {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
 	funcs["loadbalancer"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*elbv2.LoadBalancer
@@ -484,6 +592,37 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["classicloadbalancer"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elb.LoadBalancerDescription
+
+		if !conf.getBoolDefaultTrue("aws.infra.classicloadbalancer.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[classicloadbalancer]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elb.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{},
+			func(out *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.LoadBalancerDescriptions {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextMarker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["database"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*rds.DBInstance
@@ -546,6 +685,37 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, badResErr
 	}
 
+	funcs["cachesubnetgroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticache.CacheSubnetGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.cachesubnetgroup.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cachesubnetgroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elasticache.DescribeCacheSubnetGroupsPages(&elasticache.DescribeCacheSubnetGroupsInput{},
+			func(out *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.CacheSubnetGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["launchconfiguration"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*autoscaling.LaunchConfiguration
@@ -669,6 +839,58 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+
+	funcs["beanstalkapplication"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticbeanstalk.ApplicationDescription
+
+		if !conf.getBoolDefaultTrue("aws.infra.beanstalkapplication.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[beanstalkapplication]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Elasticbeanstalk.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.Applications {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["beanstalkenvironment"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticbeanstalk.EnvironmentDescription
+
+		if !conf.getBoolDefaultTrue("aws.infra.beanstalkenvironment.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[beanstalkenvironment]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Elasticbeanstalk.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.Environments {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
 	return funcs
 }
 func BuildAccessFetchFuncs(conf *Config) fetch.Funcs {
@@ -842,37 +1064,6 @@ func BuildMessagingFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
-
-	funcs["topic"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
-		var resources []*graph.Resource
-		var objects []*sns.Topic
-
-		if !conf.getBoolDefaultTrue("aws.messaging.topic.sync") {
-			conf.Log.Verbose("sync: *disabled* for resource messaging[topic]")
-			return resources, objects, nil
-		}
-		var badResErr error
-		err := conf.APIs.Sns.ListTopicsPages(&sns.ListTopicsInput{},
-			func(out *sns.ListTopicsOutput, lastPage bool) (shouldContinue bool) {
-				for _, output := range out.Topics {
-					if badResErr != nil {
-						return false
-					}
-					objects = append(objects, output)
-					var res *graph.Resource
-					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
-						return false
-					}
-					resources = append(resources, res)
-				}
-				return out.NextToken != nil
-			})
-		if err != nil {
-			return resources, objects, err
-		}
-
-		return resources, objects, badResErr
-	}
 	return funcs
 }
 func BuildDnsFetchFuncs(conf *Config) fetch.Funcs {
@@ -1015,6 +1206,36 @@ func BuildMonitoringFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	funcs["loggroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*cloudwatchlogs.LogGroup
+
+		if !conf.getBoolDefaultTrue("aws.monitoring.loggroup.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource monitoring[loggroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Cloudwatchlogs.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{},
+			func(out *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.LogGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
 	return funcs
 }
 func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
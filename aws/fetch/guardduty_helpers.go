@@ -0,0 +1,17 @@
+package awsfetch
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+)
+
+// Maximum number of findings GuardDuty returns per GetFindings call
+const maxFindingsPerGet = 50
+
+// findingInstanceID returns the id of the instance affected by a finding, if any
+func findingInstanceID(finding *guardduty.Finding) string {
+	if r := finding.Resource; r != nil && r.InstanceDetails != nil {
+		return awssdk.StringValue(r.InstanceDetails.InstanceId)
+	}
+	return ""
+}
@@ -1,7 +1,5 @@
 package awsfetch
 
-import "strings"
-
 func sliceOfSlice(in []*string, maxLength int) (res [][]*string) {
 	if maxLength <= 0 {
 		return
@@ -33,14 +31,6 @@ func appendIfNotInSlice(slice []string, s string) []string {
 	return slice
 }
 
-func arnToName(arn string) string {
-	splits := strings.Split(arn, "/")
-	if len(splits) > 1 {
-		return splits[len(splits)-1]
-	}
-	return arn
-}
-
 func pluralizeIfNeeded(str string, n uint) string {
 	if n > 1 {
 		return str + "s"
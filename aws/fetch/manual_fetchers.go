@@ -2,7 +2,9 @@ package awsfetch
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -10,12 +12,24 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
@@ -222,19 +236,19 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 						switch state {
 						case "stopped":
 							stoppedServicesCount++
-							deployments = append(deployments, &graph.KeyValue{arnToName(clusterArn), group[len("service:"):] + " (stopped service)"})
+							deployments = append(deployments, &graph.KeyValue{cloud.ShortARN(clusterArn), group[len("service:"):] + " (stopped service)"})
 						case "running":
 							runningServicesCount++
-							deployments = append(deployments, &graph.KeyValue{arnToName(clusterArn), group[len("service:"):] + " (running service)"})
+							deployments = append(deployments, &graph.KeyValue{cloud.ShortARN(clusterArn), group[len("service:"):] + " (running service)"})
 						}
 					}
 					if strings.HasPrefix(group, "family:") {
 						switch state {
 						case "stopped":
-							deployments = append(deployments, &graph.KeyValue{arnToName(clusterArn), group[len("family:"):] + " (stopped task)"})
+							deployments = append(deployments, &graph.KeyValue{cloud.ShortARN(clusterArn), group[len("family:"):] + " (stopped task)"})
 							stoppedTasksCount++
 						case "running":
-							deployments = append(deployments, &graph.KeyValue{arnToName(clusterArn), group[len("family:"):] + " (running task)"})
+							deployments = append(deployments, &graph.KeyValue{cloud.ShortARN(clusterArn), group[len("family:"):] + " (running task)"})
 							runningTasksCount++
 						}
 					}
@@ -316,6 +330,66 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		return resources, objects, nil
 	}
 
+	funcs["host"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*ec2.Host
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.host.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[host]")
+			return resources, objects, nil
+		}
+
+		var token *string
+		for {
+			out, err := conf.APIs.Ec2.DescribeHosts(&ec2.DescribeHostsInput{NextToken: token})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, host := range out.Hosts {
+				objects = append(objects, host)
+				res, err := awsconv.NewResource(host)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if awssdk.StringValue(out.NextToken) == "" {
+				return resources, objects, nil
+			}
+			token = out.NextToken
+		}
+	}
+
+	funcs["vpcendpoint"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*ec2.VpcEndpoint
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpcendpoint.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpcendpoint]")
+			return resources, objects, nil
+		}
+
+		var token *string
+		for {
+			out, err := conf.APIs.Ec2.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{NextToken: token})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, endpoint := range out.VpcEndpoints {
+				objects = append(objects, endpoint)
+				res, err := awsconv.NewResource(endpoint)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if awssdk.StringValue(out.NextToken) == "" {
+				return resources, objects, nil
+			}
+			token = out.NextToken
+		}
+	}
+
 	funcs["listener"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*elbv2.Listener
 		var resources []*graph.Resource
@@ -377,6 +451,428 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	funcs["webacl"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*wafv2.WebACL
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.webacl.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[webacl]")
+			return resources, objects, nil
+		}
+
+		// Regional web ACLs protect load balancers, CloudFront ones protect distributions
+		// and are only served by the CloudFront region
+		scopes := []struct {
+			name string
+			api  wafv2iface.WAFV2API
+		}{
+			{wafv2.ScopeRegional, conf.APIs.Wafv2},
+			{wafv2.ScopeCloudfront, conf.APIs.CloudFrontWafv2.WAFV2API},
+		}
+
+		for _, scope := range scopes {
+			acls, err := fetchWebACLs(scope.api, scope.name)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, acl := range acls {
+				objects = append(objects, acl)
+				res, err := awsconv.NewResource(acl)
+				if err != nil {
+					return resources, objects, err
+				}
+				res.Properties[properties.Scope] = scope.name
+				resources = append(resources, res)
+			}
+		}
+		return resources, objects, nil
+	}
+
+	funcs["certificate"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*acm.CertificateDetail
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.certificate.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[certificate]")
+			return resources, objects, nil
+		}
+
+		var badResErr error
+		err := conf.APIs.Acm.ListCertificatesPages(&acm.ListCertificatesInput{}, func(out *acm.ListCertificatesOutput, lastPage bool) (shouldContinue bool) {
+			for _, summary := range out.CertificateSummaryList {
+				var desc *acm.DescribeCertificateOutput
+				if desc, badResErr = conf.APIs.Acm.DescribeCertificate(&acm.DescribeCertificateInput{CertificateArn: summary.CertificateArn}); badResErr != nil {
+					return false
+				}
+				objects = append(objects, desc.Certificate)
+				var res *graph.Resource
+				if res, badResErr = awsconv.NewResource(desc.Certificate); badResErr != nil {
+					return false
+				}
+				resources = append(resources, res)
+			}
+			return out.NextToken != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+		return resources, objects, badResErr
+	}
+
+	// REST APIs are listed with the HTTP and WebSocket APIs, their protocol telling them apart
+	funcs["restapi"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []interface{}
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.restapi.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[restapi]")
+			return resources, objects, nil
+		}
+
+		all, err := getRestApisStages(cache, conf.APIs.Apigateway)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, restApi := range all.apis {
+			objects = append(objects, restApi)
+			res, err := awsconv.NewResource(restApi)
+			if err != nil {
+				return resources, objects, err
+			}
+			res.Properties[properties.Protocol] = "REST"
+			if region := conf.region(); region != "" {
+				res.Properties[properties.Endpoint] = restApiEndpoint(res.Id(), region)
+			}
+			var stages []string
+			for _, stage := range all.stages[res.Id()] {
+				stages = append(stages, awssdk.StringValue(stage.StageName))
+			}
+			if len(stages) > 0 {
+				res.Properties[properties.Stages] = stages
+			}
+			resources = append(resources, res)
+		}
+
+		httpApis, httpStages, err := getHttpApisStages(conf.APIs.Apigatewayv2)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, httpApi := range httpApis {
+			objects = append(objects, httpApi)
+			res, err := awsconv.NewResource(httpApi)
+			if err != nil {
+				return resources, objects, err
+			}
+			if stages := httpStages[res.Id()]; len(stages) > 0 {
+				res.Properties[properties.Stages] = stages
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["apistage"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*apigateway.Stage
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.apistage.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[apistage]")
+			return resources, objects, nil
+		}
+
+		all, err := getRestApisStages(cache, conf.APIs.Apigateway)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, restApi := range all.apis {
+			parent, err := awsconv.InitResource(restApi)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, stage := range all.stages[parent.Id()] {
+				objects = append(objects, stage)
+				res, err := awsconv.NewResource(stage)
+				if err != nil {
+					return resources, objects, err
+				}
+				if region := conf.region(); region != "" {
+					res.Properties[properties.Endpoint] = fmt.Sprintf("%s/%s", restApiEndpoint(parent.Id(), region), awssdk.StringValue(stage.StageName))
+				}
+				res.Relations[rdf.ChildrenOfRel] = append(res.Relations[rdf.ChildrenOfRel], parent)
+				resources = append(resources, res)
+			}
+		}
+		return resources, objects, nil
+	}
+
+	// Only the metadata of the parameters are fetched: their values are never retrieved
+	funcs["parameter"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*ssm.ParameterMetadata
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.parameter.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[parameter]")
+			return resources, objects, nil
+		}
+
+		var token *string
+		for {
+			out, err := conf.APIs.Ssm.DescribeParameters(&ssm.DescribeParametersInput{NextToken: token})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, param := range out.Parameters {
+				objects = append(objects, param)
+				res, err := awsconv.NewResource(param)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if awssdk.StringValue(out.NextToken) == "" {
+				return resources, objects, nil
+			}
+			token = out.NextToken
+		}
+	}
+
+	funcs["kmskey"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*kms.KeyMetadata
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.kmskey.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[kmskey]")
+			return resources, objects, nil
+		}
+
+		aliasesByKey := make(map[string][]string)
+		err := conf.APIs.Kms.ListAliasesPages(&kms.ListAliasesInput{}, func(out *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool) {
+			for _, alias := range out.Aliases {
+				if keyID := awssdk.StringValue(alias.TargetKeyId); keyID != "" {
+					aliasesByKey[keyID] = append(aliasesByKey[keyID], awssdk.StringValue(alias.AliasName))
+				}
+			}
+			return out.NextMarker != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var keyIDs []*string
+		err = conf.APIs.Kms.ListKeysPages(&kms.ListKeysInput{}, func(out *kms.ListKeysOutput, lastPage bool) (shouldContinue bool) {
+			for _, key := range out.Keys {
+				keyIDs = append(keyIDs, key.KeyId)
+			}
+			return out.NextMarker != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, keyID := range keyIDs {
+			out, err := conf.APIs.Kms.DescribeKey(&kms.DescribeKeyInput{KeyId: keyID})
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, out.KeyMetadata)
+			res, err := awsconv.NewResource(out.KeyMetadata)
+			if err != nil {
+				return resources, objects, err
+			}
+			if aliases, ok := aliasesByKey[awssdk.StringValue(keyID)]; ok {
+				res.Properties[properties.Aliases] = aliases
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["finding"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*guardduty.Finding
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultFalse("aws.infra.finding.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[finding]")
+			return resources, objects, nil
+		}
+
+		// GuardDuty may end with an empty token, which the pager takes for a first page request
+		var detectorIDs []*string
+		err := conf.APIs.Guardduty.ListDetectorsPages(&guardduty.ListDetectorsInput{}, func(out *guardduty.ListDetectorsOutput, lastPage bool) (shouldContinue bool) {
+			detectorIDs = append(detectorIDs, out.DetectorIds...)
+			return awssdk.StringValue(out.NextToken) != ""
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		active := &guardduty.FindingCriteria{Criterion: map[string]*guardduty.Condition{
+			"service.archived": {Eq: []*string{awssdk.String("false")}},
+		}}
+		for _, detectorID := range detectorIDs {
+			var findingIDs []*string
+			err = conf.APIs.Guardduty.ListFindingsPages(&guardduty.ListFindingsInput{DetectorId: detectorID, FindingCriteria: active}, func(out *guardduty.ListFindingsOutput, lastPage bool) (shouldContinue bool) {
+				findingIDs = append(findingIDs, out.FindingIds...)
+				return awssdk.StringValue(out.NextToken) != ""
+			})
+			if err != nil {
+				return resources, objects, err
+			}
+
+			for len(findingIDs) > 0 {
+				batch := findingIDs
+				if len(batch) > maxFindingsPerGet {
+					batch = batch[:maxFindingsPerGet]
+				}
+				findingIDs = findingIDs[len(batch):]
+
+				out, err := conf.APIs.Guardduty.GetFindings(&guardduty.GetFindingsInput{DetectorId: detectorID, FindingIds: batch})
+				if err != nil {
+					return resources, objects, err
+				}
+				for _, finding := range out.Findings {
+					objects = append(objects, finding)
+					res, err := awsconv.NewResource(finding)
+					if err != nil {
+						return resources, objects, err
+					}
+					if instanceID := findingInstanceID(finding); instanceID != "" {
+						res.Properties[properties.Instance] = instanceID
+					}
+					resources = append(resources, res)
+				}
+			}
+		}
+		return resources, objects, nil
+	}
+	// Secrets are listed only: their values are never retrieved (no GetSecretValue call)
+	funcs["secret"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*secretsmanager.SecretListEntry
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.secret.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[secret]")
+			return resources, objects, nil
+		}
+
+		var badResErr error
+		err := conf.APIs.Secretsmanager.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(out *secretsmanager.ListSecretsOutput, lastPage bool) (shouldContinue bool) {
+			for _, secret := range out.SecretList {
+				objects = append(objects, secret)
+				var res *graph.Resource
+				if res, badResErr = awsconv.NewResource(secret); badResErr != nil {
+					return false
+				}
+				resources = append(resources, res)
+			}
+			return awssdk.StringValue(out.NextToken) != ""
+		})
+		if badResErr != nil {
+			return resources, objects, badResErr
+		}
+		return resources, objects, err
+	}
+
+	funcs["cachecluster"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*elasticache.CacheCluster
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.cachecluster.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cachecluster]")
+			return resources, objects, nil
+		}
+
+		err := conf.APIs.Elasticache.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{ShowCacheNodeInfo: awssdk.Bool(true)}, func(out *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool) {
+			objects = append(objects, out.CacheClusters...)
+			return out.Marker != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+		if len(objects) == 0 {
+			return resources, objects, nil
+		}
+
+		groupsEndpoints, err := getReplicationGroupsEndpoints(conf.APIs.Elasticache)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, cluster := range objects {
+			res, err := awsconv.NewResource(cluster)
+			if err != nil {
+				return resources, objects, err
+			}
+			if endpoint := cacheClusterEndpoint(cluster, groupsEndpoints); endpoint != nil {
+				res.Properties[properties.Endpoint] = awssdk.StringValue(endpoint.Address)
+				res.Properties[properties.Port] = awssdk.Int64Value(endpoint.Port)
+			}
+			resources = append(resources, res)
+		}
+
+		account, err := getAccountID(cache, conf.APIs.Sts)
+		if err != nil {
+			return resources, objects, err
+		}
+		region := conf.region()
+		if account == "" || region == "" {
+			conf.Log.Verbose("sync: cannot build the ARN of cache clusters: skipping their tags")
+			return resources, objects, nil
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var badResErr error
+		limiter := make(chan struct{}, maxConcurrentAttributesFetch)
+
+		for _, res := range resources {
+			wg.Add(1)
+			go func(res *graph.Resource) {
+				defer wg.Done()
+				limiter <- struct{}{}
+				defer func() { <-limiter }()
+
+				tags, err := getCacheClusterTags(conf.APIs.Elasticache, cacheClusterArn(region, account, res.Id()))
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if badResErr == nil {
+						badResErr = fmt.Errorf("list tags of cache cluster %s: %s", res.Id(), err)
+					}
+					return
+				}
+				if len(tags) > 0 {
+					res.Properties[properties.Tags] = tags
+				}
+			}(res)
+		}
+		wg.Wait()
+
+		return resources, objects, badResErr
+	}
+}
+
+func fetchWebACLs(api wafv2iface.WAFV2API, scope string) ([]*wafv2.WebACL, error) {
+	var acls []*wafv2.WebACL
+	var marker *string
+	for {
+		out, err := api.ListWebACLs(&wafv2.ListWebACLsInput{Scope: awssdk.String(scope), Limit: awssdk.Int64(100), NextMarker: marker})
+		if err != nil {
+			return acls, err
+		}
+		for _, summary := range out.WebACLs {
+			acl, err := api.GetWebACL(&wafv2.GetWebACLInput{Name: summary.Name, Id: summary.Id, Scope: awssdk.String(scope)})
+			if err != nil {
+				return acls, err
+			}
+			acls = append(acls, acl.WebACL)
+		}
+		if awssdk.StringValue(out.NextMarker) == "" || len(out.WebACLs) == 0 {
+			return acls, nil
+		}
+		marker = out.NextMarker
+	}
 }
 
 func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
@@ -561,6 +1057,8 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		}
 
 		bucketM := &sync.Mutex{}
+		withSettings := conf.getBoolDefaultFalse("aws.storage.bucket.settings")
+		limiter := make(chan struct{}, maxConcurrentAttributesFetch)
 
 		err := forEachBucketParallel(ctx, cache, conf.APIs.S3, func(b *s3.Bucket) error {
 			bucketM.Lock()
@@ -575,6 +1073,14 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 				return fmt.Errorf("fetching grants for bucket %s: %s", awssdk.StringValue(b.Name), err)
 			}
 			res.Properties[properties.Grants] = grants
+			if withSettings {
+				limiter <- struct{}{}
+				err = fetchBucketSettings(ctx, conf.APIs.S3, res, awssdk.StringValue(b.Name))
+				<-limiter
+				if err != nil {
+					return fmt.Errorf("fetching settings for bucket %s: %s", awssdk.StringValue(b.Name), err)
+				}
+			}
 			bucketM.Lock()
 			resources = append(resources, res)
 			bucketM.Unlock()
@@ -613,8 +1119,146 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 
 		return resources, objects, err
 	}
+
+	funcs["table"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*dynamodb.TableDescription
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.storage.table.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource storage[table]")
+			return resources, objects, nil
+		}
+
+		var names []*string
+		err := conf.APIs.Dynamodb.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool) {
+			names = append(names, out.TableNames...)
+			return out.LastEvaluatedTableName != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var badResErr error
+		limiter := make(chan struct{}, maxConcurrentAttributesFetch)
+
+		for _, name := range names {
+			wg.Add(1)
+			go func(name *string) {
+				defer wg.Done()
+				limiter <- struct{}{}
+				defer func() { <-limiter }()
+
+				out, err := conf.APIs.Dynamodb.DescribeTable(&dynamodb.DescribeTableInput{TableName: name})
+				var res *graph.Resource
+				if err == nil {
+					res, err = awsconv.NewResource(out.Table)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if badResErr == nil {
+						badResErr = fmt.Errorf("describe table %s: %s", awssdk.StringValue(name), err)
+					}
+					return
+				}
+				objects = append(objects, out.Table)
+				resources = append(resources, res)
+			}(name)
+		}
+		wg.Wait()
+
+		return resources, objects, badResErr
+	}
 }
+
+// Max number of concurrent per resource attributes calls (SNS, SQS, DynamoDB, S3 buckets settings)
+const maxConcurrentAttributesFetch = 10
+
 func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["topic"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*sns.Topic
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.messaging.topic.sync") {
+			conf.Log.Verbose("sync: *disabled* for resource messaging[topic]")
+			return resources, objects, nil
+		}
+
+		err := conf.APIs.Sns.ListTopicsPages(&sns.ListTopicsInput{}, func(out *sns.ListTopicsOutput, lastPage bool) (shouldContinue bool) {
+			objects = append(objects, out.Topics...)
+			return out.NextToken != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// Buffered so that no goroutine is left blocked when returning on the first error
+		errC := make(chan error, len(objects))
+		resourcesC := make(chan *graph.Resource, len(objects))
+		limiter := make(chan struct{}, maxConcurrentAttributesFetch)
+		var wg sync.WaitGroup
+
+		for _, output := range objects {
+			wg.Add(1)
+			go func(topic *sns.Topic) {
+				defer wg.Done()
+				limiter <- struct{}{}
+				defer func() { <-limiter }()
+
+				res, err := awsconv.NewResource(topic)
+				if err != nil {
+					errC <- err
+					return
+				}
+				arn := awssdk.StringValue(topic.TopicArn)
+				res.Properties[properties.Name] = arn[strings.LastIndex(arn, ":")+1:]
+
+				attrs, err := conf.APIs.Sns.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topic.TopicArn})
+				if e, ok := err.(awserr.RequestFailure); ok && e.Code() == sns.ErrCodeNotFoundException {
+					return
+				}
+				if err != nil {
+					errC <- err
+					return
+				}
+				for k, v := range attrs.Attributes {
+					switch k {
+					case "DisplayName":
+						if vv := awssdk.StringValue(v); vv != "" {
+							res.Properties[properties.DisplayName] = vv
+						}
+					case "Owner":
+						res.Properties[properties.Owner] = awssdk.StringValue(v)
+					}
+				}
+				resourcesC <- res
+			}(output)
+		}
+
+		go func() {
+			wg.Wait()
+			close(errC)
+			close(resourcesC)
+		}()
+
+		for {
+			select {
+			case err := <-errC:
+				if err != nil {
+					return resources, objects, err
+				}
+			case r, ok := <-resourcesC:
+				if !ok {
+					return resources, objects, nil
+				}
+				resources = append(resources, r)
+			}
+		}
+	}
+
 	funcs["queue"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*string
 		var resources []*graph.Resource
@@ -624,6 +1268,7 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			return resources, objects, nil
 		}
 
+		// ListQueues is not paginated in this SDK version (up to 1000 queues returned)
 		out, err := conf.APIs.Sqs.ListQueues(&sqs.ListQueuesInput{})
 		if err != nil {
 			return nil, objects, err
@@ -632,15 +1277,20 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		errC := make(chan error)
 		objectsC := make(chan *string)
 		resourcesC := make(chan *graph.Resource)
+		limiter := make(chan struct{}, maxConcurrentAttributesFetch)
 		var wg sync.WaitGroup
 
 		for _, output := range out.QueueUrls {
 			wg.Add(1)
 			go func(url *string) {
 				defer wg.Done()
+				limiter <- struct{}{}
+				defer func() { <-limiter }()
+
 				objectsC <- url
 				res := graph.InitResource(cloud.Queue, awssdk.StringValue(url))
 				res.Properties[properties.ID] = awssdk.StringValue(url)
+				res.Properties[properties.Name] = path.Base(awssdk.StringValue(url))
 				attrs, err := conf.APIs.Sqs.GetQueueAttributes(&sqs.GetQueueAttributesInput{AttributeNames: []*string{awssdk.String("All")}, QueueUrl: url})
 				if e, ok := err.(awserr.RequestFailure); ok && (e.Code() == sqs.ErrCodeQueueDoesNotExist || e.Code() == sqs.ErrCodeQueueDeletedRecently) {
 					return
@@ -681,6 +1331,20 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 							errC <- err
 						}
 						res.Properties[properties.Delay] = delay
+					case "VisibilityTimeout":
+						timeout, err := strconv.Atoi(awssdk.StringValue(v))
+						if err != nil {
+							errC <- err
+						}
+						res.Properties[properties.VisibilityTimeout] = timeout
+					case "RedrivePolicy":
+						deadLetterArn, maxReceiveCount, err := parseRedrivePolicy(awssdk.StringValue(v))
+						if err != nil {
+							conf.Log.Verbosef("queue %s: %s", awssdk.StringValue(url), err)
+							continue
+						}
+						res.Properties[properties.DeadLetterQueue] = deadLetterArn
+						res.Properties[properties.MaxReceiveCount] = maxReceiveCount
 					}
 
 				}
@@ -717,6 +1381,29 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		}
 	}
 }
+
+// SQS returns the maxReceiveCount either as a number or as a string
+func parseRedrivePolicy(s string) (deadLetterArn string, maxReceiveCount int, err error) {
+	var policy struct {
+		DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+		MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+	}
+	if err = json.Unmarshal([]byte(s), &policy); err != nil {
+		return "", 0, fmt.Errorf("parsing redrive policy: %s", err)
+	}
+	if policy.DeadLetterTargetArn == "" {
+		return "", 0, fmt.Errorf("parsing redrive policy: no dead letter target in '%s'", s)
+	}
+	if policy.MaxReceiveCount != "" {
+		count, err := policy.MaxReceiveCount.Int64()
+		if err != nil {
+			return "", 0, fmt.Errorf("parsing redrive policy: max receive count: %s", err)
+		}
+		maxReceiveCount = int(count)
+	}
+	return policy.DeadLetterTargetArn, maxReceiveCount, nil
+}
+
 func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	funcs["record"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*route53.ResourceRecordSet
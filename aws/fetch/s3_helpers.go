@@ -2,12 +2,17 @@ package awsfetch
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/wallix/awless/aws/conv"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/graph"
@@ -156,3 +161,142 @@ func fetchAndExtractGrantsFn(ctx context.Context, api s3iface.S3API, bucketName
 	}
 	return grants, nil
 }
+
+// fetchBucketSettings sets the versioning status, the summaries of the lifecycle rules, the default encryption
+// and the enabled public access block settings of a bucket
+func fetchBucketSettings(ctx context.Context, api s3iface.S3API, res *graph.Resource, bucketName string) error {
+	versioning, err := api.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: awssdk.String(bucketName)})
+	if err != nil {
+		return fmt.Errorf("versioning: %s", err)
+	}
+	if status := awssdk.StringValue(versioning.Status); status != "" {
+		res.Properties[properties.Versioning] = status
+	} else {
+		res.Properties[properties.Versioning] = "Disabled"
+	}
+
+	encryption, err := api.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: awssdk.String(bucketName)})
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+		res.Properties[properties.Encryption] = "Disabled"
+	} else if err != nil {
+		return fmt.Errorf("encryption: %s", err)
+	} else {
+		res.Properties[properties.Encryption] = bucketEncryptionSummary(encryption.ServerSideEncryptionConfiguration)
+	}
+
+	accessBlock, err := api.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: awssdk.String(bucketName)})
+	if e, ok := err.(awserr.Error); ok && e.Code() == "NoSuchPublicAccessBlockConfiguration" {
+		res.Properties[properties.PublicAccessBlock] = "Disabled"
+	} else if err != nil {
+		return fmt.Errorf("public access block: %s", err)
+	} else {
+		res.Properties[properties.PublicAccessBlock] = publicAccessBlockSummary(accessBlock.PublicAccessBlockConfiguration)
+	}
+
+	lifecycle, err := api.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: awssdk.String(bucketName)})
+	if e, ok := err.(awserr.Error); ok && e.Code() == "NoSuchLifecycleConfiguration" {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("lifecycle: %s", err)
+	}
+	var rules []string
+	for _, rule := range lifecycle.Rules {
+		rules = append(rules, lifecycleRuleSummary(rule))
+	}
+	if len(rules) > 0 {
+		res.Properties[properties.LifecycleRules] = rules
+	}
+	return nil
+}
+
+// bucketEncryptionSummary gives the algorithm of the default encryption of a bucket. Ex: 'AES256' or 'aws:kms:<key>'
+func bucketEncryptionSummary(conf *s3.ServerSideEncryptionConfiguration) string {
+	if conf == nil {
+		return "Disabled"
+	}
+	for _, rule := range conf.Rules {
+		if def := rule.ApplyServerSideEncryptionByDefault; def != nil {
+			algorithm := awssdk.StringValue(def.SSEAlgorithm)
+			if key := awssdk.StringValue(def.KMSMasterKeyID); key != "" {
+				return algorithm + ":" + key
+			}
+			return algorithm
+		}
+	}
+	return "Disabled"
+}
+
+// publicAccessBlockSummary lists the enabled settings of a public access block. Ex: 'BlockPublicAcls,IgnorePublicAcls'
+func publicAccessBlockSummary(conf *s3.PublicAccessBlockConfiguration) string {
+	if conf == nil {
+		return "Disabled"
+	}
+	var settings []string
+	for _, setting := range []struct {
+		name    string
+		enabled *bool
+	}{
+		{"BlockPublicAcls", conf.BlockPublicAcls},
+		{"IgnorePublicAcls", conf.IgnorePublicAcls},
+		{"BlockPublicPolicy", conf.BlockPublicPolicy},
+		{"RestrictPublicBuckets", conf.RestrictPublicBuckets},
+	} {
+		if awssdk.BoolValue(setting.enabled) {
+			settings = append(settings, setting.name)
+		}
+	}
+	if len(settings) == 0 {
+		return "Disabled"
+	}
+	return strings.Join(settings, ",")
+}
+
+// lifecycleRuleSummary describes a rule in one line. Ex: 'logs Enabled prefix=logs/ transition=30d:GLACIER expire=365d'
+func lifecycleRuleSummary(rule *s3.LifecycleRule) string {
+	var parts []string
+	if id := awssdk.StringValue(rule.ID); id != "" {
+		parts = append(parts, id)
+	}
+	parts = append(parts, awssdk.StringValue(rule.Status))
+
+	prefix := awssdk.StringValue(rule.Prefix)
+	if f := rule.Filter; f != nil {
+		if f.Prefix != nil {
+			prefix = awssdk.StringValue(f.Prefix)
+		} else if f.And != nil {
+			prefix = awssdk.StringValue(f.And.Prefix)
+		}
+	}
+	if prefix != "" {
+		parts = append(parts, "prefix="+prefix)
+	}
+
+	for _, t := range rule.Transitions {
+		parts = append(parts, fmt.Sprintf("transition=%s:%s", lifecycleDelay(t.Days, t.Date), awssdk.StringValue(t.StorageClass)))
+	}
+	if e := rule.Expiration; e != nil {
+		if awssdk.BoolValue(e.ExpiredObjectDeleteMarker) {
+			parts = append(parts, "expire-delete-markers")
+		} else if e.Days != nil || e.Date != nil {
+			parts = append(parts, "expire="+lifecycleDelay(e.Days, e.Date))
+		}
+	}
+	for _, t := range rule.NoncurrentVersionTransitions {
+		parts = append(parts, fmt.Sprintf("noncurrent-transition=%dd:%s", awssdk.Int64Value(t.NoncurrentDays), awssdk.StringValue(t.StorageClass)))
+	}
+	if e := rule.NoncurrentVersionExpiration; e != nil {
+		parts = append(parts, fmt.Sprintf("noncurrent-expire=%dd", awssdk.Int64Value(e.NoncurrentDays)))
+	}
+	if a := rule.AbortIncompleteMultipartUpload; a != nil {
+		parts = append(parts, fmt.Sprintf("abort-multipart=%dd", awssdk.Int64Value(a.DaysAfterInitiation)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// lifecycleDelay is given either in days or as a date
+func lifecycleDelay(days *int64, date *time.Time) string {
+	if days != nil {
+		return fmt.Sprintf("%dd", awssdk.Int64Value(days))
+	}
+	return awssdk.TimeValue(date).UTC().Format("2006-01-02")
+}
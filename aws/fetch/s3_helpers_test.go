@@ -3,12 +3,15 @@ package awsfetch
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
-
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 )
 
@@ -61,13 +64,102 @@ func TestFetchFunctions(t *testing.T) {
 			}
 		}
 	})
+	t.Run("fetchBucketSettings", func(t *testing.T) {
+		expiry := time.Date(2018, 1, 31, 0, 0, 0, 0, time.UTC)
+		mock := &mockS3{
+			versionings: map[string]string{"bucket_1": "Enabled", "bucket_3": "Suspended"},
+			encryptions: map[string]*s3.ServerSideEncryptionByDefault{
+				"bucket_1": {SSEAlgorithm: awssdk.String("AES256")},
+				"bucket_3": {SSEAlgorithm: awssdk.String("aws:kms"), KMSMasterKeyID: awssdk.String("key_1")},
+			},
+			accessBlocks: map[string]*s3.PublicAccessBlockConfiguration{
+				"bucket_1": {BlockPublicAcls: awssdk.Bool(true), IgnorePublicAcls: awssdk.Bool(true), BlockPublicPolicy: awssdk.Bool(true), RestrictPublicBuckets: awssdk.Bool(true)},
+				"bucket_3": {BlockPublicAcls: awssdk.Bool(true), BlockPublicPolicy: awssdk.Bool(false)},
+			},
+			lifecycles: map[string][]*s3.LifecycleRule{
+				"bucket_1": {
+					{ID: awssdk.String("logs"), Status: awssdk.String("Enabled"), Filter: &s3.LifecycleRuleFilter{Prefix: awssdk.String("logs/")},
+						Transitions: []*s3.Transition{{Days: awssdk.Int64(30), StorageClass: awssdk.String("GLACIER")}}, Expiration: &s3.LifecycleExpiration{Days: awssdk.Int64(365)},
+						NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{NoncurrentDays: awssdk.Int64(90)}},
+					{Status: awssdk.String("Disabled"), Prefix: awssdk.String("tmp/"), Expiration: &s3.LifecycleExpiration{Date: &expiry}, AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{DaysAfterInitiation: awssdk.Int64(7)}},
+				},
+			},
+		}
+		tcases := []struct {
+			bucket     string
+			versioning string
+			encryption string
+			block      string
+			rules      []string
+		}{
+			{bucket: "bucket_1", versioning: "Enabled", encryption: "AES256", block: "BlockPublicAcls,IgnorePublicAcls,BlockPublicPolicy,RestrictPublicBuckets", rules: []string{"logs Enabled prefix=logs/ transition=30d:GLACIER expire=365d noncurrent-expire=90d", "Disabled prefix=tmp/ expire=2018-01-31 abort-multipart=7d"}},
+			{bucket: "bucket_2", versioning: "Disabled", encryption: "Disabled", block: "Disabled"},
+			{bucket: "bucket_3", versioning: "Suspended", encryption: "aws:kms:key_1", block: "BlockPublicAcls"},
+		}
+		for _, tcase := range tcases {
+			res := graph.InitResource("bucket", tcase.bucket)
+			if err := fetchBucketSettings(context.Background(), mock, res, tcase.bucket); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := res.Properties[properties.Versioning], tcase.versioning; got != want {
+				t.Fatalf("%s: got %v, want %v", tcase.bucket, got, want)
+			}
+			if got, want := res.Properties[properties.Encryption], tcase.encryption; got != want {
+				t.Fatalf("%s: got %v, want %v", tcase.bucket, got, want)
+			}
+			if got, want := res.Properties[properties.PublicAccessBlock], tcase.block; got != want {
+				t.Fatalf("%s: got %v, want %v", tcase.bucket, got, want)
+			}
+			rules, _ := res.Properties[properties.LifecycleRules].([]string)
+			if got, want := rules, tcase.rules; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s: got %#v, want %#v", tcase.bucket, got, want)
+			}
+		}
+	})
 }
 
 type mockS3 struct {
 	s3iface.S3API
-	buckets map[string][]*s3.Bucket
-	objects map[string][]*s3.Object
-	grants  map[string][]*s3.Grant
+	buckets      map[string][]*s3.Bucket
+	objects      map[string][]*s3.Object
+	grants       map[string][]*s3.Grant
+	versionings  map[string]string
+	lifecycles   map[string][]*s3.LifecycleRule
+	encryptions  map[string]*s3.ServerSideEncryptionByDefault
+	accessBlocks map[string]*s3.PublicAccessBlockConfiguration
+}
+
+func (m *mockS3) GetPublicAccessBlock(input *s3.GetPublicAccessBlockInput) (*s3.GetPublicAccessBlockOutput, error) {
+	conf, ok := m.accessBlocks[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("NoSuchPublicAccessBlockConfiguration", "The public access block configuration was not found", nil)
+	}
+	return &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: conf}, nil
+}
+
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	def, ok := m.encryptions[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil)
+	}
+	rules := []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: def}}
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{Rules: rules}}, nil
+}
+
+func (m *mockS3) GetBucketVersioning(input *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	out := &s3.GetBucketVersioningOutput{}
+	if status, ok := m.versionings[awssdk.StringValue(input.Bucket)]; ok {
+		out.Status = awssdk.String(status)
+	}
+	return out, nil
+}
+
+func (m *mockS3) GetBucketLifecycleConfiguration(input *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	rules, ok := m.lifecycles[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist", nil)
+	}
+	return &s3.GetBucketLifecycleConfigurationOutput{Rules: rules}, nil
 }
 
 func (m *mockS3) GetBucketAcl(input *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
@@ -0,0 +1,82 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+)
+
+// AccountServices are the services of an account reached by assuming one of its roles
+type AccountServices struct {
+	Account, RoleARN string
+	Services         []cloud.Service
+}
+
+// NewAccountServices assumes the role with the credentials of the profile of the config
+// and returns the services of its account in the region of the config, their calls being
+// signed with the role credentials. Fetch plugins are not reached through the role
+// and are left out. The identical calls of the services are not shared with the other
+// accounts as their responses depend on the account
+func NewAccountServices(roleARN string, conf map[string]interface{}, log *logger.Logger) (*AccountServices, error) {
+	account, err := awsconfig.AccountOfRoleARN(roleARN)
+	if err != nil {
+		return nil, err
+	}
+	awsconf := config(conf)
+
+	sb := newSessionResolver().withRegion(awsconf.region()).withProfile(awsconf.profile()).withLogger(log).withCredentialResolvers()
+	sb = sb.withRateLimiter(DefaultRateLimiter)
+	sb, err = sb.withCABundle(customCABundle)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := sb.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionName := fmt.Sprintf("awless-sync-%d", time.Now().Unix())
+	roleSess := sess.Copy(&awssdk.Config{Credentials: stscreds.NewCredentials(sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = sessionName
+	})})
+	if _, err = roleSess.Config.Credentials.Get(); err != nil {
+		return nil, fmt.Errorf("assuming role %s: %s", roleARN, err)
+	}
+
+	return &AccountServices{
+		Account: account,
+		RoleARN: roleARN,
+		Services: []cloud.Service{
+			NewAccess(roleSess, awsconf, log),
+			NewInfra(roleSess, awsconf, log),
+			NewStorage(roleSess, awsconf, log),
+			NewMessaging(roleSess, awsconf, log),
+			NewDns(roleSess, awsconf, log),
+			NewLambda(roleSess, awsconf, log),
+			NewMonitoring(roleSess, awsconf, log),
+			NewCdn(roleSess, awsconf, log),
+			NewCloudformation(roleSess, awsconf, log),
+		},
+	}, nil
+}
@@ -16,6 +16,13 @@ func (c config) profile() string {
 	return ""
 }
 
+func (c config) caBundle() string {
+	if path, ok := c["aws.cabundle"].(string); ok {
+		return path
+	}
+	return ""
+}
+
 func (c config) getBool(key string, def bool) bool {
 	if b, ok := c[key].(bool); ok {
 		return b
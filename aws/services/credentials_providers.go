@@ -17,20 +17,69 @@ limitations under the License.
 package awsservices
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/go-ini/ini"
 	"github.com/wallix/awless/aws/driver"
 	"github.com/wallix/awless/logger"
 )
 
+const (
+	processProviderName  = "ProcessProvider"
+	ssoProviderName      = "SSOProvider"
+	prompterProviderName = "PrompterProvider"
+)
+
+var credentialsSource string
+
+// CredentialsSource returns where the credentials of the current session were resolved from
+func CredentialsSource() string {
+	return credentialsSource
+}
+
+func describeCredentialsProvider(name string) string {
+	switch name {
+	case credentials.EnvProviderName:
+		return "environment variables"
+	case credentials.SharedCredsProviderName:
+		return "shared credentials file"
+	case credentials.StaticProviderName:
+		return "static credentials"
+	case stscreds.ProviderName:
+		return "assumed role"
+	case ec2rolecreds.ProviderName:
+		return "EC2 instance role"
+	case endpointcreds.ProviderName:
+		return "credentials endpoint"
+	case processProviderName:
+		return "credential_process"
+	case ssoProviderName:
+		return "SSO cached token"
+	case prompterProviderName:
+		return "prompted credentials"
+	default:
+		return "unknown"
+	}
+}
+
 type cachedCredential struct {
 	credentials.Value
 	Expiration time.Time
@@ -154,9 +203,222 @@ func (c *credentialsPrompterProvider) Retrieve() (credentials.Value, error) {
 		fmt.Fprintf(c.out, "\n\u2713 Credentials for profile '%s' stored successfully in %s\n", creds.Profile, awsdriver.AWSCredFilepath)
 	}
 	c.retrieved = true
+	creds.Val.ProviderName = prompterProviderName
 	return creds.Val, nil
 }
 
 func (c *credentialsPrompterProvider) IsExpired() bool {
 	return !c.retrieved
 }
+
+func awsConfigFilepath() string {
+	if path := os.Getenv("AWS_CONFIG_FILE"); path != "" {
+		return path
+	}
+	return filepath.Join(awsdriver.AWSCredDir, "config")
+}
+
+// Profiles are named '[profile name]' in the AWS config file, except for the default one
+func loadProfileConfig(path, profile string) (map[string]string, error) {
+	return loadConfigSection(path, "profile "+profile, profile)
+}
+
+func loadConfigSection(path string, names ...string) (map[string]string, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	file, err := ini.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading '%s': %s", path, err)
+	}
+	for _, name := range names {
+		if section, err := file.GetSection(name); err == nil {
+			return section.KeysHash(), nil
+		}
+	}
+	return nil, fmt.Errorf("no section '%s' in '%s'", names[0], path)
+}
+
+type processCredentialOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+// processProvider retrieves credentials from the external command
+// given by 'credential_process' in the profile of the AWS config file
+type processProvider struct {
+	profile    string
+	configFile string
+	expiration *time.Time
+	retrieved  bool
+}
+
+func (p *processProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved = false
+	conf, err := loadProfileConfig(p.configFile, p.profile)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	command := conf["credential_process"]
+	if command == "" {
+		return credentials.Value{}, fmt.Errorf("no credential_process for profile '%s'", p.profile)
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	if err = cmd.Run(); err != nil {
+		return credentials.Value{}, fmt.Errorf("running credential_process of profile '%s': %s", p.profile, err)
+	}
+
+	var out processCredentialOutput
+	if err = json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return credentials.Value{}, fmt.Errorf("parsing credential_process output of profile '%s': %s", p.profile, err)
+	}
+	if out.Version != 1 {
+		return credentials.Value{}, fmt.Errorf("credential_process of profile '%s': unsupported version %d", p.profile, out.Version)
+	}
+	if out.AccessKeyId == "" || out.SecretAccessKey == "" {
+		return credentials.Value{}, fmt.Errorf("credential_process of profile '%s': missing AccessKeyId or SecretAccessKey", p.profile)
+	}
+
+	p.expiration = out.Expiration
+	p.retrieved = true
+	return credentials.Value{
+		AccessKeyID:     out.AccessKeyId,
+		SecretAccessKey: out.SecretAccessKey,
+		SessionToken:    out.SessionToken,
+		ProviderName:    processProviderName,
+	}, nil
+}
+
+func (p *processProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	return p.expiration != nil && p.expiration.Before(time.Now())
+}
+
+type ssoCachedToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+func (t *ssoCachedToken) isExpired() bool {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05UTC"} {
+		if exp, err := time.Parse(layout, t.ExpiresAt); err == nil {
+			return exp.Before(time.Now())
+		}
+	}
+	return true
+}
+
+type ssoRoleCredentials struct {
+	RoleCredentials struct {
+		AccessKeyId     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		Expiration      int64  `json:"expiration"`
+	} `json:"roleCredentials"`
+}
+
+// ssoProvider exchanges the token cached by 'aws sso login' for role credentials
+// of the account and role set in the profile of the AWS config file
+type ssoProvider struct {
+	profile    string
+	configFile string
+	cacheDir   string
+	client     *http.Client
+	endpoint   string // defaults to the SSO portal of the SSO region
+	expiration time.Time
+	retrieved  bool
+}
+
+func (p *ssoProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved = false
+	conf, err := loadProfileConfig(p.configFile, p.profile)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	account, role := conf["sso_account_id"], conf["sso_role_name"]
+	if account == "" || role == "" {
+		return credentials.Value{}, fmt.Errorf("no SSO account or role for profile '%s'", p.profile)
+	}
+
+	startURL, region, cacheKey := conf["sso_start_url"], conf["sso_region"], conf["sso_start_url"]
+	if name := conf["sso_session"]; name != "" {
+		sess, err := loadConfigSection(p.configFile, "sso-session "+name)
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		startURL, region, cacheKey = sess["sso_start_url"], sess["sso_region"], name
+	}
+	if startURL == "" || region == "" {
+		return credentials.Value{}, fmt.Errorf("no SSO start url or region for profile '%s'", p.profile)
+	}
+
+	hash := sha1.Sum([]byte(cacheKey))
+	cacheFile := filepath.Join(p.cacheDir, hex.EncodeToString(hash[:])+".json")
+	content, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("no SSO cached token for profile '%s': run `aws sso login --profile %s`", p.profile, p.profile)
+	}
+	var token ssoCachedToken
+	if err = json.Unmarshal(content, &token); err != nil {
+		return credentials.Value{}, fmt.Errorf("parsing SSO cached token '%s': %s", cacheFile, err)
+	}
+	if token.AccessToken == "" || token.isExpired() {
+		return credentials.Value{}, fmt.Errorf("SSO cached token expired for profile '%s': run `aws sso login --profile %s`", p.profile, p.profile)
+	}
+
+	endpoint := p.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://portal.sso.%s.amazonaws.com", region)
+	}
+	query := url.Values{"account_id": {account}, "role_name": {role}}
+	req, err := http.NewRequest("GET", endpoint+"/federation/credentials?"+query.Encode(), nil)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token.AccessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("getting SSO role credentials: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return credentials.Value{}, fmt.Errorf("getting SSO role credentials for profile '%s': %s", p.profile, resp.Status)
+	}
+
+	var creds ssoRoleCredentials
+	if err = json.NewDecoder(resp.Body).Decode(&creds); err != nil {
+		return credentials.Value{}, fmt.Errorf("parsing SSO role credentials: %s", err)
+	}
+	if creds.RoleCredentials.AccessKeyId == "" {
+		return credentials.Value{}, errors.New("empty SSO role credentials")
+	}
+
+	p.expiration = time.Unix(0, creds.RoleCredentials.Expiration*int64(time.Millisecond))
+	p.retrieved = true
+	return credentials.Value{
+		AccessKeyID:     creds.RoleCredentials.AccessKeyId,
+		SecretAccessKey: creds.RoleCredentials.SecretAccessKey,
+		SessionToken:    creds.RoleCredentials.SessionToken,
+		ProviderName:    ssoProviderName,
+	}, nil
+}
+
+func (p *ssoProvider) IsExpired() bool {
+	return !p.retrieved || p.expiration.Before(time.Now())
+}
@@ -1,8 +1,14 @@
 package awsservices
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -58,6 +64,123 @@ func TestFileCacheProvider(t *testing.T) {
 	}
 }
 
+func TestProcessProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "config")
+	content := `[default]
+region = eu-west-1
+
+[profile dev]
+credential_process = echo '{"Version": 1, "AccessKeyId": "my-key", "SecretAccessKey": "my-secret", "SessionToken": "my-token", "Expiration": "2000-01-01T00:00:00Z"}'
+`
+	if err = ioutil.WriteFile(config, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &processProvider{profile: "dev", configFile: config}
+	if !provider.IsExpired() {
+		t.Fatal("expected expired before retrieval")
+	}
+	val, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := val, (credentials.Value{AccessKeyID: "my-key", SecretAccessKey: "my-secret", SessionToken: "my-token", ProviderName: processProviderName}); got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if !provider.IsExpired() {
+		t.Fatal("expected expired credentials")
+	}
+
+	if _, err = (&processProvider{profile: "default", configFile: config}).Retrieve(); err == nil {
+		t.Fatal("expected error for profile without credential_process")
+	}
+	if _, err = (&processProvider{profile: "dev", configFile: filepath.Join(dir, "none")}).Retrieve(); err == nil {
+		t.Fatal("expected error for missing config file")
+	}
+}
+
+func TestSSOProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "config")
+	content := `[profile legacy]
+sso_start_url = https://my-sso-portal.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = ReadOnly
+
+[profile dev]
+sso_session = my-sso
+sso_account_id = 123456789012
+sso_role_name = Admin
+
+[sso-session my-sso]
+sso_start_url = https://my-sso-portal.awsapps.com/start
+sso_region = us-east-1
+`
+	if err = ioutil.WriteFile(config, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	writeToken := func(key string, expiresAt time.Time) {
+		hash := sha1.Sum([]byte(key))
+		token := fmt.Sprintf(`{"accessToken": "token-%s", "expiresAt": "%s"}`, key, expiresAt.UTC().Format(time.RFC3339))
+		if err := ioutil.WriteFile(filepath.Join(dir, hex.EncodeToString(hash[:])+".json"), []byte(token), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeToken("my-sso", time.Now().Add(time.Hour))
+	writeToken("https://my-sso-portal.awsapps.com/start", time.Now().Add(-time.Hour))
+
+	expiration := time.Now().Add(time.Hour).Round(time.Millisecond)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/federation/credentials"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := r.Header.Get("x-amz-sso_bearer_token"), "token-my-sso"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := r.URL.Query().Get("account_id"), "123456789012"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := r.URL.Query().Get("role_name"), "Admin"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		fmt.Fprintf(w, `{"roleCredentials": {"accessKeyId": "my-key", "secretAccessKey": "my-secret", "sessionToken": "my-token", "expiration": %d}}`, expiration.UnixNano()/int64(time.Millisecond))
+	}))
+	defer server.Close()
+
+	provider := &ssoProvider{profile: "dev", configFile: config, cacheDir: dir, client: http.DefaultClient, endpoint: server.URL}
+	val, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := val, (credentials.Value{AccessKeyID: "my-key", SecretAccessKey: "my-secret", SessionToken: "my-token", ProviderName: ssoProviderName}); got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if provider.IsExpired() {
+		t.Fatal("expected valid credentials")
+	}
+	if got, want := provider.expiration, expiration; !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	provider = &ssoProvider{profile: "legacy", configFile: config, cacheDir: dir, client: http.DefaultClient, endpoint: server.URL}
+	if _, err = provider.Retrieve(); err == nil {
+		t.Fatal("expected error for expired SSO token")
+	}
+}
+
 type mockCredWithExpirationProvider struct {
 	accessCount int
 	value       credentials.Value
@@ -22,6 +22,12 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -30,16 +36,30 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -48,10 +68,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 	"github.com/wallix/awless/aws/driver"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/template/driver"
@@ -61,20 +87,26 @@ import (
 
 type mockEc2 struct {
 	ec2iface.EC2API
-	instances         []*ec2.Instance
-	subnets           []*ec2.Subnet
-	vpcs              []*ec2.Vpc
-	keypairinfos      []*ec2.KeyPairInfo
-	securitygroups    []*ec2.SecurityGroup
-	volumes           []*ec2.Volume
-	internetgateways  []*ec2.InternetGateway
-	natgateways       []*ec2.NatGateway
-	routetables       []*ec2.RouteTable
-	availabilityzones []*ec2.AvailabilityZone
-	images            []*ec2.Image
-	importimagetasks  []*ec2.ImportImageTask
-	addresss          []*ec2.Address
-	snapshots         []*ec2.Snapshot
+	instances            []*ec2.Instance
+	subnets              []*ec2.Subnet
+	vpcs                 []*ec2.Vpc
+	keypairinfos         []*ec2.KeyPairInfo
+	securitygroups       []*ec2.SecurityGroup
+	volumes              []*ec2.Volume
+	internetgateways     []*ec2.InternetGateway
+	natgateways          []*ec2.NatGateway
+	routetables          []*ec2.RouteTable
+	availabilityzones    []*ec2.AvailabilityZone
+	images               []*ec2.Image
+	importimagetasks     []*ec2.ImportImageTask
+	addresss             []*ec2.Address
+	networkinterfaces    []*ec2.NetworkInterface
+	snapshots            []*ec2.Snapshot
+	spotinstancerequests []*ec2.SpotInstanceRequest
+	reservedinstancess   []*ec2.ReservedInstances
+	placementgroups      []*ec2.PlacementGroup
+	hosts                []*ec2.Host
+	vpcendpoints         []*ec2.VpcEndpoint
 }
 
 func (m *mockEc2) Name() string {
@@ -176,6 +208,10 @@ func (m *mockEc2) DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.Des
 	return &ec2.DescribeAddressesOutput{Addresses: m.addresss}, nil
 }
 
+func (m *mockEc2) DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: m.networkinterfaces}, nil
+}
+
 func (m *mockEc2) DescribeSnapshotsPages(input *ec2.DescribeSnapshotsInput, fn func(p *ec2.DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
 	var pages [][]*ec2.Snapshot
 	for i := 0; i < len(m.snapshots); i += 2 {
@@ -193,6 +229,18 @@ func (m *mockEc2) DescribeSnapshotsPages(input *ec2.DescribeSnapshotsInput, fn f
 	return nil
 }
 
+func (m *mockEc2) DescribeSpotInstanceRequests(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	return &ec2.DescribeSpotInstanceRequestsOutput{SpotInstanceRequests: m.spotinstancerequests}, nil
+}
+
+func (m *mockEc2) DescribeReservedInstances(input *ec2.DescribeReservedInstancesInput) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{ReservedInstances: m.reservedinstancess}, nil
+}
+
+func (m *mockEc2) DescribePlacementGroups(input *ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error) {
+	return &ec2.DescribePlacementGroupsOutput{PlacementGroups: m.placementgroups}, nil
+}
+
 type mockElbv2 struct {
 	elbv2iface.ELBV2API
 	loadbalancers            []*elbv2.LoadBalancer
@@ -260,6 +308,66 @@ func (m *mockElbv2) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput)
 	return &elbv2.DescribeTargetGroupsOutput{TargetGroups: m.targetgroups}, nil
 }
 
+type mockElb struct {
+	elbiface.ELBAPI
+	loadbalancerdescriptions []*elb.LoadBalancerDescription
+}
+
+func (m *mockElb) Name() string {
+	return ""
+}
+
+func (m *mockElb) Region() string {
+	return ""
+}
+
+func (m *mockElb) Provider() string {
+	return ""
+}
+
+func (m *mockElb) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockElb) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewElbDriver(s.ELBAPI),
+	}
+}
+
+func (m *mockElb) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElb) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElb) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElb) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElb) DescribeLoadBalancersPages(input *elb.DescribeLoadBalancersInput, fn func(p *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elb.LoadBalancerDescription
+	for i := 0; i < len(m.loadbalancerdescriptions); i += 2 {
+		page := []*elb.LoadBalancerDescription{m.loadbalancerdescriptions[i]}
+		if i+1 < len(m.loadbalancerdescriptions) {
+			page = append(page, m.loadbalancerdescriptions[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elb.DescribeLoadBalancersOutput{LoadBalancerDescriptions: page, NextMarker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockRds struct {
 	rdsiface.RDSAPI
 	dbinstances    []*rds.DBInstance
@@ -338,6 +446,69 @@ func (m *mockRds) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsI
 	return nil
 }
 
+type mockElasticache struct {
+	elasticacheiface.ElastiCacheAPI
+	cacheclusters     []*elasticache.CacheCluster
+	cachesubnetgroups []*elasticache.CacheSubnetGroup
+	replicationgroups []*elasticache.ReplicationGroup
+	tags              map[string][]*elasticache.Tag
+}
+
+func (m *mockElasticache) Name() string {
+	return ""
+}
+
+func (m *mockElasticache) Region() string {
+	return ""
+}
+
+func (m *mockElasticache) Provider() string {
+	return ""
+}
+
+func (m *mockElasticache) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockElasticache) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewElasticacheDriver(s.ElastiCacheAPI),
+	}
+}
+
+func (m *mockElasticache) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElasticache) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElasticache) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) DescribeCacheSubnetGroupsPages(input *elasticache.DescribeCacheSubnetGroupsInput, fn func(p *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.CacheSubnetGroup
+	for i := 0; i < len(m.cachesubnetgroups); i += 2 {
+		page := []*elasticache.CacheSubnetGroup{m.cachesubnetgroups[i]}
+		if i+1 < len(m.cachesubnetgroups) {
+			page = append(page, m.cachesubnetgroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeCacheSubnetGroupsOutput{CacheSubnetGroups: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockAutoscaling struct {
 	autoscalingiface.AutoScalingAPI
 	launchconfigurations []*autoscaling.LaunchConfiguration
@@ -563,10 +734,54 @@ func (m *mockS3) FetchByType(t string) (*graph.Graph, error) {
 	return nil, nil
 }
 
+type mockDynamodb struct {
+	dynamodbiface.DynamoDBAPI
+	tabledescriptions []*dynamodb.TableDescription
+}
+
+func (m *mockDynamodb) Name() string {
+	return ""
+}
+
+func (m *mockDynamodb) Region() string {
+	return ""
+}
+
+func (m *mockDynamodb) Provider() string {
+	return ""
+}
+
+func (m *mockDynamodb) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockDynamodb) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewDynamodbDriver(s.DynamoDBAPI),
+	}
+}
+
+func (m *mockDynamodb) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockDynamodb) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockDynamodb) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockDynamodb) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
 type mockSns struct {
 	snsiface.SNSAPI
 	subscriptions []*sns.Subscription
 	topics        []*sns.Topic
+	attributes    map[string]map[string]*string
 }
 
 func (m *mockSns) Name() string {
@@ -752,7 +967,8 @@ func (m *mockRoute53) ListHostedZonesPages(input *route53.ListHostedZonesInput,
 
 type mockLambda struct {
 	lambdaiface.LambdaAPI
-	functionconfigurations []*lambda.FunctionConfiguration
+	functionconfigurations           []*lambda.FunctionConfiguration
+	eventsourcemappingconfigurations []*lambda.EventSourceMappingConfiguration
 }
 
 func (m *mockLambda) Name() string {
@@ -888,6 +1104,66 @@ func (m *mockCloudwatch) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInp
 	return nil
 }
 
+type mockCloudwatchlogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	loggroups []*cloudwatchlogs.LogGroup
+}
+
+func (m *mockCloudwatchlogs) Name() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Region() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Provider() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockCloudwatchlogs) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewCloudwatchlogsDriver(s.CloudWatchLogsAPI),
+	}
+}
+
+func (m *mockCloudwatchlogs) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockCloudwatchlogs) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockCloudwatchlogs) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) DescribeLogGroupsPages(input *cloudwatchlogs.DescribeLogGroupsInput, fn func(p *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*cloudwatchlogs.LogGroup
+	for i := 0; i < len(m.loggroups); i += 2 {
+		page := []*cloudwatchlogs.LogGroup{m.loggroups[i]}
+		if i+1 < len(m.loggroups) {
+			page = append(page, m.loggroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockCloudfront struct {
 	cloudfrontiface.CloudFrontAPI
 	distributionsummarys []*cloudfront.DistributionSummary
@@ -933,7 +1209,8 @@ func (m *mockCloudfront) FetchByType(t string) (*graph.Graph, error) {
 
 type mockCloudformation struct {
 	cloudformationiface.CloudFormationAPI
-	stacks []*cloudformation.Stack
+	stacks                []*cloudformation.Stack
+	stackresourcesummarys map[string][]*cloudformation.StackResourceSummary
 }
 
 func (m *mockCloudformation) Name() string {
@@ -1134,3 +1411,407 @@ func (m *mockEcs) ListTaskDefinitionsPages(input *ecs.ListTaskDefinitionsInput,
 	}
 	return nil
 }
+
+type mockWafv2 struct {
+	wafv2iface.WAFV2API
+	webacls         []*wafv2.WebACL
+	webaclResources map[string][]*string
+}
+
+func (m *mockWafv2) Name() string {
+	return ""
+}
+
+func (m *mockWafv2) Region() string {
+	return ""
+}
+
+func (m *mockWafv2) Provider() string {
+	return ""
+}
+
+func (m *mockWafv2) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockWafv2) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewWafv2Driver(s.WAFV2API),
+	}
+}
+
+func (m *mockWafv2) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockWafv2) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockWafv2) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockWafv2) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockAcm struct {
+	acmiface.ACMAPI
+	certificatedetails []*acm.CertificateDetail
+}
+
+func (m *mockAcm) Name() string {
+	return ""
+}
+
+func (m *mockAcm) Region() string {
+	return ""
+}
+
+func (m *mockAcm) Provider() string {
+	return ""
+}
+
+func (m *mockAcm) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockAcm) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewAcmDriver(s.ACMAPI),
+	}
+}
+
+func (m *mockAcm) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockAcm) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockAcm) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockAcm) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockElasticbeanstalk struct {
+	elasticbeanstalkiface.ElasticBeanstalkAPI
+	applicationdescriptions         []*elasticbeanstalk.ApplicationDescription
+	environmentdescriptions         []*elasticbeanstalk.EnvironmentDescription
+	environmentresourcedescriptions map[string][]*elasticbeanstalk.EnvironmentResourceDescription
+}
+
+func (m *mockElasticbeanstalk) Name() string {
+	return ""
+}
+
+func (m *mockElasticbeanstalk) Region() string {
+	return ""
+}
+
+func (m *mockElasticbeanstalk) Provider() string {
+	return ""
+}
+
+func (m *mockElasticbeanstalk) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockElasticbeanstalk) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewElasticbeanstalkDriver(s.ElasticBeanstalkAPI),
+	}
+}
+
+func (m *mockElasticbeanstalk) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElasticbeanstalk) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElasticbeanstalk) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElasticbeanstalk) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockElasticbeanstalk) DescribeApplications(input *elasticbeanstalk.DescribeApplicationsInput) (*elasticbeanstalk.DescribeApplicationsOutput, error) {
+	return &elasticbeanstalk.DescribeApplicationsOutput{Applications: m.applicationdescriptions}, nil
+}
+
+func (m *mockElasticbeanstalk) DescribeEnvironments(input *elasticbeanstalk.DescribeEnvironmentsInput) (*elasticbeanstalk.EnvironmentDescriptionsMessage, error) {
+	return &elasticbeanstalk.EnvironmentDescriptionsMessage{Environments: m.environmentdescriptions}, nil
+}
+
+type mockApigateway struct {
+	apigatewayiface.APIGatewayAPI
+	restapis     []*apigateway.RestApi
+	stages       map[string][]*apigateway.Stage
+	resources    map[string][]*apigateway.Resource
+	integrations map[string][]*apigateway.Integration
+}
+
+func (m *mockApigateway) Name() string {
+	return ""
+}
+
+func (m *mockApigateway) Region() string {
+	return ""
+}
+
+func (m *mockApigateway) Provider() string {
+	return ""
+}
+
+func (m *mockApigateway) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockApigateway) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewApigatewayDriver(s.APIGatewayAPI),
+	}
+}
+
+func (m *mockApigateway) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockApigateway) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockApigateway) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockApigateway) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockApigatewayv2 struct {
+	apigatewayv2iface.ApiGatewayV2API
+	apis         []*apigatewayv2.Api
+	stages       map[string][]*apigatewayv2.Stage
+	integrations map[string][]*apigatewayv2.Integration
+}
+
+func (m *mockApigatewayv2) Name() string {
+	return ""
+}
+
+func (m *mockApigatewayv2) Region() string {
+	return ""
+}
+
+func (m *mockApigatewayv2) Provider() string {
+	return ""
+}
+
+func (m *mockApigatewayv2) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockApigatewayv2) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewApigatewayv2Driver(s.ApiGatewayV2API),
+	}
+}
+
+func (m *mockApigatewayv2) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockApigatewayv2) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockApigatewayv2) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockApigatewayv2) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockSsm struct {
+	ssmiface.SSMAPI
+	parametermetadatas []*ssm.ParameterMetadata
+}
+
+func (m *mockSsm) Name() string {
+	return ""
+}
+
+func (m *mockSsm) Region() string {
+	return ""
+}
+
+func (m *mockSsm) Provider() string {
+	return ""
+}
+
+func (m *mockSsm) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockSsm) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewSsmDriver(s.SSMAPI),
+	}
+}
+
+func (m *mockSsm) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSsm) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockSsm) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSsm) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockKms struct {
+	kmsiface.KMSAPI
+	keymetadatas    []*kms.KeyMetadata
+	aliaslistentrys []*kms.AliasListEntry
+}
+
+func (m *mockKms) Name() string {
+	return ""
+}
+
+func (m *mockKms) Region() string {
+	return ""
+}
+
+func (m *mockKms) Provider() string {
+	return ""
+}
+
+func (m *mockKms) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockKms) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewKmsDriver(s.KMSAPI),
+	}
+}
+
+func (m *mockKms) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockKms) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockKms) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockKms) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockGuardduty struct {
+	guarddutyiface.GuardDutyAPI
+	findings []*guardduty.Finding
+}
+
+func (m *mockGuardduty) Name() string {
+	return ""
+}
+
+func (m *mockGuardduty) Region() string {
+	return ""
+}
+
+func (m *mockGuardduty) Provider() string {
+	return ""
+}
+
+func (m *mockGuardduty) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockGuardduty) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewGuarddutyDriver(s.GuardDutyAPI),
+	}
+}
+
+func (m *mockGuardduty) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockGuardduty) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockGuardduty) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockGuardduty) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
+
+type mockSecretsmanager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secretlistentrys []*secretsmanager.SecretListEntry
+}
+
+func (m *mockSecretsmanager) Name() string {
+	return ""
+}
+
+func (m *mockSecretsmanager) Region() string {
+	return ""
+}
+
+func (m *mockSecretsmanager) Provider() string {
+	return ""
+}
+
+func (m *mockSecretsmanager) ProviderAPI() string {
+	return ""
+}
+
+func (s *mockSecretsmanager) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewSecretsmanagerDriver(s.SecretsManagerAPI),
+	}
+}
+
+func (m *mockSecretsmanager) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSecretsmanager) FetchResources() (*graph.Graph, error) {
+	return nil, nil
+}
+
+func (m *mockSecretsmanager) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSecretsmanager) FetchByType(t string) (*graph.Graph, error) {
+	return nil, nil
+}
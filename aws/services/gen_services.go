@@ -28,6 +28,12 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -38,16 +44,30 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/guardduty/guarddutyiface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -56,12 +76,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 	"github.com/wallix/awless/aws/driver"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
@@ -99,12 +125,21 @@ var ResourceTypes = []string{
 	"image",
 	"importimagetask",
 	"elasticip",
+	"networkinterface",
 	"snapshot",
+	"spotrequest",
+	"reservedinstance",
+	"placementgroup",
+	"host",
+	"vpcendpoint",
 	"loadbalancer",
 	"targetgroup",
 	"listener",
+	"classicloadbalancer",
 	"database",
 	"dbsubnetgroup",
+	"cachecluster",
+	"cachesubnetgroup",
 	"launchconfiguration",
 	"scalinggroup",
 	"scalingpolicy",
@@ -113,6 +148,16 @@ var ResourceTypes = []string{
 	"containertask",
 	"container",
 	"containerinstance",
+	"webacl",
+	"certificate",
+	"beanstalkapplication",
+	"beanstalkenvironment",
+	"restapi",
+	"apistage",
+	"parameter",
+	"kmskey",
+	"finding",
+	"secret",
 	"user",
 	"group",
 	"role",
@@ -121,6 +166,7 @@ var ResourceTypes = []string{
 	"instanceprofile",
 	"bucket",
 	"s3object",
+	"table",
 	"subscription",
 	"topic",
 	"queue",
@@ -129,6 +175,7 @@ var ResourceTypes = []string{
 	"function",
 	"metric",
 	"alarm",
+	"loggroup",
 	"distribution",
 	"stack",
 }
@@ -136,117 +183,172 @@ var ResourceTypes = []string{
 var ServicePerAPI = map[string]string{
 	"ec2":         "infra",
 	"elbv2":       "infra",
+	"elb":         "infra",
 	"rds":         "infra",
+	"elasticache": "infra",
 	"autoscaling": "infra",
 	"ecr":         "infra",
 	"ecs":         "infra",
 	"applicationautoscaling": "infra",
+	"wafv2":       "infra",
+	"acm":         "infra",
+	"elasticbeanstalk": "infra",
+	"apigateway":       "infra",
+	"apigatewayv2":     "infra",
+	"ssm":              "infra",
+	"kms":              "infra",
+	"guardduty":        "infra",
+	"secretsmanager":   "infra",
 	"iam":            "access",
 	"sts":            "access",
 	"s3":             "storage",
+	"dynamodb":       "storage",
 	"sns":            "messaging",
 	"sqs":            "messaging",
 	"route53":        "dns",
 	"lambda":         "lambda",
 	"cloudwatch":     "monitoring",
+	"cloudwatchlogs": "monitoring",
 	"cloudfront":     "cdn",
 	"cloudformation": "cloudformation",
 }
 
 var ServicePerResourceType = map[string]string{
-	"instance":            "infra",
-	"subnet":              "infra",
-	"vpc":                 "infra",
-	"keypair":             "infra",
-	"securitygroup":       "infra",
-	"volume":              "infra",
-	"internetgateway":     "infra",
-	"natgateway":          "infra",
-	"routetable":          "infra",
-	"availabilityzone":    "infra",
-	"image":               "infra",
-	"importimagetask":     "infra",
-	"elasticip":           "infra",
-	"snapshot":            "infra",
-	"loadbalancer":        "infra",
-	"targetgroup":         "infra",
-	"listener":            "infra",
-	"database":            "infra",
-	"dbsubnetgroup":       "infra",
-	"launchconfiguration": "infra",
-	"scalinggroup":        "infra",
-	"scalingpolicy":       "infra",
-	"repository":          "infra",
-	"containercluster":    "infra",
-	"containertask":       "infra",
-	"container":           "infra",
-	"containerinstance":   "infra",
-	"user":                "access",
-	"group":               "access",
-	"role":                "access",
-	"policy":              "access",
-	"accesskey":           "access",
-	"instanceprofile":     "access",
-	"bucket":              "storage",
-	"s3object":            "storage",
-	"subscription":        "messaging",
-	"topic":               "messaging",
-	"queue":               "messaging",
-	"zone":                "dns",
-	"record":              "dns",
-	"function":            "lambda",
-	"metric":              "monitoring",
-	"alarm":               "monitoring",
-	"distribution":        "cdn",
-	"stack":               "cloudformation",
+	"instance":             "infra",
+	"subnet":               "infra",
+	"vpc":                  "infra",
+	"keypair":              "infra",
+	"securitygroup":        "infra",
+	"volume":               "infra",
+	"internetgateway":      "infra",
+	"natgateway":           "infra",
+	"routetable":           "infra",
+	"availabilityzone":     "infra",
+	"image":                "infra",
+	"importimagetask":      "infra",
+	"elasticip":            "infra",
+	"networkinterface":     "infra",
+	"snapshot":             "infra",
+	"spotrequest":          "infra",
+	"reservedinstance":     "infra",
+	"placementgroup":       "infra",
+	"host":                 "infra",
+	"vpcendpoint":          "infra",
+	"loadbalancer":         "infra",
+	"targetgroup":          "infra",
+	"listener":             "infra",
+	"classicloadbalancer":  "infra",
+	"database":             "infra",
+	"dbsubnetgroup":        "infra",
+	"cachecluster":         "infra",
+	"cachesubnetgroup":     "infra",
+	"launchconfiguration":  "infra",
+	"scalinggroup":         "infra",
+	"scalingpolicy":        "infra",
+	"repository":           "infra",
+	"containercluster":     "infra",
+	"containertask":        "infra",
+	"container":            "infra",
+	"containerinstance":    "infra",
+	"webacl":               "infra",
+	"certificate":          "infra",
+	"beanstalkapplication": "infra",
+	"beanstalkenvironment": "infra",
+	"restapi":              "infra",
+	"apistage":             "infra",
+	"parameter":            "infra",
+	"kmskey":               "infra",
+	"finding":              "infra",
+	"secret":               "infra",
+	"user":                 "access",
+	"group":                "access",
+	"role":                 "access",
+	"policy":               "access",
+	"accesskey":            "access",
+	"instanceprofile":      "access",
+	"bucket":               "storage",
+	"s3object":             "storage",
+	"table":                "storage",
+	"subscription":         "messaging",
+	"topic":                "messaging",
+	"queue":                "messaging",
+	"zone":                 "dns",
+	"record":               "dns",
+	"function":             "lambda",
+	"metric":               "monitoring",
+	"alarm":                "monitoring",
+	"loggroup":             "monitoring",
+	"distribution":         "cdn",
+	"stack":                "cloudformation",
 }
 
 var APIPerResourceType = map[string]string{
-	"instance":            "ec2",
-	"subnet":              "ec2",
-	"vpc":                 "ec2",
-	"keypair":             "ec2",
-	"securitygroup":       "ec2",
-	"volume":              "ec2",
-	"internetgateway":     "ec2",
-	"natgateway":          "ec2",
-	"routetable":          "ec2",
-	"availabilityzone":    "ec2",
-	"image":               "ec2",
-	"importimagetask":     "ec2",
-	"elasticip":           "ec2",
-	"snapshot":            "ec2",
-	"loadbalancer":        "elbv2",
-	"targetgroup":         "elbv2",
-	"listener":            "elbv2",
-	"database":            "rds",
-	"dbsubnetgroup":       "rds",
-	"launchconfiguration": "autoscaling",
-	"scalinggroup":        "autoscaling",
-	"scalingpolicy":       "autoscaling",
-	"repository":          "ecr",
-	"containercluster":    "ecs",
-	"containertask":       "ecs",
-	"container":           "ecs",
-	"containerinstance":   "ecs",
-	"user":                "iam",
-	"group":               "iam",
-	"role":                "iam",
-	"policy":              "iam",
-	"accesskey":           "iam",
-	"instanceprofile":     "iam",
-	"bucket":              "s3",
-	"s3object":            "s3",
-	"subscription":        "sns",
-	"topic":               "sns",
-	"queue":               "sqs",
-	"zone":                "route53",
-	"record":              "route53",
-	"function":            "lambda",
-	"metric":              "cloudwatch",
-	"alarm":               "cloudwatch",
-	"distribution":        "cloudfront",
-	"stack":               "cloudformation",
+	"instance":             "ec2",
+	"subnet":               "ec2",
+	"vpc":                  "ec2",
+	"keypair":              "ec2",
+	"securitygroup":        "ec2",
+	"volume":               "ec2",
+	"internetgateway":      "ec2",
+	"natgateway":           "ec2",
+	"routetable":           "ec2",
+	"availabilityzone":     "ec2",
+	"image":                "ec2",
+	"importimagetask":      "ec2",
+	"elasticip":            "ec2",
+	"networkinterface":     "ec2",
+	"snapshot":             "ec2",
+	"spotrequest":          "ec2",
+	"reservedinstance":     "ec2",
+	"placementgroup":       "ec2",
+	"host":                 "ec2",
+	"vpcendpoint":          "ec2",
+	"loadbalancer":         "elbv2",
+	"targetgroup":          "elbv2",
+	"listener":             "elbv2",
+	"classicloadbalancer":  "elb",
+	"database":             "rds",
+	"dbsubnetgroup":        "rds",
+	"cachecluster":         "elasticache",
+	"cachesubnetgroup":     "elasticache",
+	"launchconfiguration":  "autoscaling",
+	"scalinggroup":         "autoscaling",
+	"scalingpolicy":        "autoscaling",
+	"repository":           "ecr",
+	"containercluster":     "ecs",
+	"containertask":        "ecs",
+	"container":            "ecs",
+	"containerinstance":    "ecs",
+	"webacl":               "wafv2",
+	"certificate":          "acm",
+	"beanstalkapplication": "elasticbeanstalk",
+	"beanstalkenvironment": "elasticbeanstalk",
+	"restapi":              "apigateway",
+	"apistage":             "apigateway",
+	"parameter":            "ssm",
+	"kmskey":               "kms",
+	"finding":              "guardduty",
+	"secret":               "secretsmanager",
+	"user":                 "iam",
+	"group":                "iam",
+	"role":                 "iam",
+	"policy":               "iam",
+	"accesskey":            "iam",
+	"instanceprofile":      "iam",
+	"bucket":               "s3",
+	"s3object":             "s3",
+	"table":                "dynamodb",
+	"subscription":         "sns",
+	"topic":                "sns",
+	"queue":                "sqs",
+	"zone":                 "route53",
+	"record":               "route53",
+	"function":             "lambda",
+	"metric":               "cloudwatch",
+	"alarm":                "cloudwatch",
+	"loggroup":             "cloudwatchlogs",
+	"distribution":         "cloudfront",
+	"stack":                "cloudformation",
 }
 
 var GlobalServices = []string{
@@ -262,31 +364,66 @@ type Infra struct {
 	log     *logger.Logger
 	ec2iface.EC2API
 	elbv2iface.ELBV2API
+	elbiface.ELBAPI
 	rdsiface.RDSAPI
+	elasticacheiface.ElastiCacheAPI
 	autoscalingiface.AutoScalingAPI
 	ecriface.ECRAPI
 	ecsiface.ECSAPI
 	applicationautoscalingiface.ApplicationAutoScalingAPI
+	wafv2iface.WAFV2API
+	acmiface.ACMAPI
+	elasticbeanstalkiface.ElasticBeanstalkAPI
+	apigatewayiface.APIGatewayAPI
+	apigatewayv2iface.ApiGatewayV2API
+	ssmiface.SSMAPI
+	kmsiface.KMSAPI
+	guarddutyiface.GuardDutyAPI
+	secretsmanageriface.SecretsManagerAPI
 }
 
 func NewInfra(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	ec2API := ec2.New(sess)
 	elbv2API := elbv2.New(sess)
+	elbAPI := elb.New(sess)
 	rdsAPI := rds.New(sess)
+	elasticacheAPI := elasticache.New(sess)
 	autoscalingAPI := autoscaling.New(sess)
 	ecrAPI := ecr.New(sess)
 	ecsAPI := ecs.New(sess)
 	applicationautoscalingAPI := applicationautoscaling.New(sess)
+	wafv2API := wafv2.New(sess)
+	acmAPI := acm.New(sess)
+	elasticbeanstalkAPI := elasticbeanstalk.New(sess)
+	apigatewayAPI := apigateway.New(sess)
+	apigatewayv2API := apigatewayv2.New(sess)
+	ssmAPI := ssm.New(sess)
+	kmsAPI := kms.New(sess)
+	guarddutyAPI := guardduty.New(sess)
+	secretsmanagerAPI := secretsmanager.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		ec2API,
 		elbv2API,
+		elbAPI,
 		rdsAPI,
+		elasticacheAPI,
 		autoscalingAPI,
 		ecrAPI,
 		ecsAPI,
 		applicationautoscalingAPI,
+		wafv2API,
+		acmAPI,
+		elasticbeanstalkAPI,
+		apigatewayAPI,
+		apigatewayv2API,
+		ssmAPI,
+		kmsAPI,
+		guarddutyAPI,
+		secretsmanagerAPI,
+		sts.New(sess),
+		awsfetch.CloudFrontWafv2{WAFV2API: wafv2.New(sess, awssdk.NewConfig().WithRegion(awsfetch.CloudFrontRegion))},
 	)
 	fetchConfig.Extra = awsconf
 	fetchConfig.Log = log
@@ -294,11 +431,22 @@ func NewInfra(sess *session.Session, awsconf config, log *logger.Logger) cloud.S
 	return &Infra{
 		EC2API:         ec2API,
 		ELBV2API:       elbv2API,
+		ELBAPI:         elbAPI,
 		RDSAPI:         rdsAPI,
+		ElastiCacheAPI: elasticacheAPI,
 		AutoScalingAPI: autoscalingAPI,
 		ECRAPI:         ecrAPI,
 		ECSAPI:         ecsAPI,
 		ApplicationAutoScalingAPI: applicationautoscalingAPI,
+		WAFV2API:                  wafv2API,
+		ACMAPI:                    acmAPI,
+		ElasticBeanstalkAPI:       elasticbeanstalkAPI,
+		APIGatewayAPI:             apigatewayAPI,
+		ApiGatewayV2API:           apigatewayv2API,
+		SSMAPI:                    ssmAPI,
+		KMSAPI:                    kmsAPI,
+		GuardDutyAPI:              guarddutyAPI,
+		SecretsManagerAPI:         secretsmanagerAPI,
 		fetcher:                   fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
 		config:                    awsconf,
 		region:                    region,
@@ -318,11 +466,22 @@ func (s *Infra) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewEc2Driver(s.EC2API),
 		awsdriver.NewElbv2Driver(s.ELBV2API),
+		awsdriver.NewElbDriver(s.ELBAPI),
 		awsdriver.NewRdsDriver(s.RDSAPI),
+		awsdriver.NewElasticacheDriver(s.ElastiCacheAPI),
 		awsdriver.NewAutoscalingDriver(s.AutoScalingAPI),
 		awsdriver.NewEcrDriver(s.ECRAPI),
 		awsdriver.NewEcsDriver(s.ECSAPI),
 		awsdriver.NewApplicationautoscalingDriver(s.ApplicationAutoScalingAPI),
+		awsdriver.NewWafv2Driver(s.WAFV2API),
+		awsdriver.NewAcmDriver(s.ACMAPI),
+		awsdriver.NewElasticbeanstalkDriver(s.ElasticBeanstalkAPI),
+		awsdriver.NewApigatewayDriver(s.APIGatewayAPI),
+		awsdriver.NewApigatewayv2Driver(s.ApiGatewayV2API),
+		awsdriver.NewSsmDriver(s.SSMAPI),
+		awsdriver.NewKmsDriver(s.KMSAPI),
+		awsdriver.NewGuarddutyDriver(s.GuardDutyAPI),
+		awsdriver.NewSecretsmanagerDriver(s.SecretsManagerAPI),
 	}
 }
 
@@ -341,12 +500,21 @@ func (s *Infra) ResourceTypes() []string {
 		"image",
 		"importimagetask",
 		"elasticip",
+		"networkinterface",
 		"snapshot",
+		"spotrequest",
+		"reservedinstance",
+		"placementgroup",
+		"host",
+		"vpcendpoint",
 		"loadbalancer",
 		"targetgroup",
 		"listener",
+		"classicloadbalancer",
 		"database",
 		"dbsubnetgroup",
+		"cachecluster",
+		"cachesubnetgroup",
 		"launchconfiguration",
 		"scalinggroup",
 		"scalingpolicy",
@@ -355,6 +523,16 @@ func (s *Infra) ResourceTypes() []string {
 		"containertask",
 		"container",
 		"containerinstance",
+		"webacl",
+		"certificate",
+		"beanstalkapplication",
+		"beanstalkenvironment",
+		"restapi",
+		"apistage",
+		"parameter",
+		"kmskey",
+		"finding",
+		"secret",
 	}
 }
 
@@ -404,7 +582,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Instance) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -426,7 +604,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Subnet) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -448,7 +626,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Vpc) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -470,7 +648,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.KeyPairInfo) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -492,7 +670,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.SecurityGroup) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -514,7 +692,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Volume) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -536,7 +714,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.InternetGateway) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -558,7 +736,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.NatGateway) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -580,7 +758,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.RouteTable) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -602,7 +780,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.AvailabilityZone) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -624,7 +802,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Image) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -646,7 +824,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.ImportImageTask) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -668,7 +846,29 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Address) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.networkinterface.sync", true) {
+		list, err := s.fetcher.Get("networkinterface_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.NetworkInterface); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.NetworkInterface' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.NetworkInterface) {
+			for _, fn := range addParentsFns["networkinterface"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.NetworkInterface) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -690,7 +890,117 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ec2.Snapshot) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.spotrequest.sync", true) {
+		list, err := s.fetcher.Get("spotrequest_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.SpotInstanceRequest); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.SpotInstanceRequest' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.SpotInstanceRequest) {
+			for _, fn := range addParentsFns["spotrequest"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.SpotInstanceRequest) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.reservedinstance.sync", true) {
+		list, err := s.fetcher.Get("reservedinstance_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.ReservedInstances); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.ReservedInstances' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.ReservedInstances) {
+			for _, fn := range addParentsFns["reservedinstance"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.ReservedInstances) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.placementgroup.sync", true) {
+		list, err := s.fetcher.Get("placementgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.PlacementGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.PlacementGroup' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.PlacementGroup) {
+			for _, fn := range addParentsFns["placementgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.PlacementGroup) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.host.sync", true) {
+		list, err := s.fetcher.Get("host_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.Host); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.Host' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.Host) {
+			for _, fn := range addParentsFns["host"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.Host) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.vpcendpoint.sync", true) {
+		list, err := s.fetcher.Get("vpcendpoint_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpcEndpoint); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpcEndpoint' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpcEndpoint) {
+			for _, fn := range addParentsFns["vpcendpoint"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ec2.VpcEndpoint) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -712,7 +1022,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *elbv2.LoadBalancer) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -734,7 +1044,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *elbv2.TargetGroup) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -756,7 +1066,29 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *elbv2.Listener) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.classicloadbalancer.sync", true) {
+		list, err := s.fetcher.Get("classicloadbalancer_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elb.LoadBalancerDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*elb.LoadBalancerDescription' type from fetch context")
+		}
+		for _, r := range list.([]*elb.LoadBalancerDescription) {
+			for _, fn := range addParentsFns["classicloadbalancer"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *elb.LoadBalancerDescription) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -778,7 +1110,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *rds.DBInstance) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -800,7 +1132,51 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *rds.DBSubnetGroup) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.cachecluster.sync", true) {
+		list, err := s.fetcher.Get("cachecluster_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticache.CacheCluster); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.CacheCluster' type from fetch context")
+		}
+		for _, r := range list.([]*elasticache.CacheCluster) {
+			for _, fn := range addParentsFns["cachecluster"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *elasticache.CacheCluster) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.cachesubnetgroup.sync", true) {
+		list, err := s.fetcher.Get("cachesubnetgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticache.CacheSubnetGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.CacheSubnetGroup' type from fetch context")
+		}
+		for _, r := range list.([]*elasticache.CacheSubnetGroup) {
+			for _, fn := range addParentsFns["cachesubnetgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *elasticache.CacheSubnetGroup) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -822,7 +1198,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *autoscaling.LaunchConfiguration) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -844,7 +1220,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *autoscaling.Group) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -866,7 +1242,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *autoscaling.ScalingPolicy) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -888,7 +1264,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ecr.Repository) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -910,7 +1286,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ecs.Cluster) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -932,7 +1308,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ecs.TaskDefinition) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -954,7 +1330,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ecs.Container) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -976,7 +1352,227 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *ecs.ContainerInstance) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.webacl.sync", true) {
+		list, err := s.fetcher.Get("webacl_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*wafv2.WebACL); !ok {
+			return gph, errors.New("cannot cast to '[]*wafv2.WebACL' type from fetch context")
+		}
+		for _, r := range list.([]*wafv2.WebACL) {
+			for _, fn := range addParentsFns["webacl"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *wafv2.WebACL) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.certificate.sync", true) {
+		list, err := s.fetcher.Get("certificate_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*acm.CertificateDetail); !ok {
+			return gph, errors.New("cannot cast to '[]*acm.CertificateDetail' type from fetch context")
+		}
+		for _, r := range list.([]*acm.CertificateDetail) {
+			for _, fn := range addParentsFns["certificate"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *acm.CertificateDetail) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.beanstalkapplication.sync", true) {
+		list, err := s.fetcher.Get("beanstalkapplication_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticbeanstalk.ApplicationDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticbeanstalk.ApplicationDescription' type from fetch context")
+		}
+		for _, r := range list.([]*elasticbeanstalk.ApplicationDescription) {
+			for _, fn := range addParentsFns["beanstalkapplication"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *elasticbeanstalk.ApplicationDescription) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.beanstalkenvironment.sync", true) {
+		list, err := s.fetcher.Get("beanstalkenvironment_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticbeanstalk.EnvironmentDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticbeanstalk.EnvironmentDescription' type from fetch context")
+		}
+		for _, r := range list.([]*elasticbeanstalk.EnvironmentDescription) {
+			for _, fn := range addParentsFns["beanstalkenvironment"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *elasticbeanstalk.EnvironmentDescription) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.restapi.sync", true) {
+		list, err := s.fetcher.Get("restapi_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]interface{}); !ok {
+			return gph, errors.New("cannot cast to '[]interface{}' type from fetch context")
+		}
+		for _, r := range list.([]interface{}) {
+			for _, fn := range addParentsFns["restapi"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res interface{}) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.apistage.sync", true) {
+		list, err := s.fetcher.Get("apistage_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*apigateway.Stage); !ok {
+			return gph, errors.New("cannot cast to '[]*apigateway.Stage' type from fetch context")
+		}
+		for _, r := range list.([]*apigateway.Stage) {
+			for _, fn := range addParentsFns["apistage"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *apigateway.Stage) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.parameter.sync", true) {
+		list, err := s.fetcher.Get("parameter_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ssm.ParameterMetadata); !ok {
+			return gph, errors.New("cannot cast to '[]*ssm.ParameterMetadata' type from fetch context")
+		}
+		for _, r := range list.([]*ssm.ParameterMetadata) {
+			for _, fn := range addParentsFns["parameter"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *ssm.ParameterMetadata) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.kmskey.sync", true) {
+		list, err := s.fetcher.Get("kmskey_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*kms.KeyMetadata); !ok {
+			return gph, errors.New("cannot cast to '[]*kms.KeyMetadata' type from fetch context")
+		}
+		for _, r := range list.([]*kms.KeyMetadata) {
+			for _, fn := range addParentsFns["kmskey"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *kms.KeyMetadata) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.finding.sync", true) {
+		list, err := s.fetcher.Get("finding_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*guardduty.Finding); !ok {
+			return gph, errors.New("cannot cast to '[]*guardduty.Finding' type from fetch context")
+		}
+		for _, r := range list.([]*guardduty.Finding) {
+			for _, fn := range addParentsFns["finding"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *guardduty.Finding) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.infra.secret.sync", true) {
+		list, err := s.fetcher.Get("secret_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*secretsmanager.SecretListEntry); !ok {
+			return gph, errors.New("cannot cast to '[]*secretsmanager.SecretListEntry' type from fetch context")
+		}
+		for _, r := range list.([]*secretsmanager.SecretListEntry) {
+			for _, fn := range addParentsFns["secret"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *secretsmanager.SecretListEntry) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1117,7 +1713,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.UserDetail) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1139,7 +1735,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.GroupDetail) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1161,7 +1757,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.RoleDetail) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1183,7 +1779,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.Policy) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1205,7 +1801,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.AccessKeyMetadata) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1227,7 +1823,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *iam.InstanceProfile) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1271,24 +1867,28 @@ type Storage struct {
 	config  config
 	log     *logger.Logger
 	s3iface.S3API
+	dynamodbiface.DynamoDBAPI
 }
 
 func NewStorage(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	s3API := s3.New(sess)
+	dynamodbAPI := dynamodb.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		s3API,
+		dynamodbAPI,
 	)
 	fetchConfig.Extra = awsconf
 	fetchConfig.Log = log
 
 	return &Storage{
-		S3API:   s3API,
-		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(fetchConfig)),
-		config:  awsconf,
-		region:  region,
-		log:     log,
+		S3API:       s3API,
+		DynamoDBAPI: dynamodbAPI,
+		fetcher:     fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(fetchConfig)),
+		config:      awsconf,
+		region:      region,
+		log:         log,
 	}
 }
 
@@ -1303,6 +1903,7 @@ func (s *Storage) Region() string {
 func (s *Storage) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewS3Driver(s.S3API),
+		awsdriver.NewDynamodbDriver(s.DynamoDBAPI),
 	}
 }
 
@@ -1310,6 +1911,7 @@ func (s *Storage) ResourceTypes() []string {
 	return []string{
 		"bucket",
 		"s3object",
+		"table",
 	}
 }
 
@@ -1359,7 +1961,7 @@ func (s *Storage) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *s3.Bucket) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1381,7 +1983,29 @@ func (s *Storage) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *s3.Object) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.storage.table.sync", true) {
+		list, err := s.fetcher.Get("table_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*dynamodb.TableDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*dynamodb.TableDescription' type from fetch context")
+		}
+		for _, r := range list.([]*dynamodb.TableDescription) {
+			for _, fn := range addParentsFns["table"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *dynamodb.TableDescription) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1519,7 +2143,7 @@ func (s *Messaging) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *sns.Subscription) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1541,7 +2165,7 @@ func (s *Messaging) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *sns.Topic) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1563,7 +2187,7 @@ func (s *Messaging) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *string) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1695,7 +2319,7 @@ func (s *Dns) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *route53.HostedZone) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1717,7 +2341,7 @@ func (s *Dns) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *route53.ResourceRecordSet) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1848,7 +2472,7 @@ func (s *Lambda) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *lambda.FunctionConfiguration) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1892,24 +2516,28 @@ type Monitoring struct {
 	config  config
 	log     *logger.Logger
 	cloudwatchiface.CloudWatchAPI
+	cloudwatchlogsiface.CloudWatchLogsAPI
 }
 
 func NewMonitoring(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	cloudwatchAPI := cloudwatch.New(sess)
+	cloudwatchlogsAPI := cloudwatchlogs.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		cloudwatchAPI,
+		cloudwatchlogsAPI,
 	)
 	fetchConfig.Extra = awsconf
 	fetchConfig.Log = log
 
 	return &Monitoring{
-		CloudWatchAPI: cloudwatchAPI,
-		fetcher:       fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)),
-		config:        awsconf,
-		region:        region,
-		log:           log,
+		CloudWatchAPI:     cloudwatchAPI,
+		CloudWatchLogsAPI: cloudwatchlogsAPI,
+		fetcher:           fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)),
+		config:            awsconf,
+		region:            region,
+		log:               log,
 	}
 }
 
@@ -1924,6 +2552,7 @@ func (s *Monitoring) Region() string {
 func (s *Monitoring) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewCloudwatchDriver(s.CloudWatchAPI),
+		awsdriver.NewCloudwatchlogsDriver(s.CloudWatchLogsAPI),
 	}
 }
 
@@ -1931,6 +2560,7 @@ func (s *Monitoring) ResourceTypes() []string {
 	return []string{
 		"metric",
 		"alarm",
+		"loggroup",
 	}
 }
 
@@ -1980,7 +2610,7 @@ func (s *Monitoring) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *cloudwatch.Metric) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -2002,7 +2632,29 @@ func (s *Monitoring) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *cloudwatch.MetricAlarm) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, s.region, r)
+			}
+		}
+	}
+	if s.config.getBool("aws.monitoring.loggroup.sync", true) {
+		list, err := s.fetcher.Get("loggroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*cloudwatchlogs.LogGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudwatchlogs.LogGroup' type from fetch context")
+		}
+		for _, r := range list.([]*cloudwatchlogs.LogGroup) {
+			for _, fn := range addParentsFns["loggroup"] {
+				wg.Add(1)
+				go func(f addParentFn, region string, res *cloudwatchlogs.LogGroup) {
+					defer wg.Done()
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -2133,7 +2785,7 @@ func (s *Cdn) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *cloudfront.DistributionSummary) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -2264,7 +2916,7 @@ func (s *Cloudformation) FetchResources() (*graph.Graph, error) {
 				wg.Add(1)
 				go func(f addParentFn, region string, res *cloudformation.Stack) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -1,9 +1,12 @@
 package awsservices
 
 import (
+	"reflect"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
@@ -46,3 +49,59 @@ func TestGetIdentityParseAllTypesOfUsername(t *testing.T) {
 		}
 	}
 }
+
+type mockSimulateIAM struct {
+	iamiface.IAMAPI
+	input   *iam.SimulatePrincipalPolicyInput
+	allowed map[string]bool
+}
+
+func (m *mockSimulateIAM) SimulatePrincipalPolicyPages(in *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error {
+	m.input = in
+	out := &iam.SimulatePolicyResponse{}
+	for _, a := range in.ActionNames {
+		decision := iam.PolicyEvaluationDecisionTypeImplicitDeny
+		if m.allowed[awssdk.StringValue(a)] {
+			decision = iam.PolicyEvaluationDecisionTypeAllowed
+		}
+		out.EvaluationResults = append(out.EvaluationResults, &iam.EvaluationResult{EvalActionName: a, EvalDecision: awssdk.String(decision)})
+	}
+	fn(out, true)
+	return nil
+}
+
+func TestSimulatePermissions(t *testing.T) {
+	tcases := []struct {
+		arn, expPolicySource string
+	}{
+		{arn: "arn:aws:iam::123456789012:user/Bob", expPolicySource: "arn:aws:iam::123456789012:user/Bob"},
+		{arn: "arn:aws:sts::123456789012:assumed-role/Admin/bob-session", expPolicySource: "arn:aws:iam::123456789012:role/Admin"},
+	}
+
+	for _, tcase := range tcases {
+		mIAM := &mockSimulateIAM{allowed: map[string]bool{"s3:CreateBucket": true}}
+		access := Access{
+			STSAPI: &mockSTS{output: &sts.GetCallerIdentityOutput{Account: awssdk.String("123456789012"), Arn: awssdk.String(tcase.arn)}},
+			IAMAPI: mIAM,
+		}
+		allowed, err := access.SimulatePermissions([]string{"s3:CreateBucket", "iam:CreateUser"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := awssdk.StringValue(mIAM.input.PolicySourceArn), tcase.expPolicySource; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := allowed, map[string]bool{"s3:CreateBucket": true, "iam:CreateUser": false}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	access := Access{STSAPI: &mockSTS{output: &sts.GetCallerIdentityOutput{Arn: awssdk.String("arn:aws:iam::123456789012:root")}}}
+	allowed, err := access.SimulatePermissions([]string{"iam:CreateUser"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !allowed["iam:CreateUser"] {
+		t.Fatal("expected root to be allowed")
+	}
+}
@@ -19,13 +19,19 @@ package awsservices
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 
 	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
 )
 
+// customCABundle is the PEM encoded CA bundle trusted by the sessions of the services and drivers
+var customCABundle []byte
+
 var (
 	AccessService, InfraService, StorageService, MessagingService, DnsService, LambdaService, MonitoringService, CdnService, CloudformationService cloud.Service
 )
@@ -37,13 +43,35 @@ func Init(conf map[string]interface{}, log *logger.Logger, profileSetterCallback
 		return errors.New("empty AWS region. Set it with `awless config set aws.region`")
 	}
 
+	caBundlePath := awsconf.caBundle()
+	if caBundlePath == "" {
+		caBundlePath = os.Getenv("AWS_CA_BUNDLE")
+	}
+	if caBundlePath != "" {
+		content, err := ioutil.ReadFile(caBundlePath)
+		if err != nil {
+			return fmt.Errorf("cannot read CA bundle: %s", err)
+		}
+		customCABundle = content
+		log.ExtraVerbosef("trusting the certificates of CA bundle %s", caBundlePath)
+	}
+
 	sb := newSessionResolver().withRegion(region).withProfile(awsconf.profile())
 	sb = sb.withProfileSetter(profileSetterCallback).withLogger(log).withCredentialResolvers()
+	sb = sb.withRateLimiter(DefaultRateLimiter).withRequestDedup(DefaultRequestDedup)
+	DefaultRateLimiter.SetLogger(log)
+	sb, err := sb.withCABundle(customCABundle)
+	if err != nil {
+		return fmt.Errorf("CA bundle %s: %s", caBundlePath, err)
+	}
 
 	sess, err := sb.resolve()
 	if err != nil {
 		return err
 	}
+	if creds, err := sess.Config.Credentials.Get(); err == nil {
+		credentialsSource = describeCredentialsProvider(creds.ProviderName)
+	}
 
 	AccessService = NewAccess(sess, awsconf, log)
 	InfraService = NewInfra(sess, awsconf, log)
@@ -65,7 +93,7 @@ func Init(conf map[string]interface{}, log *logger.Logger, profileSetterCallback
 	cloud.ServiceRegistry[CdnService.Name()] = CdnService
 	cloud.ServiceRegistry[CloudformationService.Name()] = CloudformationService
 
-	return nil
+	return registerPluginServices(fetch.Plugins(), region, log)
 }
 
 func NewDriver(region, profile string, log ...*logger.Logger) (driver.Driver, error) {
@@ -79,6 +107,11 @@ func NewDriver(region, profile string, log ...*logger.Logger) (driver.Driver, er
 	}
 
 	sb := newSessionResolver().withRegion(region).withProfile(profile).withLogger(drivLog).withCredentialResolvers()
+	sb = sb.withRateLimiter(DefaultRateLimiter)
+	sb, err := sb.withCABundle(customCABundle)
+	if err != nil {
+		return nil, err
+	}
 
 	sess, err := sb.resolve()
 	if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package awsservices
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"sync"
@@ -117,6 +118,47 @@ func (s *Access) GetIdentity() (*Identity, error) {
 	return ident, nil
 }
 
+// PolicySourceArn returns the ARN of the IAM user or role whose policies apply to this identity
+func (i *Identity) PolicySourceArn() string {
+	if i.ResourceType == "assumed-role" {
+		role := strings.SplitN(i.Resource, "/", 2)[0]
+		return fmt.Sprintf("arn:aws:iam::%s:role/%s", i.Account, role)
+	}
+	return i.Arn
+}
+
+// SimulatePermissions evaluates with the IAM policy simulator whether the
+// current identity is allowed to invoke the given actions (ex: ec2:RunInstances)
+func (s *Access) SimulatePermissions(actions []string) (map[string]bool, error) {
+	ident, err := s.GetIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool)
+	if ident.IsRoot() {
+		for _, a := range actions {
+			allowed[a] = true
+		}
+		return allowed, nil
+	}
+	if len(actions) == 0 {
+		return allowed, nil
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: awssdk.String(ident.PolicySourceArn()),
+		ActionNames:     awssdk.StringSlice(actions),
+	}
+	err = s.SimulatePrincipalPolicyPages(input, func(out *iam.SimulatePolicyResponse, lastPage bool) bool {
+		for _, res := range out.EvaluationResults {
+			allowed[awssdk.StringValue(res.EvalActionName)] = awssdk.StringValue(res.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed
+		}
+		return true
+	})
+	return allowed, err
+}
+
 type UserPolicies struct {
 	Username string
 	Inlined  []string
@@ -3,16 +3,32 @@ package awsservices
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 )
 
 func (m *mockEc2) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
@@ -83,10 +99,50 @@ func (m *mockS3) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBuc
 	return nil, fmt.Errorf("bucket location mock: bucket %s not found", awssdk.StringValue(input.Bucket))
 }
 
+func (m *mockSns) GetTopicAttributes(input *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+	return &sns.GetTopicAttributesOutput{Attributes: m.attributes[awssdk.StringValue(input.TopicArn)]}, nil
+}
+
 func (m *mockSqs) GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
 	return &sqs.GetQueueAttributesOutput{Attributes: m.attributes[awssdk.StringValue(input.QueueUrl)]}, nil
 }
 
+func (m *mockCloudformation) ListStackResourcesPages(input *cloudformation.ListStackResourcesInput, fn func(p *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	summaries, ok := m.stackresourcesummarys[awssdk.StringValue(input.StackName)]
+	if !ok {
+		return awserr.New("ValidationError", "Stack does not exist", nil)
+	}
+	fn(&cloudformation.ListStackResourcesOutput{StackResourceSummaries: summaries}, true)
+	return nil
+}
+
+func (m *mockDynamodb) ListTablesPages(input *dynamodb.ListTablesInput, fn func(p *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, table := range m.tabledescriptions {
+		fn(&dynamodb.ListTablesOutput{TableNames: []*string{table.TableName}}, i == len(m.tabledescriptions)-1)
+	}
+	return nil
+}
+
+func (m *mockDynamodb) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	for _, table := range m.tabledescriptions {
+		if awssdk.StringValue(table.TableName) == awssdk.StringValue(input.TableName) {
+			return &dynamodb.DescribeTableOutput{Table: table}, nil
+		}
+	}
+	return nil, fmt.Errorf("describe table mock: table %s not found", awssdk.StringValue(input.TableName))
+}
+
+func (m *mockLambda) ListEventSourceMappingsPages(input *lambda.ListEventSourceMappingsInput, fn func(p *lambda.ListEventSourceMappingsOutput, lastPage bool) (shouldContinue bool)) error {
+	var mappings []*lambda.EventSourceMappingConfiguration
+	for _, mapping := range m.eventsourcemappingconfigurations {
+		if input.FunctionName == nil || awssdk.StringValue(mapping.FunctionArn) == awssdk.StringValue(input.FunctionName) {
+			mappings = append(mappings, mapping)
+		}
+	}
+	fn(&lambda.ListEventSourceMappingsOutput{EventSourceMappings: mappings}, true)
+	return nil
+}
+
 func (m *mockCloudfront) ListDistributionsPages(input *cloudfront.ListDistributionsInput, fn func(p *cloudfront.ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	var pages [][]*cloudfront.DistributionSummary
 	for i := 0; i < len(m.distributionsummarys); i += 2 {
@@ -179,3 +235,318 @@ func (m *mockEcs) ListContainerInstancesPages(input *ecs.ListContainerInstancesI
 func (m *mockEcs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
 	return &ecs.DescribeContainerInstancesOutput{ContainerInstances: m.containerinstances[awssdk.StringValue(input.Cluster)]}, nil
 }
+
+// Return one web ACL of the requested scope per page to exercise the pagination
+func (m *mockWafv2) ListWebACLs(input *wafv2.ListWebACLsInput) (*wafv2.ListWebACLsOutput, error) {
+	var acls []*wafv2.WebACL
+	for _, acl := range m.webacls {
+		if webACLScope(acl) == awssdk.StringValue(input.Scope) {
+			acls = append(acls, acl)
+		}
+	}
+	var index int
+	if input.NextMarker != nil {
+		var err error
+		if index, err = strconv.Atoi(awssdk.StringValue(input.NextMarker)); err != nil {
+			return nil, err
+		}
+	}
+	if index >= len(acls) {
+		return &wafv2.ListWebACLsOutput{}, nil
+	}
+	out := &wafv2.ListWebACLsOutput{WebACLs: []*wafv2.WebACLSummary{{Id: acls[index].Id, Name: acls[index].Name, ARN: acls[index].ARN}}}
+	if index+1 < len(acls) {
+		out.NextMarker = awssdk.String(strconv.Itoa(index + 1))
+	}
+	return out, nil
+}
+
+func (m *mockWafv2) GetWebACL(input *wafv2.GetWebACLInput) (*wafv2.GetWebACLOutput, error) {
+	for _, acl := range m.webacls {
+		if awssdk.StringValue(acl.Id) == awssdk.StringValue(input.Id) && webACLScope(acl) == awssdk.StringValue(input.Scope) {
+			return &wafv2.GetWebACLOutput{WebACL: acl}, nil
+		}
+	}
+	return nil, fmt.Errorf("web acl %s not found in scope %s", awssdk.StringValue(input.Id), awssdk.StringValue(input.Scope))
+}
+
+func (m *mockWafv2) ListResourcesForWebACL(input *wafv2.ListResourcesForWebACLInput) (*wafv2.ListResourcesForWebACLOutput, error) {
+	if awssdk.StringValue(input.ResourceType) != wafv2.ResourceTypeApplicationLoadBalancer {
+		return &wafv2.ListResourcesForWebACLOutput{}, nil
+	}
+	return &wafv2.ListResourcesForWebACLOutput{ResourceArns: m.webaclResources[awssdk.StringValue(input.WebACLArn)]}, nil
+}
+
+// The ARNs of CloudFront web ACLs are global, the other ones regional
+func webACLScope(acl *wafv2.WebACL) string {
+	if strings.Contains(awssdk.StringValue(acl.ARN), ":global/") {
+		return wafv2.ScopeCloudfront
+	}
+	return wafv2.ScopeRegional
+}
+
+func (m *mockAcm) ListCertificatesPages(input *acm.ListCertificatesInput, fn func(p *acm.ListCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	var summaries []*acm.CertificateSummary
+	for _, c := range m.certificatedetails {
+		summaries = append(summaries, &acm.CertificateSummary{CertificateArn: c.CertificateArn, DomainName: c.DomainName})
+	}
+	fn(&acm.ListCertificatesOutput{CertificateSummaryList: summaries}, true)
+	return nil
+}
+
+func (m *mockAcm) DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	for _, c := range m.certificatedetails {
+		if awssdk.StringValue(c.CertificateArn) == awssdk.StringValue(input.CertificateArn) {
+			return &acm.DescribeCertificateOutput{Certificate: c}, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate %s not found", awssdk.StringValue(input.CertificateArn))
+}
+
+func (m *mockElasticbeanstalk) DescribeEnvironmentResources(input *elasticbeanstalk.DescribeEnvironmentResourcesInput) (*elasticbeanstalk.DescribeEnvironmentResourcesOutput, error) {
+	out := &elasticbeanstalk.DescribeEnvironmentResourcesOutput{}
+	if descs := m.environmentresourcedescriptions[awssdk.StringValue(input.EnvironmentId)]; len(descs) > 0 {
+		out.EnvironmentResources = descs[0]
+	}
+	return out, nil
+}
+
+// Return one cache cluster per page
+func (m *mockElasticache) DescribeCacheClustersPages(input *elasticache.DescribeCacheClustersInput, fn func(p *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, cluster := range m.cacheclusters {
+		out := &elasticache.DescribeCacheClustersOutput{CacheClusters: []*elasticache.CacheCluster{cluster}}
+		if i+1 < len(m.cacheclusters) {
+			out.Marker = awssdk.String(strconv.Itoa(i + 1))
+		}
+		if !fn(out, i+1 == len(m.cacheclusters)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockElasticache) DescribeReplicationGroupsPages(input *elasticache.DescribeReplicationGroupsInput, fn func(p *elasticache.DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: m.replicationgroups}, true)
+	return nil
+}
+
+func (m *mockElasticache) ListTagsForResource(input *elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error) {
+	return &elasticache.TagListMessage{TagList: m.tags[awssdk.StringValue(input.ResourceName)]}, nil
+}
+
+// Return one API per page. As API Gateway does, the position is still given on the last page
+func (m *mockApigateway) GetRestApis(input *apigateway.GetRestApisInput) (*apigateway.GetRestApisOutput, error) {
+	var index int
+	if pos := awssdk.StringValue(input.Position); pos != "" {
+		var err error
+		if index, err = strconv.Atoi(pos); err != nil {
+			return nil, err
+		}
+	}
+	out := &apigateway.GetRestApisOutput{Position: input.Position}
+	if index < len(m.restapis) {
+		out.Items = []*apigateway.RestApi{m.restapis[index]}
+		if index+1 < len(m.restapis) {
+			out.Position = awssdk.String(strconv.Itoa(index + 1))
+		}
+	}
+	return out, nil
+}
+
+func (m *mockApigateway) GetStages(input *apigateway.GetStagesInput) (*apigateway.GetStagesOutput, error) {
+	return &apigateway.GetStagesOutput{Item: m.stages[awssdk.StringValue(input.RestApiId)]}, nil
+}
+
+func (m *mockApigateway) GetResources(input *apigateway.GetResourcesInput) (*apigateway.GetResourcesOutput, error) {
+	return &apigateway.GetResourcesOutput{Items: m.resources[awssdk.StringValue(input.RestApiId)]}, nil
+}
+
+func (m *mockApigateway) GetIntegration(input *apigateway.GetIntegrationInput) (*apigateway.Integration, error) {
+	if integs := m.integrations[awssdk.StringValue(input.ResourceId)]; len(integs) > 0 {
+		return integs[0], nil
+	}
+	return nil, awserr.New(apigateway.ErrCodeNotFoundException, "no integration", nil)
+}
+
+// Return one API per page, the last page having an empty token
+func (m *mockApigatewayv2) GetApis(input *apigatewayv2.GetApisInput) (*apigatewayv2.GetApisOutput, error) {
+	var index int
+	if token := awssdk.StringValue(input.NextToken); token != "" {
+		var err error
+		if index, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+	out := &apigatewayv2.GetApisOutput{NextToken: awssdk.String("")}
+	if index < len(m.apis) {
+		out.Items = []*apigatewayv2.Api{m.apis[index]}
+		if index+1 < len(m.apis) {
+			out.NextToken = awssdk.String(strconv.Itoa(index + 1))
+		}
+	}
+	return out, nil
+}
+
+func (m *mockApigatewayv2) GetStages(input *apigatewayv2.GetStagesInput) (*apigatewayv2.GetStagesOutput, error) {
+	return &apigatewayv2.GetStagesOutput{Items: m.stages[awssdk.StringValue(input.ApiId)]}, nil
+}
+
+func (m *mockApigatewayv2) GetIntegrations(input *apigatewayv2.GetIntegrationsInput) (*apigatewayv2.GetIntegrationsOutput, error) {
+	return &apigatewayv2.GetIntegrationsOutput{Items: m.integrations[awssdk.StringValue(input.ApiId)]}, nil
+}
+
+// Return one parameter per page
+func (m *mockSsm) DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	var index int
+	if token := awssdk.StringValue(input.NextToken); token != "" {
+		var err error
+		if index, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+	out := &ssm.DescribeParametersOutput{}
+	if index < len(m.parametermetadatas) {
+		out.Parameters = []*ssm.ParameterMetadata{m.parametermetadatas[index]}
+		if index+1 < len(m.parametermetadatas) {
+			out.NextToken = awssdk.String(strconv.Itoa(index + 1))
+		}
+	}
+	return out, nil
+}
+
+func (m *mockGuardduty) ListDetectorsPages(input *guardduty.ListDetectorsInput, fn func(p *guardduty.ListDetectorsOutput, lastPage bool) (shouldContinue bool)) error {
+	var detectorIDs []*string
+	for _, finding := range m.findings {
+		if id := finding.Service.DetectorId; !containsString(detectorIDs, id) {
+			detectorIDs = append(detectorIDs, id)
+		}
+	}
+	return guarddutyPages(len(detectorIDs), func(i int, token *string, last bool) bool {
+		return fn(&guardduty.ListDetectorsOutput{DetectorIds: detectorIDs[i : i+1], NextToken: token}, last)
+	})
+}
+
+func (m *mockGuardduty) ListFindingsPages(input *guardduty.ListFindingsInput, fn func(p *guardduty.ListFindingsOutput, lastPage bool) (shouldContinue bool)) error {
+	if cond := input.FindingCriteria.Criterion["service.archived"]; cond == nil || awssdk.StringValueSlice(cond.Eq)[0] != "false" {
+		return fmt.Errorf("list findings: expected only active findings, got %v", input.FindingCriteria)
+	}
+	var findingIDs []*string
+	for _, finding := range m.findings {
+		if awssdk.StringValue(finding.Service.DetectorId) == awssdk.StringValue(input.DetectorId) && !awssdk.BoolValue(finding.Service.Archived) {
+			findingIDs = append(findingIDs, finding.Id)
+		}
+	}
+	return guarddutyPages(len(findingIDs), func(i int, token *string, last bool) bool {
+		return fn(&guardduty.ListFindingsOutput{FindingIds: findingIDs[i : i+1], NextToken: token}, last)
+	})
+}
+
+func (m *mockGuardduty) GetFindings(input *guardduty.GetFindingsInput) (*guardduty.GetFindingsOutput, error) {
+	out := &guardduty.GetFindingsOutput{}
+	for _, finding := range m.findings {
+		if containsString(input.FindingIds, finding.Id) {
+			out.Findings = append(out.Findings, finding)
+		}
+	}
+	return out, nil
+}
+
+// Return one secret per page
+func (m *mockSecretsmanager) ListSecretsPages(input *secretsmanager.ListSecretsInput, fn func(p *secretsmanager.ListSecretsOutput, lastPage bool) (shouldContinue bool)) error {
+	return guarddutyPages(len(m.secretlistentrys), func(i int, token *string, last bool) bool {
+		return fn(&secretsmanager.ListSecretsOutput{SecretList: m.secretlistentrys[i : i+1], NextToken: token}, last)
+	})
+}
+
+func (m *mockSecretsmanager) GetSecretValue(input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	return nil, fmt.Errorf("get secret value %s: secret values must never be fetched", awssdk.StringValue(input.SecretId))
+}
+
+// guarddutyPages sends one item per page, the last page having an empty token as GuardDuty does.
+// A callback continuing after the last page would make the pager request the first page again
+func guarddutyPages(count int, send func(i int, token *string, last bool) bool) error {
+	for i := 0; i < count; i++ {
+		last := i == count-1
+		token := awssdk.String(strconv.Itoa(i + 1))
+		if last {
+			token = awssdk.String("")
+		}
+		if !send(i, token, last) {
+			return nil
+		}
+	}
+	if count > 0 {
+		return fmt.Errorf("paging continued after the last page (empty token)")
+	}
+	return nil
+}
+
+func containsString(list []*string, s *string) bool {
+	for _, e := range list {
+		if awssdk.StringValue(e) == awssdk.StringValue(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockKms) ListAliasesPages(input *kms.ListAliasesInput, fn func(p *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&kms.ListAliasesOutput{Aliases: m.aliaslistentrys}, true)
+	return nil
+}
+
+func (m *mockKms) ListKeysPages(input *kms.ListKeysInput, fn func(p *kms.ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	var keys []*kms.KeyListEntry
+	for _, key := range m.keymetadatas {
+		keys = append(keys, &kms.KeyListEntry{KeyId: key.KeyId, KeyArn: key.Arn})
+	}
+	fn(&kms.ListKeysOutput{Keys: keys}, true)
+	return nil
+}
+
+func (m *mockKms) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	for _, key := range m.keymetadatas {
+		if awssdk.StringValue(key.KeyId) == awssdk.StringValue(input.KeyId) {
+			return &kms.DescribeKeyOutput{KeyMetadata: key}, nil
+		}
+	}
+	return nil, awserr.New(kms.ErrCodeNotFoundException, "key not found", nil)
+}
+
+// Return one host per page
+func (m *mockEc2) DescribeHosts(input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error) {
+	var index int
+	if token := awssdk.StringValue(input.NextToken); token != "" {
+		var err error
+		if index, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+	out := &ec2.DescribeHostsOutput{}
+	if index < len(m.hosts) {
+		out.Hosts = []*ec2.Host{m.hosts[index]}
+		if index+1 < len(m.hosts) {
+			out.NextToken = awssdk.String(strconv.Itoa(index + 1))
+		}
+	}
+	return out, nil
+}
+
+// Return one VPC endpoint per page
+func (m *mockEc2) DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+	var index int
+	if token := awssdk.StringValue(input.NextToken); token != "" {
+		var err error
+		if index, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+	out := &ec2.DescribeVpcEndpointsOutput{}
+	if index < len(m.vpcendpoints) {
+		out.VpcEndpoints = []*ec2.VpcEndpoint{m.vpcendpoints[index]}
+		if index+1 < len(m.vpcendpoints) {
+			out.NextToken = awssdk.String(strconv.Itoa(index + 1))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// maximum names per GetParameters call
+const getParametersBatchSize = 10
+
+// GetParameterValues returns the values of the parameters of the SSM parameter store,
+// SecureString ones decrypted. Names not found are left out of the returned values
+func (s *Infra) GetParameterValues(names []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for start := 0; start < len(names); start += getParametersBatchSize {
+		end := start + getParametersBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		out, err := s.GetParameters(&ssm.GetParametersInput{Names: aws.StringSlice(names[start:end]), WithDecryption: aws.Bool(true)})
+		if err != nil {
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "AccessDeniedException" {
+				return values, fmt.Errorf("%s (SecureString parameters also require kms:Decrypt on their key)", aerr.Message())
+			}
+			return values, err
+		}
+		for _, param := range out.Parameters {
+			values[aws.StringValue(param.Name)] = aws.StringValue(param.Value)
+		}
+	}
+	return values, nil
+}
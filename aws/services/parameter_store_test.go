@@ -0,0 +1,67 @@
+package awsservices
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+type parameterStoreMock struct {
+	ssmiface.SSMAPI
+	store map[string]string
+	calls int
+	err   error
+}
+
+func (m *parameterStoreMock) GetParameters(input *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	if !aws.BoolValue(input.WithDecryption) {
+		return nil, fmt.Errorf("expected decryption")
+	}
+	out := &ssm.GetParametersOutput{}
+	for _, name := range input.Names {
+		if v, ok := m.store[aws.StringValue(name)]; ok {
+			out.Parameters = append(out.Parameters, &ssm.Parameter{Name: name, Value: aws.String(v)})
+		} else {
+			out.InvalidParameters = append(out.InvalidParameters, name)
+		}
+	}
+	return out, nil
+}
+
+func TestGetParameterValues(t *testing.T) {
+	store := make(map[string]string)
+	var names []string
+	for i := 0; i < 12; i++ {
+		name := fmt.Sprintf("/prod/param%d", i)
+		store[name] = fmt.Sprint(i)
+		names = append(names, name)
+	}
+	mock := &parameterStoreMock{store: store}
+	infra := &Infra{SSMAPI: mock}
+
+	values, err := infra.GetParameterValues(append(names, "/prod/missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values, store; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := mock.calls, 2; got != want {
+		t.Fatalf("got %d calls, want %d", got, want)
+	}
+
+	mock.err = awserr.New("AccessDeniedException", "not authorized", nil)
+	if _, err := infra.GetParameterValues(names); err == nil || !strings.Contains(err.Error(), "kms:Decrypt") {
+		t.Fatalf("got %v, want access denied error", err)
+	}
+}
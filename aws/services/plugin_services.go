@@ -0,0 +1,112 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+)
+
+// pluginService syncs the resources of a registered fetch plugin as a service of its own
+type pluginService struct {
+	plugin fetch.Plugin
+	region string
+	log    *logger.Logger
+}
+
+func newPluginService(p fetch.Plugin, region string, log *logger.Logger) cloud.Service {
+	return &pluginService{plugin: p, region: region, log: log}
+}
+
+func (s *pluginService) Name() string {
+	return s.plugin.Name()
+}
+
+func (s *pluginService) Region() string {
+	return s.region
+}
+
+func (s *pluginService) Drivers() []driver.Driver {
+	return nil
+}
+
+func (s *pluginService) ResourceTypes() []string {
+	return s.plugin.ResourceTypes()
+}
+
+func (s *pluginService) IsSyncDisabled() bool {
+	return false
+}
+
+func (s *pluginService) FetchResources() (*graph.Graph, error) {
+	ctx := context.WithValue(context.Background(), "region", s.region)
+	gph, err := s.plugin.Fetch(ctx, s.region)
+	if err != nil {
+		return graph.NewGraph(), fmt.Errorf("plugin %s: %s", s.Name(), err)
+	}
+	if gph == nil {
+		gph = graph.NewGraph()
+	}
+	return gph, nil
+}
+
+func (s *pluginService) FetchByType(t string) (*graph.Graph, error) {
+	var declared bool
+	for _, typ := range s.ResourceTypes() {
+		declared = declared || typ == t
+	}
+	if !declared {
+		return graph.NewGraph(), fmt.Errorf("plugin %s: no fetch func defined for resource type '%s'", s.Name(), t)
+	}
+	all, err := s.FetchResources()
+	if err != nil {
+		return all, err
+	}
+	resources, err := all.GetAllResources(t)
+	if err != nil {
+		return all, err
+	}
+	gph := graph.NewGraph()
+	gph.AddResource(resources...)
+	return gph, nil
+}
+
+// registerPluginServices adds the fetch plugins to the cloud services, failing
+// on plugins named as an AWS service or fetching AWS resource types
+func registerPluginServices(plugins []fetch.Plugin, region string, log *logger.Logger) error {
+	for _, p := range plugins {
+		for _, name := range ServiceNames {
+			if p.Name() == name {
+				return fmt.Errorf("fetch plugin %s: name already used by an AWS service", p.Name())
+			}
+		}
+		for _, t := range p.ResourceTypes() {
+			if srv, ok := ServicePerResourceType[t]; ok {
+				return fmt.Errorf("fetch plugin %s: resource type %s already fetched by AWS service %s", p.Name(), t, srv)
+			}
+		}
+		log.ExtraVerbosef("registering fetch plugin %s for %v", p.Name(), p.ResourceTypes())
+		cloud.ServiceRegistry[p.Name()] = newPluginService(p, region, log)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package awsservices
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+)
+
+type stubPlugin struct {
+	name  string
+	types []string
+}
+
+func (p *stubPlugin) Name() string            { return p.name }
+func (p *stubPlugin) ResourceTypes() []string { return p.types }
+func (p *stubPlugin) Fetch(ctx context.Context, region string) (*graph.Graph, error) {
+	g := graph.NewGraph()
+	host, disk := graph.InitResource("stubhost", "host_1"), graph.InitResource("stubdisk", "disk_1")
+	g.AddResource(host, disk)
+	g.AddAppliesOnRelation(host, graph.InitResource(cloud.Instance, "inst_1"))
+	return g, nil
+}
+
+func TestPluginService(t *testing.T) {
+	srv := newPluginService(&stubPlugin{name: "stub", types: []string{"stubhost", "stubdisk"}}, "eu-west-1", logger.DiscardLogger)
+	if got, want := srv.Name(), "stub"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	g, err := srv.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, err := g.GetResource("stubhost", "host_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied, err := g.ListResourcesAppliedOn(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(applied), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	g, err = srv.FetchByType("stubdisk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := g.GetAllResources("stubhost", "stubdisk"); len(all) != 1 || all[0].Id() != "disk_1" {
+		t.Fatalf("got %v, want only disk_1", all)
+	}
+	if _, err = srv.FetchByType(cloud.Instance); err == nil {
+		t.Fatal("expected error fetching undeclared type")
+	}
+}
+
+func TestRegisterPluginServices(t *testing.T) {
+	tcases := []struct {
+		plugin *stubPlugin
+		err    string
+	}{
+		{&stubPlugin{name: "infra", types: []string{"stubhost"}}, "name already used by an AWS service"},
+		{&stubPlugin{name: "stub", types: []string{"stubhost", cloud.Instance}}, "resource type instance already fetched by AWS service infra"},
+	}
+	for _, tcase := range tcases {
+		if err := registerPluginServices([]fetch.Plugin{tcase.plugin}, "eu-west-1", logger.DiscardLogger); err == nil || !strings.Contains(err.Error(), tcase.err) {
+			t.Fatalf("got %v, want error with '%s'", err, tcase.err)
+		}
+	}
+	if _, ok := cloud.ServiceRegistry["stub"]; ok {
+		t.Fatal("conflicting plugin registered as service")
+	}
+}
@@ -19,17 +19,36 @@ package awsservices
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 )
 
@@ -45,7 +64,9 @@ type funcBuilder struct {
 	relation                            int
 }
 
-type addParentFn func(*graph.Graph, string, interface{}) error
+// addParentFn adds the relations of a fetched resource. The service is the one that fetched it:
+// its APIs must be used for extra calls so that they reach the account and region it was fetched from
+type addParentFn func(*graph.Graph, cloud.Service, string, interface{}) error
 
 var addParentsFns = map[string][]addParentFn{
 	// Infra
@@ -56,6 +77,8 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "SecurityGroups", relation: APPLIES_ON}.build(),
 		funcBuilder{parent: cloud.Keypair, fieldName: "KeyName", relation: APPLIES_ON}.build(),
+		addInstanceProfileRelation,
+		addInstancePlacementGroupRelation,
 	},
 	cloud.SecurityGroup: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
@@ -68,6 +91,7 @@ var addParentsFns = map[string][]addParentFn{
 		addRegionParent,
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.ElasticIP, fieldName: "AllocationId", listName: "NatGatewayAddresses", relation: APPLIES_ON}.build(),
 	},
 	cloud.RouteTable: {
 		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", listName: "Associations", relation: DEPENDING_ON}.build(),
@@ -80,11 +104,36 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.ElasticIP: {
 		addRegionParent,
 		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.NetworkInterface, fieldName: "NetworkInterfaceId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.NetworkInterface: {
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId"}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
+		addNetworkInterfaceAttachment,
+		addNetworkInterfaceVpcEndpoint,
 	},
 	cloud.Snapshot: {
 		addRegionParent,
 		funcBuilder{parent: cloud.Volume, fieldName: "VolumeId", relation: DEPENDING_ON}.build(),
 	},
+	cloud.SpotRequest: {
+		addRegionParent,
+		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.ReservedInstance: {
+		addRegionParent,
+	},
+	cloud.PlacementGroup: {
+		addRegionParent,
+	},
+	cloud.Host: {
+		addRegionParent,
+		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+	},
+	cloud.VpcEndpoint: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.RouteTable, stringListName: "RouteTableIds", relation: DEPENDING_ON}.build(),
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
@@ -92,6 +141,12 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "ZoneName", listName: "AvailabilityZones", relation: DEPENDING_ON}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
 	},
+	cloud.ClassicLoadBalancer: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VPCId"}.build(),
+		funcBuilder{parent: cloud.Subnet, stringListName: "Subnets", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+	},
 	cloud.Listener: {
 		funcBuilder{parent: cloud.LoadBalancer, fieldName: "LoadBalancerArn"}.build(),
 	},
@@ -105,6 +160,14 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, listName: "VpcSecurityGroups", fieldName: "VpcSecurityGroupId", relation: APPLIES_ON}.build(),
 	},
+	cloud.CacheCluster: {
+		funcBuilder{parent: cloud.SecurityGroup, listName: "SecurityGroups", fieldName: "SecurityGroupId", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.CacheSubnetGroup, fieldName: "CacheSubnetGroupName", relation: DEPENDING_ON}.build(),
+	},
+	cloud.CacheSubnetGroup: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetIdentifier", listName: "Subnets", relation: DEPENDING_ON}.build(),
+	},
 	// Autoscaling
 	cloud.LaunchConfiguration: {
 		addRegionParent,
@@ -123,6 +186,52 @@ var addParentsFns = map[string][]addParentFn{
 	},
 	cloud.Subscription: {
 		funcBuilder{parent: cloud.Topic, fieldName: "TopicArn"}.build(),
+		addSubscriptionEndpoint,
+	},
+	cloud.Queue: {
+		addQueueDeadLetterRelation,
+	},
+	// Firewall
+	cloud.WebACL: {
+		addRegionParent,
+		addWebACLResourcesRelations,
+	},
+	// Certificates
+	cloud.Certificate: {
+		addRegionParent,
+		addCertificateUsersRelations,
+	},
+	// Beanstalk
+	cloud.BeanstalkApplication: {addRegionParent},
+	cloud.BeanstalkEnvironment: {
+		funcBuilder{parent: cloud.BeanstalkApplication, fieldName: "ApplicationName"}.build(),
+		addBeanstalkEnvironmentResourcesRelations,
+	},
+	// API Gateway
+	cloud.RestApi: {
+		addRegionParent,
+		addRestApiIntegrationsRelations,
+	},
+	// SSM
+	cloud.Parameter: {
+		addRegionParent,
+		addParameterKMSKeyRelation,
+	},
+	// KMS
+	cloud.KMSKey: {addRegionParent},
+	// GuardDuty
+	cloud.Finding: {
+		addRegionParent,
+		addFindingInstanceRelation,
+	},
+	// Secrets Manager
+	cloud.Secret: {
+		addRegionParent,
+		addSecretKMSKeyRelation,
+	},
+	// CDN
+	cloud.Distribution: {
+		funcBuilder{parent: cloud.WebACL, fieldName: "WebACLId", relation: APPLIES_ON}.build(),
 	},
 	cloud.Vpc:              {addRegionParent},
 	cloud.AvailabilityZone: {addRegionParent},
@@ -130,16 +239,19 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Image:            {addRegionParent},
 	cloud.Repository:       {addRegionParent},
 	cloud.ContainerCluster: {addRegionParent},
-	cloud.ContainerTask:    {addRegionParent},
+	cloud.ContainerTask:    {addRegionParent, addContainerTaskLogGroupsRelations},
 	cloud.User:             {userAddGroupsRelations, addManagedPoliciesRelations},
 	cloud.Role:             {addManagedPoliciesRelations},
+	cloud.InstanceProfile:  {addInstanceProfileRolesRelations},
 	cloud.Group:            {addManagedPoliciesRelations},
 	cloud.Bucket:           {addRegionParent},
-	cloud.Function:         {addRegionParent},
+	cloud.Function:         {addRegionParent, addFunctionStreamSourcesRelations},
+	cloud.Table:            {addRegionParent},
 	cloud.Topic:            {addRegionParent},
 	cloud.Alarm:            {addRegionParent, addAlarmMetric},
 	cloud.Metric:           {addRegionParent},
-	cloud.Stack:            {addRegionParent},
+	cloud.LogGroup:         {addRegionParent, addLogGroupFunctionRelation},
+	cloud.Stack:            {addRegionParent, addStackManagedResourcesRelations},
 }
 
 func (fb funcBuilder) build() addParentFn {
@@ -154,7 +266,7 @@ func (fb funcBuilder) build() addParentFn {
 }
 
 func (fb funcBuilder) addRelationWithField() addParentFn {
-	return func(g *graph.Graph, region string, i interface{}) error {
+	return func(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.fieldName)
 		if err != nil {
 			return err
@@ -180,7 +292,7 @@ func (fb funcBuilder) addRelationWithField() addParentFn {
 }
 
 func (fb funcBuilder) addRelationListWithStringField() addParentFn {
-	return func(g *graph.Graph, region string, i interface{}) error {
+	return func(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.stringListName)
 		if err != nil {
 			return err
@@ -215,7 +327,7 @@ func (fb funcBuilder) addRelationListWithStringField() addParentFn {
 }
 
 func (fb funcBuilder) addRelationListWithField() addParentFn {
-	return func(g *graph.Graph, region string, i interface{}) error {
+	return func(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.listName)
 		if err != nil {
 			return err
@@ -293,7 +405,7 @@ func addRelation(g *graph.Graph, first, other *graph.Resource, relation int) err
 	return nil
 }
 
-func addRegionParent(g *graph.Graph, region string, i interface{}) error {
+func addRegionParent(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	res, err := awsconv.InitResource(i)
 	if err != nil {
 		return err
@@ -302,7 +414,7 @@ func addRegionParent(g *graph.Graph, region string, i interface{}) error {
 	return nil
 }
 
-func addManagedPoliciesRelations(g *graph.Graph, region string, i interface{}) error {
+func addManagedPoliciesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	res, err := awsconv.InitResource(i)
 	if err != nil {
 		return err
@@ -340,7 +452,58 @@ func addManagedPoliciesRelations(g *graph.Graph, region string, i interface{}) e
 	return nil
 }
 
-func userAddGroupsRelations(g *graph.Graph, region string, i interface{}) error {
+// addInstanceProfileRelation links the instance profile to the instance it is associated with.
+// The profile belongs to the access graph, so it is referenced by the id EC2 returns along its ARN
+func addInstanceProfileRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	inst, ok := i.(*ec2.Instance)
+	if !ok {
+		return fmt.Errorf("add instance profile relation: not an instance, but a %T", i)
+	}
+	if inst.IamInstanceProfile == nil || awssdk.StringValue(inst.IamInstanceProfile.Id) == "" {
+		return nil
+	}
+	res, err := awsconv.InitResource(inst)
+	if err != nil {
+		return err
+	}
+	return g.AddAppliesOnRelation(graph.InitResource(cloud.InstanceProfile, awssdk.StringValue(inst.IamInstanceProfile.Id)), res)
+}
+
+func addInstancePlacementGroupRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	inst, ok := i.(*ec2.Instance)
+	if !ok {
+		return fmt.Errorf("add instance placement group relation: not an instance, but a %T", i)
+	}
+	if inst.Placement == nil || awssdk.StringValue(inst.Placement.GroupName) == "" {
+		return nil
+	}
+	res, err := awsconv.InitResource(inst)
+	if err != nil {
+		return err
+	}
+	return g.AddAppliesOnRelation(graph.InitResource(cloud.PlacementGroup, awssdk.StringValue(inst.Placement.GroupName)), res)
+}
+
+// addInstanceProfileRolesRelations links the roles of an instance profile to it, so that the
+// policies of the roles applying on a profile can be traced up from the instances it applies on
+func addInstanceProfileRolesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	profile, ok := i.(*iam.InstanceProfile)
+	if !ok {
+		return fmt.Errorf("add instance profile roles relations: not an instance profile, but a %T", i)
+	}
+	res, err := awsconv.InitResource(profile)
+	if err != nil {
+		return err
+	}
+	for _, role := range profile.Roles {
+		if err = g.AddAppliesOnRelation(graph.InitResource(cloud.Role, awssdk.StringValue(role.RoleId)), res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func userAddGroupsRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	user, ok := i.(*iam.UserDetail)
 	if !ok {
 		return fmt.Errorf("aws fetch: not a user, but a %T", i)
@@ -372,7 +535,7 @@ func userAddGroupsRelations(g *graph.Graph, region string, i interface{}) error
 	return nil
 }
 
-func fetchTargetsAndAddRelations(g *graph.Graph, region string, i interface{}) error {
+func fetchTargetsAndAddRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	group, ok := i.(*elbv2.TargetGroup)
 	if !ok {
 		return fmt.Errorf("add targets relation: not a target group, but a %T", i)
@@ -382,7 +545,7 @@ func fetchTargetsAndAddRelations(g *graph.Graph, region string, i interface{}) e
 		return err
 	}
 
-	targets, err := InfraService.(*Infra).DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
+	targets, err := svc.(*Infra).DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
 	if err != nil {
 		return err
 	}
@@ -397,7 +560,7 @@ func fetchTargetsAndAddRelations(g *graph.Graph, region string, i interface{}) e
 	return nil
 }
 
-func addScalingGroupSubnets(g *graph.Graph, region string, i interface{}) error {
+func addScalingGroupSubnets(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	group, ok := i.(*autoscaling.Group)
 	if !ok {
 		return fmt.Errorf("add autoscaling group relation: not a autoscaling group, but a %T", i)
@@ -419,7 +582,7 @@ func addScalingGroupSubnets(g *graph.Graph, region string, i interface{}) error
 	return nil
 }
 
-func addAlarmMetric(g *graph.Graph, region string, i interface{}) error {
+func addAlarmMetric(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
 	alarm, ok := i.(*cloudwatch.MetricAlarm)
 	if !ok {
 		return fmt.Errorf("add alarm metric relation: not a alarm, but a %T", i)
@@ -438,3 +601,570 @@ func addAlarmMetric(g *graph.Graph, region string, i interface{}) error {
 	}
 	return nil
 }
+
+const lambdaLogGroupPrefix = "/aws/lambda/"
+
+// addLogGroupFunctionRelation links the Lambda function writing to a log group
+// named after it, its ARN being built from the log group ARN. Functions being in
+// another service, the relation only adds the ARN, without declaring the function:
+// the sync then drops it when no graph declares the function (ex: deleted function)
+func addLogGroupFunctionRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	group, ok := i.(*cloudwatchlogs.LogGroup)
+	if !ok {
+		return fmt.Errorf("add log group function relation: not a log group, but a %T", i)
+	}
+	name := awssdk.StringValue(group.LogGroupName)
+	if !strings.HasPrefix(name, lambdaLogGroupPrefix) {
+		return nil
+	}
+	arn := strings.Split(awssdk.StringValue(group.Arn), ":")
+	if len(arn) < 5 || arn[4] == "" {
+		return nil
+	}
+	res, err := awsconv.InitResource(group)
+	if err != nil {
+		return err
+	}
+	fnArn := fmt.Sprintf("arn:%s:lambda:%s:%s:function:%s", arn[1], arn[3], arn[4], strings.TrimPrefix(name, lambdaLogGroupPrefix))
+	return g.AddAppliesOnRelation(graph.InitResource(cloud.Function, fnArn), res)
+}
+
+// addContainerTaskLogGroupsRelations links the log groups the containers of a task definition
+// write to with the awslogs log driver
+func addContainerTaskLogGroupsRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	task, ok := i.(*ecs.TaskDefinition)
+	if !ok {
+		return fmt.Errorf("add container task log groups relations: not a task definition, but a %T", i)
+	}
+	res, err := awsconv.InitResource(task)
+	if err != nil {
+		return err
+	}
+	added := make(map[string]bool)
+	for _, container := range task.ContainerDefinitions {
+		if container.LogConfiguration == nil || awssdk.StringValue(container.LogConfiguration.LogDriver) != ecs.LogDriverAwslogs {
+			continue
+		}
+		if group := awssdk.StringValue(container.LogConfiguration.Options["awslogs-group"]); group != "" && !added[group] {
+			added[group] = true
+			if err := g.AddAppliesOnRelation(res, graph.InitResource(cloud.LogGroup, group)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addSubscriptionEndpoint(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	subscription, ok := i.(*sns.Subscription)
+	if !ok {
+		return fmt.Errorf("add subscription endpoint relation: not a subscription, but a %T", i)
+	}
+	res, err := awsconv.InitResource(subscription)
+	if err != nil {
+		return err
+	}
+
+	endpoint := awssdk.StringValue(subscription.Endpoint)
+	if endpoint == "" {
+		return nil
+	}
+
+	switch awssdk.StringValue(subscription.Protocol) {
+	case "lambda":
+		// remove alias or version qualifier: arn:aws:lambda:region:account:function:name[:qualifier]
+		if splits := strings.Split(endpoint, ":"); len(splits) > 7 {
+			endpoint = strings.Join(splits[:7], ":")
+		}
+		g.AddAppliesOnRelation(res, graph.InitResource(cloud.Function, endpoint))
+	case "sqs":
+		queues, err := graph.ResolveResourcesOnSnapShot(g.AsRDFGraphSnaphot(), &graph.And{Resolvers: []graph.Resolver{
+			&graph.ByProperty{Key: "Arn", Value: endpoint},
+			&graph.ByType{Typ: cloud.Queue},
+		}})
+		if err != nil {
+			return err
+		}
+		if len(queues) == 1 {
+			g.AddAppliesOnRelation(res, queues[0])
+		}
+	}
+	return nil
+}
+
+func addQueueDeadLetterRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	url, ok := i.(*string)
+	if !ok {
+		return fmt.Errorf("add dead letter queue relation: not a queue url, but a %T", i)
+	}
+	queue, err := g.GetResource(cloud.Queue, awssdk.StringValue(url))
+	if err != nil {
+		return nil // queue may have been deleted while fetching
+	}
+	deadLetterArn, ok := queue.Properties[properties.DeadLetterQueue].(string)
+	if !ok || deadLetterArn == "" {
+		return nil
+	}
+
+	deadLetters, err := graph.ResolveResourcesOnSnapShot(g.AsRDFGraphSnaphot(), &graph.And{Resolvers: []graph.Resolver{
+		&graph.ByProperty{Key: "Arn", Value: deadLetterArn},
+		&graph.ByType{Typ: cloud.Queue},
+	}})
+	if err != nil {
+		return err
+	}
+	if len(deadLetters) != 1 { // dead letter queue not synced (ex: another region)
+		return nil
+	}
+	return addRelation(g, deadLetters[0], queue, DEPENDING_ON)
+}
+
+// stackManagedResourceTypes maps the CloudFormation resource types whose physical id
+// is also their awless id to their awless resource type
+var stackManagedResourceTypes = map[string]string{
+	"AWS::EC2::Instance":                        cloud.Instance,
+	"AWS::EC2::VPC":                             cloud.Vpc,
+	"AWS::EC2::Subnet":                          cloud.Subnet,
+	"AWS::EC2::SecurityGroup":                   cloud.SecurityGroup,
+	"AWS::EC2::InternetGateway":                 cloud.InternetGateway,
+	"AWS::EC2::NatGateway":                      cloud.NatGateway,
+	"AWS::EC2::RouteTable":                      cloud.RouteTable,
+	"AWS::EC2::Volume":                          cloud.Volume,
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": cloud.LoadBalancer,
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  cloud.TargetGroup,
+	"AWS::ElasticLoadBalancing::LoadBalancer":   cloud.ClassicLoadBalancer,
+	"AWS::RDS::DBInstance":                      cloud.Database,
+	"AWS::S3::Bucket":                           cloud.Bucket,
+	"AWS::SNS::Topic":                           cloud.Topic,
+	"AWS::SQS::Queue":                           cloud.Queue,
+	"AWS::CloudFront::Distribution":             cloud.Distribution,
+}
+
+// addStackManagedResourcesRelations links a stack to the resources it manages.
+// The resources live in the graphs of other services: the syncer drops the relations to the ones
+// no graph has. Failing to list the resources of a stack (ex: deleted while syncing) only loses its relations
+func addStackManagedResourcesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	stack, ok := i.(*cloudformation.Stack)
+	if !ok {
+		return fmt.Errorf("add stack managed resources relation: not a stack, but a %T", i)
+	}
+	res, err := g.GetResource(cloud.Stack, awssdk.StringValue(stack.StackId))
+	if err != nil {
+		return err
+	}
+
+	var managed []*graph.Resource
+	err = svc.(*Cloudformation).ListStackResourcesPages(&cloudformation.ListStackResourcesInput{StackName: stack.StackId}, func(out *cloudformation.ListStackResourcesOutput, lastPage bool) bool {
+		for _, summary := range out.StackResourceSummaries {
+			resType, ok := stackManagedResourceTypes[awssdk.StringValue(summary.ResourceType)]
+			if id := awssdk.StringValue(summary.PhysicalResourceId); ok && id != "" {
+				managed = append(managed, graph.InitResource(resType, id))
+			}
+		}
+		return out.NextToken != nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "add resources managed by stack '%s': %s. Ignoring them.\n", res.Id(), err)
+		return nil
+	}
+	for _, m := range managed {
+		if err = g.AddAppliesOnRelation(res, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addFunctionStreamSourcesRelations links the DynamoDB tables whose stream triggers the function.
+// Stream ARNs are the ARN of their table followed by '/stream/<label>'. The mappings of all
+// functions are listed once per fetch. Failing to list them only loses the relations
+func addFunctionStreamSourcesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	fn, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("add function stream sources relation: not a function, but a %T", i)
+	}
+	res, err := g.GetResource(cloud.Function, awssdk.StringValue(fn.FunctionArn))
+	if err != nil {
+		return err
+	}
+
+	lambdaSvc := svc.(*Lambda)
+	tables, err := lambdaSvc.fetcher.Get("stream_tables_by_function", func() (interface{}, error) {
+		return listStreamTablesByFunction(lambdaSvc)
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "add stream sources of function '%s': %s. Ignoring them.\n", res.Id(), err)
+		return nil
+	}
+	for _, arn := range tables.(map[string][]string)[res.Id()] {
+		if err = g.AddAppliesOnRelation(graph.InitResource(cloud.Table, arn), res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listStreamTablesByFunction(api lambdaiface.LambdaAPI) (map[string][]string, error) {
+	tables := make(map[string][]string)
+	err := api.ListEventSourceMappingsPages(&lambda.ListEventSourceMappingsInput{}, func(out *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+		for _, mapping := range out.EventSourceMappings {
+			source := awssdk.StringValue(mapping.EventSourceArn)
+			if idx := strings.Index(source, "/stream/"); strings.Contains(source, ":dynamodb:") && idx > 0 {
+				fnArn := awssdk.StringValue(mapping.FunctionArn)
+				tables[fnArn] = append(tables[fnArn], source[:idx])
+			}
+		}
+		return out.NextMarker != nil
+	})
+	return tables, err
+}
+
+// addWebACLResourcesRelations links a regional web ACL to the load balancers it protects
+func addWebACLResourcesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	acl, ok := i.(*wafv2.WebACL)
+	if !ok {
+		return fmt.Errorf("add web acl resources relation: not a web acl, but a %T", i)
+	}
+	res, err := g.GetResource(cloud.WebACL, awssdk.StringValue(acl.ARN))
+	if err != nil {
+		return err
+	}
+	// CloudFront distributions reference their web ACL themselves
+	if res.Properties[properties.Scope] != wafv2.ScopeRegional {
+		return nil
+	}
+
+	out, err := svc.(*Infra).WAFV2API.ListResourcesForWebACL(&wafv2.ListResourcesForWebACLInput{WebACLArn: acl.ARN, ResourceType: awssdk.String(wafv2.ResourceTypeApplicationLoadBalancer)})
+	if err != nil {
+		return err
+	}
+	for _, arn := range out.ResourceArns {
+		if err = g.AddAppliesOnRelation(res, graph.InitResource(cloud.LoadBalancer, awssdk.StringValue(arn))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addCertificateUsersRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	cert, ok := i.(*acm.CertificateDetail)
+	if !ok {
+		return fmt.Errorf("add certificate users relation: not a certificate, but a %T", i)
+	}
+	res, err := g.GetResource(cloud.Certificate, awssdk.StringValue(cert.CertificateArn))
+	if err != nil {
+		return err
+	}
+	for _, arn := range cert.InUseBy {
+		var user *graph.Resource
+		switch a := awssdk.StringValue(arn); {
+		case strings.Contains(a, ":cloudfront:"):
+			if idx := strings.LastIndex(a, "distribution/"); idx > -1 {
+				user = graph.InitResource(cloud.Distribution, a[idx+len("distribution/"):])
+			}
+		case strings.Contains(a, ":elasticloadbalancing:"):
+			user = graph.InitResource(cloud.LoadBalancer, a)
+		}
+		if user == nil {
+			continue
+		}
+		if err = g.AddAppliesOnRelation(res, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addParameterKMSKeyRelation links a parameter to the KMS key encrypting it
+func addParameterKMSKeyRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	param, ok := i.(*ssm.ParameterMetadata)
+	if !ok {
+		return fmt.Errorf("add parameter kms key relation: not a parameter, but a %T", i)
+	}
+	return addKMSKeyRelation(g, param, awssdk.StringValue(param.KeyId))
+}
+
+// addSecretKMSKeyRelation links a secret to the KMS key encrypting it. Secrets without a key
+// use the account default key, which is not linked
+func addSecretKMSKeyRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	secret, ok := i.(*secretsmanager.SecretListEntry)
+	if !ok {
+		return fmt.Errorf("add secret kms key relation: not a secret, but a %T", i)
+	}
+	return addKMSKeyRelation(g, secret, awssdk.StringValue(secret.KmsKeyId))
+}
+
+// addKMSKeyRelation links a resource to the KMS key encrypting it. The key is referenced
+// either by id, ARN, alias name or alias ARN, so only the keys present in the graph can be resolved
+func addKMSKeyRelation(g *graph.Graph, i interface{}, keyRef string) error {
+	if keyRef == "" {
+		return nil
+	}
+	if index := strings.Index(keyRef, ":alias/"); index > -1 {
+		keyRef = keyRef[index+1:]
+	}
+	res, err := awsconv.InitResource(i)
+	if err != nil {
+		return err
+	}
+
+	keys, err := g.GetAllResources(cloud.KMSKey)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Id() == keyRef || key.Properties[properties.Arn] == keyRef {
+			return g.AddAppliesOnRelation(key, res)
+		}
+		if aliases, ok := key.Properties[properties.Aliases].([]string); ok {
+			for _, alias := range aliases {
+				if alias == keyRef {
+					return g.AddAppliesOnRelation(key, res)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addFindingInstanceRelation links an active finding to the instance it affects. Only instances
+// present in the graph are linked: findings on other resources only keep their properties
+func addFindingInstanceRelation(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	finding, ok := i.(*guardduty.Finding)
+	if !ok {
+		return fmt.Errorf("add finding instance relation: not a finding, but a %T", i)
+	}
+	if finding.Resource == nil || finding.Resource.InstanceDetails == nil {
+		return nil
+	}
+	instanceID := awssdk.StringValue(finding.Resource.InstanceDetails.InstanceId)
+	res, err := awsconv.InitResource(finding)
+	if err != nil {
+		return err
+	}
+
+	instances, err := g.GetAllResources(cloud.Instance)
+	if err != nil {
+		return err
+	}
+	for _, inst := range instances {
+		if inst.Id() == instanceID {
+			return g.AddAppliesOnRelation(res, inst)
+		}
+	}
+	return nil
+}
+
+// addBeanstalkEnvironmentResourcesRelations links an environment to the scaling group and load balancers
+// it runs on. Beanstalk only returns their names, so only the ones present in the graph can be resolved
+func addBeanstalkEnvironmentResourcesRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	env, ok := i.(*elasticbeanstalk.EnvironmentDescription)
+	if !ok {
+		return fmt.Errorf("add beanstalk environment resources relation: not an environment, but a %T", i)
+	}
+	res, err := awsconv.InitResource(env)
+	if err != nil {
+		return err
+	}
+
+	out, err := svc.(*Infra).DescribeEnvironmentResources(&elasticbeanstalk.DescribeEnvironmentResourcesInput{EnvironmentId: env.EnvironmentId})
+	if err != nil {
+		return err
+	}
+	if out.EnvironmentResources == nil {
+		return nil
+	}
+
+	link := func(typ, name string) error {
+		if name == "" {
+			return nil
+		}
+		found, err := g.FindResourcesByProperty(properties.Name, name)
+		if err != nil {
+			return err
+		}
+		for _, r := range found {
+			if r.Type() != typ {
+				continue
+			}
+			if err := g.AddAppliesOnRelation(res, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, asg := range out.EnvironmentResources.AutoScalingGroups {
+		if err := link(cloud.ScalingGroup, awssdk.StringValue(asg.Name)); err != nil {
+			return err
+		}
+	}
+	for _, lb := range out.EnvironmentResources.LoadBalancers {
+		if err := link(cloud.LoadBalancer, awssdk.StringValue(lb.Name)); err != nil {
+			return err
+		}
+		if err := link(cloud.ClassicLoadBalancer, awssdk.StringValue(lb.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var integrationFunctionArnRegex = regexp.MustCompile(`arn:aws[a-z-]*:lambda:[^/]+:function:[^/:]+`)
+
+// addRestApiIntegrationsRelations links an API to the Lambda functions and load balancers
+// its methods or routes are integrated with. Load balancers are resolved by DNS name or listener
+// ARN, so only the ones present in the graph are linked. Integrations using stage variables cannot be resolved
+func addRestApiIntegrationsRelations(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	switch api := i.(type) {
+	case *apigateway.RestApi:
+		return addRestApiMethodsRelations(g, svc.(*Infra), api)
+	case *apigatewayv2.Api:
+		return addHttpApiRoutesRelations(g, svc.(*Infra), api)
+	default:
+		return fmt.Errorf("add rest api integrations relation: not an api, but a %T", i)
+	}
+}
+
+func addRestApiMethodsRelations(g *graph.Graph, infra *Infra, restApi *apigateway.RestApi) error {
+	res, err := awsconv.InitResource(restApi)
+	if err != nil {
+		return err
+	}
+
+	var resources []*apigateway.Resource
+	var position *string
+	for {
+		out, err := infra.APIGatewayAPI.GetResources(&apigateway.GetResourcesInput{RestApiId: restApi.Id, Limit: awssdk.Int64(500), Position: position})
+		if err != nil {
+			return err
+		}
+		resources = append(resources, out.Items...)
+		next := awssdk.StringValue(out.Position)
+		if next == "" || len(out.Items) == 0 || next == awssdk.StringValue(position) {
+			break
+		}
+		position = out.Position
+	}
+
+	for _, r := range resources {
+		for method := range r.ResourceMethods {
+			integ, err := infra.APIGatewayAPI.GetIntegration(&apigateway.GetIntegrationInput{RestApiId: restApi.Id, ResourceId: r.Id, HttpMethod: awssdk.String(method)})
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == apigateway.ErrCodeNotFoundException {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := addApiIntegrationRelation(g, res, awssdk.StringValue(integ.Type), awssdk.StringValue(integ.Uri)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addHttpApiRoutesRelations(g *graph.Graph, infra *Infra, httpApi *apigatewayv2.Api) error {
+	res, err := awsconv.InitResource(httpApi)
+	if err != nil {
+		return err
+	}
+
+	var token *string
+	for {
+		out, err := infra.ApiGatewayV2API.GetIntegrations(&apigatewayv2.GetIntegrationsInput{ApiId: httpApi.ApiId, NextToken: token})
+		if err != nil {
+			return err
+		}
+		for _, integ := range out.Items {
+			if err := addApiIntegrationRelation(g, res, awssdk.StringValue(integ.IntegrationType), awssdk.StringValue(integ.IntegrationUri)); err != nil {
+				return err
+			}
+		}
+		if awssdk.StringValue(out.NextToken) == "" {
+			return nil
+		}
+		token = out.NextToken
+	}
+}
+
+// addApiIntegrationRelation links an API to the backend of one of its integrations. REST and HTTP APIs
+// share the integration types. The backend of HTTP API private integrations is a load balancer listener ARN
+func addApiIntegrationRelation(g *graph.Graph, api *graph.Resource, integrationType, uri string) error {
+	if strings.Contains(uri, "${") {
+		return nil
+	}
+	switch integrationType {
+	case apigateway.IntegrationTypeAws, apigateway.IntegrationTypeAwsProxy:
+		if arn := integrationFunctionArnRegex.FindString(uri); arn != "" {
+			return g.AddAppliesOnRelation(api, graph.InitResource(cloud.Function, arn))
+		}
+	case apigateway.IntegrationTypeHttp, apigateway.IntegrationTypeHttpProxy:
+		var lbs []*graph.Resource
+		listeners, err := g.FindResourcesByProperty(properties.Arn, uri)
+		if err != nil {
+			return err
+		}
+		for _, listener := range listeners {
+			if listener.Type() != cloud.Listener {
+				continue
+			}
+			if lbs, err = g.FindResourcesByProperty(properties.Arn, listener.Properties[properties.LoadBalancer]); err != nil {
+				return err
+			}
+		}
+		if len(lbs) == 0 {
+			u, err := url.Parse(uri)
+			if err != nil || u.Hostname() == "" {
+				return nil
+			}
+			if lbs, err = g.FindResourcesByProperty(properties.PublicDNS, u.Hostname()); err != nil {
+				return err
+			}
+		}
+		for _, lb := range lbs {
+			if lb.Type() != cloud.LoadBalancer {
+				continue
+			}
+			if err := g.AddAppliesOnRelation(api, lb); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addNetworkInterfaceAttachment(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	eni, ok := i.(*ec2.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("add network interface attachment: not a network interface, but a %T", i)
+	}
+	if eni.Attachment == nil || awssdk.StringValue(eni.Attachment.InstanceId) == "" {
+		return nil
+	}
+	res, err := awsconv.InitResource(eni)
+	if err != nil {
+		return err
+	}
+	return addRelation(g, graph.InitResource(cloud.Instance, awssdk.StringValue(eni.Attachment.InstanceId)), res, DEPENDING_ON)
+}
+
+// The SDK does not describe the network interfaces of interface endpoints:
+// AWS names them after the endpoint in the interface description
+const vpcEndpointInterfaceDescriptionPrefix = "VPC Endpoint Interface "
+
+func addNetworkInterfaceVpcEndpoint(g *graph.Graph, svc cloud.Service, region string, i interface{}) error {
+	eni, ok := i.(*ec2.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("add network interface vpc endpoint: not a network interface, but a %T", i)
+	}
+	description := awssdk.StringValue(eni.Description)
+	if !strings.HasPrefix(description, vpcEndpointInterfaceDescriptionPrefix) {
+		return nil
+	}
+	res, err := awsconv.InitResource(eni)
+	if err != nil {
+		return err
+	}
+	endpointID := strings.TrimSpace(strings.TrimPrefix(description, vpcEndpointInterfaceDescriptionPrefix))
+	return addRelation(g, graph.InitResource(cloud.VpcEndpoint, endpointID), res, DEPENDING_ON)
+}
@@ -24,19 +24,34 @@ import (
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
 	p "github.com/wallix/awless/cloud/properties"
@@ -160,7 +175,12 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		},
 	}
 
-	mock := &mockIam{groupdetails: groups, userdetails: usersDetails, roledetails: roles, managedpolicydetails: managedPolicies, users: users}
+	instanceProfiles := []*iam.InstanceProfile{
+		{InstanceProfileId: awssdk.String("profile_1"), InstanceProfileName: awssdk.String("nprofile_1"), Roles: []*iam.Role{{RoleId: awssdk.String("role_1")}}},
+		{InstanceProfileId: awssdk.String("profile_2"), InstanceProfileName: awssdk.String("nprofile_2")},
+	}
+
+	mock := &mockIam{groupdetails: groups, userdetails: usersDetails, roledetails: roles, managedpolicydetails: managedPolicies, users: users, instanceprofiles: instanceProfiles}
 	access := Access{
 		IAMAPI:  mock,
 		region:  "eu-west-1",
@@ -173,7 +193,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.GetAllResources("policy", "group", "role", "user")
+	resources, err := g.GetAllResources("policy", "group", "role", "user", "instanceprofile")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -208,6 +228,8 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"usr_9":            resourcetest.User("usr_9").Prop(p.InlinePolicies, []string{"npolicy_4"}).Build(),
 		"usr_10":           resourcetest.User("usr_10").Build(),
 		"usr_11":           resourcetest.User("usr_11").Build(),
+		"profile_1":        resourcetest.InstanceProfile("profile_1").Prop(p.Name, "nprofile_1").Prop(p.Roles, []string{"role_1"}).Build(),
+		"profile_2":        resourcetest.InstanceProfile("profile_2").Prop(p.Name, "nprofile_2").Build(),
 	}
 
 	expectedChildren := map[string][]string{}
@@ -219,6 +241,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"managed_policy_1": {"group_1", "role_1", "usr_1", "usr_3"},
 		"managed_policy_2": {"group_2", "role_3", "usr_3"},
 		"managed_policy_3": {"group_3", "usr_6"},
+		"role_1":           {"profile_1"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
@@ -248,7 +271,7 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			Placement:          &ec2.Placement{Affinity: awssdk.String("inst_affinity"), AvailabilityZone: awssdk.String("inst_az"), GroupName: awssdk.String("inst_group"), HostId: awssdk.String("inst_host")},
 			Architecture:       awssdk.String("x86"),
 			Hypervisor:         awssdk.String("xen"),
-			IamInstanceProfile: &ec2.IamInstanceProfile{Arn: awssdk.String("arn:instance:profile")},
+			IamInstanceProfile: &ec2.IamInstanceProfile{Arn: awssdk.String("arn:instance:profile"), Id: awssdk.String("profile_1")},
 			InstanceLifecycle:  awssdk.String("lifecycle"),
 			NetworkInterfaces:  []*ec2.InstanceNetworkInterface{{NetworkInterfaceId: awssdk.String("my-network-interface")}},
 			PublicDnsName:      awssdk.String("my-instance.dns"),
@@ -264,11 +287,11 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 
 	securityGroups := []*ec2.SecurityGroup{
 		{GroupId: awssdk.String("securitygroup_1"), GroupName: awssdk.String("my_securitygroup"), VpcId: awssdk.String("vpc_1")},
-		{GroupId: awssdk.String("securitygroup_2"), VpcId: awssdk.String("vpc_1")},
+		{GroupId: awssdk.String("securitygroup_2"), VpcId: awssdk.String("vpc_1"), OwnerId: awssdk.String("123456789012")},
 	}
 
 	subnets := []*ec2.Subnet{
-		{SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1")},
+		{SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1"), CidrBlock: awssdk.String("10.0.0.0/24"), AvailableIpAddressCount: awssdk.Int64(249)},
 		{SubnetId: awssdk.String("sub_2"), VpcId: awssdk.String("vpc_1")},
 		{SubnetId: awssdk.String("sub_3"), VpcId: awssdk.String("vpc_2")},
 		{SubnetId: awssdk.String("sub_4"), VpcId: nil}, // edge case subnet with no vpc id
@@ -283,7 +306,38 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	}
 
 	natgws := []*ec2.NatGateway{
-		{NatGatewayId: awssdk.String("natgw_1"), VpcId: awssdk.String("vpc_1"), SubnetId: awssdk.String("sub_1")},
+		{NatGatewayId: awssdk.String("natgw_1"), VpcId: awssdk.String("vpc_1"), SubnetId: awssdk.String("sub_1"), NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: awssdk.String("eip_2"), NetworkInterfaceId: awssdk.String("eni_2")}}},
+	}
+
+	addresses := []*ec2.Address{
+		{AllocationId: awssdk.String("eip_1"), PublicIp: awssdk.String("52.0.0.1"), AssociationId: awssdk.String("assoc_1"), InstanceId: awssdk.String("inst_6"), NetworkInterfaceId: awssdk.String("eni_1"), PrivateIpAddress: awssdk.String("10.0.0.1")},
+		{AllocationId: awssdk.String("eip_2"), PublicIp: awssdk.String("52.0.0.2"), AssociationId: awssdk.String("assoc_2"), NetworkInterfaceId: awssdk.String("eni_2")},
+		{AllocationId: awssdk.String("eip_3"), PublicIp: awssdk.String("52.0.0.3")},
+	}
+
+	spotRequests := []*ec2.SpotInstanceRequest{
+		{SpotInstanceRequestId: awssdk.String("sir_1"), State: awssdk.String("active"), Status: &ec2.SpotInstanceStatus{Code: awssdk.String("fulfilled"), Message: awssdk.String("Your spot request is fulfilled.")},
+			SpotPrice: awssdk.String("0.050000"), Type: awssdk.String("one-time"), InstanceId: awssdk.String("inst_1"), LaunchedAvailabilityZone: awssdk.String("us-west-1a"), ProductDescription: awssdk.String("Linux/UNIX"),
+			LaunchSpecification: &ec2.LaunchSpecification{InstanceType: awssdk.String("t2.micro"), ImageId: awssdk.String("ami-1")}, CreateTime: &now, Tags: []*ec2.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("my_spot")}}},
+		{SpotInstanceRequestId: awssdk.String("sir_2"), State: awssdk.String("open"), Status: &ec2.SpotInstanceStatus{Code: awssdk.String("price-too-low"), Message: awssdk.String("Your bid price is lower than the minimum required.")},
+			SpotPrice: awssdk.String("0.001000"), Type: awssdk.String("persistent"), LaunchSpecification: &ec2.LaunchSpecification{InstanceType: awssdk.String("m4.large")}},
+	}
+
+	riEnd := now.Add(365 * 24 * time.Hour)
+	reservedInstances := []*ec2.ReservedInstances{
+		{ReservedInstancesId: awssdk.String("ri_1"), State: awssdk.String("active"), InstanceType: awssdk.String("t2.micro"), InstanceCount: awssdk.Int64(2), OfferingType: awssdk.String("All Upfront"),
+			FixedPrice: awssdk.Float64(150), UsagePrice: awssdk.Float64(0), Scope: awssdk.String("Region"), ProductDescription: awssdk.String("Linux/UNIX"), Start: &now, End: &riEnd},
+	}
+
+	placementGroups := []*ec2.PlacementGroup{
+		{GroupName: awssdk.String("inst_group"), State: awssdk.String("available"), Strategy: awssdk.String("cluster")},
+	}
+
+	hosts := []*ec2.Host{
+		{HostId: awssdk.String("h_1"), State: awssdk.String("available"), AvailabilityZone: awssdk.String("us-west-1a"), HostProperties: &ec2.HostProperties{InstanceType: awssdk.String("t2.micro")},
+			AvailableCapacity: &ec2.AvailableCapacity{AvailableInstanceCapacity: []*ec2.InstanceCapacity{{InstanceType: awssdk.String("t2.micro"), AvailableCapacity: awssdk.Int64(20), TotalCapacity: awssdk.Int64(22)}}},
+			Instances:         []*ec2.HostInstance{{InstanceId: awssdk.String("inst_1"), InstanceType: awssdk.String("t2.micro")}, {InstanceId: awssdk.String("inst_6"), InstanceType: awssdk.String("t2.micro")}}},
+		{HostId: awssdk.String("h_2"), State: awssdk.String("released")},
 	}
 
 	routeTables := []*ec2.RouteTable{
@@ -299,11 +353,29 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		{ZoneName: awssdk.String("us-west-1a"), State: awssdk.String("available"), RegionName: awssdk.String("us-west-1"), Messages: []*ec2.AvailabilityZoneMessage{{Message: awssdk.String("msg 1")}, {Message: awssdk.String("msg 2")}}},
 		{ZoneName: awssdk.String("us-west-1b")},
 	}
+
+	networkInterfaces := []*ec2.NetworkInterface{
+		{NetworkInterfaceId: awssdk.String("eni_1"), TagSet: []*ec2.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("eni_1_name")}}, Description: awssdk.String("primary interface"), InterfaceType: awssdk.String("interface"),
+			Status: awssdk.String("in-use"), SubnetId: awssdk.String("sub_3"), VpcId: awssdk.String("vpc_2"), AvailabilityZone: awssdk.String("us-west-1a"), MacAddress: awssdk.String("0a:1b:2c:3d:4e:5f"),
+			PrivateIpAddress: awssdk.String("10.0.0.1"), PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{{PrivateIpAddress: awssdk.String("10.0.0.1")}, {PrivateIpAddress: awssdk.String("10.0.0.2")}},
+			Association: &ec2.NetworkInterfaceAssociation{PublicIp: awssdk.String("52.0.0.1"), PublicDnsName: awssdk.String("eni_1.public.dns")}, Groups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("securitygroup_1")}},
+			Attachment: &ec2.NetworkInterfaceAttachment{AttachmentId: awssdk.String("eni-attach-1"), InstanceId: awssdk.String("inst_6")}, OwnerId: awssdk.String("owner_id")},
+		{NetworkInterfaceId: awssdk.String("eni_2"), Status: awssdk.String("in-use"), SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1"), RequesterId: awssdk.String("amazon-elb"),
+			Groups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("securitygroup_1")}, {GroupId: awssdk.String("securitygroup_2")}}, Attachment: &ec2.NetworkInterfaceAttachment{AttachmentId: awssdk.String("eni-attach-2")}},
+		{NetworkInterfaceId: awssdk.String("eni_3"), Description: awssdk.String("VPC Endpoint Interface vpce_2"), Status: awssdk.String("in-use"), SubnetId: awssdk.String("sub_1"), VpcId: awssdk.String("vpc_1"),
+			Groups: []*ec2.GroupIdentifier{{GroupId: awssdk.String("securitygroup_2")}}},
+	}
+
+	vpcEndpoints := []*ec2.VpcEndpoint{
+		{VpcEndpointId: awssdk.String("vpce_1"), VpcId: awssdk.String("vpc_1"), ServiceName: awssdk.String("com.amazonaws.eu-west-1.s3"), State: awssdk.String("available"),
+			RouteTableIds: []*string{awssdk.String("rt_1")}, CreationTimestamp: &now},
+		{VpcEndpointId: awssdk.String("vpce_2"), VpcId: awssdk.String("vpc_1"), ServiceName: awssdk.String("com.amazonaws.eu-west-1.ssm"), State: awssdk.String("pending")},
+	}
 	//ELB
 	lbPages := []*elbv2.LoadBalancer{
 		{LoadBalancerArn: awssdk.String("lb_1"), LoadBalancerName: awssdk.String("my_loadbalancer"), VpcId: awssdk.String("vpc_1")},
 		{LoadBalancerArn: awssdk.String("lb_2"), VpcId: awssdk.String("vpc_2")},
-		{LoadBalancerArn: awssdk.String("lb_3"), VpcId: awssdk.String("vpc_1"), SecurityGroups: []*string{awssdk.String("securitygroup_1"), awssdk.String("securitygroup_2")}},
+		{LoadBalancerArn: awssdk.String("lb_3"), VpcId: awssdk.String("vpc_1"), DNSName: awssdk.String("my-lb-3.eu-west-1.elb.amazonaws.com"), SecurityGroups: []*string{awssdk.String("securitygroup_1"), awssdk.String("securitygroup_2")}},
 	}
 	targetGroups := []*elbv2.TargetGroup{
 		{TargetGroupArn: awssdk.String("tg_1"), VpcId: awssdk.String("vpc_1"), LoadBalancerArns: []*string{awssdk.String("lb_1"), awssdk.String("lb_3")}},
@@ -314,6 +386,14 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		{ListenerArn: awssdk.String("list_2"), LoadBalancerArn: awssdk.String("lb_2")},
 		{ListenerArn: awssdk.String("list_3"), LoadBalancerArn: awssdk.String("lb_3")},
 	}
+	classicLbs := []*elb.LoadBalancerDescription{
+		{LoadBalancerName: awssdk.String("clb_1"), VPCId: awssdk.String("vpc_1"), DNSName: awssdk.String("clb-1.eu-west-1.elb.amazonaws.com"), Scheme: awssdk.String("internet-facing"),
+			Subnets: []*string{awssdk.String("sub_1")}, SecurityGroups: []*string{awssdk.String("securitygroup_1")}, Instances: []*elb.Instance{{InstanceId: awssdk.String("inst_1")}, {InstanceId: awssdk.String("inst_2")}},
+			ListenerDescriptions: []*elb.ListenerDescription{{Listener: &elb.Listener{Protocol: awssdk.String("HTTP"), LoadBalancerPort: awssdk.Int64(80), InstanceProtocol: awssdk.String("HTTP"), InstancePort: awssdk.Int64(8080)}}},
+			HealthCheck:          &elb.HealthCheck{Target: awssdk.String("HTTP:8080/health"), Interval: awssdk.Int64(30), Timeout: awssdk.Int64(5), HealthyThreshold: awssdk.Int64(3), UnhealthyThreshold: awssdk.Int64(2)}},
+		{LoadBalancerName: awssdk.String("clb_2"), VPCId: awssdk.String("vpc_2")},
+		{LoadBalancerName: awssdk.String("clb_3"), VPCId: awssdk.String("vpc_2"), Instances: []*elb.Instance{{InstanceId: awssdk.String("inst_3")}}},
+	}
 	targetHealths := map[string][]*elbv2.TargetHealthDescription{
 		"tg_1": {{HealthCheckPort: awssdk.String("80"), Target: &elbv2.TargetDescription{Id: awssdk.String("inst_1"), Port: awssdk.Int64(443)}}},
 		"tg_2": {{Target: &elbv2.TargetDescription{Id: awssdk.String("inst_2"), Port: awssdk.Int64(80)}}, {Target: &elbv2.TargetDescription{Id: awssdk.String("inst_3"), Port: awssdk.Int64(80)}}},
@@ -346,8 +426,8 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 	tasksDef := []*ecs.TaskDefinition{
 		{
 			ContainerDefinitions: []*ecs.ContainerDefinition{
-				{Name: awssdk.String("cont_name_1"), Image: awssdk.String("image_1")},
-				{Name: awssdk.String("cont_name_2"), Image: awssdk.String("image_2")},
+				{Name: awssdk.String("cont_name_1"), Image: awssdk.String("image_1"), LogConfiguration: &ecs.LogConfiguration{LogDriver: awssdk.String("awslogs"), Options: map[string]*string{"awslogs-group": awssdk.String("/ecs/cs_1")}}},
+				{Name: awssdk.String("cont_name_2"), Image: awssdk.String("image_2"), LogConfiguration: &ecs.LogConfiguration{LogDriver: awssdk.String("awslogs"), Options: map[string]*string{"awslogs-group": awssdk.String("/ecs/cs_1")}}},
 				{Name: awssdk.String("cont_name_3"), Image: awssdk.String("image_3")},
 			},
 			Family:            awssdk.String("cs_1"),
@@ -482,27 +562,206 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		},
 	}
 
-	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws}
+	//WAF
+	acl1Arn := "arn:aws:wafv2:eu-west-1:123456789012:regional/webacl/my_acl/acl_1"
+	acl2Arn := "arn:aws:wafv2:eu-west-1:123456789012:regional/webacl/other_acl/acl_2"
+	acl3Arn := "arn:aws:wafv2:us-east-1:123456789012:global/webacl/my_cdn_acl/acl_3"
+	regionalACLs := []*wafv2.WebACL{
+		{Id: awssdk.String("acl_1"), ARN: awssdk.String(acl1Arn), Name: awssdk.String("my_acl"), Description: awssdk.String("protects the load balancers"), VisibilityConfig: &wafv2.VisibilityConfig{MetricName: awssdk.String("myacl")},
+			DefaultAction: &wafv2.DefaultAction{Allow: &wafv2.AllowAction{}}, Rules: []*wafv2.Rule{{Priority: awssdk.Int64(1), Name: awssdk.String("rule_1")}, {Priority: awssdk.Int64(2), Name: awssdk.String("rule_2")}}},
+		{Id: awssdk.String("acl_2"), ARN: awssdk.String(acl2Arn), DefaultAction: &wafv2.DefaultAction{Block: &wafv2.BlockAction{}}, Rules: []*wafv2.Rule{}},
+	}
+	webaclResources := map[string][]*string{
+		acl1Arn: {awssdk.String("lb_1"), awssdk.String("lb_3")},
+	}
+	cloudfrontACLs := []*wafv2.WebACL{
+		{Id: awssdk.String("acl_3"), ARN: awssdk.String(acl3Arn), Name: awssdk.String("my_cdn_acl"), DefaultAction: &wafv2.DefaultAction{Block: &wafv2.BlockAction{}}, Rules: []*wafv2.Rule{{Priority: awssdk.Int64(1), Name: awssdk.String("rule_1")}}},
+	}
+
+	expiry := now.Add(30 * 24 * time.Hour)
+	certificates := []*acm.CertificateDetail{
+		{CertificateArn: awssdk.String("cert_1"), DomainName: awssdk.String("my.domain.com"), SubjectAlternativeNames: []*string{awssdk.String("my.domain.com"), awssdk.String("www.my.domain.com")},
+			Status: awssdk.String("ISSUED"), Type: awssdk.String("AMAZON_ISSUED"), Issuer: awssdk.String("Amazon"), CreatedAt: &now, NotAfter: &expiry,
+			InUseBy: []*string{awssdk.String("arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/lb/50dc6c495c0c9188"), awssdk.String("arn:aws:cloudfront::123456789012:distribution/dist_1")}},
+		{CertificateArn: awssdk.String("cert_2"), DomainName: awssdk.String("other.domain.com"), Status: awssdk.String("PENDING_VALIDATION")},
+	}
+
+	//Beanstalk
+	beanstalkApps := []*elasticbeanstalk.ApplicationDescription{
+		{ApplicationName: awssdk.String("my_app"), Description: awssdk.String("my application"), Versions: []*string{awssdk.String("v2"), awssdk.String("v1")}, DateCreated: &now},
+	}
+	beanstalkEnvs := []*elasticbeanstalk.EnvironmentDescription{
+		{EnvironmentId: awssdk.String("env_1"), EnvironmentName: awssdk.String("my_env"), ApplicationName: awssdk.String("my_app"), Status: awssdk.String("Ready"), Health: awssdk.String("Green"),
+			HealthStatus: awssdk.String("Ok"), VersionLabel: awssdk.String("v2"), SolutionStackName: awssdk.String("64bit Amazon Linux running Go 1.8"), Tier: &elasticbeanstalk.EnvironmentTier{Name: awssdk.String("WebServer")},
+			CNAME: awssdk.String("my-env.elasticbeanstalk.com"), DateUpdated: &now},
+		{EnvironmentId: awssdk.String("env_2"), EnvironmentName: awssdk.String("my_worker"), ApplicationName: awssdk.String("my_app"), Status: awssdk.String("Launching")},
+	}
+	beanstalkEnvResources := map[string][]*elasticbeanstalk.EnvironmentResourceDescription{
+		"env_1": {{
+			AutoScalingGroups: []*elasticbeanstalk.AutoScalingGroup{{Name: awssdk.String("asg_name_1")}},
+			LoadBalancers:     []*elasticbeanstalk.LoadBalancer{{Name: awssdk.String("my_loadbalancer")}, {Name: awssdk.String("clb_1")}},
+		}},
+	}
+
+	//API Gateway
+	restApis := []*apigateway.RestApi{
+		{Id: awssdk.String("api_1"), Name: awssdk.String("my_api"), Description: awssdk.String("my public api"), Version: awssdk.String("1.0"), CreatedDate: &now},
+		{Id: awssdk.String("api_2"), Name: awssdk.String("other_api")},
+		{Id: awssdk.String("api_3")},
+	}
+	apiStages := map[string][]*apigateway.Stage{
+		"api_1": {
+			{StageName: awssdk.String("prod"), DeploymentId: awssdk.String("dep_1"), Description: awssdk.String("production"), CreatedDate: &now, LastUpdatedDate: &now},
+			{StageName: awssdk.String("dev"), DeploymentId: awssdk.String("dep_2")},
+		},
+		"api_2": {{StageName: awssdk.String("prod"), DeploymentId: awssdk.String("dep_3")}},
+	}
+	apiResources := map[string][]*apigateway.Resource{
+		"api_1": {
+			{Id: awssdk.String("res_1"), Path: awssdk.String("/"), ResourceMethods: map[string]*apigateway.Method{"GET": {}}},
+			{Id: awssdk.String("res_2"), Path: awssdk.String("/items"), ResourceMethods: map[string]*apigateway.Method{"GET": {}, "POST": {}}},
+		},
+		"api_2": {
+			{Id: awssdk.String("res_3"), Path: awssdk.String("/{proxy+}"), ResourceMethods: map[string]*apigateway.Method{"ANY": {}}},
+			{Id: awssdk.String("res_4"), Path: awssdk.String("/fn"), ResourceMethods: map[string]*apigateway.Method{"GET": {}}},
+		},
+	}
+	apiIntegrations := map[string][]*apigateway.Integration{
+		"res_1": {{Type: awssdk.String("AWS_PROXY"), Uri: awssdk.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-1:123456789012:function:my_func:live/invocations")}},
+		"res_3": {{Type: awssdk.String("HTTP_PROXY"), Uri: awssdk.String("http://my-lb-3.eu-west-1.elb.amazonaws.com/{proxy}")}},
+		"res_4": {{Type: awssdk.String("AWS"), Uri: awssdk.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-1:123456789012:function:${stageVariables.fn}/invocations")}},
+	}
+	httpApis := []*apigatewayv2.Api{
+		{ApiId: awssdk.String("http_1"), Name: awssdk.String("my_http_api"), ProtocolType: awssdk.String("HTTP"), ApiEndpoint: awssdk.String("https://http_1.execute-api.eu-west-1.amazonaws.com"), CreatedDate: &now},
+		{ApiId: awssdk.String("ws_1"), Name: awssdk.String("my_websocket_api"), ProtocolType: awssdk.String("WEBSOCKET"), ApiEndpoint: awssdk.String("wss://ws_1.execute-api.eu-west-1.amazonaws.com")},
+	}
+	httpApiStages := map[string][]*apigatewayv2.Stage{
+		"http_1": {{StageName: awssdk.String("$default")}},
+		"ws_1":   {{StageName: awssdk.String("prod")}, {StageName: awssdk.String("dev")}},
+	}
+	httpApiIntegrations := map[string][]*apigatewayv2.Integration{
+		"http_1": {
+			{IntegrationType: awssdk.String("AWS_PROXY"), IntegrationUri: awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:other_func")},
+			{IntegrationType: awssdk.String("HTTP_PROXY"), ConnectionType: awssdk.String("VPC_LINK"), IntegrationUri: awssdk.String("list_2")},
+		},
+		"ws_1": {{IntegrationType: awssdk.String("MOCK")}},
+	}
+
+	//SSM
+	parameters := []*ssm.ParameterMetadata{
+		{Name: awssdk.String("/app/db/host"), Type: awssdk.String("String"), Description: awssdk.String("database host"), LastModifiedDate: &now, LastModifiedUser: awssdk.String("arn:aws:iam::123456789012:user/admin")},
+		{Name: awssdk.String("db_password"), Type: awssdk.String("SecureString"), KeyId: awssdk.String("alias/aws/ssm")},
+		{Name: awssdk.String("api_token"), Type: awssdk.String("SecureString"), KeyId: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_2")},
+		{Name: awssdk.String("other_token"), Type: awssdk.String("SecureString"), KeyId: awssdk.String("arn:aws:kms:eu-west-1:123456789012:alias/missing")},
+	}
+
+	//KMS
+	kmsKeys := []*kms.KeyMetadata{
+		{KeyId: awssdk.String("key_1"), Arn: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1"), Description: awssdk.String("Default master key for SSM"), Enabled: awssdk.Bool(true), KeyState: awssdk.String("Enabled"), CreationDate: &now},
+		{KeyId: awssdk.String("key_2"), Arn: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_2"), Enabled: awssdk.Bool(false), KeyState: awssdk.String("Disabled")},
+	}
+	kmsAliases := []*kms.AliasListEntry{
+		{AliasName: awssdk.String("alias/aws/ssm"), TargetKeyId: awssdk.String("key_1")},
+		{AliasName: awssdk.String("alias/ssm"), TargetKeyId: awssdk.String("key_1")},
+		{AliasName: awssdk.String("alias/aws/ebs")},
+	}
+
+	//GuardDuty
+	findingTime := "2017-11-28T20:16:33.917Z"
+	findingInstance := func(id string) *guardduty.Resource {
+		return &guardduty.Resource{ResourceType: awssdk.String("Instance"), InstanceDetails: &guardduty.InstanceDetails{InstanceId: awssdk.String(id)}}
+	}
+	findings := []*guardduty.Finding{
+		{Id: awssdk.String("find_1"), Arn: awssdk.String("arn:aws:guardduty:eu-west-1:123456789012:detector/det_1/finding/find_1"), Title: awssdk.String("SSH brute force attacks against inst_1"),
+			Type: awssdk.String("UnauthorizedAccess:EC2/SSHBruteForce"), Severity: awssdk.Float64(8), CreatedAt: awssdk.String(findingTime), UpdatedAt: awssdk.String(findingTime),
+			Resource: findingInstance("inst_1"), Service: &guardduty.Service{DetectorId: awssdk.String("det_1"), Archived: awssdk.Bool(false)}},
+		{Id: awssdk.String("find_2"), Type: awssdk.String("Recon:IAMUser/TorIPCaller"), Severity: awssdk.Float64(5),
+			Resource: &guardduty.Resource{ResourceType: awssdk.String("AccessKey")}, Service: &guardduty.Service{DetectorId: awssdk.String("det_1"), Archived: awssdk.Bool(false)}},
+		{Id: awssdk.String("find_3"), Type: awssdk.String("Recon:EC2/Portscan"), Severity: awssdk.Float64(2),
+			Resource: findingInstance("inst_2"), Service: &guardduty.Service{DetectorId: awssdk.String("det_1"), Archived: awssdk.Bool(true)}},
+		{Id: awssdk.String("find_4"), Type: awssdk.String("Recon:EC2/Portscan"), Severity: awssdk.Float64(2),
+			Resource: findingInstance("inst_terminated"), Service: &guardduty.Service{DetectorId: awssdk.String("det_2"), Archived: awssdk.Bool(false)}},
+	}
+
+	//Secrets Manager
+	secrets := []*secretsmanager.SecretListEntry{
+		{Name: awssdk.String("prod/db/credentials"), ARN: awssdk.String("arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db/credentials-a1b2c3"), Description: awssdk.String("database credentials"),
+			KmsKeyId: awssdk.String("alias/ssm"), CreatedDate: &now, LastChangedDate: &now},
+		{Name: awssdk.String("prod/api/key"), KmsKeyId: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_2")},
+		{Name: awssdk.String("legacy_secret")},
+	}
+
+	//ElastiCache
+	cacheClusters := []*elasticache.CacheCluster{
+		{CacheClusterId: awssdk.String("cc_1"), Engine: awssdk.String("redis"), EngineVersion: awssdk.String("3.2.4"), CacheNodeType: awssdk.String("cache.t2.micro"), NumCacheNodes: awssdk.Int64(1),
+			CacheClusterStatus: awssdk.String("available"), CacheSubnetGroupName: awssdk.String("csg_1"), ReplicationGroupId: awssdk.String("rg_1"), PreferredAvailabilityZone: awssdk.String("eu-west-1a"),
+			SecurityGroups: []*elasticache.SecurityGroupMembership{{SecurityGroupId: awssdk.String("securitygroup_1")}}, CacheClusterCreateTime: &now,
+			CacheNodes: []*elasticache.CacheNode{{Endpoint: &elasticache.Endpoint{Address: awssdk.String("cc_1.0001.cache.amazonaws.com"), Port: awssdk.Int64(6379)}}}},
+		{CacheClusterId: awssdk.String("cc_2"), Engine: awssdk.String("memcached"), CacheNodeType: awssdk.String("cache.m4.large"), NumCacheNodes: awssdk.Int64(2), CacheSubnetGroupName: awssdk.String("csg_1"),
+			SecurityGroups:        []*elasticache.SecurityGroupMembership{{SecurityGroupId: awssdk.String("securitygroup_1")}, {SecurityGroupId: awssdk.String("securitygroup_2")}},
+			ConfigurationEndpoint: &elasticache.Endpoint{Address: awssdk.String("cc_2.cfg.cache.amazonaws.com"), Port: awssdk.Int64(11211)}},
+		{CacheClusterId: awssdk.String("cc_3"), Engine: awssdk.String("redis"), NumCacheNodes: awssdk.Int64(1),
+			CacheNodes: []*elasticache.CacheNode{{Endpoint: &elasticache.Endpoint{Address: awssdk.String("cc_3.0001.cache.amazonaws.com"), Port: awssdk.Int64(6379)}}}},
+	}
+	replicationGroups := []*elasticache.ReplicationGroup{
+		{ReplicationGroupId: awssdk.String("rg_1"), NodeGroups: []*elasticache.NodeGroup{{PrimaryEndpoint: &elasticache.Endpoint{Address: awssdk.String("rg_1.cache.amazonaws.com"), Port: awssdk.Int64(6379)}}}},
+	}
+	cacheSubnetGroups := []*elasticache.CacheSubnetGroup{
+		{CacheSubnetGroupName: awssdk.String("csg_1"), CacheSubnetGroupDescription: awssdk.String("cache subnets"), VpcId: awssdk.String("vpc_1"),
+			Subnets: []*elasticache.Subnet{{SubnetIdentifier: awssdk.String("sub_1")}, {SubnetIdentifier: awssdk.String("sub_2")}}},
+	}
+	cacheTags := map[string][]*elasticache.Tag{
+		"arn:aws:elasticache:eu-west-1:123456789012:cluster:cc_1": {{Key: awssdk.String("Env"), Value: awssdk.String("prod")}},
+	}
+
+	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws, addresss: addresses, networkinterfaces: networkInterfaces, spotinstancerequests: spotRequests, reservedinstancess: reservedInstances, placementgroups: placementGroups, hosts: hosts, vpcendpoints: vpcEndpoints}
 	mockLb := &mockElbv2{loadbalancers: lbPages, targetgroups: targetGroups, listeners: listeners, targethealthdescriptions: targetHealths}
+	mockClassicLb := &mockElb{loadbalancerdescriptions: classicLbs}
 	mockEcr := &mockEcr{repositorys: repositories}
 	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances}
 	mockRds := &mockRds{}
+	mockElasticache := &mockElasticache{cacheclusters: cacheClusters, cachesubnetgroups: cacheSubnetGroups, replicationgroups: replicationGroups, tags: cacheTags}
 	mockAutoscaling := &mockAutoscaling{launchconfigurations: launchConfigs, groups: scalingGroups}
-	InfraService = &Infra{
-		EC2API:         mock,
-		ECRAPI:         mockEcr,
-		ECSAPI:         mockEcs,
-		ELBV2API:       mockLb,
-		RDSAPI:         mockRds,
-		AutoScalingAPI: mockAutoscaling,
-		region:         "eu-west-1",
-		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockLb, mockRds, mockAutoscaling))),
-	}
-	g, err := InfraService.FetchResources()
+	mockCloudFrontWafv2 := &mockWafv2{webacls: cloudfrontACLs}
+	mockWafv2 := &mockWafv2{webacls: regionalACLs, webaclResources: webaclResources}
+	mockAcm := &mockAcm{certificatedetails: certificates}
+	mockBeanstalk := &mockElasticbeanstalk{applicationdescriptions: beanstalkApps, environmentdescriptions: beanstalkEnvs, environmentresourcedescriptions: beanstalkEnvResources}
+	mockApigateway := &mockApigateway{restapis: restApis, stages: apiStages, resources: apiResources, integrations: apiIntegrations}
+	mockApigatewayv2 := &mockApigatewayv2{apis: httpApis, stages: httpApiStages, integrations: httpApiIntegrations}
+	mockSsm := &mockSsm{parametermetadatas: parameters}
+	mockKms := &mockKms{keymetadatas: kmsKeys, aliaslistentrys: kmsAliases}
+	mockGuardduty := &mockGuardduty{findings: findings}
+	mockSecretsmanager := &mockSecretsmanager{secretlistentrys: secrets}
+	mockSts := &mockSTS{output: &sts.GetCallerIdentityOutput{Account: awssdk.String("123456789012")}}
+	fetchConfig := awsfetch.NewConfig(mock, mockEcr, mockEcs, mockLb, mockClassicLb, mockRds, mockElasticache, mockAutoscaling, mockWafv2, awsfetch.CloudFrontWafv2{WAFV2API: mockCloudFrontWafv2}, mockAcm, mockBeanstalk, mockApigateway, mockApigatewayv2, mockSsm, mockKms, mockGuardduty, mockSecretsmanager, mockSts)
+	fetchConfig.Extra["aws.region"] = "eu-west-1"
+	fetchConfig.Extra["aws.infra.finding.sync"] = true
+	infra := &Infra{
+		EC2API:              mock,
+		ECRAPI:              mockEcr,
+		ECSAPI:              mockEcs,
+		ELBV2API:            mockLb,
+		ELBAPI:              mockClassicLb,
+		RDSAPI:              mockRds,
+		ElastiCacheAPI:      mockElasticache,
+		AutoScalingAPI:      mockAutoscaling,
+		WAFV2API:            mockWafv2,
+		ACMAPI:              mockAcm,
+		ElasticBeanstalkAPI: mockBeanstalk,
+		APIGatewayAPI:       mockApigateway,
+		ApiGatewayV2API:     mockApigatewayv2,
+		SSMAPI:              mockSsm,
+		KMSAPI:              mockKms,
+		GuardDutyAPI:        mockGuardduty,
+		SecretsManagerAPI:   mockSecretsmanager,
+		region:              "eu-west-1",
+		fetcher:             fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
+	}
+	g, err := infra.FetchResources()
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.GetAllResources("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance)
+	resources, err := g.GetAllResources("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "loadbalancer", cloud.ClassicLoadBalancer, "targetgroup", "listener", cloud.CacheCluster, cloud.CacheSubnetGroup, "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.WebACL, cloud.ElasticIP, cloud.SpotRequest, cloud.ReservedInstance, cloud.PlacementGroup, cloud.Host, cloud.VpcEndpoint, cloud.Certificate, cloud.NetworkInterface, cloud.BeanstalkApplication, cloud.BeanstalkEnvironment, cloud.RestApi, cloud.ApiStage, cloud.Parameter, cloud.KMSKey, cloud.Finding, cloud.Secret)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -515,9 +774,27 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		if p, ok := res.Properties[p.Vpcs].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
 		if p, ok := res.Properties[p.Messages].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties[p.AlternateNames].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties[p.PrivateIPs].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties[p.Versions].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties[p.Aliases].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties[p.Stages].([]string); ok {
+			sort.Strings(p)
+		}
 		if p, ok := res.Properties[p.ContainersImages].([]*graph.KeyValue); ok {
 			sort.Slice(p, func(i, j int) bool {
 				if p[i].KeyName == p[j].KeyName {
@@ -536,6 +813,7 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		}
 	}
 
+	prodStage1, devStage1, prodStage2 := awsconv.HashFields("dep_1", "prod"), awsconv.HashFields("dep_2", "dev"), awsconv.HashFields("dep_3", "prod")
 	expected := map[string]*graph.Resource{
 		"eu-west-1": resourcetest.Region("eu-west-1").Build(),
 		"inst_1":    resourcetest.Instance("inst_1").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Prop(p.Name, "instance1-name").Prop(p.Tags, []string{"Name=instance1-name"}).Build(),
@@ -547,23 +825,28 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			Prop(p.Image, "ami-1234").Prop(p.Launched, now).Prop(p.State, "running").Prop(p.KeyPair, "my_key").Prop(p.SecurityGroups, []string{"securitygroup_1"}).Prop(p.Affinity, "inst_affinity").
 			Prop(p.AvailabilityZone, "inst_az").Prop(p.PlacementGroup, "inst_group").Prop(p.Host, "inst_host").Prop(p.Architecture, "x86").Prop(p.Hypervisor, "xen").Prop(p.Profile, "arn:instance:profile").
 			Prop(p.Lifecycle, "lifecycle").Prop(p.NetworkInterfaces, []string{"my-network-interface"}).Prop(p.PublicDNS, "my-instance.dns").Prop(p.RootDevice, "/dev/xvda").Prop(p.RootDeviceType, "ebs").Build(),
-		"vpc_1":            resourcetest.VPC("vpc_1").Build(),
-		"vpc_2":            resourcetest.VPC("vpc_2").Build(),
-		"securitygroup_1":  resourcetest.SecurityGroup("securitygroup_1").Prop(p.Name, "my_securitygroup").Prop(p.Vpc, "vpc_1").Build(),
-		"securitygroup_2":  resourcetest.SecurityGroup("securitygroup_2").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_1":            resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_2":            resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
-		"sub_3":            resourcetest.Subnet("sub_3").Prop(p.Vpc, "vpc_2").Build(),
-		"sub_4":            resourcetest.Subnet("sub_4").Build(),
-		"us-west-1a":       resourcetest.AvailabilityZone("us-west-1a").Prop(p.Name, "us-west-1a").Prop(p.State, "available").Prop(p.Region, "us-west-1").Prop(p.Messages, []string{"msg 1", "msg 2"}).Build(),
-		"us-west-1b":       resourcetest.AvailabilityZone("us-west-1b").Prop(p.Name, "us-west-1b").Build(),
-		"my_key":           resourcetest.KeyPair("my_key").Build(),
-		"igw_1":            resourcetest.InternetGw("igw_1").Prop(p.Vpcs, []string{"vpc_2"}).Build(),
-		"natgw_1":          resourcetest.NatGw("natgw_1").Prop(p.Vpc, "vpc_1").Prop(p.Subnet, "sub_1").Build(),
-		"rt_1":             resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Main, false).Build(),
-		"lb_1":             resourcetest.LoadBalancer("lb_1").Prop(p.Arn, "lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
-		"lb_2":             resourcetest.LoadBalancer("lb_2").Prop(p.Arn, "lb_2").Prop(p.Vpc, "vpc_2").Build(),
-		"lb_3":             resourcetest.LoadBalancer("lb_3").Prop(p.Arn, "lb_3").Prop(p.Vpc, "vpc_1").Build(),
+		"vpc_1":           resourcetest.VPC("vpc_1").Build(),
+		"vpc_2":           resourcetest.VPC("vpc_2").Build(),
+		"securitygroup_1": resourcetest.SecurityGroup("securitygroup_1").Prop(p.Name, "my_securitygroup").Prop(p.Vpc, "vpc_1").Build(),
+		"securitygroup_2": resourcetest.SecurityGroup("securitygroup_2").Prop(p.Vpc, "vpc_1").Prop(p.Owner, "123456789012").Build(),
+		"sub_1":           resourcetest.Subnet("sub_1").Prop(p.Vpc, "vpc_1").Prop(p.CIDR, "10.0.0.0/24").Prop(p.AvailableIPs, 249).Prop(p.TotalIPs, 251).Build(),
+		"sub_2":           resourcetest.Subnet("sub_2").Prop(p.Vpc, "vpc_1").Build(),
+		"sub_3":           resourcetest.Subnet("sub_3").Prop(p.Vpc, "vpc_2").Build(),
+		"sub_4":           resourcetest.Subnet("sub_4").Build(),
+		"us-west-1a":      resourcetest.AvailabilityZone("us-west-1a").Prop(p.Name, "us-west-1a").Prop(p.State, "available").Prop(p.Region, "us-west-1").Prop(p.Messages, []string{"msg 1", "msg 2"}).Build(),
+		"us-west-1b":      resourcetest.AvailabilityZone("us-west-1b").Prop(p.Name, "us-west-1b").Build(),
+		"my_key":          resourcetest.KeyPair("my_key").Build(),
+		"igw_1":           resourcetest.InternetGw("igw_1").Prop(p.Vpcs, []string{"vpc_2"}).Build(),
+		"natgw_1":         resourcetest.NatGw("natgw_1").Prop(p.Vpc, "vpc_1").Prop(p.Subnet, "sub_1").Build(),
+		"rt_1":            resourcetest.RouteTable("rt_1").Prop(p.Vpc, "vpc_1").Prop(p.Main, false).Build(),
+		"lb_1":            resourcetest.LoadBalancer("lb_1").Prop(p.Arn, "lb_1").Prop(p.Name, "my_loadbalancer").Prop(p.Vpc, "vpc_1").Build(),
+		"lb_2":            resourcetest.LoadBalancer("lb_2").Prop(p.Arn, "lb_2").Prop(p.Vpc, "vpc_2").Build(),
+		"lb_3":            resourcetest.LoadBalancer("lb_3").Prop(p.Arn, "lb_3").Prop(p.Vpc, "vpc_1").Prop(p.PublicDNS, "my-lb-3.eu-west-1.elb.amazonaws.com").Build(),
+		"clb_1": resourcetest.ClassicLoadBalancer("clb_1").Prop(p.Name, "clb_1").Prop(p.Vpc, "vpc_1").Prop(p.PublicDNS, "clb-1.eu-west-1.elb.amazonaws.com").Prop(p.Scheme, "internet-facing").
+			Prop(p.Subnets, []string{"sub_1"}).Prop(p.SecurityGroups, []string{"securitygroup_1"}).Prop(p.Listeners, []string{"HTTP:80->HTTP:8080"}).Prop(p.HealthCheck, "HTTP:8080/health").
+			Prop(p.CheckInterval, 30).Prop(p.CheckTimeout, 5).Prop(p.HealthyThresholdCount, 3).Prop(p.UnhealthyThresholdCount, 2).Build(),
+		"clb_2":            resourcetest.ClassicLoadBalancer("clb_2").Prop(p.Name, "clb_2").Prop(p.Vpc, "vpc_2").Build(),
+		"clb_3":            resourcetest.ClassicLoadBalancer("clb_3").Prop(p.Name, "clb_3").Prop(p.Vpc, "vpc_2").Build(),
 		"tg_1":             resourcetest.TargetGroup("tg_1").Prop(p.Arn, "tg_1").Prop(p.Vpc, "vpc_1").Build(),
 		"tg_2":             resourcetest.TargetGroup("tg_2").Prop(p.Arn, "tg_2").Prop(p.Vpc, "vpc_2").Build(),
 		"list_1":           resourcetest.Listener("list_1").Prop(p.Arn, "list_1").Prop(p.LoadBalancer, "lb_1").Build(),
@@ -596,37 +879,119 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			Prop(p.Instance, "inst_2").Prop(p.PendingTasksCount, 4).Prop(p.Created, now.Add(-2*time.Hour)).Prop(p.RunningTasksCount, 2).Prop(p.State, "ACTIVE").Prop(p.Version, "2").Prop(p.AgentVersion, "0.0.5").Prop(p.DockerVersion, "v1.0.12").Prop(p.Cluster, "clust_1").Build(),
 		"cont_inst_2": resourcetest.ContainerInstance("cont_inst_2").Prop(p.Arn, "cont_inst_2").Prop(p.Instance, "inst_3").Prop(p.Cluster, "clust_1").Build(),
 		"cont_inst_3": resourcetest.ContainerInstance("cont_inst_3").Prop(p.Arn, "cont_inst_3").Prop(p.Instance, "inst_1").Prop(p.Cluster, "clust_2").Build(),
-	}
+		acl1Arn:       resourcetest.WebACL(acl1Arn).Prop(p.Arn, acl1Arn).Prop(p.Name, "my_acl").Prop(p.Description, "protects the load balancers").Prop(p.MetricName, "myacl").Prop(p.DefaultAction, "ALLOW").Prop(p.RuleCount, 2).Prop(p.Scope, "REGIONAL").Build(),
+		acl2Arn:       resourcetest.WebACL(acl2Arn).Prop(p.Arn, acl2Arn).Prop(p.DefaultAction, "BLOCK").Prop(p.RuleCount, 0).Prop(p.Scope, "REGIONAL").Build(),
+		"eip_1": resourcetest.ElasticIP("eip_1").Prop(p.Name, "52.0.0.1").Prop(p.PublicIP, "52.0.0.1").Prop(p.PrivateIP, "10.0.0.1").Prop(p.Association, "assoc_1").Prop(p.Associated, true).
+			Prop(p.Instance, "inst_6").Prop(p.NetworkInterface, "eni_1").Build(),
+		"eip_2": resourcetest.ElasticIP("eip_2").Prop(p.Name, "52.0.0.2").Prop(p.PublicIP, "52.0.0.2").Prop(p.Association, "assoc_2").Prop(p.Associated, true).Prop(p.NetworkInterface, "eni_2").Build(),
+		"eip_3": resourcetest.ElasticIP("eip_3").Prop(p.Name, "52.0.0.3").Prop(p.PublicIP, "52.0.0.3").Prop(p.Associated, false).Build(),
+		"sir_1": resourcetest.SpotRequest("sir_1").Prop(p.Name, "my_spot").Prop(p.Tags, []string{"Name=my_spot"}).Prop(p.State, "active").Prop(p.StateMessage, "Your spot request is fulfilled.").Prop(p.SpotPrice, "0.050000").
+			Prop(p.Type, "t2.micro").Prop(p.Image, "ami-1").Prop(p.Instance, "inst_1").Prop(p.AvailabilityZone, "us-west-1a").Prop(p.Platform, "Linux/UNIX").Prop(p.Created, now).Build(),
+		"sir_2": resourcetest.SpotRequest("sir_2").Prop(p.State, "open").Prop(p.StateMessage, "Your bid price is lower than the minimum required.").Prop(p.SpotPrice, "0.001000").Prop(p.Type, "m4.large").Build(),
+		"ri_1": resourcetest.ReservedInstance("ri_1").Prop(p.State, "active").Prop(p.Type, "t2.micro").Prop(p.InstanceCount, 2).Prop(p.OfferingType, "All Upfront").Prop(p.FixedPrice, "150").Prop(p.UsagePrice, "0").
+			Prop(p.Scope, "Region").Prop(p.Platform, "Linux/UNIX").Prop(p.Launched, now).Prop(p.Expires, riEnd).Build(),
+		"inst_group": resourcetest.PlacementGroup("inst_group").Prop(p.Name, "inst_group").Prop(p.State, "available").Prop(p.Strategy, "cluster").Build(),
+		"h_1": resourcetest.Host("h_1").Prop(p.State, "available").Prop(p.Type, "t2.micro").Prop(p.AvailabilityZone, "us-west-1a").Prop(p.Capacity, 22).Prop(p.AvailableCapacity, 20).
+			Prop(p.InstanceCount, 2).Build(),
+		"h_2":   resourcetest.Host("h_2").Prop(p.State, "released").Build(),
+		acl3Arn: resourcetest.WebACL(acl3Arn).Prop(p.Arn, acl3Arn).Prop(p.Name, "my_cdn_acl").Prop(p.DefaultAction, "BLOCK").Prop(p.RuleCount, 1).Prop(p.Scope, "CLOUDFRONT").Build(),
+		"eni_1": resourcetest.NetworkInterface("eni_1").Prop(p.Name, "eni_1_name").Prop(p.Tags, []string{"Name=eni_1_name"}).Prop(p.Description, "primary interface").Prop(p.Type, "interface").Prop(p.State, "in-use").
+			Prop(p.Subnet, "sub_3").Prop(p.Vpc, "vpc_2").Prop(p.AvailabilityZone, "us-west-1a").Prop(p.MACAddress, "0a:1b:2c:3d:4e:5f").Prop(p.PrivateIP, "10.0.0.1").Prop(p.PrivateIPs, []string{"10.0.0.1", "10.0.0.2"}).
+			Prop(p.PublicIP, "52.0.0.1").Prop(p.PublicDNS, "eni_1.public.dns").Prop(p.SecurityGroups, []string{"securitygroup_1"}).Prop(p.Attachment, "eni-attach-1").Prop(p.Instance, "inst_6").Prop(p.Owner, "owner_id").Build(),
+		"eni_2":  resourcetest.NetworkInterface("eni_2").Prop(p.State, "in-use").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Prop(p.Requester, "amazon-elb").Prop(p.SecurityGroups, []string{"securitygroup_1", "securitygroup_2"}).Prop(p.Attachment, "eni-attach-2").Build(),
+		"eni_3":  resourcetest.NetworkInterface("eni_3").Prop(p.Description, "VPC Endpoint Interface vpce_2").Prop(p.State, "in-use").Prop(p.Subnet, "sub_1").Prop(p.Vpc, "vpc_1").Prop(p.SecurityGroups, []string{"securitygroup_2"}).Build(),
+		"vpce_1": resourcetest.VpcEndpoint("vpce_1").Prop(p.Vpc, "vpc_1").Prop(p.ServiceName, "com.amazonaws.eu-west-1.s3").Prop(p.State, "available").Prop(p.RouteTables, []string{"rt_1"}).Prop(p.Created, now).Build(),
+		"vpce_2": resourcetest.VpcEndpoint("vpce_2").Prop(p.Vpc, "vpc_1").Prop(p.ServiceName, "com.amazonaws.eu-west-1.ssm").Prop(p.State, "pending").Build(),
+		"cc_1": resourcetest.CacheCluster("cc_1").Prop(p.Name, "cc_1").Prop(p.Engine, "redis").Prop(p.EngineVersion, "3.2.4").Prop(p.Class, "cache.t2.micro").Prop(p.NodeCount, 1).Prop(p.State, "available").
+			Prop(p.CacheSubnetGroup, "csg_1").Prop(p.ReplicationGroup, "rg_1").Prop(p.AvailabilityZone, "eu-west-1a").Prop(p.SecurityGroups, []string{"securitygroup_1"}).Prop(p.Created, now).
+			Prop(p.Endpoint, "rg_1.cache.amazonaws.com").Prop(p.Port, 6379).Prop(p.Tags, []string{"Env=prod"}).Build(),
+		"cc_2": resourcetest.CacheCluster("cc_2").Prop(p.Name, "cc_2").Prop(p.Engine, "memcached").Prop(p.Class, "cache.m4.large").Prop(p.NodeCount, 2).Prop(p.CacheSubnetGroup, "csg_1").
+			Prop(p.SecurityGroups, []string{"securitygroup_1", "securitygroup_2"}).Prop(p.Endpoint, "cc_2.cfg.cache.amazonaws.com").Prop(p.Port, 11211).Build(),
+		"cc_3":  resourcetest.CacheCluster("cc_3").Prop(p.Name, "cc_3").Prop(p.Engine, "redis").Prop(p.NodeCount, 1).Prop(p.Endpoint, "cc_3.0001.cache.amazonaws.com").Prop(p.Port, 6379).Build(),
+		"csg_1": resourcetest.CacheSubnetGroup("csg_1").Prop(p.Name, "csg_1").Prop(p.Description, "cache subnets").Prop(p.Vpc, "vpc_1").Prop(p.Subnets, []string{"sub_1", "sub_2"}).Build(),
+		"cert_1": resourcetest.Certificate("cert_1").Prop(p.Arn, "cert_1").Prop(p.Name, "my.domain.com").Prop(p.AlternateNames, []string{"my.domain.com", "www.my.domain.com"}).Prop(p.State, "ISSUED").
+			Prop(p.Type, "AMAZON_ISSUED").Prop(p.Issuer, "Amazon").Prop(p.Created, now).Prop(p.Expires, expiry).Prop(p.InUse, true).Build(),
+		"cert_2": resourcetest.Certificate("cert_2").Prop(p.Arn, "cert_2").Prop(p.Name, "other.domain.com").Prop(p.State, "PENDING_VALIDATION").Prop(p.InUse, false).Build(),
+		"my_app": resourcetest.BeanstalkApplication("my_app").Prop(p.Name, "my_app").Prop(p.Description, "my application").Prop(p.Versions, []string{"v1", "v2"}).Prop(p.Created, now).Build(),
+		"env_1": resourcetest.BeanstalkEnvironment("env_1").Prop(p.Name, "my_env").Prop(p.Application, "my_app").Prop(p.State, "Ready").Prop(p.Health, "Green").Prop(p.HealthStatus, "Ok").
+			Prop(p.Version, "v2").Prop(p.Platform, "64bit Amazon Linux running Go 1.8").Prop(p.Tier, "WebServer").Prop(p.PublicDNS, "my-env.elasticbeanstalk.com").Prop(p.Modified, now).Build(),
+		"env_2": resourcetest.BeanstalkEnvironment("env_2").Prop(p.Name, "my_worker").Prop(p.Application, "my_app").Prop(p.State, "Launching").Build(),
+		"api_1": resourcetest.RestApi("api_1").Prop(p.Name, "my_api").Prop(p.Description, "my public api").Prop(p.Version, "1.0").Prop(p.Created, now).Prop(p.Protocol, "REST").
+			Prop(p.Endpoint, "https://api_1.execute-api.eu-west-1.amazonaws.com").Prop(p.Stages, []string{"dev", "prod"}).Build(),
+		"api_2": resourcetest.RestApi("api_2").Prop(p.Name, "other_api").Prop(p.Protocol, "REST").Prop(p.Endpoint, "https://api_2.execute-api.eu-west-1.amazonaws.com").Prop(p.Stages, []string{"prod"}).Build(),
+		"api_3": resourcetest.RestApi("api_3").Prop(p.Protocol, "REST").Prop(p.Endpoint, "https://api_3.execute-api.eu-west-1.amazonaws.com").Build(),
+		"http_1": resourcetest.RestApi("http_1").Prop(p.Name, "my_http_api").Prop(p.Protocol, "HTTP").Prop(p.Endpoint, "https://http_1.execute-api.eu-west-1.amazonaws.com").
+			Prop(p.Created, now).Prop(p.Stages, []string{"$default"}).Build(),
+		"ws_1": resourcetest.RestApi("ws_1").Prop(p.Name, "my_websocket_api").Prop(p.Protocol, "WEBSOCKET").Prop(p.Endpoint, "wss://ws_1.execute-api.eu-west-1.amazonaws.com").
+			Prop(p.Stages, []string{"dev", "prod"}).Build(),
+		prodStage1: resourcetest.ApiStage(prodStage1).Prop(p.Name, "prod").Prop(p.Description, "production").Prop(p.Deployment, "dep_1").Prop(p.Created, now).Prop(p.Modified, now).
+			Prop(p.Endpoint, "https://api_1.execute-api.eu-west-1.amazonaws.com/prod").Build(),
+		devStage1:  resourcetest.ApiStage(devStage1).Prop(p.Name, "dev").Prop(p.Deployment, "dep_2").Prop(p.Endpoint, "https://api_1.execute-api.eu-west-1.amazonaws.com/dev").Build(),
+		prodStage2: resourcetest.ApiStage(prodStage2).Prop(p.Name, "prod").Prop(p.Deployment, "dep_3").Prop(p.Endpoint, "https://api_2.execute-api.eu-west-1.amazonaws.com/prod").Build(),
+		"/app/db/host": resourcetest.Parameter("/app/db/host").Prop(p.Name, "/app/db/host").Prop(p.Type, "String").Prop(p.Description, "database host").Prop(p.Modified, now).
+			Prop(p.ModifiedBy, "arn:aws:iam::123456789012:user/admin").Build(),
+		"db_password": resourcetest.Parameter("db_password").Prop(p.Name, "db_password").Prop(p.Type, "SecureString").Prop(p.KMSKey, "alias/aws/ssm").Build(),
+		"api_token":   resourcetest.Parameter("api_token").Prop(p.Name, "api_token").Prop(p.Type, "SecureString").Prop(p.KMSKey, "arn:aws:kms:eu-west-1:123456789012:key/key_2").Build(),
+		"other_token": resourcetest.Parameter("other_token").Prop(p.Name, "other_token").Prop(p.Type, "SecureString").Prop(p.KMSKey, "arn:aws:kms:eu-west-1:123456789012:alias/missing").Build(),
+		"key_1": resourcetest.KMSKey("key_1").Prop(p.Arn, "arn:aws:kms:eu-west-1:123456789012:key/key_1").Prop(p.Description, "Default master key for SSM").Prop(p.Enabled, true).
+			Prop(p.State, "Enabled").Prop(p.Created, now).Prop(p.Aliases, []string{"alias/aws/ssm", "alias/ssm"}).Build(),
+		"key_2": resourcetest.KMSKey("key_2").Prop(p.Arn, "arn:aws:kms:eu-west-1:123456789012:key/key_2").Prop(p.Enabled, false).Prop(p.State, "Disabled").Build(),
+		"find_1": resourcetest.Finding("find_1").Prop(p.Arn, "arn:aws:guardduty:eu-west-1:123456789012:detector/det_1/finding/find_1").Prop(p.Name, "SSH brute force attacks against inst_1").
+			Prop(p.Type, "UnauthorizedAccess:EC2/SSHBruteForce").Prop(p.Severity, "High").Prop(p.Created, time.Date(2017, 11, 28, 20, 16, 33, 917000000, time.UTC)).
+			Prop(p.Modified, time.Date(2017, 11, 28, 20, 16, 33, 917000000, time.UTC)).Prop(p.Instance, "inst_1").Build(),
+		"find_2": resourcetest.Finding("find_2").Prop(p.Type, "Recon:IAMUser/TorIPCaller").Prop(p.Severity, "Medium").Build(),
+		"find_4": resourcetest.Finding("find_4").Prop(p.Type, "Recon:EC2/Portscan").Prop(p.Severity, "Low").Prop(p.Instance, "inst_terminated").Build(),
+		"prod/db/credentials": resourcetest.Secret("prod/db/credentials").Prop(p.Name, "prod/db/credentials").Prop(p.Arn, "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db/credentials-a1b2c3").
+			Prop(p.Description, "database credentials").Prop(p.KMSKey, "alias/ssm").Prop(p.Created, now).Prop(p.Modified, now).Build(),
+		"prod/api/key":  resourcetest.Secret("prod/api/key").Prop(p.Name, "prod/api/key").Prop(p.KMSKey, "arn:aws:kms:eu-west-1:123456789012:key/key_2").Build(),
+		"legacy_secret": resourcetest.Secret("legacy_secret").Prop(p.Name, "legacy_secret").Build(),
+	}
+	api1Stages := []string{prodStage1, devStage1}
+	sort.Strings(api1Stages)
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"asg_arn_1", "asg_arn_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "repo_1", "repo_2", "repo_3", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
+		"eu-west-1": {"/app/db/host", "api_1", "api_2", "api_3", "api_token", acl1Arn, acl2Arn, acl3Arn, "asg_arn_1", "asg_arn_2", "cert_1", "cert_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "db_password", "eip_1", "eip_2", "eip_3", "find_1", "find_2", "find_4", "h_1", "h_2", "http_1", "igw_1", "img_1", "img_2", "inst_group", "key_1", "key_2", "launchconfig_arn", "legacy_secret", "my_app", "my_key", "natgw_1", "other_token", "prod/api/key", "prod/db/credentials", "repo_1", "repo_2", "repo_3", "ri_1", "sir_1", "sir_2", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2", "ws_1"},
+		"my_app":    {"env_1", "env_2"},
+		"api_1":     api1Stages,
+		"api_2":     {prodStage2},
 		"lb_1":      {"list_1", "list_1.2"},
 		"lb_2":      {"list_2"},
 		"lb_3":      {"list_3"},
-		"sub_1":     {"inst_1"},
+		"sub_1":     {"eni_2", "eni_3", "inst_1"},
 		"sub_2":     {"inst_2"},
-		"sub_3":     {"inst_3", "inst_4", "inst_6"},
-		"vpc_1":     {"lb_1", "lb_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1"},
-		"vpc_2":     {"lb_2", "sub_3", "tg_2"},
+		"sub_3":     {"eni_1", "inst_3", "inst_4", "inst_6"},
+		"vpc_1":     {"clb_1", "csg_1", "lb_1", "lb_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1", "vpce_1", "vpce_2"},
+		"vpc_2":     {"clb_2", "clb_3", "lb_2", "sub_3", "tg_2"},
 		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3"},
 		"clust_2":   {"cont_inst_3", "container_4", "container_5"},
 	}
 
 	expectedAppliedOn := map[string][]string{
+		acl1Arn:           {"lb_1", "lb_3"},
+		"clb_1":           {"inst_1", "inst_2", "sub_1"},
+		"clb_3":           {"inst_3"},
+		"cert_1":          {"arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/lb/50dc6c495c0c9188", "dist_1"},
+		"eip_1":           {"eni_1", "inst_6"},
+		"eni_1":           {"inst_6"},
+		"eni_3":           {"vpce_2"},
+		"eip_2":           {"eni_2", "natgw_1"},
+		"h_1":             {"inst_1", "inst_6"},
 		"igw_1":           {"vpc_2"},
+		"inst_group":      {"inst_6"},
 		"lb_1":            {"tg_1"},
 		"lb_2":            {"tg_2"},
 		"lb_3":            {"tg_1"},
 		"my_key":          {"inst_4", "inst_6", "launchconfig_arn"},
 		"natgw_1":         {"sub_1"},
 		"rt_1":            {"sub_1"},
-		"securitygroup_1": {"inst_2", "inst_4", "inst_6", "lb_3"},
-		"securitygroup_2": {"inst_4", "lb_3"},
+		"sir_1":           {"inst_1"},
+		"securitygroup_1": {"cc_1", "cc_2", "clb_1", "eni_1", "eni_2", "inst_2", "inst_4", "inst_6", "lb_3"},
+		"securitygroup_2": {"cc_2", "eni_2", "eni_3", "inst_4", "lb_3"},
 		"tg_1":            {"inst_1"},
 		"tg_2":            {"inst_2", "inst_3"},
 		"asg_arn_1":       {"inst_1", "inst_3", "sub_1", "sub_2"},
 		"asg_arn_2":       {"tg_1", "tg_2"},
-		"cs_1:1":          {"container_5"},
+		"cs_1:1":          {"/ecs/cs_1", "container_5"},
 		"cs_2:1":          {"container_1", "container_2", "container_3"},
 		"cs_2:2":          {"container_4"},
 		"inst_1":          {"cont_inst_3"},
@@ -635,9 +1000,32 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"cont_inst_1":     {"container_1", "container_2", "container_3"},
 		"cont_inst_2":     {"container_4"},
 		"cont_inst_3":     {"container_5"},
+		"env_1":           {"asg_arn_1", "clb_1", "lb_1"},
+		"api_1":           {"arn:aws:lambda:eu-west-1:123456789012:function:my_func"},
+		"api_2":           {"lb_3"},
+		"http_1":          {"arn:aws:lambda:eu-west-1:123456789012:function:other_func", "lb_2"},
+		"vpce_1":          {"rt_1"},
+		"cc_1":            {"csg_1"},
+		"cc_2":            {"csg_1"},
+		"csg_1":           {"sub_1", "sub_2"},
+		"key_1":           {"db_password", "prod/db/credentials"},
+		"key_2":           {"api_token", "prod/api/key"},
+		"find_1":          {"inst_1"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	dependingOn, err := g.ListResourcesDependingOn(graph.InitResource(cloud.Instance, "inst_6"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, r := range dependingOn {
+		found = found || r.Id() == "profile_1"
+	}
+	if !found {
+		t.Fatalf("expected instance profile to apply on instance, got %v", dependingOn)
+	}
 }
 
 func TestBuildStorageRdfGraph(t *testing.T) {
@@ -684,19 +1072,40 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 		},
 	}
 
+	tables := []*dynamodb.TableDescription{
+		{
+			TableArn:              awssdk.String("table_1_arn"),
+			TableName:             awssdk.String("table_1"),
+			TableStatus:           awssdk.String("ACTIVE"),
+			KeySchema:             []*dynamodb.KeySchemaElement{{AttributeName: awssdk.String("id"), KeyType: awssdk.String("HASH")}, {AttributeName: awssdk.String("date"), KeyType: awssdk.String("RANGE")}},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(5), WriteCapacityUnits: awssdk.Int64(10)},
+			ItemCount:             awssdk.Int64(42),
+			TableSizeBytes:        awssdk.Int64(1024),
+			StreamSpecification:   &dynamodb.StreamSpecification{StreamEnabled: awssdk.Bool(true)},
+			LatestStreamArn:       awssdk.String("table_1_arn/stream/2017-01-01T00:00:00.000"),
+		},
+		{
+			TableArn:              awssdk.String("table_2_arn"),
+			TableName:             awssdk.String("table_2"),
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(0), WriteCapacityUnits: awssdk.Int64(0)},
+		},
+	}
+
 	mocks3 := &mockS3{buckets: buckets, objects: objects, grants: bucketsACL}
+	mockdynamodb := &mockDynamodb{tabledescriptions: tables}
 	StorageService = mocks3
 	storage := Storage{
-		S3API:   mocks3,
-		region:  "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(awsfetch.NewConfig(mocks3))),
+		S3API:       mocks3,
+		DynamoDBAPI: mockdynamodb,
+		region:      "eu-west-1",
+		fetcher:     fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(awsfetch.NewConfig(mocks3, mockdynamodb))),
 	}
 
 	g, err := storage.FetchResources()
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.GetAllResources("region", "bucket")
+	resources, err := g.GetAllResources("region", "bucket", "table")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -705,9 +1114,14 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 		"eu-west-1":   resourcetest.Region("eu-west-1").Build(),
 		"bucket_eu_1": resourcetest.Bucket("bucket_eu_1").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_2"}, Permission: "Write"}}).Build(),
 		"bucket_eu_2": resourcetest.Bucket("bucket_eu_2").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_1"}, Permission: "Write"}}).Build(),
+		"table_1_arn": resourcetest.Table("table_1_arn").Prop(p.Arn, "table_1_arn").Prop(p.Name, "table_1").Prop(p.State, "ACTIVE").Prop(p.KeySchema, "id:HASH,date:RANGE").
+			Prop(p.BillingMode, "PROVISIONED").Prop(p.ReadCapacity, 5).Prop(p.WriteCapacity, 10).Prop(p.ItemCount, 42).Prop(p.Size, 1024).
+			Prop(p.StreamEnabled, true).Prop(p.Stream, "table_1_arn/stream/2017-01-01T00:00:00.000").Build(),
+		"table_2_arn": resourcetest.Table("table_2_arn").Prop(p.Arn, "table_2_arn").Prop(p.Name, "table_2").Prop(p.BillingMode, "PAY_PER_REQUEST").
+			Prop(p.ReadCapacity, 0).Prop(p.WriteCapacity, 0).Build(),
 	}
 	expectedChildren := map[string][]string{
-		"eu-west-1":   {"bucket_eu_1", "bucket_eu_2"},
+		"eu-west-1":   {"bucket_eu_1", "bucket_eu_2", "table_1_arn", "table_2_arn"},
 		"bucket_eu_1": {"obj_4"},
 		"bucket_eu_2": {"obj_5", "obj_6"},
 	}
@@ -786,23 +1200,37 @@ func TestBuildNotificationGraph(t *testing.T) {
 		{Endpoint: awssdk.String("endpoint_1")},
 		{Endpoint: awssdk.String("endpoint_2"), Owner: awssdk.String("subscr_owner"), Protocol: awssdk.String("subscr_prot"), SubscriptionArn: awssdk.String("subscr_arn"), TopicArn: awssdk.String("topic_arn_2")},
 		{Endpoint: awssdk.String("endpoint_3"), TopicArn: awssdk.String("topic_arn_2")},
+		{Endpoint: awssdk.String("queue_2_arn"), Protocol: awssdk.String("sqs"), TopicArn: awssdk.String("topic_arn_3")},
+		{Endpoint: awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod"), Protocol: awssdk.String("lambda"), TopicArn: awssdk.String("topic_arn_3")},
+	}
+	topicAttributes := map[string]map[string]*string{
+		"topic_arn_2": {
+			"DisplayName": awssdk.String("my topic"),
+			"Owner":       awssdk.String("123456789012"),
+		},
 	}
 	queues := []*string{awssdk.String("queue_1"), awssdk.String("queue_2"), awssdk.String("queue_3")}
 	attributes := map[string]map[string]*string{
+		"queue_1": {
+			"RedrivePolicy": awssdk.String(`{"deadLetterTargetArn":"unknown_queue_arn","maxReceiveCount":3}`),
+		},
 		"queue_2": {
 			"ApproximateNumberOfMessages": awssdk.String("4"),
 			"CreatedTimestamp":            awssdk.String("1494419259"),
 			"LastModifiedTimestamp":       awssdk.String("1494332859"),
 			"QueueArn":                    awssdk.String("queue_2_arn"),
 			"DelaySeconds":                awssdk.String("15"),
+			"VisibilityTimeout":           awssdk.String("30"),
+			"RedrivePolicy":               awssdk.String(`{"deadLetterTargetArn":"queue_3_arn","maxReceiveCount":"5"}`),
 		},
 		"queue_3": {
 			"ApproximateNumberOfMessages": awssdk.String("12"),
+			"QueueArn":                    awssdk.String("queue_3_arn"),
 		},
 	}
 
 	sqs := &mockSqs{strings: queues, attributes: attributes}
-	sns := &mockSns{subscriptions: subscriptions, topics: topics}
+	sns := &mockSns{subscriptions: subscriptions, topics: topics, attributes: topicAttributes}
 
 	service := Messaging{
 		SNSAPI: sns, SQSAPI: sqs, region: "eu-west-1",
@@ -823,15 +1251,21 @@ func TestBuildNotificationGraph(t *testing.T) {
 		"endpoint_1":  resourcetest.Subscription("endpoint_1").Prop(p.Endpoint, "endpoint_1").Build(),
 		"endpoint_2":  resourcetest.Subscription("endpoint_2").Prop(p.Endpoint, "endpoint_2").Prop(p.Owner, "subscr_owner").Prop(p.Protocol, "subscr_prot").Prop(p.Arn, "subscr_arn").Prop(p.Topic, "topic_arn_2").Build(),
 		"endpoint_3":  resourcetest.Subscription("endpoint_3").Prop(p.Endpoint, "endpoint_3").Prop(p.Topic, "topic_arn_2").Build(),
-		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Build(),
-		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Build(),
-		"topic_arn_3": resourcetest.Topic("topic_arn_3").Prop(p.Arn, "topic_arn_3").Build(),
+		"queue_2_arn": resourcetest.Subscription("queue_2_arn").Prop(p.Endpoint, "queue_2_arn").Prop(p.Protocol, "sqs").Prop(p.Topic, "topic_arn_3").Build(),
+		"arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod": resourcetest.Subscription("arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod").Prop(p.Endpoint, "arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod").Prop(p.Protocol, "lambda").Prop(p.Topic, "topic_arn_3").Build(),
+		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Prop(p.Name, "topic_arn_1").Build(),
+		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Prop(p.Name, "topic_arn_2").Prop(p.DisplayName, "my topic").Prop(p.Owner, "123456789012").Build(),
+		"topic_arn_3": resourcetest.Topic("topic_arn_3").Prop(p.Arn, "topic_arn_3").Prop(p.Name, "topic_arn_3").Build(),
 	}
 	expectedChildren := map[string][]string{
 		"eu-west-1":   {"topic_arn_1", "topic_arn_2", "topic_arn_3"},
 		"topic_arn_2": {"endpoint_2", "endpoint_3"},
+		"topic_arn_3": {"arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod", "queue_2_arn"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"queue_2_arn": {"queue_2"},
+		"arn:aws:lambda:eu-west-1:123456789012:function:func_1:prod": {"arn:aws:lambda:eu-west-1:123456789012:function:func_1"},
 	}
-	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 
@@ -841,12 +1275,15 @@ func TestBuildNotificationGraph(t *testing.T) {
 	}
 
 	expected = map[string]*graph.Resource{
-		"queue_1": resourcetest.Queue("queue_1").Build(),
-		"queue_2": resourcetest.Queue("queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).Build(),
-		"queue_3": resourcetest.Queue("queue_3").Prop(p.ApproximateMessageCount, 12).Build(),
+		"queue_1": resourcetest.Queue("queue_1").Prop(p.Name, "queue_1").Prop(p.DeadLetterQueue, "unknown_queue_arn").Prop(p.MaxReceiveCount, 3).Build(),
+		"queue_2": resourcetest.Queue("queue_2").Prop(p.Name, "queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).
+			Prop(p.VisibilityTimeout, 30).Prop(p.DeadLetterQueue, "queue_3_arn").Prop(p.MaxReceiveCount, 5).Build(),
+		"queue_3": resourcetest.Queue("queue_3").Prop(p.Name, "queue_3").Prop(p.ApproximateMessageCount, 12).Prop(p.Arn, "queue_3_arn").Build(),
 	}
 	expectedChildren = map[string][]string{}
-	expectedAppliedOn = map[string][]string{}
+	expectedAppliedOn = map[string][]string{
+		"queue_2": {"queue_3"},
+	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 
@@ -871,8 +1308,12 @@ func TestBuildLambdaGraph(t *testing.T) {
 		},
 		{FunctionArn: awssdk.String("func_3_arn")},
 	}
+	mappings := []*lambda.EventSourceMappingConfiguration{
+		{FunctionArn: awssdk.String("func_1_arn"), EventSourceArn: awssdk.String("arn:aws:dynamodb:eu-west-1:0123456789:table/my_table/stream/2017-01-01T00:00:00.000")},
+		{FunctionArn: awssdk.String("func_3_arn"), EventSourceArn: awssdk.String("arn:aws:sqs:eu-west-1:0123456789:my_queue")},
+	}
 
-	mock := &mockLambda{functionconfigurations: functions}
+	mock := &countingMappingsLambda{mockLambda: &mockLambda{functionconfigurations: functions, eventsourcemappingconfigurations: mappings}}
 
 	service := Lambda{
 		LambdaAPI: mock, region: "eu-west-1",
@@ -903,6 +1344,24 @@ func TestBuildLambdaGraph(t *testing.T) {
 	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	table := graph.InitResource(cloud.Table, "arn:aws:dynamodb:eu-west-1:0123456789:table/my_table")
+	if got, want := mustGetAppliedOnId(g, table), []string{"func_1_arn"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("table stream triggers: got %v, want %v", got, want)
+	}
+	if got, want := mock.listMappingsCalls, 1; got != want {
+		t.Fatalf("event source mappings listings: got %d, want %d", got, want)
+	}
+}
+
+type countingMappingsLambda struct {
+	*mockLambda
+	listMappingsCalls int
+}
+
+func (m *countingMappingsLambda) ListEventSourceMappingsPages(input *lambda.ListEventSourceMappingsInput, fn func(p *lambda.ListEventSourceMappingsOutput, lastPage bool) (shouldContinue bool)) error {
+	m.listMappingsCalls++
+	return m.mockLambda.ListEventSourceMappingsPages(input, fn)
 }
 
 func TestBuildMonitoringGraph(t *testing.T) {
@@ -932,11 +1391,17 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		},
 	}
 
+	logGroups := []*cloudwatchlogs.LogGroup{
+		{LogGroupName: awssdk.String("/aws/lambda/my_function"), Arn: awssdk.String("arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/my_function:*"), RetentionInDays: awssdk.Int64(14), StoredBytes: awssdk.Int64(2048), CreationTime: awssdk.Int64(now.UnixNano() / int64(time.Millisecond))},
+		{LogGroupName: awssdk.String("/ecs/cs_1"), Arn: awssdk.String("arn:aws:logs:eu-west-1:123456789012:log-group:/ecs/cs_1:*"), StoredBytes: awssdk.Int64(0)},
+	}
+
 	mock := &mockCloudwatch{metrics: metrics, metricalarms: alarms}
+	mockLogs := &mockCloudwatchlogs{loggroups: logGroups}
 
 	service := Monitoring{
-		CloudWatchAPI: mock, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(awsfetch.NewConfig(mock))),
+		CloudWatchAPI: mock, CloudWatchLogsAPI: mockLogs, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(awsfetch.NewConfig(mock, mockLogs))),
 	}
 
 	g, err := service.FetchResources()
@@ -944,7 +1409,7 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.GetAllResources("metric", "alarm")
+	resources, err := g.GetAllResources("metric", "alarm", "loggroup")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -979,16 +1444,24 @@ func TestBuildMonitoringGraph(t *testing.T) {
 		"alarm_3": resourcetest.Alarm("alarm_3").Prop(p.Arn, "alarm_3").Prop(p.Name, "my_alarm").Prop(p.ActionsEnabled, true).Prop(p.AlarmActions, []string{"action_arn_1", "action_arn_2", "action_arn_3"}).Prop(p.InsufficientDataActions, []string{"action_arn_1", "action_arn_3"}).
 			Prop(p.OKActions, []string{"action_arn_2"}).Prop(p.Description, "my alarm description").Prop(p.Dimensions, []*graph.KeyValue{{KeyName: "first", Value: "dimension"}, {KeyName: "second", Value: "dimension"}}).Prop(p.MetricName, "metric_2").
 			Prop(p.Namespace, "namespace_2").Prop(p.Updated, now).Prop(p.State, "OK").Build(),
+		"/aws/lambda/my_function": resourcetest.LogGroup("/aws/lambda/my_function").Prop(p.Name, "/aws/lambda/my_function").Prop(p.Arn, "arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/my_function:*").
+			Prop(p.Retention, 14).Prop(p.StoredBytes, 2048).Prop(p.Created, now.Truncate(time.Millisecond)).Build(),
+		"/ecs/cs_1": resourcetest.LogGroup("/ecs/cs_1").Prop(p.Name, "/ecs/cs_1").Prop(p.Arn, "arn:aws:logs:eu-west-1:123456789012:log-group:/ecs/cs_1:*").Prop(p.StoredBytes, 0).Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"awls-4ba90752", "awls-4baa0753", "awls-4bb20753", "awls-4bb30754", "alarm_1", "alarm_2", "alarm_3"},
+		"eu-west-1": {"/aws/lambda/my_function", "/ecs/cs_1", "awls-4ba90752", "awls-4baa0753", "awls-4bb20753", "awls-4bb30754", "alarm_1", "alarm_2", "alarm_3"},
 	}
 	expectedAppliedOn := map[string][]string{
 		"alarm_3": {"awls-4bb30754"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	fn := graph.InitResource(cloud.Function, "arn:aws:lambda:eu-west-1:123456789012:function:my_function")
+	if got, want := mustGetAppliedOnId(g, fn), []string{"/aws/lambda/my_function"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
 }
 
 func TestBuildCdnGraph(t *testing.T) {
@@ -1100,6 +1573,18 @@ func TestBuildCdnGraph(t *testing.T) {
 	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	// the web ACL itself lives in the infra graph
+	acls, err := g.ListResourcesDependingOn(resourcetest.Distribution("ds_1").Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(acls), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := acls[0].Id(), "id"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
 }
 
 func TestBuildCloudFormationGraph(t *testing.T) {
@@ -1130,7 +1615,17 @@ func TestBuildCloudFormationGraph(t *testing.T) {
 		},
 	}
 
-	mock := &mockCloudformation{stacks: stacks}
+	stackResources := map[string][]*cloudformation.StackResourceSummary{
+		"id_1": {
+			{ResourceType: awssdk.String("AWS::EC2::Instance"), PhysicalResourceId: awssdk.String("inst_1")},
+			{ResourceType: awssdk.String("AWS::S3::Bucket"), PhysicalResourceId: awssdk.String("bucket_1")},
+			{ResourceType: awssdk.String("AWS::EC2::EIP"), PhysicalResourceId: awssdk.String("1.2.3.4")},
+			{ResourceType: awssdk.String("AWS::EC2::Subnet")},
+		},
+		"id_2": {}, // listing the resources of id_3 fails as if deleted while syncing
+	}
+
+	mock := &mockCloudformation{stacks: stacks, stackresourcesummarys: stackResources}
 
 	service := Cloudformation{
 		CloudFormationAPI: mock, region: "eu-west-1",
@@ -1195,7 +1690,9 @@ func TestBuildCloudFormationGraph(t *testing.T) {
 	expectedChildren := map[string][]string{
 		"eu-west-1": {"id_1", "id_2", "id_3"},
 	}
-	expectedAppliedOn := map[string][]string{}
+	expectedAppliedOn := map[string][]string{
+		"id_1": {"bucket_1", "inst_1"},
+	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
@@ -1223,11 +1720,11 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 
 	infra := Infra{
 		EC2API:   &mockEc2{},
-		ELBV2API: &mockElbv2{},
-		RDSAPI:   &mockRds{}, AutoScalingAPI: &mockAutoscaling{},
-		ECRAPI: &mockEcr{}, ECSAPI: &mockEcs{}, region: "eu-west-1",
+		ELBV2API: &mockElbv2{}, ELBAPI: &mockElb{},
+		RDSAPI: &mockRds{}, ElastiCacheAPI: &mockElasticache{}, AutoScalingAPI: &mockAutoscaling{},
+		ECRAPI: &mockEcr{}, ECSAPI: &mockEcs{}, WAFV2API: &mockWafv2{}, ACMAPI: &mockAcm{}, ElasticBeanstalkAPI: &mockElasticbeanstalk{}, APIGatewayAPI: &mockApigateway{}, ApiGatewayV2API: &mockApigatewayv2{}, SSMAPI: &mockSsm{}, KMSAPI: &mockKms{}, GuardDutyAPI: &mockGuardduty{}, SecretsManagerAPI: &mockSecretsmanager{}, region: "eu-west-1",
 		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
-			&mockEc2{}, &mockElbv2{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{},
+			&mockEc2{}, &mockElbv2{}, &mockElb{}, &mockRds{}, &mockElasticache{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockWafv2{}, awsfetch.CloudFrontWafv2{WAFV2API: &mockWafv2{}}, &mockAcm{}, &mockElasticbeanstalk{}, &mockApigateway{}, &mockApigatewayv2{}, &mockSsm{}, &mockKms{}, &mockGuardduty{}, &mockSecretsmanager{},
 		))),
 	}
 
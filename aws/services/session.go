@@ -17,21 +17,40 @@ limitations under the License.
 package awsservices
 
 import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/logger"
 )
 
+// DefaultRateLimiter bounds the AWS calls in flight across all services and backs off on throttling
+var DefaultRateLimiter = fetch.NewAdaptiveLimiter(2, 40)
+
+// DefaultRequestDedup shares across all services the responses of identical read calls made during a sync
+var DefaultRequestDedup = fetch.NewDedup()
+
 func ResolveRegionAndAmiFromEnv() (region string, ami string) {
 	var sess *session.Session
 	var err error
@@ -62,21 +81,51 @@ func ResolveRegionAndAmiFromEnv() (region string, ami string) {
 	return
 }
 
+// newHTTPTransport returns a transport going through the HTTP(S) proxies given in the
+// environment (HTTPS_PROXY, HTTP_PROXY, NO_PROXY) and trusting the certificates of the
+// PEM encoded CA bundle on top of the system ones
+func newHTTPTransport(caBundle []byte) (*http.Transport, error) {
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if len(caBundle) == 0 {
+		return transport, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caBundle) {
+		return nil, errors.New("no PEM encoded certificate found in CA bundle")
+	}
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return transport, nil
+}
+
 type sessionResolver struct {
 	region, profile                      string
+	caBundle                             []byte
 	profileSetterCallback                func(val string) error
 	httpClient                           *http.Client
 	credentialHTTPClient                 *http.Client
 	logger                               *logger.Logger
+	rateLimiter                          *fetch.AdaptiveLimiter
+	requestDedup                         *fetch.Dedup
 	enableRequestsFullLogging            bool
 	enableNetworkMonitorRequestsHandlers bool
 	enableCredentialResolvers            bool
 }
 
 func newSessionResolver() *sessionResolver {
+	transport, _ := newHTTPTransport(nil)
 	return &sessionResolver{
-		credentialHTTPClient:  &http.Client{Timeout: 1 * time.Second},
-		httpClient:            http.DefaultClient,
+		credentialHTTPClient:  &http.Client{Timeout: 1 * time.Second, Transport: transport},
+		httpClient:            &http.Client{Transport: transport},
 		profileSetterCallback: func(val string) error { return nil },
 		logger:                logger.DiscardLogger,
 	}
@@ -102,13 +151,38 @@ func (s *sessionResolver) withProfileSetter(f func(val string) error) *sessionRe
 	return s
 }
 
+func (s *sessionResolver) withRateLimiter(l *fetch.AdaptiveLimiter) *sessionResolver {
+	s.rateLimiter = l
+	return s
+}
+
+func (s *sessionResolver) withRequestDedup(d *fetch.Dedup) *sessionResolver {
+	s.requestDedup = d
+	return s
+}
+
+// withCABundle makes the sessions trust the certificates of the PEM encoded
+// CA bundle (ex: corporate proxy intercepting TLS)
+func (s *sessionResolver) withCABundle(caBundle []byte) (*sessionResolver, error) {
+	if len(caBundle) == 0 {
+		return s, nil
+	}
+	transport, err := newHTTPTransport(caBundle)
+	if err != nil {
+		return s, err
+	}
+	s.caBundle = caBundle
+	s.httpClient = &http.Client{Transport: transport}
+	return s, nil
+}
+
 func (s *sessionResolver) withLogger(l *logger.Logger) *sessionResolver {
 	s.logger = l
 	return s
 }
 
 func (s *sessionResolver) resolve() (*session.Session, error) {
-	session, err := session.NewSessionWithOptions(session.Options{
+	opts := session.Options{
 		Config: awssdk.Config{
 			Region:                        awssdk.String(s.region),
 			HTTPClient:                    s.credentialHTTPClient,
@@ -117,7 +191,11 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 		SharedConfigState:       session.SharedConfigEnable,
 		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
 		Profile:                 s.profile,
-	})
+	}
+	if len(s.caBundle) > 0 {
+		opts.CustomCABundle = bytes.NewReader(s.caBundle)
+	}
+	session, err := session.NewSessionWithOptions(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +213,14 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 		})
 	}
 
+	if s.requestDedup != nil {
+		addRequestDedupHandlers(&session.Handlers, s.requestDedup)
+	}
+
+	if s.rateLimiter != nil {
+		addRateLimiterHandlers(&session.Handlers, s.rateLimiter)
+	}
+
 	if s.enableCredentialResolvers {
 		session.Config.Credentials = credentials.NewCredentials(
 			&credentials.ChainProvider{
@@ -145,6 +231,16 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 						profile: s.profile,
 						log:     s.logger,
 					},
+					&processProvider{
+						profile:    s.profile,
+						configFile: awsConfigFilepath(),
+					},
+					&ssoProvider{
+						profile:    s.profile,
+						configFile: awsConfigFilepath(),
+						cacheDir:   filepath.Join(awsdriver.AWSCredDir, "sso", "cache"),
+						client:     s.httpClient,
+					},
 					&credentialsPrompterProvider{
 						profile: s.profile,
 						out:     os.Stderr,
@@ -162,3 +258,89 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 
 	return session, nil
 }
+
+// addRateLimiterHandlers takes a slot of the limiter for each attempt sent,
+// including SDK retries, and reports throttling and successes back to it
+func addRateLimiterHandlers(h *request.Handlers, l *fetch.AdaptiveLimiter) {
+	h.Send.PushFront(func(r *request.Request) {
+		l.Acquire()
+	})
+	h.Send.PushBack(func(r *request.Request) {
+		l.Release()
+	})
+	h.Retry.PushFront(func(r *request.Request) {
+		if r.IsErrorThrottle() {
+			l.Throttled()
+		}
+	})
+	h.Complete.PushBack(func(r *request.Request) {
+		if r.Error == nil {
+			l.Succeeded()
+		}
+	})
+}
+
+type dedupResponse struct {
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+// addRequestDedupHandlers replaces the sending of requests so that the identical read calls
+// (Describe, List and Get operations with the same params) made in a scope of the dedup are
+// sent once, the others receiving a copy of the successful response
+func addRequestDedupHandlers(h *request.Handlers, d *fetch.Dedup) {
+	h.Send.RemoveByName(corehandlers.SendHandler.Name)
+	h.Send.PushBackNamed(request.NamedHandler{Name: "awless.DedupSendHandler", Fn: func(r *request.Request) {
+		key, ok := dedupRequestKey(r)
+		if !ok {
+			corehandlers.SendHandler.Fn(r)
+			return
+		}
+		var sent bool
+		res, ok := d.Do(key, func() (interface{}, bool) {
+			sent = true
+			corehandlers.SendHandler.Fn(r)
+			if r.Error != nil || r.HTTPResponse.StatusCode/100 != 2 {
+				return nil, false
+			}
+			body, err := ioutil.ReadAll(r.HTTPResponse.Body)
+			r.HTTPResponse.Body.Close()
+			r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if err != nil {
+				r.Error = awserr.New("RequestError", "read response body failed", err)
+				return nil, false
+			}
+			return &dedupResponse{statusCode: r.HTTPResponse.StatusCode, status: r.HTTPResponse.Status, header: r.HTTPResponse.Header, body: body}, true
+		})
+		if sent {
+			return
+		}
+		if !ok {
+			corehandlers.SendHandler.Fn(r)
+			return
+		}
+		shared := res.(*dedupResponse)
+		r.HTTPResponse = &http.Response{
+			StatusCode:    shared.statusCode,
+			Status:        shared.status,
+			Header:        shared.header,
+			Body:          ioutil.NopCloser(bytes.NewReader(shared.body)),
+			ContentLength: int64(len(shared.body)),
+			Request:       r.HTTPRequest,
+		}
+	}})
+}
+
+func dedupRequestKey(r *request.Request) (string, bool) {
+	name := r.Operation.Name
+	if !strings.HasPrefix(name, "Describe") && !strings.HasPrefix(name, "List") && !strings.HasPrefix(name, "Get") {
+		return "", false
+	}
+	params, err := json.Marshal(r.Params)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s %s %s %s", r.ClientInfo.ServiceName, r.ClientInfo.Endpoint, name, params), true
+}
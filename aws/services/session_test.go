@@ -0,0 +1,100 @@
+package awsservices
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/wallix/awless/fetch"
+)
+
+func TestSessionResolverCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	t.Run("untrusted without bundle", func(t *testing.T) {
+		resolver := newSessionResolver()
+		if _, err := resolver.httpClient.Get(server.URL); err == nil {
+			t.Fatal("expected certificate error")
+		}
+		if resolver.httpClient.Transport.(*http.Transport).Proxy == nil {
+			t.Fatal("expected proxy from environment")
+		}
+	})
+
+	t.Run("trusted with bundle", func(t *testing.T) {
+		resolver, err := newSessionResolver().withCABundle(bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := resolver.httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resolver.httpClient.Transport.(*http.Transport).Proxy == nil {
+			t.Fatal("expected proxy from environment")
+		}
+	})
+
+	t.Run("invalid bundle", func(t *testing.T) {
+		if _, err := newSessionResolver().withCABundle([]byte("not a certificate")); err == nil || !strings.Contains(err.Error(), "no PEM encoded certificate") {
+			t.Fatalf("got %v, want invalid bundle error", err)
+		}
+	})
+}
+
+func TestRequestDedupHandlers(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte("<DescribeSecurityGroupsResponse><securityGroupInfo/></DescribeSecurityGroupsResponse>"))
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dedup := fetch.NewDedup()
+	addRequestDedupHandlers(&sess.Handlers, dedup)
+	api := ec2.New(sess)
+
+	describe := func() {
+		if _, err := api.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	describe()
+	describe()
+	if got, want := atomic.LoadInt32(&hits), int32(2); got != want {
+		t.Fatalf("outside of scope: got %d hits, want %d", got, want)
+	}
+
+	atomic.StoreInt32(&hits, 0)
+	dedup.Open()
+	describe()
+	describe()
+	if _, err := api.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{GroupIds: []*string{aws.String("sg-1234")}}); err != nil {
+		t.Fatal(err)
+	}
+	if issued, shared := dedup.Close(); issued != 2 || shared != 1 {
+		t.Fatalf("got %d issued, %d shared, want 2, 1", issued, shared)
+	}
+	if got, want := atomic.LoadInt32(&hits), int32(2); got != want {
+		t.Fatalf("in scope: got %d hits, want %d", got, want)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import "strings"
+
+// IsARN returns whether the string is an Amazon Resource Name
+func IsARN(s string) bool {
+	return strings.HasPrefix(s, "arn:")
+}
+
+// ShortARN returns the resource of an ARN without its type
+// (ex: 'web/73e2d6bc24d8a067' for 'arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067')
+func ShortARN(arn string) string {
+	splits := strings.SplitN(arn, ":", 6)
+	if len(splits) < 6 {
+		return arn
+	}
+	resource := splits[5]
+	if i := strings.IndexAny(resource, "/:"); i > 0 && i < len(resource)-1 {
+		return resource[i+1:]
+	}
+	return resource
+}
@@ -0,0 +1,20 @@
+package cloud
+
+import "testing"
+
+func TestShortARN(t *testing.T) {
+	tcases := []struct {
+		arn, exp string
+	}{
+		{arn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067", exp: "web/73e2d6bc24d8a067"},
+		{arn: "arn:aws:ecs:us-east-1:123456789012:cluster/default", exp: "default"},
+		{arn: "arn:aws:lambda:us-east-1:123456789012:function:api", exp: "api"},
+		{arn: "arn:aws:sns:us-east-1:123456789012:alerts", exp: "alerts"},
+		{arn: "clust_1", exp: "clust_1"},
+	}
+	for _, tcase := range tcases {
+		if got, want := ShortARN(tcase.arn), tcase.exp; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
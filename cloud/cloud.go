@@ -45,14 +45,23 @@ const (
 	NatGateway       string = "natgateway"
 	RouteTable       string = "routetable"
 	ElasticIP        string = "elasticip"
+	NetworkInterface string = "networkinterface"
 	Snapshot         string = "snapshot"
+	SpotRequest      string = "spotrequest"
+	ReservedInstance string = "reservedinstance"
+	PlacementGroup   string = "placementgroup"
+	Host             string = "host"
+	VpcEndpoint      string = "vpcendpoint"
 	//loadbalancer
-	LoadBalancer string = "loadbalancer"
-	TargetGroup  string = "targetgroup"
-	Listener     string = "listener"
+	LoadBalancer        string = "loadbalancer"
+	ClassicLoadBalancer string = "classicloadbalancer"
+	TargetGroup         string = "targetgroup"
+	Listener            string = "listener"
 	//database
-	Database      string = "database"
-	DbSubnetGroup string = "dbsubnetgroup"
+	Database         string = "database"
+	DbSubnetGroup    string = "dbsubnetgroup"
+	CacheCluster     string = "cachecluster"
+	CacheSubnetGroup string = "cachesubnetgroup"
 	//access
 	User         string = "user"
 	Role         string = "role"
@@ -79,8 +88,9 @@ const (
 	ScalingGroup        string = "scalinggroup"
 	ScalingPolicy       string = "scalingpolicy"
 	//monitoring
-	Metric string = "metric"
-	Alarm  string = "alarm"
+	Metric   string = "metric"
+	Alarm    string = "alarm"
+	LogGroup string = "loggroup"
 	//cdn
 	Distribution string = "distribution"
 	//cloudformation
@@ -96,6 +106,26 @@ const (
 	//application autoscaling
 	AppScalingTarget string = "appscalingtarget"
 	AppScalingPolicy string = "appscalingpolicy"
+	//firewall
+	WebACL string = "webacl"
+	//nosql
+	Table string = "table"
+	//certificates
+	Certificate string = "certificate"
+	//beanstalk
+	BeanstalkApplication string = "beanstalkapplication"
+	BeanstalkEnvironment string = "beanstalkenvironment"
+	//api gateway
+	RestApi  string = "restapi"
+	ApiStage string = "apistage"
+	//ssm
+	Parameter string = "parameter"
+	//kms
+	KMSKey string = "kmskey"
+	//guardduty
+	Finding string = "finding"
+	//secretsmanager
+	Secret string = "secret"
 )
 
 type Service interface {
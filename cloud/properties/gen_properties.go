@@ -31,18 +31,27 @@ const (
 	AlarmNames                        = "AlarmNames"
 	AlarmActions                      = "AlarmActions"
 	Aliases                           = "Aliases"
+	AlternateNames                    = "AlternateNames"
 	ApproximateMessageCount           = "ApproximateMessageCount"
+	Application                       = "Application"
+	Associated                        = "Associated"
 	Association                       = "Association"
+	Attachment                        = "Attachment"
 	Architecture                      = "Architecture"
 	Arn                               = "Arn"
 	Attachable                        = "Attachable"
 	Attributes                        = "Attributes"
 	AutoUpgrade                       = "AutoUpgrade"
+	AvailableCapacity                 = "AvailableCapacity"
+	AvailableIPs                      = "AvailableIPs"
 	ScalingGroupName                  = "ScalingGroupName"
 	AvailabilityZone                  = "AvailabilityZone"
 	AvailabilityZones                 = "AvailabilityZones"
 	BackupRetentionPeriod             = "BackupRetentionPeriod"
+	BillingMode                       = "BillingMode"
 	Bucket                            = "Bucket"
+	CacheSubnetGroup                  = "CacheSubnetGroup"
+	Capacity                          = "Capacity"
 	CallerReference                   = "CallerReference"
 	Capabilities                      = "Capabilities"
 	Certificate                       = "Certificate"
@@ -73,29 +82,38 @@ const (
 	Created                           = "Created"
 	DBSecurityGroups                  = "DBSecurityGroups"
 	DBSubnetGroup                     = "DBSubnetGroup"
+	DeadLetterQueue                   = "DeadLetterQueue"
 	Default                           = "Default"
+	DefaultAction                     = "DefaultAction"
 	DefaultCooldown                   = "DefaultCooldown"
 	Delay                             = "Delay"
 	Description                       = "Description"
+	Deployment                        = "Deployment"
 	DesiredCapacity                   = "DesiredCapacity"
 	DeploymentName                    = "DeploymentName"
 	Deployments                       = "Deployments"
 	Dimensions                        = "Dimensions"
 	DisableRollback                   = "DisableRollback"
+	DisplayName                       = "DisplayName"
 	DockerVersion                     = "DockerVersion"
 	Enabled                           = "Enabled"
 	Encrypted                         = "Encrypted"
+	Encryption                        = "Encryption"
 	Endpoint                          = "Endpoint"
 	Engine                            = "Engine"
 	EngineVersion                     = "EngineVersion"
 	ExitCode                          = "ExitCode"
+	Expires                           = "Expires"
 	Failover                          = "Failover"
 	Fingerprint                       = "Fingerprint"
+	FixedPrice                        = "FixedPrice"
 	GlobalID                          = "GlobalID"
 	GranteeType                       = "GranteeType"
 	Grants                            = "Grants"
 	Handler                           = "Handler"
 	Hash                              = "Hash"
+	Health                            = "Health"
+	HealthStatus                      = "HealthStatus"
 	HealthCheck                       = "HealthCheck"
 	HealthCheckType                   = "HealthCheckType"
 	HealthCheckGracePeriod            = "HealthCheckGracePeriod"
@@ -108,37 +126,51 @@ const (
 	InboundRules                      = "InboundRules"
 	InlinePolicies                    = "InlinePolicies"
 	Instance                          = "Instance"
+	InstanceCount                     = "InstanceCount"
 	Instances                         = "Instances"
 	InsufficientDataActions           = "InsufficientDataActions"
+	InUse                             = "InUse"
 	IOPS                              = "IOPS"
 	IPType                            = "IPType"
 	IPv6Enabled                       = "IPv6Enabled"
+	Issuer                            = "Issuer"
+	ItemCount                         = "ItemCount"
 	Key                               = "Key"
 	KeyName                           = "KeyName"
 	KeyPair                           = "KeyPair"
+	KeySchema                         = "KeySchema"
+	KMSKey                            = "KMSKey"
 	LatestRestorableTime              = "LatestRestorableTime"
 	Launched                          = "Launched"
 	LaunchConfigurationName           = "LaunchConfigurationName"
 	License                           = "License"
 	Lifecycle                         = "Lifecycle"
+	LifecycleRules                    = "LifecycleRules"
+	Listeners                         = "Listeners"
 	LoadBalancer                      = "LoadBalancer"
 	Location                          = "Location"
+	MACAddress                        = "MACAddress"
 	Main                              = "Main"
 	MaxSize                           = "MaxSize"
+	MaxReceiveCount                   = "MaxReceiveCount"
 	Memory                            = "Memory"
 	Messages                          = "Messages"
 	MetricName                        = "MetricName"
 	MinSize                           = "MinSize"
 	Modified                          = "Modified"
+	ModifiedBy                        = "ModifiedBy"
 	MonitoringInterval                = "MonitoringInterval"
 	MonitoringRole                    = "MonitoringRole"
 	MultiAZ                           = "MultiAZ"
 	Name                              = "Name"
 	Namespace                         = "Namespace"
 	NewInstancesProtected             = "NewInstancesProtected"
+	NetworkInterface                  = "NetworkInterface"
 	NetworkInterfaces                 = "NetworkInterfaces"
+	NodeCount                         = "NodeCount"
 	Notifications                     = "Notifications"
 	OKActions                         = "OKActions"
+	OfferingType                      = "OfferingType"
 	OptionGroups                      = "OptionGroups"
 	OutboundRules                     = "OutboundRules"
 	Owner                             = "Owner"
@@ -151,6 +183,7 @@ const (
 	PathPrefix                        = "PathPrefix"
 	PendingTasksCount                 = "PendingTasksCount"
 	PlacementGroup                    = "PlacementGroup"
+	Platform                          = "Platform"
 	Port                              = "Port"
 	PortRange                         = "PortRange"
 	PreferredBackupDate               = "PreferredBackupDate"
@@ -158,44 +191,62 @@ const (
 	PriceClass                        = "PriceClass"
 	Private                           = "Private"
 	PrivateIP                         = "PrivateIP"
+	PrivateIPs                        = "PrivateIPs"
 	Profile                           = "Profile"
 	Progress                          = "Progress"
 	Protocol                          = "Protocol"
 	Public                            = "Public"
+	PublicAccessBlock                 = "PublicAccessBlock"
 	PublicDNS                         = "PublicDNS"
 	PublicIP                          = "PublicIP"
+	ReadCapacity                      = "ReadCapacity"
 	RecordCount                       = "RecordCount"
 	Records                           = "Records"
 	Region                            = "Region"
+	ReplicationGroup                  = "ReplicationGroup"
+	Requester                         = "Requester"
+	Retention                         = "Retention"
 	RegisteredContainerInstancesCount = "RegisteredContainerInstancesCount"
 	Role                              = "Role"
 	Roles                             = "Roles"
 	RootDevice                        = "RootDevice"
 	RootDeviceType                    = "RootDeviceType"
 	Routes                            = "Routes"
+	RouteTables                       = "RouteTables"
+	RuleCount                         = "RuleCount"
 	Runtime                           = "Runtime"
 	RunningTasksCount                 = "RunningTasksCount"
 	ScalingAdjustment                 = "ScalingAdjustment"
 	Scheme                            = "Scheme"
+	Scope                             = "Scope"
 	SecondaryAvailabilityZone         = "SecondaryAvailabilityZone"
 	SecurityGroups                    = "SecurityGroups"
+	ServiceName                       = "ServiceName"
 	Set                               = "Set"
+	Severity                          = "Severity"
 	Size                              = "Size"
 	SpotInstanceRequestId             = "SpotInstanceRequestId"
 	SpotPrice                         = "SpotPrice"
 	SSLSupportMethod                  = "SSLSupportMethod"
+	Stages                            = "Stages"
 	State                             = "State"
 	StateMessage                      = "StateMessage"
 	Stopped                           = "Stopped"
 	Storage                           = "Storage"
+	StoredBytes                       = "StoredBytes"
 	StorageType                       = "StorageType"
+	Strategy                          = "Strategy"
+	Stream                            = "Stream"
+	StreamEnabled                     = "StreamEnabled"
 	Subnet                            = "Subnet"
 	Subnets                           = "Subnets"
 	Tags                              = "Tags"
 	Timeout                           = "Timeout"
 	Timezone                          = "Timezone"
+	Tier                              = "Tier"
 	TLSVersionRequired                = "TLSVersionRequired"
 	Topic                             = "Topic"
+	TotalIPs                          = "TotalIPs"
 	TrafficPolicyInstance             = "TrafficPolicyInstance"
 	TTL                               = "TTL"
 	Type                              = "Type"
@@ -204,13 +255,18 @@ const (
 	UserData                          = "UserData"
 	Username                          = "Username"
 	URI                               = "URI"
+	UsagePrice                        = "UsagePrice"
 	Value                             = "Value"
 	Version                           = "Version"
+	Versioning                        = "Versioning"
+	Versions                          = "Versions"
 	Virtualization                    = "Virtualization"
+	VisibilityTimeout                 = "VisibilityTimeout"
 	Volume                            = "Volume"
 	Vpc                               = "Vpc"
 	Vpcs                              = "Vpcs"
 	Weight                            = "Weight"
+	WriteCapacity                     = "WriteCapacity"
 	WebACL                            = "WebACL"
 	Zone                              = "Zone"
 )
@@ -33,18 +33,27 @@ const (
 	AlarmNames                        = "cloud:alarmNames"
 	AlarmActions                      = "cloud:alarmActions"
 	Aliases                           = "cloud:aliases"
+	AlternateNames                    = "cloud:alternateNames"
 	ApproximateMessageCount           = "cloud:approximateMessageCount"
+	Application                       = "cloud:application"
+	Associated                        = "cloud:associated"
 	Association                       = "cloud:association"
+	Attachment                        = "cloud:attachment"
 	Architecture                      = "cloud:architecture"
 	Arn                               = "cloud:arn"
 	Attachable                        = "cloud:attachable"
 	Attributes                        = "cloud:attributes"
 	AutoUpgrade                       = "cloud:autoUpgrade"
+	AvailableCapacity                 = "cloud:availableCapacity"
+	AvailableIPs                      = "cloud:availableIPs"
 	ScalingGroupName                  = "cloud:scalingGroupName"
 	AvailabilityZone                  = "cloud:availabilityZone"
 	AvailabilityZones                 = "cloud:availabilityZones"
 	BackupRetentionPeriod             = "cloud:backupRetentionPeriod"
+	BillingMode                       = "cloud:billingMode"
 	Bucket                            = "cloud:bucketName"
+	CacheSubnetGroup                  = "cloud:cacheSubnetGroup"
+	Capacity                          = "cloud:capacity"
 	CallerReference                   = "cloud:callerReference"
 	Capabilities                      = "cloud:capabilities"
 	Certificate                       = "cloud:certificate"
@@ -75,29 +84,38 @@ const (
 	Created                           = "cloud:created"
 	DBSecurityGroups                  = "cloud:dbSecurityGroups"
 	DBSubnetGroup                     = "cloud:dbSubnetGroup"
+	DeadLetterQueue                   = "cloud:deadLetterQueue"
 	Default                           = "cloud:default"
+	DefaultAction                     = "cloud:defaultAction"
 	DefaultCooldown                   = "cloud:defaultCooldown"
 	Delay                             = "cloud:delaySeconds"
 	Description                       = "cloud:description"
+	Deployment                        = "cloud:deployment"
 	DesiredCapacity                   = "cloud:desiredCapacity"
 	DeploymentName                    = "cloud:deploymentName"
 	Deployments                       = "cloud:deployments"
 	Dimensions                        = "cloud:dimensions"
 	DisableRollback                   = "cloud:disableRollback"
+	DisplayName                       = "cloud:displayName"
 	DockerVersion                     = "cloud:dockerVersion"
 	Enabled                           = "cloud:enabled"
 	Encrypted                         = "cloud:encrypted"
+	Encryption                        = "cloud:encryption"
 	Endpoint                          = "cloud:endpoint"
 	Engine                            = "cloud:engine"
 	EngineVersion                     = "cloud:engineVersion"
 	ExitCode                          = "cloud:exitCode"
+	Expires                           = "cloud:expires"
 	Failover                          = "cloud:failover"
 	Fingerprint                       = "cloud:fingerprint"
+	FixedPrice                        = "cloud:fixedPrice"
 	GlobalID                          = "cloud:globalID"
 	GranteeType                       = "cloud:granteeType"
 	Grants                            = "cloud:grants"
 	Handler                           = "cloud:handler"
 	Hash                              = "cloud:hash"
+	Health                            = "cloud:health"
+	HealthStatus                      = "cloud:healthStatus"
 	HealthCheck                       = "cloud:healthCheck"
 	HealthCheckType                   = "cloud:healthCheckType"
 	HealthCheckGracePeriod            = "cloud:healthCheckGracePeriod"
@@ -110,37 +128,51 @@ const (
 	InboundRules                      = "net:inboundRules"
 	InlinePolicies                    = "cloud:inlinePolicies"
 	Instance                          = "cloud:instance"
+	InstanceCount                     = "cloud:instanceCount"
 	Instances                         = "cloud:instances"
 	InsufficientDataActions           = "cloud:insufficientDataActions"
+	InUse                             = "cloud:inUse"
 	IOPS                              = "cloud:iops"
 	IPType                            = "net:ipType"
 	IPv6Enabled                       = "cloud:ipv6Enabled"
+	Issuer                            = "cloud:issuer"
+	ItemCount                         = "cloud:itemCount"
 	Key                               = "cloud:key"
 	KeyName                           = "cloud:keyName"
 	KeyPair                           = "cloud:keyPair"
+	KeySchema                         = "cloud:keySchema"
+	KMSKey                            = "cloud:kmsKey"
 	LatestRestorableTime              = "cloud:latestRestorableTime"
 	Launched                          = "cloud:launched"
 	LaunchConfigurationName           = "cloud:launchConfigurationName"
 	License                           = "cloud:license"
 	Lifecycle                         = "cloud:lifecycle"
+	LifecycleRules                    = "cloud:lifecycleRules"
+	Listeners                         = "cloud:listeners"
 	LoadBalancer                      = "cloud:loadBalancer"
 	Location                          = "cloud:location"
+	MACAddress                        = "cloud:macAddress"
 	Main                              = "cloud:main"
 	MaxSize                           = "cloud:maxSize"
+	MaxReceiveCount                   = "cloud:maxReceiveCount"
 	Memory                            = "cloud:memory"
 	Messages                          = "cloud:messages"
 	MetricName                        = "cloud:metricName"
 	MinSize                           = "cloud:minSize"
 	Modified                          = "cloud:modified"
+	ModifiedBy                        = "cloud:modifiedBy"
 	MonitoringInterval                = "cloud:monitoringInterval"
 	MonitoringRole                    = "cloud:monitoringRole"
 	MultiAZ                           = "cloud:multiAZ"
 	Name                              = "cloud:name"
 	Namespace                         = "cloud:namemespace"
 	NewInstancesProtected             = "cloud:newInstancesProtected"
+	NetworkInterface                  = "cloud:networkInterface"
 	NetworkInterfaces                 = "cloud:networkInterfaces"
+	NodeCount                         = "cloud:nodeCount"
 	Notifications                     = "cloud:notifications"
 	OKActions                         = "cloud:okActions"
+	OfferingType                      = "cloud:offeringType"
 	OptionGroups                      = "cloud:optionGroups"
 	OutboundRules                     = "net:outboundRules"
 	Owner                             = "cloud:owner"
@@ -153,6 +185,7 @@ const (
 	PathPrefix                        = "cloud:pathPrefix"
 	PendingTasksCount                 = "cloud:pendingTasksCount"
 	PlacementGroup                    = "cloud:placementGroup"
+	Platform                          = "cloud:platform"
 	Port                              = "net:port"
 	PortRange                         = "net:portRange"
 	PreferredBackupDate               = "cloud:preferredBackupDate"
@@ -160,44 +193,62 @@ const (
 	PriceClass                        = "cloud:priceClass"
 	Private                           = "cloud:private"
 	PrivateIP                         = "net:privateIP"
+	PrivateIPs                        = "cloud:privateIPs"
 	Profile                           = "cloud:profile"
 	Progress                          = "cloud:progress"
 	Protocol                          = "net:protocol"
 	Public                            = "cloud:public"
+	PublicAccessBlock                 = "cloud:publicAccessBlock"
 	PublicDNS                         = "cloud:publicDNS"
 	PublicIP                          = "net:publicIP"
+	ReadCapacity                      = "cloud:readCapacity"
 	RecordCount                       = "cloud:records"
 	Records                           = "cloud:recordCount"
 	Region                            = "cloud:region"
+	ReplicationGroup                  = "cloud:replicationGroup"
+	Requester                         = "cloud:requester"
+	Retention                         = "cloud:retention"
 	RegisteredContainerInstancesCount = "cloud:registeredContainerInstancesCount"
 	Role                              = "cloud:role"
 	Roles                             = "cloud:roles"
 	RootDevice                        = "cloud:rootDevice"
 	RootDeviceType                    = "cloud:rootDeviceType"
 	Routes                            = "net:routes"
+	RouteTables                       = "cloud:routeTables"
+	RuleCount                         = "cloud:ruleCount"
 	Runtime                           = "cloud:runtime"
 	RunningTasksCount                 = "cloud:runningTasksCount"
 	ScalingAdjustment                 = "cloud:scalingAdjustment"
 	Scheme                            = "net:scheme"
+	Scope                             = "cloud:scope"
 	SecondaryAvailabilityZone         = "cloud:secondaryAvailabilityZone"
 	SecurityGroups                    = "cloud:securityGroups"
+	ServiceName                       = "cloud:serviceName"
 	Set                               = "cloud:set"
+	Severity                          = "cloud:severity"
 	Size                              = "cloud:size"
 	SpotInstanceRequestId             = "cloud:spotInstanceRequestId"
 	SpotPrice                         = "cloud:spotPrice"
 	SSLSupportMethod                  = "cloud:sslSupportMethod"
+	Stages                            = "cloud:stages"
 	State                             = "cloud:state"
 	StateMessage                      = "cloud:stateMessage"
 	Stopped                           = "cloud:stopped"
 	Storage                           = "cloud:storage"
+	StoredBytes                       = "cloud:storedBytes"
 	StorageType                       = "cloud:storageType"
+	Strategy                          = "cloud:strategy"
+	Stream                            = "cloud:stream"
+	StreamEnabled                     = "cloud:streamEnabled"
 	Subnet                            = "cloud:subnet"
 	Subnets                           = "cloud:subnets"
 	Tags                              = "cloud:tags"
 	Timeout                           = "cloud:timezone"
 	Timezone                          = "cloud:timeout"
+	Tier                              = "cloud:tier"
 	TLSVersionRequired                = "cloud:tlsVersionRequired"
 	Topic                             = "cloud:topic"
+	TotalIPs                          = "cloud:totalIPs"
 	TrafficPolicyInstance             = "cloud:trafficPolicyInstance"
 	TTL                               = "cloud:ttl"
 	Type                              = "cloud:type"
@@ -206,13 +257,18 @@ const (
 	UserData                          = "cloud:userData"
 	Username                          = "cloud:username"
 	URI                               = "cloud:uri"
+	UsagePrice                        = "cloud:usagePrice"
 	Value                             = "cloud:value"
 	Version                           = "cloud:version"
+	Versioning                        = "cloud:versioning"
+	Versions                          = "cloud:versions"
 	Virtualization                    = "cloud:virtualization"
+	VisibilityTimeout                 = "cloud:visibilityTimeout"
 	Volume                            = "cloud:volume"
 	Vpc                               = "cloud:vpc"
 	Vpcs                              = "cloud:vpcs"
 	Weight                            = "cloud:weight"
+	WriteCapacity                     = "cloud:writeCapacity"
 	WebACL                            = "cloud:webACL"
 	Zone                              = "cloud:zone"
 )
@@ -231,18 +287,27 @@ var Labels = map[string]string{
 	properties.AlarmNames:                        AlarmNames,
 	properties.AlarmActions:                      AlarmActions,
 	properties.Aliases:                           Aliases,
+	properties.AlternateNames:                    AlternateNames,
 	properties.ApproximateMessageCount:           ApproximateMessageCount,
+	properties.Application:                       Application,
+	properties.Associated:                        Associated,
 	properties.Association:                       Association,
+	properties.Attachment:                        Attachment,
 	properties.Architecture:                      Architecture,
 	properties.Arn:                               Arn,
 	properties.Attachable:                        Attachable,
 	properties.Attributes:                        Attributes,
 	properties.AutoUpgrade:                       AutoUpgrade,
+	properties.AvailableCapacity:                 AvailableCapacity,
+	properties.AvailableIPs:                      AvailableIPs,
 	properties.ScalingGroupName:                  ScalingGroupName,
 	properties.AvailabilityZone:                  AvailabilityZone,
 	properties.AvailabilityZones:                 AvailabilityZones,
 	properties.BackupRetentionPeriod:             BackupRetentionPeriod,
+	properties.BillingMode:                       BillingMode,
 	properties.Bucket:                            Bucket,
+	properties.CacheSubnetGroup:                  CacheSubnetGroup,
+	properties.Capacity:                          Capacity,
 	properties.CallerReference:                   CallerReference,
 	properties.Capabilities:                      Capabilities,
 	properties.Certificate:                       Certificate,
@@ -273,29 +338,38 @@ var Labels = map[string]string{
 	properties.Created:                           Created,
 	properties.DBSecurityGroups:                  DBSecurityGroups,
 	properties.DBSubnetGroup:                     DBSubnetGroup,
+	properties.DeadLetterQueue:                   DeadLetterQueue,
 	properties.Default:                           Default,
+	properties.DefaultAction:                     DefaultAction,
 	properties.DefaultCooldown:                   DefaultCooldown,
 	properties.Delay:                             Delay,
 	properties.Description:                       Description,
+	properties.Deployment:                        Deployment,
 	properties.DesiredCapacity:                   DesiredCapacity,
 	properties.DeploymentName:                    DeploymentName,
 	properties.Deployments:                       Deployments,
 	properties.Dimensions:                        Dimensions,
 	properties.DisableRollback:                   DisableRollback,
+	properties.DisplayName:                       DisplayName,
 	properties.DockerVersion:                     DockerVersion,
 	properties.Enabled:                           Enabled,
 	properties.Encrypted:                         Encrypted,
+	properties.Encryption:                        Encryption,
 	properties.Endpoint:                          Endpoint,
 	properties.Engine:                            Engine,
 	properties.EngineVersion:                     EngineVersion,
 	properties.ExitCode:                          ExitCode,
+	properties.Expires:                           Expires,
 	properties.Failover:                          Failover,
 	properties.Fingerprint:                       Fingerprint,
+	properties.FixedPrice:                        FixedPrice,
 	properties.GlobalID:                          GlobalID,
 	properties.GranteeType:                       GranteeType,
 	properties.Grants:                            Grants,
 	properties.Handler:                           Handler,
 	properties.Hash:                              Hash,
+	properties.Health:                            Health,
+	properties.HealthStatus:                      HealthStatus,
 	properties.HealthCheck:                       HealthCheck,
 	properties.HealthCheckType:                   HealthCheckType,
 	properties.HealthCheckGracePeriod:            HealthCheckGracePeriod,
@@ -308,37 +382,51 @@ var Labels = map[string]string{
 	properties.InboundRules:                      InboundRules,
 	properties.InlinePolicies:                    InlinePolicies,
 	properties.Instance:                          Instance,
+	properties.InstanceCount:                     InstanceCount,
 	properties.Instances:                         Instances,
 	properties.InsufficientDataActions:           InsufficientDataActions,
+	properties.InUse:                             InUse,
 	properties.IOPS:                              IOPS,
 	properties.IPType:                            IPType,
 	properties.IPv6Enabled:                       IPv6Enabled,
+	properties.Issuer:                            Issuer,
+	properties.ItemCount:                         ItemCount,
 	properties.Key:                               Key,
 	properties.KeyName:                           KeyName,
 	properties.KeyPair:                           KeyPair,
+	properties.KeySchema:                         KeySchema,
+	properties.KMSKey:                            KMSKey,
 	properties.LatestRestorableTime:              LatestRestorableTime,
 	properties.Launched:                          Launched,
 	properties.LaunchConfigurationName:           LaunchConfigurationName,
 	properties.License:                           License,
 	properties.Lifecycle:                         Lifecycle,
+	properties.LifecycleRules:                    LifecycleRules,
+	properties.Listeners:                         Listeners,
 	properties.LoadBalancer:                      LoadBalancer,
 	properties.Location:                          Location,
+	properties.MACAddress:                        MACAddress,
 	properties.Main:                              Main,
 	properties.MaxSize:                           MaxSize,
+	properties.MaxReceiveCount:                   MaxReceiveCount,
 	properties.Memory:                            Memory,
 	properties.Messages:                          Messages,
 	properties.MetricName:                        MetricName,
 	properties.MinSize:                           MinSize,
 	properties.Modified:                          Modified,
+	properties.ModifiedBy:                        ModifiedBy,
 	properties.MonitoringInterval:                MonitoringInterval,
 	properties.MonitoringRole:                    MonitoringRole,
 	properties.MultiAZ:                           MultiAZ,
 	properties.Name:                              Name,
 	properties.Namespace:                         Namespace,
 	properties.NewInstancesProtected:             NewInstancesProtected,
+	properties.NetworkInterface:                  NetworkInterface,
 	properties.NetworkInterfaces:                 NetworkInterfaces,
+	properties.NodeCount:                         NodeCount,
 	properties.Notifications:                     Notifications,
 	properties.OKActions:                         OKActions,
+	properties.OfferingType:                      OfferingType,
 	properties.OptionGroups:                      OptionGroups,
 	properties.OutboundRules:                     OutboundRules,
 	properties.Owner:                             Owner,
@@ -351,6 +439,7 @@ var Labels = map[string]string{
 	properties.PathPrefix:                        PathPrefix,
 	properties.PendingTasksCount:                 PendingTasksCount,
 	properties.PlacementGroup:                    PlacementGroup,
+	properties.Platform:                          Platform,
 	properties.Port:                              Port,
 	properties.PortRange:                         PortRange,
 	properties.PreferredBackupDate:               PreferredBackupDate,
@@ -358,44 +447,62 @@ var Labels = map[string]string{
 	properties.PriceClass:                        PriceClass,
 	properties.Private:                           Private,
 	properties.PrivateIP:                         PrivateIP,
+	properties.PrivateIPs:                        PrivateIPs,
 	properties.Profile:                           Profile,
 	properties.Progress:                          Progress,
 	properties.Protocol:                          Protocol,
 	properties.Public:                            Public,
+	properties.PublicAccessBlock:                 PublicAccessBlock,
 	properties.PublicDNS:                         PublicDNS,
 	properties.PublicIP:                          PublicIP,
+	properties.ReadCapacity:                      ReadCapacity,
 	properties.RecordCount:                       RecordCount,
 	properties.Records:                           Records,
 	properties.Region:                            Region,
+	properties.ReplicationGroup:                  ReplicationGroup,
+	properties.Requester:                         Requester,
+	properties.Retention:                         Retention,
 	properties.RegisteredContainerInstancesCount: RegisteredContainerInstancesCount,
 	properties.Role:                              Role,
 	properties.Roles:                             Roles,
 	properties.RootDevice:                        RootDevice,
 	properties.RootDeviceType:                    RootDeviceType,
 	properties.Routes:                            Routes,
+	properties.RouteTables:                       RouteTables,
+	properties.RuleCount:                         RuleCount,
 	properties.Runtime:                           Runtime,
 	properties.RunningTasksCount:                 RunningTasksCount,
 	properties.ScalingAdjustment:                 ScalingAdjustment,
 	properties.Scheme:                            Scheme,
+	properties.Scope:                             Scope,
 	properties.SecondaryAvailabilityZone:         SecondaryAvailabilityZone,
 	properties.SecurityGroups:                    SecurityGroups,
+	properties.ServiceName:                       ServiceName,
 	properties.Set:                               Set,
+	properties.Severity:                          Severity,
 	properties.Size:                              Size,
 	properties.SpotInstanceRequestId:             SpotInstanceRequestId,
 	properties.SpotPrice:                         SpotPrice,
 	properties.SSLSupportMethod:                  SSLSupportMethod,
+	properties.Stages:                            Stages,
 	properties.State:                             State,
 	properties.StateMessage:                      StateMessage,
 	properties.Stopped:                           Stopped,
 	properties.Storage:                           Storage,
+	properties.StoredBytes:                       StoredBytes,
 	properties.StorageType:                       StorageType,
+	properties.Strategy:                          Strategy,
+	properties.Stream:                            Stream,
+	properties.StreamEnabled:                     StreamEnabled,
 	properties.Subnet:                            Subnet,
 	properties.Subnets:                           Subnets,
 	properties.Tags:                              Tags,
 	properties.Timeout:                           Timeout,
 	properties.Timezone:                          Timezone,
+	properties.Tier:                              Tier,
 	properties.TLSVersionRequired:                TLSVersionRequired,
 	properties.Topic:                             Topic,
+	properties.TotalIPs:                          TotalIPs,
 	properties.TrafficPolicyInstance:             TrafficPolicyInstance,
 	properties.TTL:                               TTL,
 	properties.Type:                              Type,
@@ -404,211 +511,272 @@ var Labels = map[string]string{
 	properties.UserData:                          UserData,
 	properties.Username:                          Username,
 	properties.URI:                               URI,
+	properties.UsagePrice:                        UsagePrice,
 	properties.Value:                             Value,
 	properties.Version:                           Version,
+	properties.Versioning:                        Versioning,
+	properties.Versions:                          Versions,
 	properties.Virtualization:                    Virtualization,
+	properties.VisibilityTimeout:                 VisibilityTimeout,
 	properties.Volume:                            Volume,
 	properties.Vpc:                               Vpc,
 	properties.Vpcs:                              Vpcs,
 	properties.Weight:                            Weight,
+	properties.WriteCapacity:                     WriteCapacity,
 	properties.WebACL:                            WebACL,
 	properties.Zone:                              Zone,
 }
 
 var Properties = RDFProperties{
-	Account:                 {ID: Account, RdfType: "rdf:Property", RdfsLabel: "Account", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Actions:                 {ID: Actions, RdfType: "rdf:Property", RdfsLabel: "Actions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	ActionsEnabled:          {ID: ActionsEnabled, RdfType: "rdf:Property", RdfsLabel: "ActionsEnabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	ActiveServicesCount:     {ID: ActiveServicesCount, RdfType: "rdf:Property", RdfsLabel: "ActiveServicesCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	ACMCertificate:          {ID: ACMCertificate, RdfType: "rdf:Property", RdfsLabel: "ACMCertificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	AdjustmentType:          {ID: AdjustmentType, RdfType: "rdf:Property", RdfsLabel: "AdjustmentType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Affinity:                {ID: Affinity, RdfType: "rdf:Property", RdfsLabel: "Affinity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	AgentConnected:          {ID: AgentConnected, RdfType: "rdf:Property", RdfsLabel: "AgentConnected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	AgentState:              {ID: AgentState, RdfType: "rdf:Property", RdfsLabel: "AgentState", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	AgentVersion:            {ID: AgentVersion, RdfType: "rdf:Property", RdfsLabel: "AgentVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	AlarmNames:              {ID: AlarmNames, RdfType: "rdf:Property", RdfsLabel: "AlarmNames", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	AlarmActions:            {ID: AlarmActions, RdfType: "rdf:Property", RdfsLabel: "AlarmActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Aliases:                 {ID: Aliases, RdfType: "rdf:Property", RdfsLabel: "Aliases", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	ApproximateMessageCount: {ID: ApproximateMessageCount, RdfType: "rdf:Property", RdfsLabel: "ApproximateMessageCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Association:             {ID: Association, RdfType: "rdf:Property", RdfsLabel: "Association", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Architecture:            {ID: Architecture, RdfType: "rdf:Property", RdfsLabel: "Architecture", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Arn:                     {ID: Arn, RdfType: "rdf:Property", RdfsLabel: "Arn", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Attachable:              {ID: Attachable, RdfType: "rdf:Property", RdfsLabel: "Attachable", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	Attributes:              {ID: Attributes, RdfType: "rdf:Property", RdfsLabel: "Attributes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	AutoUpgrade:             {ID: AutoUpgrade, RdfType: "rdf:Property", RdfsLabel: "AutoUpgrade", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	ScalingGroupName:        {ID: ScalingGroupName, RdfType: "rdf:Property", RdfsLabel: "ScalingGroupName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	AvailabilityZone:        {ID: AvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	AvailabilityZones:       {ID: AvailabilityZones, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZones", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	BackupRetentionPeriod:   {ID: BackupRetentionPeriod, RdfType: "rdf:Property", RdfsLabel: "BackupRetentionPeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	Bucket:                  {ID: Bucket, RdfType: "rdf:Property", RdfsLabel: "Bucket", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	CallerReference:         {ID: CallerReference, RdfType: "rdf:Property", RdfsLabel: "CallerReference", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Capabilities:            {ID: Capabilities, RdfType: "rdf:Property", RdfsLabel: "Capabilities", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Certificate:             {ID: Certificate, RdfType: "rdf:Property", RdfsLabel: "Certificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CertificateAuthority:    {ID: CertificateAuthority, RdfType: "rdf:Property", RdfsLabel: "CertificateAuthority", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Certificates:            {ID: Certificates, RdfType: "rdf:Property", RdfsLabel: "Certificates", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Charset:                 {ID: Charset, RdfType: "rdf:Property", RdfsLabel: "Charset", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	ChangeSet:               {ID: ChangeSet, RdfType: "rdf:Property", RdfsLabel: "ChangeSet", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CheckHTTPCode:           {ID: CheckHTTPCode, RdfType: "rdf:Property", RdfsLabel: "CheckHTTPCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CheckInterval:           {ID: CheckInterval, RdfType: "rdf:Property", RdfsLabel: "CheckInterval", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	CheckPath:               {ID: CheckPath, RdfType: "rdf:Property", RdfsLabel: "CheckPath", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CheckPort:               {ID: CheckPort, RdfType: "rdf:Property", RdfsLabel: "CheckPort", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CheckProtocol:           {ID: CheckProtocol, RdfType: "rdf:Property", RdfsLabel: "CheckProtocol", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CheckTimeout:            {ID: CheckTimeout, RdfType: "rdf:Property", RdfsLabel: "CheckTimeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	CIDR:                    {ID: CIDR, RdfType: "rdf:Property", RdfsLabel: "CIDR", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CIDRv6:                  {ID: CIDRv6, RdfType: "rdf:Property", RdfsLabel: "CIDRv6", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	CipherSuite:             {ID: CipherSuite, RdfType: "rdf:Property", RdfsLabel: "CipherSuite", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Class:                   {ID: Class, RdfType: "rdf:Property", RdfsLabel: "Class", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Cluster:                 {ID: Cluster, RdfType: "rdf:Property", RdfsLabel: "Cluster", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Comment:                 {ID: Comment, RdfType: "rdf:Property", RdfsLabel: "Comment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	ContainerInstance:       {ID: ContainerInstance, RdfType: "rdf:Property", RdfsLabel: "ContainerInstance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Continent:               {ID: Continent, RdfType: "rdf:Property", RdfsLabel: "Continent", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Config:                  {ID: Config, RdfType: "rdf:Property", RdfsLabel: "Config", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Cooldown:                {ID: Cooldown, RdfType: "rdf:Property", RdfsLabel: "Cooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	CopyTagsToSnapshot:      {ID: CopyTagsToSnapshot, RdfType: "rdf:Property", RdfsLabel: "CopyTagsToSnapshot", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	ContainersImages:        {ID: ContainersImages, RdfType: "rdf:Property", RdfsLabel: "ContainersImages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	ContainerTask:           {ID: ContainerTask, RdfType: "rdf:Property", RdfsLabel: "ContainerTask", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Country:                 {ID: Country, RdfType: "rdf:Property", RdfsLabel: "Country", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Created:                 {ID: Created, RdfType: "rdf:Property", RdfsLabel: "Created", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	DBSecurityGroups:        {ID: DBSecurityGroups, RdfType: "rdf:Property", RdfsLabel: "DBSecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	DBSubnetGroup:           {ID: DBSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "DBSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Default:                 {ID: Default, RdfType: "rdf:Property", RdfsLabel: "Default", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	DefaultCooldown:         {ID: DefaultCooldown, RdfType: "rdf:Property", RdfsLabel: "DefaultCooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Delay:                   {ID: Delay, RdfType: "rdf:Property", RdfsLabel: "Delay", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Description:             {ID: Description, RdfType: "rdf:Property", RdfsLabel: "Description", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	DesiredCapacity:         {ID: DesiredCapacity, RdfType: "rdf:Property", RdfsLabel: "DesiredCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	DeploymentName:          {ID: DeploymentName, RdfType: "rdf:Property", RdfsLabel: "DeploymentName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Deployments:             {ID: Deployments, RdfType: "rdf:Property", RdfsLabel: "Deployments", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	Dimensions:              {ID: Dimensions, RdfType: "rdf:Property", RdfsLabel: "Dimensions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	DisableRollback:         {ID: DisableRollback, RdfType: "rdf:Property", RdfsLabel: "DisableRollback", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	DockerVersion:           {ID: DockerVersion, RdfType: "rdf:Property", RdfsLabel: "DockerVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Enabled:                 {ID: Enabled, RdfType: "rdf:Property", RdfsLabel: "Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	Encrypted:               {ID: Encrypted, RdfType: "rdf:Property", RdfsLabel: "Encrypted", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	Endpoint:                {ID: Endpoint, RdfType: "rdf:Property", RdfsLabel: "Endpoint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Engine:                  {ID: Engine, RdfType: "rdf:Property", RdfsLabel: "Engine", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	EngineVersion:           {ID: EngineVersion, RdfType: "rdf:Property", RdfsLabel: "EngineVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	ExitCode:                {ID: ExitCode, RdfType: "rdf:Property", RdfsLabel: "ExitCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Failover:                {ID: Failover, RdfType: "rdf:Property", RdfsLabel: "Failover", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Fingerprint:             {ID: Fingerprint, RdfType: "rdf:Property", RdfsLabel: "Fingerprint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	GlobalID:                {ID: GlobalID, RdfType: "rdf:Property", RdfsLabel: "GlobalID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	GranteeType:             {ID: GranteeType, RdfType: "rdf:Property", RdfsLabel: "GranteeType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Grants:                  {ID: Grants, RdfType: "rdf:Property", RdfsLabel: "Grants", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:Grant"},
-	Handler:                 {ID: Handler, RdfType: "rdf:Property", RdfsLabel: "Handler", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Hash:                    {ID: Hash, RdfType: "rdf:Property", RdfsLabel: "Hash", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	HealthCheck:             {ID: HealthCheck, RdfType: "rdf:Property", RdfsLabel: "HealthCheck", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	HealthCheckType:         {ID: HealthCheckType, RdfType: "rdf:Property", RdfsLabel: "HealthCheckType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	HealthCheckGracePeriod:  {ID: HealthCheckGracePeriod, RdfType: "rdf:Property", RdfsLabel: "HealthCheckGracePeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	HealthyThresholdCount:   {ID: HealthyThresholdCount, RdfType: "rdf:Property", RdfsLabel: "HealthyThresholdCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	HTTPVersion:             {ID: HTTPVersion, RdfType: "rdf:Property", RdfsLabel: "HTTPVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Host:                    {ID: Host, RdfType: "rdf:Property", RdfsLabel: "Host", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Hypervisor:              {ID: Hypervisor, RdfType: "rdf:Property", RdfsLabel: "Hypervisor", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	ID:                      {ID: ID, RdfType: "rdf:Property", RdfsLabel: "ID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Image:                   {ID: Image, RdfType: "rdf:Property", RdfsLabel: "Image", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	InboundRules:            {ID: InboundRules, RdfType: "rdf:Property", RdfsLabel: "InboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
-	InlinePolicies:          {ID: InlinePolicies, RdfType: "rdf:Property", RdfsLabel: "InlinePolicies", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	Instance:                {ID: Instance, RdfType: "rdf:Property", RdfsLabel: "Instance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Instances:               {ID: Instances, RdfType: "rdf:Property", RdfsLabel: "Instances", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	InsufficientDataActions: {ID: InsufficientDataActions, RdfType: "rdf:Property", RdfsLabel: "InsufficientDataActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	IOPS:                     {ID: IOPS, RdfType: "rdf:Property", RdfsLabel: "IOPS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	IPType:                   {ID: IPType, RdfType: "rdf:Property", RdfsLabel: "IPType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	IPv6Enabled:              {ID: IPv6Enabled, RdfType: "rdf:Property", RdfsLabel: "IPv6Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	Key:                      {ID: Key, RdfType: "rdf:Property", RdfsLabel: "Key", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	KeyName:                  {ID: KeyName, RdfType: "rdf:Property", RdfsLabel: "KeyName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	KeyPair:                  {ID: KeyPair, RdfType: "rdf:Property", RdfsLabel: "KeyPair", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	LatestRestorableTime:     {ID: LatestRestorableTime, RdfType: "rdf:Property", RdfsLabel: "LatestRestorableTime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	Launched:                 {ID: Launched, RdfType: "rdf:Property", RdfsLabel: "Launched", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	LaunchConfigurationName:  {ID: LaunchConfigurationName, RdfType: "rdf:Property", RdfsLabel: "LaunchConfigurationName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	License:                  {ID: License, RdfType: "rdf:Property", RdfsLabel: "License", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Lifecycle:                {ID: Lifecycle, RdfType: "rdf:Property", RdfsLabel: "Lifecycle", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	LoadBalancer:             {ID: LoadBalancer, RdfType: "rdf:Property", RdfsLabel: "LoadBalancer", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Location:                 {ID: Location, RdfType: "rdf:Property", RdfsLabel: "Location", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Main:                     {ID: Main, RdfType: "rdf:Property", RdfsLabel: "Main", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	MaxSize:                  {ID: MaxSize, RdfType: "rdf:Property", RdfsLabel: "MaxSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Memory:                   {ID: Memory, RdfType: "rdf:Property", RdfsLabel: "Memory", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Messages:                 {ID: Messages, RdfType: "rdf:Property", RdfsLabel: "Messages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	MetricName:               {ID: MetricName, RdfType: "rdf:Property", RdfsLabel: "MetricName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	MinSize:                  {ID: MinSize, RdfType: "rdf:Property", RdfsLabel: "MinSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Modified:                 {ID: Modified, RdfType: "rdf:Property", RdfsLabel: "Modified", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	MonitoringInterval:       {ID: MonitoringInterval, RdfType: "rdf:Property", RdfsLabel: "MonitoringInterval", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	MonitoringRole:           {ID: MonitoringRole, RdfType: "rdf:Property", RdfsLabel: "MonitoringRole", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	MultiAZ:                  {ID: MultiAZ, RdfType: "rdf:Property", RdfsLabel: "MultiAZ", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Name:                     {ID: Name, RdfType: "rdf:Property", RdfsLabel: "Name", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Namespace:                {ID: Namespace, RdfType: "rdf:Property", RdfsLabel: "Namespace", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	NewInstancesProtected:    {ID: NewInstancesProtected, RdfType: "rdf:Property", RdfsLabel: "NewInstancesProtected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	NetworkInterfaces:        {ID: NetworkInterfaces, RdfType: "rdf:Property", RdfsLabel: "NetworkInterfaces", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Notifications:            {ID: Notifications, RdfType: "rdf:Property", RdfsLabel: "Notifications", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	OKActions:                {ID: OKActions, RdfType: "rdf:Property", RdfsLabel: "OKActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	OptionGroups:             {ID: OptionGroups, RdfType: "rdf:Property", RdfsLabel: "OptionGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	OutboundRules:            {ID: OutboundRules, RdfType: "rdf:Property", RdfsLabel: "OutboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
-	Owner:                    {ID: Owner, RdfType: "rdf:Property", RdfsLabel: "Owner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Origins:                  {ID: Origins, RdfType: "rdf:Property", RdfsLabel: "Origins", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:DistributionOrigin"},
-	Outputs:                  {ID: Outputs, RdfType: "rdf:Property", RdfsLabel: "Outputs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	Parameters:               {ID: Parameters, RdfType: "rdf:Property", RdfsLabel: "Parameters", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
-	ParameterGroups:          {ID: ParameterGroups, RdfType: "rdf:Property", RdfsLabel: "ParameterGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	PasswordLastUsed:         {ID: PasswordLastUsed, RdfType: "rdf:Property", RdfsLabel: "PasswordLastUsed", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	Path:                     {ID: Path, RdfType: "rdf:Property", RdfsLabel: "Path", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PathPrefix:               {ID: PathPrefix, RdfType: "rdf:Property", RdfsLabel: "PathPrefix", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PendingTasksCount:        {ID: PendingTasksCount, RdfType: "rdf:Property", RdfsLabel: "PendingTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	PlacementGroup:           {ID: PlacementGroup, RdfType: "rdf:Property", RdfsLabel: "PlacementGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Port:                     {ID: Port, RdfType: "rdf:Property", RdfsLabel: "Port", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	PortRange:                {ID: PortRange, RdfType: "rdfs:subPropertyOf", RdfsLabel: "PortRange", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PreferredBackupDate:      {ID: PreferredBackupDate, RdfType: "rdf:Property", RdfsLabel: "PreferredBackupDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PreferredMaintenanceDate: {ID: PreferredMaintenanceDate, RdfType: "rdf:Property", RdfsLabel: "PreferredMaintenanceDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PriceClass:               {ID: PriceClass, RdfType: "rdf:Property", RdfsLabel: "PriceClass", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Private:                  {ID: Private, RdfType: "rdf:Property", RdfsLabel: "Private", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PrivateIP:                {ID: PrivateIP, RdfType: "rdf:Property", RdfsLabel: "PrivateIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Profile:                  {ID: Profile, RdfType: "rdf:Property", RdfsLabel: "Profile", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Progress:                 {ID: Progress, RdfType: "rdf:Property", RdfsLabel: "Progress", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Protocol:                 {ID: Protocol, RdfType: "rdf:Property", RdfsLabel: "Protocol", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Public:                   {ID: Public, RdfType: "rdf:Property", RdfsLabel: "Public", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
-	PublicDNS:                {ID: PublicDNS, RdfType: "rdf:Property", RdfsLabel: "PublicDNS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	PublicIP:                 {ID: PublicIP, RdfType: "rdf:Property", RdfsLabel: "PublicIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	RecordCount:              {ID: RecordCount, RdfType: "rdf:Property", RdfsLabel: "RecordCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Records:                  {ID: Records, RdfType: "rdf:Property", RdfsLabel: "Records", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Region:                   {ID: Region, RdfType: "rdf:Property", RdfsLabel: "Region", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Account:                           {ID: Account, RdfType: "rdf:Property", RdfsLabel: "Account", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Actions:                           {ID: Actions, RdfType: "rdf:Property", RdfsLabel: "Actions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	ActionsEnabled:                    {ID: ActionsEnabled, RdfType: "rdf:Property", RdfsLabel: "ActionsEnabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	ActiveServicesCount:               {ID: ActiveServicesCount, RdfType: "rdf:Property", RdfsLabel: "ActiveServicesCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	ACMCertificate:                    {ID: ACMCertificate, RdfType: "rdf:Property", RdfsLabel: "ACMCertificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AdjustmentType:                    {ID: AdjustmentType, RdfType: "rdf:Property", RdfsLabel: "AdjustmentType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Affinity:                          {ID: Affinity, RdfType: "rdf:Property", RdfsLabel: "Affinity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AgentConnected:                    {ID: AgentConnected, RdfType: "rdf:Property", RdfsLabel: "AgentConnected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	AgentState:                        {ID: AgentState, RdfType: "rdf:Property", RdfsLabel: "AgentState", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AgentVersion:                      {ID: AgentVersion, RdfType: "rdf:Property", RdfsLabel: "AgentVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AlarmNames:                        {ID: AlarmNames, RdfType: "rdf:Property", RdfsLabel: "AlarmNames", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	AlarmActions:                      {ID: AlarmActions, RdfType: "rdf:Property", RdfsLabel: "AlarmActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Aliases:                           {ID: Aliases, RdfType: "rdf:Property", RdfsLabel: "Aliases", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	AlternateNames:                    {ID: AlternateNames, RdfType: "rdf:Property", RdfsLabel: "AlternateNames", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	ApproximateMessageCount:           {ID: ApproximateMessageCount, RdfType: "rdf:Property", RdfsLabel: "ApproximateMessageCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Application:                       {ID: Application, RdfType: "rdf:Property", RdfsLabel: "Application", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Associated:                        {ID: Associated, RdfType: "rdf:Property", RdfsLabel: "Associated", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Association:                       {ID: Association, RdfType: "rdf:Property", RdfsLabel: "Association", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Attachment:                        {ID: Attachment, RdfType: "rdf:Property", RdfsLabel: "Attachment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Architecture:                      {ID: Architecture, RdfType: "rdf:Property", RdfsLabel: "Architecture", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Arn:                               {ID: Arn, RdfType: "rdf:Property", RdfsLabel: "Arn", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Attachable:                        {ID: Attachable, RdfType: "rdf:Property", RdfsLabel: "Attachable", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Attributes:                        {ID: Attributes, RdfType: "rdf:Property", RdfsLabel: "Attributes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	AutoUpgrade:                       {ID: AutoUpgrade, RdfType: "rdf:Property", RdfsLabel: "AutoUpgrade", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	AvailableCapacity:                 {ID: AvailableCapacity, RdfType: "rdf:Property", RdfsLabel: "AvailableCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	AvailableIPs:                      {ID: AvailableIPs, RdfType: "rdf:Property", RdfsLabel: "AvailableIPs", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	ScalingGroupName:                  {ID: ScalingGroupName, RdfType: "rdf:Property", RdfsLabel: "ScalingGroupName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AvailabilityZone:                  {ID: AvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	AvailabilityZones:                 {ID: AvailabilityZones, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZones", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	BackupRetentionPeriod:             {ID: BackupRetentionPeriod, RdfType: "rdf:Property", RdfsLabel: "BackupRetentionPeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	BillingMode:                       {ID: BillingMode, RdfType: "rdf:Property", RdfsLabel: "BillingMode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Bucket:                            {ID: Bucket, RdfType: "rdf:Property", RdfsLabel: "Bucket", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	CacheSubnetGroup:                  {ID: CacheSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "CacheSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Capacity:                          {ID: Capacity, RdfType: "rdf:Property", RdfsLabel: "Capacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	CallerReference:                   {ID: CallerReference, RdfType: "rdf:Property", RdfsLabel: "CallerReference", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Capabilities:                      {ID: Capabilities, RdfType: "rdf:Property", RdfsLabel: "Capabilities", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Certificate:                       {ID: Certificate, RdfType: "rdf:Property", RdfsLabel: "Certificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CertificateAuthority:              {ID: CertificateAuthority, RdfType: "rdf:Property", RdfsLabel: "CertificateAuthority", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Certificates:                      {ID: Certificates, RdfType: "rdf:Property", RdfsLabel: "Certificates", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Charset:                           {ID: Charset, RdfType: "rdf:Property", RdfsLabel: "Charset", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ChangeSet:                         {ID: ChangeSet, RdfType: "rdf:Property", RdfsLabel: "ChangeSet", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CheckHTTPCode:                     {ID: CheckHTTPCode, RdfType: "rdf:Property", RdfsLabel: "CheckHTTPCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CheckInterval:                     {ID: CheckInterval, RdfType: "rdf:Property", RdfsLabel: "CheckInterval", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	CheckPath:                         {ID: CheckPath, RdfType: "rdf:Property", RdfsLabel: "CheckPath", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CheckPort:                         {ID: CheckPort, RdfType: "rdf:Property", RdfsLabel: "CheckPort", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CheckProtocol:                     {ID: CheckProtocol, RdfType: "rdf:Property", RdfsLabel: "CheckProtocol", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CheckTimeout:                      {ID: CheckTimeout, RdfType: "rdf:Property", RdfsLabel: "CheckTimeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	CIDR:                              {ID: CIDR, RdfType: "rdf:Property", RdfsLabel: "CIDR", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CIDRv6:                            {ID: CIDRv6, RdfType: "rdf:Property", RdfsLabel: "CIDRv6", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CipherSuite:                       {ID: CipherSuite, RdfType: "rdf:Property", RdfsLabel: "CipherSuite", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Class:                             {ID: Class, RdfType: "rdf:Property", RdfsLabel: "Class", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Cluster:                           {ID: Cluster, RdfType: "rdf:Property", RdfsLabel: "Cluster", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Comment:                           {ID: Comment, RdfType: "rdf:Property", RdfsLabel: "Comment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ContainerInstance:                 {ID: ContainerInstance, RdfType: "rdf:Property", RdfsLabel: "ContainerInstance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Continent:                         {ID: Continent, RdfType: "rdf:Property", RdfsLabel: "Continent", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Config:                            {ID: Config, RdfType: "rdf:Property", RdfsLabel: "Config", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Cooldown:                          {ID: Cooldown, RdfType: "rdf:Property", RdfsLabel: "Cooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	CopyTagsToSnapshot:                {ID: CopyTagsToSnapshot, RdfType: "rdf:Property", RdfsLabel: "CopyTagsToSnapshot", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ContainersImages:                  {ID: ContainersImages, RdfType: "rdf:Property", RdfsLabel: "ContainersImages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	ContainerTask:                     {ID: ContainerTask, RdfType: "rdf:Property", RdfsLabel: "ContainerTask", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Country:                           {ID: Country, RdfType: "rdf:Property", RdfsLabel: "Country", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Created:                           {ID: Created, RdfType: "rdf:Property", RdfsLabel: "Created", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	DBSecurityGroups:                  {ID: DBSecurityGroups, RdfType: "rdf:Property", RdfsLabel: "DBSecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	DBSubnetGroup:                     {ID: DBSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "DBSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	DeadLetterQueue:                   {ID: DeadLetterQueue, RdfType: "rdf:Property", RdfsLabel: "DeadLetterQueue", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Default:                           {ID: Default, RdfType: "rdf:Property", RdfsLabel: "Default", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	DefaultAction:                     {ID: DefaultAction, RdfType: "rdf:Property", RdfsLabel: "DefaultAction", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	DefaultCooldown:                   {ID: DefaultCooldown, RdfType: "rdf:Property", RdfsLabel: "DefaultCooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Delay:                             {ID: Delay, RdfType: "rdf:Property", RdfsLabel: "Delay", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Description:                       {ID: Description, RdfType: "rdf:Property", RdfsLabel: "Description", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Deployment:                        {ID: Deployment, RdfType: "rdf:Property", RdfsLabel: "Deployment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	DesiredCapacity:                   {ID: DesiredCapacity, RdfType: "rdf:Property", RdfsLabel: "DesiredCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	DeploymentName:                    {ID: DeploymentName, RdfType: "rdf:Property", RdfsLabel: "DeploymentName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Deployments:                       {ID: Deployments, RdfType: "rdf:Property", RdfsLabel: "Deployments", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	Dimensions:                        {ID: Dimensions, RdfType: "rdf:Property", RdfsLabel: "Dimensions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	DisableRollback:                   {ID: DisableRollback, RdfType: "rdf:Property", RdfsLabel: "DisableRollback", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	DisplayName:                       {ID: DisplayName, RdfType: "rdf:Property", RdfsLabel: "DisplayName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	DockerVersion:                     {ID: DockerVersion, RdfType: "rdf:Property", RdfsLabel: "DockerVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Enabled:                           {ID: Enabled, RdfType: "rdf:Property", RdfsLabel: "Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Encrypted:                         {ID: Encrypted, RdfType: "rdf:Property", RdfsLabel: "Encrypted", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Encryption:                        {ID: Encryption, RdfType: "rdf:Property", RdfsLabel: "Encryption", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Endpoint:                          {ID: Endpoint, RdfType: "rdf:Property", RdfsLabel: "Endpoint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Engine:                            {ID: Engine, RdfType: "rdf:Property", RdfsLabel: "Engine", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	EngineVersion:                     {ID: EngineVersion, RdfType: "rdf:Property", RdfsLabel: "EngineVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ExitCode:                          {ID: ExitCode, RdfType: "rdf:Property", RdfsLabel: "ExitCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Expires:                           {ID: Expires, RdfType: "rdf:Property", RdfsLabel: "Expires", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	Failover:                          {ID: Failover, RdfType: "rdf:Property", RdfsLabel: "Failover", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Fingerprint:                       {ID: Fingerprint, RdfType: "rdf:Property", RdfsLabel: "Fingerprint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	FixedPrice:                        {ID: FixedPrice, RdfType: "rdf:Property", RdfsLabel: "FixedPrice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	GlobalID:                          {ID: GlobalID, RdfType: "rdf:Property", RdfsLabel: "GlobalID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	GranteeType:                       {ID: GranteeType, RdfType: "rdf:Property", RdfsLabel: "GranteeType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Grants:                            {ID: Grants, RdfType: "rdf:Property", RdfsLabel: "Grants", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:Grant"},
+	Handler:                           {ID: Handler, RdfType: "rdf:Property", RdfsLabel: "Handler", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Hash:                              {ID: Hash, RdfType: "rdf:Property", RdfsLabel: "Hash", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Health:                            {ID: Health, RdfType: "rdf:Property", RdfsLabel: "Health", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HealthStatus:                      {ID: HealthStatus, RdfType: "rdf:Property", RdfsLabel: "HealthStatus", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HealthCheck:                       {ID: HealthCheck, RdfType: "rdf:Property", RdfsLabel: "HealthCheck", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HealthCheckType:                   {ID: HealthCheckType, RdfType: "rdf:Property", RdfsLabel: "HealthCheckType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HealthCheckGracePeriod:            {ID: HealthCheckGracePeriod, RdfType: "rdf:Property", RdfsLabel: "HealthCheckGracePeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	HealthyThresholdCount:             {ID: HealthyThresholdCount, RdfType: "rdf:Property", RdfsLabel: "HealthyThresholdCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	HTTPVersion:                       {ID: HTTPVersion, RdfType: "rdf:Property", RdfsLabel: "HTTPVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Host:                              {ID: Host, RdfType: "rdf:Property", RdfsLabel: "Host", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Hypervisor:                        {ID: Hypervisor, RdfType: "rdf:Property", RdfsLabel: "Hypervisor", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ID:                                {ID: ID, RdfType: "rdf:Property", RdfsLabel: "ID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Image:                             {ID: Image, RdfType: "rdf:Property", RdfsLabel: "Image", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	InboundRules:                      {ID: InboundRules, RdfType: "rdf:Property", RdfsLabel: "InboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
+	InlinePolicies:                    {ID: InlinePolicies, RdfType: "rdf:Property", RdfsLabel: "InlinePolicies", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	Instance:                          {ID: Instance, RdfType: "rdf:Property", RdfsLabel: "Instance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	InstanceCount:                     {ID: InstanceCount, RdfType: "rdf:Property", RdfsLabel: "InstanceCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Instances:                         {ID: Instances, RdfType: "rdf:Property", RdfsLabel: "Instances", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	InsufficientDataActions:           {ID: InsufficientDataActions, RdfType: "rdf:Property", RdfsLabel: "InsufficientDataActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	InUse:                             {ID: InUse, RdfType: "rdf:Property", RdfsLabel: "InUse", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	IOPS:                              {ID: IOPS, RdfType: "rdf:Property", RdfsLabel: "IOPS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	IPType:                            {ID: IPType, RdfType: "rdf:Property", RdfsLabel: "IPType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	IPv6Enabled:                       {ID: IPv6Enabled, RdfType: "rdf:Property", RdfsLabel: "IPv6Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Issuer:                            {ID: Issuer, RdfType: "rdf:Property", RdfsLabel: "Issuer", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ItemCount:                         {ID: ItemCount, RdfType: "rdf:Property", RdfsLabel: "ItemCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Key:                               {ID: Key, RdfType: "rdf:Property", RdfsLabel: "Key", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	KeyName:                           {ID: KeyName, RdfType: "rdf:Property", RdfsLabel: "KeyName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	KeyPair:                           {ID: KeyPair, RdfType: "rdf:Property", RdfsLabel: "KeyPair", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	KeySchema:                         {ID: KeySchema, RdfType: "rdf:Property", RdfsLabel: "KeySchema", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	KMSKey:                            {ID: KMSKey, RdfType: "rdf:Property", RdfsLabel: "KMSKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	LatestRestorableTime:              {ID: LatestRestorableTime, RdfType: "rdf:Property", RdfsLabel: "LatestRestorableTime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	Launched:                          {ID: Launched, RdfType: "rdf:Property", RdfsLabel: "Launched", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	LaunchConfigurationName:           {ID: LaunchConfigurationName, RdfType: "rdf:Property", RdfsLabel: "LaunchConfigurationName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	License:                           {ID: License, RdfType: "rdf:Property", RdfsLabel: "License", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Lifecycle:                         {ID: Lifecycle, RdfType: "rdf:Property", RdfsLabel: "Lifecycle", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	LifecycleRules:                    {ID: LifecycleRules, RdfType: "rdf:Property", RdfsLabel: "LifecycleRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Listeners:                         {ID: Listeners, RdfType: "rdf:Property", RdfsLabel: "Listeners", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	LoadBalancer:                      {ID: LoadBalancer, RdfType: "rdf:Property", RdfsLabel: "LoadBalancer", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Location:                          {ID: Location, RdfType: "rdf:Property", RdfsLabel: "Location", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	MACAddress:                        {ID: MACAddress, RdfType: "rdf:Property", RdfsLabel: "MACAddress", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Main:                              {ID: Main, RdfType: "rdf:Property", RdfsLabel: "Main", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	MaxSize:                           {ID: MaxSize, RdfType: "rdf:Property", RdfsLabel: "MaxSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	MaxReceiveCount:                   {ID: MaxReceiveCount, RdfType: "rdf:Property", RdfsLabel: "MaxReceiveCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Memory:                            {ID: Memory, RdfType: "rdf:Property", RdfsLabel: "Memory", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Messages:                          {ID: Messages, RdfType: "rdf:Property", RdfsLabel: "Messages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	MetricName:                        {ID: MetricName, RdfType: "rdf:Property", RdfsLabel: "MetricName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	MinSize:                           {ID: MinSize, RdfType: "rdf:Property", RdfsLabel: "MinSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Modified:                          {ID: Modified, RdfType: "rdf:Property", RdfsLabel: "Modified", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	ModifiedBy:                        {ID: ModifiedBy, RdfType: "rdf:Property", RdfsLabel: "ModifiedBy", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	MonitoringInterval:                {ID: MonitoringInterval, RdfType: "rdf:Property", RdfsLabel: "MonitoringInterval", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	MonitoringRole:                    {ID: MonitoringRole, RdfType: "rdf:Property", RdfsLabel: "MonitoringRole", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	MultiAZ:                           {ID: MultiAZ, RdfType: "rdf:Property", RdfsLabel: "MultiAZ", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Name:                              {ID: Name, RdfType: "rdf:Property", RdfsLabel: "Name", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Namespace:                         {ID: Namespace, RdfType: "rdf:Property", RdfsLabel: "Namespace", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	NewInstancesProtected:             {ID: NewInstancesProtected, RdfType: "rdf:Property", RdfsLabel: "NewInstancesProtected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	NetworkInterface:                  {ID: NetworkInterface, RdfType: "rdf:Property", RdfsLabel: "NetworkInterface", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	NetworkInterfaces:                 {ID: NetworkInterfaces, RdfType: "rdf:Property", RdfsLabel: "NetworkInterfaces", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	NodeCount:                         {ID: NodeCount, RdfType: "rdf:Property", RdfsLabel: "NodeCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Notifications:                     {ID: Notifications, RdfType: "rdf:Property", RdfsLabel: "Notifications", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	OKActions:                         {ID: OKActions, RdfType: "rdf:Property", RdfsLabel: "OKActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	OfferingType:                      {ID: OfferingType, RdfType: "rdf:Property", RdfsLabel: "OfferingType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	OptionGroups:                      {ID: OptionGroups, RdfType: "rdf:Property", RdfsLabel: "OptionGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	OutboundRules:                     {ID: OutboundRules, RdfType: "rdf:Property", RdfsLabel: "OutboundRules", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:FirewallRule"},
+	Owner:                             {ID: Owner, RdfType: "rdf:Property", RdfsLabel: "Owner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Origins:                           {ID: Origins, RdfType: "rdf:Property", RdfsLabel: "Origins", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:DistributionOrigin"},
+	Outputs:                           {ID: Outputs, RdfType: "rdf:Property", RdfsLabel: "Outputs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	Parameters:                        {ID: Parameters, RdfType: "rdf:Property", RdfsLabel: "Parameters", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
+	ParameterGroups:                   {ID: ParameterGroups, RdfType: "rdf:Property", RdfsLabel: "ParameterGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	PasswordLastUsed:                  {ID: PasswordLastUsed, RdfType: "rdf:Property", RdfsLabel: "PasswordLastUsed", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	Path:                              {ID: Path, RdfType: "rdf:Property", RdfsLabel: "Path", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PathPrefix:                        {ID: PathPrefix, RdfType: "rdf:Property", RdfsLabel: "PathPrefix", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PendingTasksCount:                 {ID: PendingTasksCount, RdfType: "rdf:Property", RdfsLabel: "PendingTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	PlacementGroup:                    {ID: PlacementGroup, RdfType: "rdf:Property", RdfsLabel: "PlacementGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Platform:                          {ID: Platform, RdfType: "rdf:Property", RdfsLabel: "Platform", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Port:                              {ID: Port, RdfType: "rdf:Property", RdfsLabel: "Port", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	PortRange:                         {ID: PortRange, RdfType: "rdfs:subPropertyOf", RdfsLabel: "PortRange", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PreferredBackupDate:               {ID: PreferredBackupDate, RdfType: "rdf:Property", RdfsLabel: "PreferredBackupDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PreferredMaintenanceDate:          {ID: PreferredMaintenanceDate, RdfType: "rdf:Property", RdfsLabel: "PreferredMaintenanceDate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PriceClass:                        {ID: PriceClass, RdfType: "rdf:Property", RdfsLabel: "PriceClass", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Private:                           {ID: Private, RdfType: "rdf:Property", RdfsLabel: "Private", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PrivateIP:                         {ID: PrivateIP, RdfType: "rdf:Property", RdfsLabel: "PrivateIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PrivateIPs:                        {ID: PrivateIPs, RdfType: "rdf:Property", RdfsLabel: "PrivateIPs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Profile:                           {ID: Profile, RdfType: "rdf:Property", RdfsLabel: "Profile", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Progress:                          {ID: Progress, RdfType: "rdf:Property", RdfsLabel: "Progress", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Protocol:                          {ID: Protocol, RdfType: "rdf:Property", RdfsLabel: "Protocol", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Public:                            {ID: Public, RdfType: "rdf:Property", RdfsLabel: "Public", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	PublicAccessBlock:                 {ID: PublicAccessBlock, RdfType: "rdf:Property", RdfsLabel: "PublicAccessBlock", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PublicDNS:                         {ID: PublicDNS, RdfType: "rdf:Property", RdfsLabel: "PublicDNS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PublicIP:                          {ID: PublicIP, RdfType: "rdf:Property", RdfsLabel: "PublicIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ReadCapacity:                      {ID: ReadCapacity, RdfType: "rdf:Property", RdfsLabel: "ReadCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	RecordCount:                       {ID: RecordCount, RdfType: "rdf:Property", RdfsLabel: "RecordCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Records:                           {ID: Records, RdfType: "rdf:Property", RdfsLabel: "Records", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Region:                            {ID: Region, RdfType: "rdf:Property", RdfsLabel: "Region", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ReplicationGroup:                  {ID: ReplicationGroup, RdfType: "rdf:Property", RdfsLabel: "ReplicationGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Requester:                         {ID: Requester, RdfType: "rdf:Property", RdfsLabel: "Requester", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Retention:                         {ID: Retention, RdfType: "rdf:Property", RdfsLabel: "Retention", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	RegisteredContainerInstancesCount: {ID: RegisteredContainerInstancesCount, RdfType: "rdf:Property", RdfsLabel: "RegisteredContainerInstancesCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Role:              {ID: Role, RdfType: "rdf:Property", RdfsLabel: "Role", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Roles:             {ID: Roles, RdfType: "rdf:Property", RdfsLabel: "Roles", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	RootDevice:        {ID: RootDevice, RdfType: "rdf:Property", RdfsLabel: "RootDevice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	RootDeviceType:    {ID: RootDeviceType, RdfType: "rdf:Property", RdfsLabel: "RootDeviceType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Routes:            {ID: Routes, RdfType: "rdf:Property", RdfsLabel: "Routes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:Route"},
-	Runtime:           {ID: Runtime, RdfType: "rdf:Property", RdfsLabel: "Runtime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	RunningTasksCount: {ID: RunningTasksCount, RdfType: "rdf:Property", RdfsLabel: "RunningTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	ScalingAdjustment: {ID: ScalingAdjustment, RdfType: "rdf:Property", RdfsLabel: "ScalingAdjustment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Scheme:            {ID: Scheme, RdfType: "rdf:Property", RdfsLabel: "Scheme", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	SecondaryAvailabilityZone: {ID: SecondaryAvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "SecondaryAvailabilityZone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	SecurityGroups:            {ID: SecurityGroups, RdfType: "rdf:Property", RdfsLabel: "SecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	Set:                       {ID: Set, RdfType: "rdf:Property", RdfsLabel: "Set", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Size:                      {ID: Size, RdfType: "rdf:Property", RdfsLabel: "Size", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	SpotInstanceRequestId: {ID: SpotInstanceRequestId, RdfType: "rdf:Property", RdfsLabel: "SpotInstanceRequestId", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	SpotPrice:             {ID: SpotPrice, RdfType: "rdf:Property", RdfsLabel: "SpotPrice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	SSLSupportMethod:      {ID: SSLSupportMethod, RdfType: "rdf:Property", RdfsLabel: "SSLSupportMethod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	State:                 {ID: State, RdfType: "rdf:Property", RdfsLabel: "State", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	StateMessage:          {ID: StateMessage, RdfType: "rdf:Property", RdfsLabel: "StateMessage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Stopped:               {ID: Stopped, RdfType: "rdf:Property", RdfsLabel: "Stopped", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
-	Storage:               {ID: Storage, RdfType: "rdf:Property", RdfsLabel: "Storage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	StorageType:           {ID: StorageType, RdfType: "rdf:Property", RdfsLabel: "StorageType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Subnet:                {ID: Subnet, RdfType: "rdf:Property", RdfsLabel: "Subnet", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Subnets:               {ID: Subnets, RdfType: "rdf:Property", RdfsLabel: "Subnets", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	Tags:                  {ID: Tags, RdfType: "rdf:Property", RdfsLabel: "Tags", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
-	Timeout:               {ID: Timeout, RdfType: "rdf:Property", RdfsLabel: "Timeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Timezone:              {ID: Timezone, RdfType: "rdf:Property", RdfsLabel: "Timezone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	TLSVersionRequired:    {ID: TLSVersionRequired, RdfType: "rdf:Property", RdfsLabel: "TLSVersionRequired", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Topic:                 {ID: Topic, RdfType: "rdf:Property", RdfsLabel: "Topic", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	TrafficPolicyInstance: {ID: TrafficPolicyInstance, RdfType: "rdf:Property", RdfsLabel: "TrafficPolicyInstance", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	TTL:  {ID: TTL, RdfType: "rdf:Property", RdfsLabel: "TTL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Type: {ID: Type, RdfType: "rdf:Property", RdfsLabel: "Type", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	UnhealthyThresholdCount: {ID: UnhealthyThresholdCount, RdfType: "rdf:Property", RdfsLabel: "UnhealthyThresholdCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
-	Updated:                 {ID: Updated, RdfType: "rdf:Property", RdfsLabel: "Updated", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	UserData:                {ID: UserData, RdfType: "rdf:Property", RdfsLabel: "UserData", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Username:                {ID: Username, RdfType: "rdf:Property", RdfsLabel: "Username", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	URI:                     {ID: URI, RdfType: "rdf:Property", RdfsLabel: "URI", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Value:                   {ID: Value, RdfType: "rdf:Property", RdfsLabel: "Value", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Version:                 {ID: Version, RdfType: "rdf:Property", RdfsLabel: "Version", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Virtualization:          {ID: Virtualization, RdfType: "rdf:Property", RdfsLabel: "Virtualization", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Volume:                  {ID: Volume, RdfType: "rdf:Property", RdfsLabel: "Volume", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Vpc:                     {ID: Vpc, RdfType: "rdf:Property", RdfsLabel: "Vpc", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
-	Vpcs:                    {ID: Vpcs, RdfType: "rdf:Property", RdfsLabel: "Vpcs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
-	Weight:                  {ID: Weight, RdfType: "rdf:Property", RdfsLabel: "Weight", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	WebACL:                  {ID: WebACL, RdfType: "rdf:Property", RdfsLabel: "WebACL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
-	Zone:                    {ID: Zone, RdfType: "rdf:Property", RdfsLabel: "Zone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Role:                              {ID: Role, RdfType: "rdf:Property", RdfsLabel: "Role", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Roles:                             {ID: Roles, RdfType: "rdf:Property", RdfsLabel: "Roles", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	RootDevice:                        {ID: RootDevice, RdfType: "rdf:Property", RdfsLabel: "RootDevice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	RootDeviceType:                    {ID: RootDeviceType, RdfType: "rdf:Property", RdfsLabel: "RootDeviceType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Routes:                            {ID: Routes, RdfType: "rdf:Property", RdfsLabel: "Routes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:Route"},
+	RouteTables:                       {ID: RouteTables, RdfType: "rdf:Property", RdfsLabel: "RouteTables", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	RuleCount:                         {ID: RuleCount, RdfType: "rdf:Property", RdfsLabel: "RuleCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Runtime:                           {ID: Runtime, RdfType: "rdf:Property", RdfsLabel: "Runtime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	RunningTasksCount:                 {ID: RunningTasksCount, RdfType: "rdf:Property", RdfsLabel: "RunningTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	ScalingAdjustment:                 {ID: ScalingAdjustment, RdfType: "rdf:Property", RdfsLabel: "ScalingAdjustment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Scheme:                            {ID: Scheme, RdfType: "rdf:Property", RdfsLabel: "Scheme", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Scope:                             {ID: Scope, RdfType: "rdf:Property", RdfsLabel: "Scope", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	SecondaryAvailabilityZone:         {ID: SecondaryAvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "SecondaryAvailabilityZone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	SecurityGroups:                    {ID: SecurityGroups, RdfType: "rdf:Property", RdfsLabel: "SecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	ServiceName:                       {ID: ServiceName, RdfType: "rdf:Property", RdfsLabel: "ServiceName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Set:                               {ID: Set, RdfType: "rdf:Property", RdfsLabel: "Set", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Severity:                          {ID: Severity, RdfType: "rdf:Property", RdfsLabel: "Severity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Size:                              {ID: Size, RdfType: "rdf:Property", RdfsLabel: "Size", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	SpotInstanceRequestId:             {ID: SpotInstanceRequestId, RdfType: "rdf:Property", RdfsLabel: "SpotInstanceRequestId", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	SpotPrice:                         {ID: SpotPrice, RdfType: "rdf:Property", RdfsLabel: "SpotPrice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	SSLSupportMethod:                  {ID: SSLSupportMethod, RdfType: "rdf:Property", RdfsLabel: "SSLSupportMethod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Stages:                            {ID: Stages, RdfType: "rdf:Property", RdfsLabel: "Stages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	State:                             {ID: State, RdfType: "rdf:Property", RdfsLabel: "State", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	StateMessage:                      {ID: StateMessage, RdfType: "rdf:Property", RdfsLabel: "StateMessage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Stopped:                           {ID: Stopped, RdfType: "rdf:Property", RdfsLabel: "Stopped", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	Storage:                           {ID: Storage, RdfType: "rdf:Property", RdfsLabel: "Storage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	StoredBytes:                       {ID: StoredBytes, RdfType: "rdf:Property", RdfsLabel: "StoredBytes", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	StorageType:                       {ID: StorageType, RdfType: "rdf:Property", RdfsLabel: "StorageType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Strategy:                          {ID: Strategy, RdfType: "rdf:Property", RdfsLabel: "Strategy", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Stream:                            {ID: Stream, RdfType: "rdf:Property", RdfsLabel: "Stream", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	StreamEnabled:                     {ID: StreamEnabled, RdfType: "rdf:Property", RdfsLabel: "StreamEnabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	Subnet:                            {ID: Subnet, RdfType: "rdf:Property", RdfsLabel: "Subnet", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Subnets:                           {ID: Subnets, RdfType: "rdf:Property", RdfsLabel: "Subnets", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	Tags:                              {ID: Tags, RdfType: "rdf:Property", RdfsLabel: "Tags", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Timeout:                           {ID: Timeout, RdfType: "rdf:Property", RdfsLabel: "Timeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Timezone:                          {ID: Timezone, RdfType: "rdf:Property", RdfsLabel: "Timezone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Tier:                              {ID: Tier, RdfType: "rdf:Property", RdfsLabel: "Tier", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	TLSVersionRequired:                {ID: TLSVersionRequired, RdfType: "rdf:Property", RdfsLabel: "TLSVersionRequired", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Topic:                             {ID: Topic, RdfType: "rdf:Property", RdfsLabel: "Topic", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	TotalIPs:                          {ID: TotalIPs, RdfType: "rdf:Property", RdfsLabel: "TotalIPs", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	TrafficPolicyInstance:             {ID: TrafficPolicyInstance, RdfType: "rdf:Property", RdfsLabel: "TrafficPolicyInstance", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	TTL:                               {ID: TTL, RdfType: "rdf:Property", RdfsLabel: "TTL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Type:                              {ID: Type, RdfType: "rdf:Property", RdfsLabel: "Type", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	UnhealthyThresholdCount:           {ID: UnhealthyThresholdCount, RdfType: "rdf:Property", RdfsLabel: "UnhealthyThresholdCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Updated:                           {ID: Updated, RdfType: "rdf:Property", RdfsLabel: "Updated", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	UserData:                          {ID: UserData, RdfType: "rdf:Property", RdfsLabel: "UserData", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Username:                          {ID: Username, RdfType: "rdf:Property", RdfsLabel: "Username", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	URI:                               {ID: URI, RdfType: "rdf:Property", RdfsLabel: "URI", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	UsagePrice:                        {ID: UsagePrice, RdfType: "rdf:Property", RdfsLabel: "UsagePrice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Value:                             {ID: Value, RdfType: "rdf:Property", RdfsLabel: "Value", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Version:                           {ID: Version, RdfType: "rdf:Property", RdfsLabel: "Version", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Versioning:                        {ID: Versioning, RdfType: "rdf:Property", RdfsLabel: "Versioning", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Versions:                          {ID: Versions, RdfType: "rdf:Property", RdfsLabel: "Versions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	Virtualization:                    {ID: Virtualization, RdfType: "rdf:Property", RdfsLabel: "Virtualization", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	VisibilityTimeout:                 {ID: VisibilityTimeout, RdfType: "rdf:Property", RdfsLabel: "VisibilityTimeout", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Volume:                            {ID: Volume, RdfType: "rdf:Property", RdfsLabel: "Volume", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Vpc:                               {ID: Vpc, RdfType: "rdf:Property", RdfsLabel: "Vpc", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	Vpcs:                              {ID: Vpcs, RdfType: "rdf:Property", RdfsLabel: "Vpcs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	Weight:                            {ID: Weight, RdfType: "rdf:Property", RdfsLabel: "Weight", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	WriteCapacity:                     {ID: WriteCapacity, RdfType: "rdf:Property", RdfsLabel: "WriteCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	WebACL:                            {ID: WebACL, RdfType: "rdf:Property", RdfsLabel: "WebACL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Zone:                              {ID: Zone, RdfType: "rdf:Property", RdfsLabel: "Zone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 }
@@ -14,6 +14,21 @@ const (
 	NetowlNS   = "net-owl"
 )
 
+// Namespace IRIs of the awless ontology used when exporting graphs as RDF (ex: Turtle).
+// Resources are identified by their cloud id under ResourcesIRI
+var NamespaceIRIs = map[string]string{
+	RdfNS:      "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+	RdfsNS:     "http://www.w3.org/2000/01/rdf-schema#",
+	XsdNS:      "http://www.w3.org/2001/XMLSchema#",
+	CloudNS:    "http://awless.io/ontology/cloud#",
+	CloudRelNS: "http://awless.io/ontology/cloud-rel#",
+	CloudOwlNS: "http://awless.io/ontology/cloud-owl#",
+	NetNS:      "http://awless.io/ontology/net#",
+	NetowlNS:   "http://awless.io/ontology/net-owl#",
+}
+
+const ResourcesIRI = "http://awless.io/resources/"
+
 // Existing terms
 var (
 	RdfsLabel       = fmt.Sprintf("%s:label", RdfsNS)
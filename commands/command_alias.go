@@ -0,0 +1,104 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/template"
+)
+
+func isCommandAlias(arg string) bool {
+	return strings.HasPrefix(arg, "@") && len(arg) > 1
+}
+
+// expandCommandAlias builds the template of a one-liner stored in config under 'aliases.<name>'.
+// Params given on the command line override the stored ones; other given keys
+// are returned as fillers for the holes of the stored one-liner
+func expandCommandAlias(name string, args []string, lookupAlias func(string) (string, bool)) (*template.Template, map[string]interface{}, error) {
+	text, ok := lookupAlias(name)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command alias '@%s': set it with `awless config set aliases.%[1]s \"create instance ...\"`", name)
+	}
+
+	tpl, err := template.Parse(text)
+	if err != nil {
+		return nil, nil, fmt.Errorf("command alias '@%s': %s", name, err)
+	}
+	cmds := tpl.CommandNodesIterator()
+	if len(tpl.Statements) != 1 || len(cmds) != 1 {
+		return nil, nil, fmt.Errorf("command alias '@%s': expecting a single command, got '%s'", name, text)
+	}
+	cmd := cmds[0]
+
+	def, ok := awsdriver.AWSLookupDefinitions(fmt.Sprintf("%s%s", cmd.Action, cmd.Entity))
+	if !ok {
+		return nil, nil, fmt.Errorf("command alias '@%s': unsupported command '%s %s'", name, cmd.Action, cmd.Entity)
+	}
+	isParam := make(map[string]bool)
+	for _, k := range append(def.Required(), def.Extra()...) {
+		isParam[k] = true
+	}
+
+	fillers := make(map[string]interface{})
+	if len(args) == 0 {
+		return tpl, fillers, nil
+	}
+
+	given, err := template.Parse(fmt.Sprintf("%s %s %s", cmd.Action, cmd.Entity, strings.Join(args, " ")))
+	if err != nil {
+		return nil, nil, fmt.Errorf("command alias '@%s': %s", name, err)
+	}
+	givenCmd := given.CommandNodesIterator()[0]
+
+	if cmd.Params == nil {
+		cmd.Params = make(map[string]interface{})
+	}
+	if cmd.Refs == nil {
+		cmd.Refs = make(map[string]string)
+	}
+	if cmd.Holes == nil {
+		cmd.Holes = make(map[string]string)
+	}
+
+	for _, k := range givenCmd.Keys() {
+		if !isParam[k] {
+			v, ok := givenCmd.Params[k]
+			if !ok {
+				return nil, nil, fmt.Errorf("command alias '@%s': '%s' is neither a param of '%s %s' nor a hole value", name, k, cmd.Action, cmd.Entity)
+			}
+			fillers[k] = v
+			continue
+		}
+		delete(cmd.Params, k)
+		delete(cmd.Refs, k)
+		delete(cmd.Holes, k)
+		if v, ok := givenCmd.Params[k]; ok {
+			cmd.Params[k] = v
+		}
+		if v, ok := givenCmd.Refs[k]; ok {
+			cmd.Refs[k] = v
+		}
+		if v, ok := givenCmd.Holes[k]; ok {
+			cmd.Holes[k] = v
+		}
+	}
+
+	return tpl, fillers, nil
+}
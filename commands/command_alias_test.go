@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandCommandAlias(t *testing.T) {
+	aliases := map[string]string{
+		"micro": "create instance type=t2.micro count=1 name={instance.name}",
+		"multi": "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24",
+	}
+	lookup := func(name string) (string, bool) {
+		a, ok := aliases[name]
+		return a, ok
+	}
+
+	t.Run("stored one-liner", func(t *testing.T) {
+		tpl, fillers, err := expandCommandAlias("micro", nil, lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "create instance count=1 name={instance.name} type=t2.micro"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := len(fillers), 0; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+	t.Run("command line params win", func(t *testing.T) {
+		tpl, fillers, err := expandCommandAlias("micro", []string{"type=t3.micro", "name=web", "subnet=@public"}, lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "create instance count=1 name=web subnet=@public type=t3.micro"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := len(fillers), 0; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+	t.Run("hole values", func(t *testing.T) {
+		tpl, fillers, err := expandCommandAlias("micro", []string{"instance.name=web"}, lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "create instance count=1 name={instance.name} type=t2.micro"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := fillers, map[string]interface{}{"instance.name": "web"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+	t.Run("errors", func(t *testing.T) {
+		if _, _, err := expandCommandAlias("unknown", nil, lookup); err == nil {
+			t.Fatal("expected error for unknown alias")
+		}
+		if _, _, err := expandCommandAlias("multi", nil, lookup); err == nil {
+			t.Fatal("expected error for alias of several commands")
+		}
+		if _, _, err := expandCommandAlias("micro", []string{"unknown={hole}"}, lookup); err == nil {
+			t.Fatal("expected error for unexpected hole")
+		}
+	})
+}
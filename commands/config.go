@@ -18,10 +18,13 @@ package commands
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 )
 
 var keysOnly bool
@@ -41,19 +44,35 @@ var configCmd = &cobra.Command{
 	PersistentPreRunE: initAwlessEnvHook,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		if keysOnly {
-			for k := range config.Config {
-				fmt.Println(k)
-			}
-			for k := range config.Defaults {
-				fmt.Println(k)
-			}
-		} else {
-			fmt.Println(config.DisplayConfig())
-		}
+		exitOn(console.CheckOutputFormat("config", console.TableFormat, console.JSONFormat))
+		exitOn(printConfig(os.Stdout, keysOnly))
 	},
 }
 
+func printConfig(w io.Writer, keysOnly bool) error {
+	if keysOnly {
+		var keys []string
+		for k := range config.Config {
+			keys = append(keys, k)
+		}
+		for k := range config.Defaults {
+			keys = append(keys, k)
+		}
+		return console.PrintOutput(w, keys, func(w io.Writer) error {
+			for _, k := range keys {
+				fmt.Fprintln(w, k)
+			}
+			return nil
+		})
+	}
+
+	all := map[string]map[string]interface{}{"config": config.Config, "defaults": config.Defaults}
+	return console.PrintOutput(w, all, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, config.DisplayConfig())
+		return err
+	})
+}
+
 var configGetCmd = &cobra.Command{
 	Use:   "get KEY",
 	Short: "Get a configuration value",
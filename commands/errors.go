@@ -19,13 +19,136 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/fatih/color"
+	"github.com/wallix/awless/template"
 )
 
+// Exit codes of awless commands, letting scripts tell failures apart
+const (
+	ExitGeneric     = 1 // unexpected error
+	ExitValidation  = 2 // invalid usage, template or params: nothing was run
+	ExitAuth        = 3 // AWS authentication or permission error
+	ExitFailedClean = 4 // a template run failed leaving no change applied
+	ExitFailedDirty = 5 // a template run failed after applying changes, that `awless revert` can undo
+	ExitChanges     = 6 // running the template would change resources (see run --detect-changes)
+	ExitUnverified  = 7 // changes of some statements could not be detected (see run --detect-changes)
+)
+
+const exitCodesHelp = `Exit codes:
+  0  success
+  1  unexpected error
+  2  invalid usage, template or params: nothing was run
+  3  AWS authentication or permission error
+  4  template run failed leaving no change applied
+  5  template run failed after applying changes (see awless revert)
+  6  template run would change resources (see awless run --detect-changes)
+  7  template changes could not be detected for some statements (see awless run --detect-changes)`
+
+var authErrorCodes = []string{
+	"AccessDenied", "AccessDeniedException", "AuthFailure", "UnauthorizedOperation",
+	"InvalidClientTokenId", "UnrecognizedClientException", "SignatureDoesNotMatch",
+	"ExpiredToken", "ExpiredTokenException", "NoCredentialProviders", "MissingAuthenticationToken",
+}
+
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func withExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{code: code, err: err}
+}
+
 func exitOn(err error) {
 	if err != nil {
 		fmt.Fprintln(os.Stderr, color.RedString("[error]  "), err)
-		os.Exit(1)
+		os.Exit(ExitCode(err))
+	}
+}
+
+// ExitCode returns the code awless exits with on the given error
+func ExitCode(err error) int {
+	switch e := err.(type) {
+	case *exitCodeError:
+		return e.code
+	case *template.Errors:
+		errs, _ := e.Errors()
+		for _, err := range errs {
+			if isAuthError(err) {
+				return ExitAuth
+			}
+		}
+	}
+	if isAuthError(err) {
+		return ExitAuth
+	}
+	// cobra does not type its errors on unknown commands
+	if strings.HasPrefix(err.Error(), "unknown command ") {
+		return ExitValidation
+	}
+	return ExitGeneric
+}
+
+// isAuthError detects AWS auth errors, also once formatted in another error
+// as drivers do not keep the original AWS error
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		for _, code := range authErrorCodes {
+			if awsErr.Code() == code {
+				return true
+			}
+		}
+		return false
+	}
+	msg := err.Error()
+	for _, code := range authErrorCodes {
+		if strings.Contains(msg, code+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// runFailureExitCode returns the exit code of a failed template run
+// given what has been applied before the failure
+func runFailureExitCode(executed *template.Template, runErr error) int {
+	if executed == nil || executed.AST == nil {
+		if isAuthError(runErr) {
+			return ExitAuth
+		}
+		return ExitFailedClean
+	}
+	cmds := executed.CommandNodesIterator()
+	// on a run error, the last command has been appended without being executed
+	if runErr != nil && len(cmds) > 0 {
+		cmds = cmds[:len(cmds)-1]
+	}
+
+	for _, cmd := range cmds {
+		if cmd.CmdErr == nil && !cmd.CmdSkipped && cmd.Action != "check" && cmd.Action != "wait" && cmd.Action != "import" {
+			return ExitFailedDirty
+		}
+	}
+	if isAuthError(runErr) {
+		return ExitAuth
+	}
+	for _, cmd := range cmds {
+		if isAuthError(cmd.CmdErr) {
+			return ExitAuth
+		}
 	}
+	return ExitFailedClean
 }
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/template"
+)
+
+func TestExitCode(t *testing.T) {
+	tcases := []struct {
+		err error
+		exp int
+	}{
+		{err: errors.New("any"), exp: ExitGeneric},
+		{err: withExitCode(ExitValidation, errors.New("invalid")), exp: ExitValidation},
+		{err: awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil), exp: ExitAuth},
+		{err: awserr.New("InvalidParameterValue", "invalid value", nil), exp: ExitGeneric},
+		{err: fmt.Errorf("create instance: %s", awserr.New("AccessDenied", "access denied", nil)), exp: ExitAuth},
+		{err: fmt.Errorf("create instance: AccessDenied is not a code here"), exp: ExitGeneric},
+		{err: fmt.Errorf(`unknown command "lst" for "awless"`), exp: ExitValidation},
+		{err: RootCmd.FlagErrorFunc()(RootCmd, errors.New("unknown flag: --unknown")), exp: ExitValidation},
+	}
+	for i, tcase := range tcases {
+		if got, want := ExitCode(tcase.err), tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
+
+func TestRunFailureExitCode(t *testing.T) {
+	authErr := awserr.New("UnauthorizedOperation", "not authorized", nil)
+	tcases := []struct {
+		tpl    string
+		errs   []error
+		runErr error
+		exp    int
+	}{
+		{tpl: "create vpc cidr=10.0.0.0/16", errs: []error{errors.New("fail")}, exp: ExitFailedClean},
+		{tpl: "create vpc cidr=10.0.0.0/16", errs: []error{authErr}, exp: ExitAuth},
+		{tpl: "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24", errs: []error{nil, errors.New("fail")}, exp: ExitFailedDirty},
+		{tpl: "check instance id=inst_1 state=running timeout=1\ncreate subnet cidr=10.0.0.0/24", errs: []error{nil, errors.New("fail")}, exp: ExitFailedClean},
+		{tpl: "import vpc id=vpc-1 as @vpc\ncreate subnet cidr=10.0.0.0/24 vpc=@vpc", errs: []error{nil, errors.New("fail")}, exp: ExitFailedClean},
+		{tpl: "create vpc cidr=10.0.0.0/16", runErr: errors.New("no driver"), exp: ExitFailedClean},
+		{tpl: "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24", runErr: errors.New("no driver"), exp: ExitFailedDirty},
+	}
+	for i, tcase := range tcases {
+		tpl := template.MustParse(tcase.tpl)
+		for j, cmd := range tpl.CommandNodesIterator() {
+			if j < len(tcase.errs) {
+				cmd.CmdErr = tcase.errs[j]
+			}
+		}
+		if got, want := runFailureExitCode(tpl, tcase.runErr), tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
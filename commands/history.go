@@ -18,7 +18,9 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"text/tabwriter"
 
 	"github.com/wallix/awless/aws/services"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/sync/repo"
 )
 
 var (
@@ -41,13 +44,20 @@ func init() {
 }
 
 var historyCmd = &cobra.Command{
-	Use:               "history",
-	Hidden:            true,
-	Short:             "(in progress) Show a infra resource history & changes using your locally sync snapshots",
+	Use:   "history [REFERENCE]",
+	Short: "Show how a resource properties changed over time using your locally synced snapshots (or the infra changes without REFERENCE)",
+	Example: `  awless history sg-1234      # when did this security group open port 22?
+  awless history @my-instance
+  awless history sg-1234 --json`,
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		exitOn(checkHistoryOutputFormat(args))
+		if len(args) > 0 {
+			return showResourceHistory(args[0])
+		}
+
 		region := config.GetAWSRegion()
 
 		root := graph.InitResource(cloud.Region, region)
@@ -78,6 +88,80 @@ var historyCmd = &cobra.Command{
 	},
 }
 
+// checkHistoryOutputFormat rejects the formats other than table, the history
+// of a given resource being also displayable as JSON
+func checkHistoryOutputFormat(args []string) error {
+	if len(args) > 0 {
+		return console.CheckOutputFormat("history", console.TableFormat, console.JSONFormat)
+	}
+	return console.CheckOutputFormat("history", console.TableFormat)
+}
+
+// showResourceHistory walks the snapshots synced with the current profile in the current
+// region. Deleted resources are not in the local graphs anymore: their id is then the reference
+func showResourceHistory(ref string) error {
+	id := deprefix(ref)
+	if resource, _ := findResourceInLocalGraphs(ref); resource != nil {
+		id = resource.Id()
+	}
+
+	all, err := sync.DefaultSyncer.List()
+	exitOn(err)
+	profile := config.GetAWSProfile()
+	var revs []*repo.Rev
+	for _, rev := range all {
+		if rev.Profile == "" || rev.Profile == profile {
+			revs = append(revs, rev)
+		}
+	}
+
+	changes, err := sync.BuildResourceHistory(sync.DefaultSyncer, revs, id, config.GetAWSRegion(), "global")
+	exitOn(err)
+
+	return console.PrintOutput(os.Stdout, changes, func(w io.Writer) error {
+		printResourceHistory(w, id, len(revs), changes)
+		return nil
+	})
+}
+
+func printResourceHistory(w io.Writer, id string, revCount int, changes []*sync.ResourceChange) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "%s not found in the %d local snapshot(s)\n", id, revCount)
+		return
+	}
+	fmt.Fprintf(w, "▶ %s, %d change(s) in %d local snapshot(s)\n", id, len(changes), revCount)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, c := range changes {
+		when := fmt.Sprintf("%s\t%s", c.Date, shortRevId(c.RevId))
+		switch {
+		case c.Created:
+			fmt.Fprintf(tw, "%s\t%s\n", when, renderGreenFn("created"))
+		case c.Deleted:
+			fmt.Fprintf(tw, "%s\t%s\n", when, renderRedFn("deleted"))
+		default:
+			for _, prop := range c.Properties {
+				fmt.Fprintf(tw, "%s\t%s: %s -> %s\n", when, prop.Name, historyValue(prop.Before), historyValue(prop.After))
+				when = "\t"
+			}
+		}
+	}
+	tw.Flush()
+}
+
+func historyValue(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return v
+}
+
+func shortRevId(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+	return id
+}
+
 func displayRevisionDiff(diff *sync.Diff, cloudService string, root *graph.Resource, verbose bool) {
 	fromRevision := "repository creation"
 	if diff.From.Id != "" {
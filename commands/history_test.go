@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/sync"
+)
+
+func TestPrintResourceHistory(t *testing.T) {
+	changes := []*sync.ResourceChange{
+		{RevId: "1234567890", Date: "Mon Jan 2 15:04:05", Created: true},
+		{RevId: "abcdefghij", Date: "Tue Jan 3 15:04:05", Properties: []*sync.PropertyChange{
+			{Name: "InboundRules", Before: "[PortRange:{FromPort:443 ToPort:443 Any:false}]", After: "[PortRange:{FromPort:22 ToPort:22 Any:false}]"},
+			{Name: "Description", Before: "web", After: ""},
+		}},
+		{RevId: "klmnopqrst", Date: "Wed Jan 4 15:04:05", Deleted: true},
+	}
+
+	var buf bytes.Buffer
+	printResourceHistory(&buf, "sg-1234", 5, changes)
+	expected := []string{
+		"▶ sg-1234, 3 change(s) in 5 local snapshot(s)",
+		"Mon Jan 2 15:04:05  1234567  created",
+		"Tue Jan 3 15:04:05  abcdefg  InboundRules: [PortRange:{FromPort:443 ToPort:443 Any:false}] -> [PortRange:{FromPort:22 ToPort:22 Any:false}]",
+		"                             Description: web -> (none)",
+		"Wed Jan 4 15:04:05  klmnopq  deleted",
+	}
+	if got, want := buf.String(), strings.Join(expected, "\n")+"\n"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	printResourceHistory(&buf, "sg-1234", 5, nil)
+	if got, want := buf.String(), "sg-1234 not found in the 5 local snapshot(s)\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
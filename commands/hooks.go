@@ -19,11 +19,14 @@ package commands
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
@@ -56,15 +59,52 @@ func initAwlessEnvHook(cmd *cobra.Command, args []string) error {
 		if err := config.SetVolatile(config.ProfileConfigKey, awsProfileGlobalFlag); err != nil {
 			return err
 		}
+	} else if envProfile := os.Getenv("AWS_PROFILE"); envProfile != "" {
+		if err := config.SetVolatile(config.ProfileConfigKey, envProfile); err != nil {
+			return err
+		}
 	} else if envProfile := os.Getenv("AWS_DEFAULT_PROFILE"); envProfile != "" {
 		if err := config.SetVolatile(config.ProfileConfigKey, envProfile); err != nil {
 			return err
 		}
 	}
+	if caBundleGlobalFlag != "" {
+		if err := config.SetVolatile(config.CABundleConfigKey, caBundleGlobalFlag); err != nil {
+			return err
+		}
+	}
+
+	if unredactGlobalFlag {
+		logger.Warningf("--unredact: values of %s are displayed and logged in clear", strings.Join(config.GetRedactedProperties(), ", "))
+	} else {
+		logger.SetRedactedKeys(config.GetRedactedProperties()...)
+	}
 
 	return nil
 }
 
+// redactedProperties returns the properties whose values are masked on display
+func redactedProperties() []string {
+	if unredactGlobalFlag {
+		return nil
+	}
+	return config.GetRedactedProperties()
+}
+
+// displayIDFormat returns the form of the displayed ids given by flag or config
+func displayIDFormat() string {
+	if idFormatFlag == "" {
+		return config.GetDisplayIDFormat()
+	}
+	switch idFormatFlag {
+	case console.IDFormatShort, console.IDFormatARN:
+		return idFormatFlag
+	default:
+		exitOn(withExitCode(ExitValidation, fmt.Errorf("invalid --id-format '%s', expected %s or %s", idFormatFlag, console.IDFormatShort, console.IDFormatARN)))
+		return ""
+	}
+}
+
 func initCloudServicesHook(cmd *cobra.Command, args []string) error {
 	if localGlobalFlag {
 		return nil
@@ -82,7 +122,7 @@ func initCloudServicesHook(cmd *cobra.Command, args []string) error {
 		for _, s := range cloud.ServiceRegistry {
 			services = append(services, s)
 		}
-		sync.NewSyncer(logger.DefaultLogger).Sync(services...)
+		newProfileSyncer().Sync(services...)
 	}
 
 	return nil
@@ -98,10 +138,22 @@ func includeHookIf(cond *bool, hook func(*cobra.Command, []string) error) func(*
 }
 
 func initSyncerHook(cmd *cobra.Command, args []string) error {
-	sync.DefaultSyncer = sync.NewSyncer(logger.DefaultLogger)
+	sync.DefaultSyncer = newProfileSyncer()
+	return initFetchCacheHook(cmd, args)
+}
+
+func initFetchCacheHook(cmd *cobra.Command, args []string) error {
+	ttl := config.GetCacheTTL()
+	logger.ExtraVerbosef("fetch cache TTL: %s", ttl)
+	sync.DefaultFetchCache = sync.NewFetchCache(filepath.Join(os.Getenv("__AWLESS_CACHE"), "fetch"), config.GetAWSProfile(), ttl)
 	return nil
 }
 
+func newProfileSyncer() sync.Syncer {
+	profile := config.GetAWSProfile()
+	return sync.NewSyncer(profile, config.GetSyncRetention(profile), logger.DefaultLogger)
+}
+
 func initLoggerHook(cmd *cobra.Command, args []string) error {
 	var flag int
 	if verboseGlobalFlag {
@@ -111,13 +163,21 @@ func initLoggerHook(cmd *cobra.Command, args []string) error {
 		flag = flag | logger.ExtraVerboseF
 	}
 
-	logger.DefaultLogger.SetVerbose(flag)
-	if silentGlobalFlag {
+	if console.IsQuiet() {
 		logger.DefaultLogger = logger.DiscardLogger
+		return nil
 	}
+	logger.DefaultLogger.SetVerbose(flag)
 	return nil
 }
 
+func initOutput() {
+	exitOn(console.SetOutput(formatGlobalFlag, jsonGlobalFlag, quietGlobalFlag || silentGlobalFlag))
+	if console.IsQuiet() {
+		logger.DefaultLogger = logger.DiscardLogger
+	}
+}
+
 func onVersionUpgrade(cmd *cobra.Command, args []string) error {
 	var lastVersion string
 	if derr := database.Execute(func(db *database.DB) (err error) {
@@ -0,0 +1,80 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+)
+
+func init() {
+	RootCmd.AddCommand(impactCmd)
+}
+
+var impactCmd = &cobra.Command{
+	Use:   "impact REFERENCE",
+	Short: "Show the resources depending on a resource, directly and transitively, to assess what its deletion would break",
+	Example: `  awless impact sg-1234       # instances, load balancers, ... using a security group
+  awless impact @my-vpc       # everything living in a VPC`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("REFERENCE required. See examples.")
+		}
+
+		resource, gph := findResourceInLocalGraphs(args[0])
+		if resource == nil {
+			logger.Infof("resource with reference '%s' not found locally (sync it with `awless sync`)", deprefix(args[0]))
+			suggestNamesForRef(args[0])
+			return nil
+		}
+
+		root, count, err := gph.Impact(resource)
+		exitOn(err)
+
+		tree := buildImpactTree(root)
+		tree.Label = renderGreenFn(tree.Label)
+		exitOn(console.RenderTree(os.Stdout, tree, 0))
+		fmt.Printf("\n%d resource(s) depending on %s\n", count, resource)
+		return nil
+	},
+}
+
+// buildImpactTree draws the dependents of each resource under a node per relation
+func buildImpactTree(node *graph.ImpactNode) *console.TreeNode {
+	tree := &console.TreeNode{Label: node.Resource.String()}
+	for _, rel := range graph.DependencyRelations {
+		dependents := node.Dependents[rel]
+		if len(dependents) == 0 {
+			continue
+		}
+		group := &console.TreeNode{Label: renderCyanBoldFn(fmt.Sprintf("%s (%d)", dependencyRelationLabel(rel), len(dependents)))}
+		for _, dep := range dependents {
+			group.Children = append(group.Children, buildImpactTree(dep))
+		}
+		tree.Children = append(tree.Children, group)
+	}
+	return tree
+}
@@ -17,25 +17,36 @@ limitations under the License.
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/inspect"
+	"github.com/wallix/awless/inspect/inspectors"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
 
 var (
-	inspectorFlag string
+	inspectorFlag          string
+	inspectWithinFlag      string
+	inspectCriticalFlag    string
+	inspectAllAccountsFlag bool
 )
 
 func init() {
 	RootCmd.AddCommand(inspectCmd)
 
 	inspectCmd.Flags().StringVarP(&inspectorFlag, "inspector", "i", "", "Indicates which inspector to run")
+	inspectCmd.Flags().BoolVar(&inspectAllAccountsFlag, "all-accounts", false, "Inspect the local graphs of all the accounts synced with 'awless sync --all-accounts'")
+	inspectCmd.Flags().StringVar(&inspectWithinFlag, "within", "30d", "certexpiry: report certificates expiring within this window (ex: 30d, 12h)")
+	inspectCmd.Flags().StringVar(&inspectCriticalFlag, "critical", "7d", "certexpiry: exit with non zero status when certificates expire within this window")
 }
 
 var inspectCmd = &cobra.Command{
@@ -43,16 +54,31 @@ var inspectCmd = &cobra.Command{
 	Short: fmt.Sprintf(
 		"Inspecting your infrastructure using available inspectors: %s", allInspectors(),
 	),
-	Example:           "  awless inspect -i bucket_sizer\n  awless inspect -i pricer\n  awless inspect -i port_scanner",
+	Example:           "  awless inspect -i bucket_sizer\n  awless inspect -i pricer\n  awless inspect -i port_scanner\n  awless inspect -i deprecated_types\n  awless inspect -i certexpiry --within 30d --critical 7d\n  awless inspect -i tagpolicy\n  awless inspect -i bucketcompliance\n  awless inspect -i tagpolicy --format sarif > findings.sarif\n  awless inspect -i bucketcompliance --all-accounts",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
 	RunE: func(c *cobra.Command, args []string) error {
+		exitOn(checkInspectOutputFormat())
+
 		inspector, ok := inspect.InspectorsRegister[inspectorFlag]
 		if !ok {
 			return fmt.Errorf("command needs a valid inspector: %s", allInspectors())
 		}
 
+		if certExpiry, ok := inspector.(*inspectors.CertExpiry); ok {
+			var err error
+			certExpiry.Within, err = graph.ParseDaysDuration(inspectWithinFlag)
+			exitOn(err)
+			certExpiry.Critical, err = graph.ParseDaysDuration(inspectCriticalFlag)
+			exitOn(err)
+		}
+
+		if inspectAllAccountsFlag {
+			inspectAllAccounts(inspector)
+			return nil
+		}
+
 		if !localGlobalFlag {
 			logger.Info("Running full sync before inspection (disable it with --local flag)\n")
 			var services []cloud.Service
@@ -71,12 +97,61 @@ var inspectCmd = &cobra.Command{
 		err = inspector.Inspect(g)
 		exitOn(err)
 
-		inspector.Print(os.Stdout)
+		switch console.OutputFormat() {
+		case console.JSONFormat:
+			exitOn(inspect.WriteJSON(os.Stdout, inspector))
+		case console.SARIFFormat:
+			exitOn(inspect.WriteSARIF(os.Stdout, inspector, config.Version))
+		default:
+			inspector.Print(os.Stdout)
+		}
+
+		if reporter, ok := inspector.(inspect.CriticalReporter); ok {
+			if count := reporter.CriticalCount(); count > 0 {
+				exitOn(fmt.Errorf("%s: %d critical finding(s)", inspector.Name(), count))
+			}
+		}
 
 		return nil
 	},
 }
 
+// checkInspectOutputFormat rejects the formats inspectors cannot be reported in
+func checkInspectOutputFormat() error {
+	return console.CheckOutputFormat("inspect", console.TableFormat, console.JSONFormat, console.SARIFFormat)
+}
+
+// inspectAllAccounts runs the inspector on the local graphs of each synced account,
+// without syncing them first, and exits on critical findings or failed accounts
+func inspectAllAccounts(inspector inspect.Inspector) {
+	accounts := sync.SyncedAccounts()
+	if len(accounts) == 0 {
+		exitOn(errors.New("no account synced: run `awless sync --all-accounts`"))
+	}
+
+	report := inspect.InspectAccounts(inspector, accounts, sync.LoadAccountGraphs)
+
+	switch console.OutputFormat() {
+	case console.JSONFormat:
+		exitOn(report.WriteJSON(os.Stdout))
+	case console.SARIFFormat:
+		exitOn(report.WriteSARIF(os.Stdout, config.Version))
+	default:
+		report.Print(os.Stdout)
+	}
+
+	var failures []string
+	if failed := report.Failed(); len(failed) > 0 {
+		failures = append(failures, fmt.Sprintf("%d of %d account(s) failed to inspect: %s", len(failed), len(accounts), strings.Join(failed, ", ")))
+	}
+	if count := report.CriticalCount(); count > 0 {
+		failures = append(failures, fmt.Sprintf("%d critical finding(s)", count))
+	}
+	if len(failures) > 0 {
+		exitOn(fmt.Errorf("%s: %s", inspector.Name(), strings.Join(failures, ", ")))
+	}
+}
+
 func allInspectors() string {
 	var all []string
 	for name := range inspect.InspectorsRegister {
@@ -18,6 +18,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
@@ -25,14 +26,15 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
 
 var (
-	listingFormat              string
 	listingFiltersFlag         []string
 	listingTagFiltersFlag      []string
 	listingTagKeyFiltersFlag   []string
@@ -40,6 +42,10 @@ var (
 	listOnlyIDs                bool
 	noHeadersFlag              bool
 	sortBy                     []string
+	templateFlag               string
+	refreshFlag                bool
+	listColumnsFlag            []string
+	idFormatFlag               string
 )
 
 func init() {
@@ -62,43 +68,59 @@ func init() {
 		}
 	}
 
-	listCmd.PersistentFlags().StringVar(&listingFormat, "format", "table", "Output format: table, csv, tsv, json (default to table)")
-	listCmd.PersistentFlags().StringSliceVar(&listingFiltersFlag, "filter", []string{}, "Filter resources given key/values fields (case insensitive). Ex: --filter type=t2.micro")
+	listCmd.PersistentFlags().StringSliceVar(&listingFiltersFlag, "filter", []string{}, "Filter resources given key/values fields (case insensitive). Ex: --filter type=t2.micro, or on dates --filter 'expires=<30d'")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagFiltersFlag, "tag", []string{}, "Filter EC2 resources given tags (case sensitive!). Ex: --tag Env=Production")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagKeyFiltersFlag, "tag-key", []string{}, "Filter EC2 resources given a tag key only (case sensitive!). Ex: --tag-key Env")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagValueFiltersFlag, "tag-value", []string{}, "Filter EC2 resources given a tag value only (case sensitive!). Ex: --tag-value Staging")
 	listCmd.PersistentFlags().BoolVar(&listOnlyIDs, "ids", false, "List only ids")
 	listCmd.PersistentFlags().BoolVar(&noHeadersFlag, "no-headers", false, "Do not display headers")
 	listCmd.PersistentFlags().StringSliceVar(&sortBy, "sort", []string{"Id"}, "Sort tables by column(s) name(s)")
+	listCmd.PersistentFlags().StringSliceVar(&listColumnsFlag, "columns", nil, "Properties displayed as columns, overriding the ones configured with 'awless config set display.columns.<type>'. Ex: --columns name,type,privateip")
+	listCmd.PersistentFlags().StringVar(&idFormatFlag, "id-format", "", "Display the ids in short form (resource of the ARN ids) or as ARNs, overriding the display.idformat config: short or arn")
+	listCmd.PersistentFlags().BoolVar(&refreshFlag, "refresh", false, "Fetch the resources even if they have been fetched or synced within the cache TTL (see 'awless config set cache.ttl')")
+	listCmd.PersistentFlags().StringVar(&templateFlag, "template", "", "Format each resource with a Go template (properties as lowercased fields). Ex: --template '{{.name}} ({{.id}}) in {{.availabilityzone}}'")
 }
 
 var listCmd = &cobra.Command{
 	Use:               "list",
 	Aliases:           []string{"ls"},
-	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket ",
-	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list users --json\n  awless list instances --format ttl\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket\n  awless list certificates --filter 'expires=<30d'\n  awless list instances --template '{{.name}} ({{.id}}) in {{.availabilityzone}}'\n  awless list subnets --sort availableips\n  awless list all instances volumes subnets",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initFetchCacheHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 	Short:             "List various type of resources",
 }
 
+var listResourceAliases = map[string][]string{
+	cloud.ElasticIP:            {"addresses"},
+	cloud.BeanstalkApplication: {"beanstalk-applications"},
+	cloud.BeanstalkEnvironment: {"beanstalk-environments"},
+	cloud.ApiStage:             {"api-stages"},
+}
+
 var listSpecificResourceCmd = func(resType string) *cobra.Command {
 	return &cobra.Command{
-		Use:   cloud.PluralizeResource(resType),
-		Short: fmt.Sprintf("[%s] List %s %s", awsservices.ServicePerResourceType[resType], strings.ToUpper(awsservices.APIPerResourceType[resType]), cloud.PluralizeResource(resType)),
+		Use:     cloud.PluralizeResource(resType),
+		Aliases: listResourceAliases[resType],
+		Short:   fmt.Sprintf("[%s] List %s %s", awsservices.ServicePerResourceType[resType], strings.ToUpper(awsservices.APIPerResourceType[resType]), cloud.PluralizeResource(resType)),
 
 		Run: func(cmd *cobra.Command, args []string) {
+			exitOn(console.CheckOutputFormat("list "+cloud.PluralizeResource(resType), console.TableFormat, console.CSVFormat, console.TSVFormat, console.JSONFormat, console.TurtleFormat))
 			var g *graph.Graph
 
 			if localGlobalFlag {
 				if srvName, ok := awsservices.ServicePerResourceType[resType]; ok {
-					g = sync.LoadLocalGraphForService(srvName, config.GetAWSRegion())
+					if console.OutputFormat() == console.TurtleFormat { // relations are output as well
+						g = sync.LoadLocalGraphForService(srvName, config.GetAWSRegion())
+					} else {
+						g = sync.LoadLocalGraphForType(resType, srvName, config.GetAWSRegion())
+					}
 				} else {
 					exitOn(fmt.Errorf("cannot find service for resource type %s", resType))
 				}
 			} else {
 				srv, err := cloud.GetServiceForType(resType)
 				exitOn(err)
-				g, err = srv.FetchByType(resType)
+				g, err = sync.DefaultFetchCache.FetchByType(srv, resType, refreshFlag)
 				exitOn(err)
 			}
 
@@ -114,11 +136,15 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 		Hidden: true,
 
 		Run: func(cmd *cobra.Command, args []string) {
+			exitOn(console.CheckOutputFormat("list "+srvName, console.TableFormat, console.JSONFormat, console.TurtleFormat))
 			g := sync.LoadLocalGraphForService(srvName, config.GetAWSRegion())
 			displayer, err := console.BuildOptions(
-				console.WithFormat(listingFormat),
+				console.WithFormat(console.OutputFormat()),
 				console.WithMaxWidth(console.GetTerminalWidth()),
 				console.WithIDsOnly(listOnlyIDs),
+				console.WithRedactedProperties(redactedProperties()),
+				console.WithIDFormat(displayIDFormat()),
+				console.WithTemplate(templateFlag),
 			).SetSource(g).Build()
 			exitOn(err)
 			exitOn(displayer.Print(os.Stdout))
@@ -127,20 +153,123 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 }
 
 func printResources(g *graph.Graph, resType string) {
+	exitOn(writeResources(os.Stdout, g, resType))
+}
+
+func writeResources(w io.Writer, g *graph.Graph, resType string) error {
+	var deprecatedTypes []string
+	if resType == cloud.Instance {
+		deprecatedTypes = config.GetDeprecatedInstanceTypes()
+		warnDeprecatedInstanceTypes(g, deprecatedTypes)
+	}
+	if resType == cloud.LogGroup {
+		warnLogGroupsWithoutRetention(g)
+	}
+	if resType == cloud.Subnet {
+		warnSubnetsLowOnIPs(g, config.GetSubnetFreeIPsThreshold())
+	}
+
+	headers, err := listingColumns(resType)
+	if err != nil {
+		return err
+	}
+
 	displayer, err := console.BuildOptions(
 		console.WithRdfType(resType),
-		console.WithHeaders(console.DefaultsColumnDefinitions[resType]),
+		console.WithHeaders(headers),
+		console.WithDeprecatedValues(properties.Type, deprecatedTypes),
 		console.WithFilters(listingFiltersFlag),
 		console.WithTagFilters(listingTagFiltersFlag),
 		console.WithTagKeyFilters(listingTagKeyFiltersFlag),
 		console.WithTagValueFilters(listingTagValueFiltersFlag),
 		console.WithMaxWidth(console.GetTerminalWidth()),
-		console.WithFormat(listingFormat),
+		console.WithFormat(console.OutputFormat()),
 		console.WithIDsOnly(listOnlyIDs),
 		console.WithSortBy(sortBy...),
 		console.WithNoHeaders(noHeadersFlag),
+		console.WithHeaderAliases(config.GetDisplayAliases()),
+		console.WithRedactedProperties(redactedProperties()),
+		console.WithIDFormat(displayIDFormat()),
+		console.WithTemplate(templateFlag),
 	).SetSource(g).Build()
-	exitOn(err)
+	if err != nil {
+		return err
+	}
+
+	return displayer.Print(w)
+}
+
+// listingColumns returns the columns given with --columns, else the ones configured
+// for the resource type, else its default ones
+func listingColumns(resType string) ([]console.ColumnDefinition, error) {
+	if len(listColumnsFlag) > 0 {
+		return console.ColumnDefinitionsFor(resType, listColumnsFlag)
+	}
+	if columns := config.GetDisplayColumns(resType); len(columns) > 0 {
+		headers, err := console.ColumnDefinitionsFor(resType, columns)
+		if err != nil {
+			return nil, fmt.Errorf("%s: fix it with `awless config set display.columns.%s`", err, resType)
+		}
+		return headers, nil
+	}
+	return console.DefaultsColumnDefinitions[resType], nil
+}
 
-	exitOn(displayer.Print(os.Stdout))
+func warnDeprecatedInstanceTypes(g *graph.Graph, deprecated []string) {
+	instances, err := g.GetAllResources(cloud.Instance)
+	if err != nil {
+		logger.Verbose(err)
+		return
+	}
+	isDeprecated := make(map[string]bool)
+	for _, t := range deprecated {
+		isDeprecated[t] = true
+	}
+	var count int
+	for _, inst := range instances {
+		if isDeprecated[fmt.Sprint(inst.Properties[properties.Type])] {
+			count++
+		}
+	}
+	if count > 0 {
+		logger.Warningf("%d instance(s) on deprecated instance types. Report them all with `awless inspect -i deprecated_types`", count)
+	}
+}
+
+func warnLogGroupsWithoutRetention(g *graph.Graph) {
+	groups, err := g.GetAllResources(cloud.LogGroup)
+	if err != nil {
+		logger.Verbose(err)
+		return
+	}
+	var count int
+	for _, group := range groups {
+		if _, ok := group.Properties[properties.Retention]; !ok {
+			count++
+		}
+	}
+	if count > 0 {
+		logger.Warningf("%d log group(s) without retention policy retain their events forever, growing storage costs", count)
+	}
+}
+
+func warnSubnetsLowOnIPs(g *graph.Graph, threshold int) {
+	if threshold <= 0 {
+		return
+	}
+	subnets, err := g.GetAllResources(cloud.Subnet)
+	if err != nil {
+		logger.Verbose(err)
+		return
+	}
+	var low []string
+	for _, sub := range subnets {
+		if available, ok := sub.Properties[properties.AvailableIPs].(int); ok && available < threshold {
+			low = append(low, sub.Id())
+		}
+	}
+	if len(low) > 0 {
+		sort.Strings(low)
+		logger.Warningf("%d subnet(s) with less than %d available IP addresses: %s. Spot them with `awless list subnets --sort availableips`", len(low), threshold, strings.Join(low, ", "))
+	}
 }
@@ -0,0 +1,216 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	gosync "sync"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var listAllCombinedFlag bool
+
+// listAllDefaultTypes are listed by `awless list all` when no resource types are given
+var listAllDefaultTypes = []string{cloud.Vpc, cloud.Subnet, cloud.Instance, cloud.SecurityGroup, cloud.Volume, cloud.LoadBalancer, cloud.Database, cloud.Bucket}
+
+func init() {
+	listCmd.AddCommand(listAllCmd)
+
+	listAllCmd.Flags().BoolVar(&listAllCombinedFlag, "combined", false, "Display all the resources in a single table instead of a section per resource type")
+}
+
+var listAllCmd = &cobra.Command{
+	Use:   "all [RESOURCE TYPES...]",
+	Short: fmt.Sprintf("List several resource types at once, fetched concurrently (default: %s)", strings.Join(pluralizeAll(listAllDefaultTypes), ", ")),
+	Example: `  awless list all
+  awless list all instances volumes elasticips
+  awless list all users roles --combined --local`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		exitOn(console.CheckOutputFormat("list all", console.TableFormat, console.JSONFormat))
+
+		types, err := listAllTypes(args)
+		exitOn(err)
+
+		listed := fetchConcurrently(types, fetchForListing)
+
+		if listAllCombinedFlag {
+			exitOn(writeCombinedListing(os.Stdout, listed))
+		} else {
+			exitOn(writeListingSections(os.Stdout, listed))
+		}
+
+		var failed int
+		for _, l := range listed {
+			if l.err != nil {
+				failed++
+				logger.Errorf("list %s: %s", cloud.PluralizeResource(l.resType), l.err)
+			}
+		}
+		if failed > 0 {
+			exitOn(fmt.Errorf("%d of %d resource type(s) could not be listed", failed, len(listed)))
+		}
+	},
+}
+
+type listedResources struct {
+	resType string
+	g       *graph.Graph
+	err     error
+}
+
+// listAllTypes returns the resource types given in singular or plural, defaulting to listAllDefaultTypes
+func listAllTypes(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return listAllDefaultTypes, nil
+	}
+	var types []string
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		resType := strings.ToLower(arg)
+		if _, ok := awsservices.ServicePerResourceType[resType]; !ok {
+			resType = cloud.SingularizeResource(resType)
+		}
+		if _, ok := awsservices.ServicePerResourceType[resType]; !ok {
+			return nil, fmt.Errorf("unknown resource type '%s'", arg)
+		}
+		if !seen[resType] {
+			seen[resType] = true
+			types = append(types, resType)
+		}
+	}
+	return types, nil
+}
+
+// fetchConcurrently fetches each resource type in its own goroutine, the AWS calls
+// being bounded by the rate limiter shared by the services. A failing resource type
+// is reported in its result without interrupting the fetch of the others
+func fetchConcurrently(types []string, fetch func(resType string) (*graph.Graph, error)) []*listedResources {
+	listed := make([]*listedResources, len(types))
+	var wg gosync.WaitGroup
+	for i, resType := range types {
+		wg.Add(1)
+		go func(i int, resType string) {
+			defer wg.Done()
+			g, err := fetch(resType)
+			if g == nil {
+				g = graph.NewGraph()
+			}
+			listed[i] = &listedResources{resType: resType, g: g, err: err}
+		}(i, resType)
+	}
+	wg.Wait()
+	return listed
+}
+
+func fetchForListing(resType string) (*graph.Graph, error) {
+	if localGlobalFlag {
+		srvName, ok := awsservices.ServicePerResourceType[resType]
+		if !ok {
+			return nil, fmt.Errorf("cannot find service for resource type %s", resType)
+		}
+		return sync.LoadLocalGraphForType(resType, srvName, config.GetAWSRegion()), nil
+	}
+	srv, err := cloud.GetServiceForType(resType)
+	if err != nil {
+		return nil, err
+	}
+	return sync.DefaultFetchCache.FetchByType(srv, resType, refreshFlag)
+}
+
+// writeListingSections displays the resources of each type listed without error in its
+// own section, as a table, or as JSON keyed by the plural of the resource types
+func writeListingSections(w io.Writer, listed []*listedResources) error {
+	if console.IsJSONOutput() {
+		sections := make(map[string]json.RawMessage)
+		for _, l := range listed {
+			if l.err != nil {
+				continue
+			}
+			var buff bytes.Buffer
+			if err := writeResources(&buff, l.g, l.resType); err != nil {
+				return err
+			}
+			sections[cloud.PluralizeResource(l.resType)] = json.RawMessage(buff.Bytes())
+		}
+		return console.PrintJSON(w, sections)
+	}
+
+	var written int
+	for _, l := range listed {
+		if l.err != nil {
+			continue
+		}
+		resources, err := l.g.GetAllResources(l.resType)
+		if err != nil {
+			return err
+		}
+		if written > 0 {
+			fmt.Fprintln(w)
+		}
+		written++
+		fmt.Fprintln(w, renderCyanBoldFn(fmt.Sprintf("%s (%d)", cloud.PluralizeResource(l.resType), len(resources))))
+		if len(resources) == 0 {
+			continue
+		}
+		if err := writeResources(w, l.g, l.resType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCombinedListing displays the resources of all the types listed without error together
+func writeCombinedListing(w io.Writer, listed []*listedResources) error {
+	all := graph.NewGraph()
+	for _, l := range listed {
+		if l.err == nil {
+			all.AddGraph(l.g)
+		}
+	}
+	displayer, err := console.BuildOptions(
+		console.WithFormat(console.OutputFormat()),
+		console.WithMaxWidth(console.GetTerminalWidth()),
+		console.WithIDsOnly(listOnlyIDs),
+		console.WithRedactedProperties(redactedProperties()),
+		console.WithIDFormat(displayIDFormat()),
+	).SetSource(all).Build()
+	if err != nil {
+		return err
+	}
+	return displayer.Print(w)
+}
+
+func pluralizeAll(types []string) (plurals []string) {
+	for _, t := range types {
+		plurals = append(plurals, cloud.PluralizeResource(t))
+	}
+	return
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+)
+
+func TestListAllTypes(t *testing.T) {
+	types, err := listAllTypes(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types, listAllDefaultTypes; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	types, err = listAllTypes([]string{"instances", "Volume", "instance", "policies"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types, []string{cloud.Instance, cloud.Volume, cloud.Policy}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err = listAllTypes([]string{"instances", "unicorns"}); err == nil || !strings.Contains(err.Error(), "unknown resource type 'unicorns'") {
+		t.Fatalf("got %v, want unknown type error", err)
+	}
+}
+
+func TestFetchConcurrently(t *testing.T) {
+	listed := fetchConcurrently([]string{cloud.Instance, cloud.Volume, cloud.Subnet}, func(resType string) (*graph.Graph, error) {
+		if resType == cloud.Volume {
+			return nil, errors.New("access denied")
+		}
+		g := graph.NewGraph()
+		g.AddResource(graph.InitResource(resType, resType+"_1"))
+		return g, nil
+	})
+
+	var types []string
+	for _, l := range listed {
+		types = append(types, l.resType)
+	}
+	if got, want := types, []string{cloud.Instance, cloud.Volume, cloud.Subnet}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if listed[1].err == nil || listed[0].err != nil || listed[2].err != nil {
+		t.Fatalf("expected only volumes to fail, got %v, %v, %v", listed[0].err, listed[1].err, listed[2].err)
+	}
+	for _, i := range []int{0, 2} {
+		resources, err := listed[i].g.GetAllResources(listed[i].resType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(resources), 1; got != want {
+			t.Fatalf("%s: got %d, want %d", listed[i].resType, got, want)
+		}
+	}
+}
@@ -18,9 +18,11 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template"
@@ -37,7 +39,7 @@ func init() {
 
 	logCmd.Flags().BoolVar(&deleteAllLogsFlag, "delete-all", false, "Delete all logs from local db")
 	logCmd.Flags().StringVar(&deleteFromIdLogsFlag, "delete", "", "Delete a specifc log entry given its id")
-	logCmd.Flags().BoolVar(&logsAsRawJSONFlag, "raw-json", false, "Display logs as raw json")
+	logCmd.Flags().BoolVar(&logsAsRawJSONFlag, "raw-json", false, "Display logs as raw json, one document per log (see --json for a single JSON list)")
 }
 
 var logCmd = &cobra.Command{
@@ -47,6 +49,8 @@ var logCmd = &cobra.Command{
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
 	RunE: func(c *cobra.Command, args []string) error {
+		exitOn(console.CheckOutputFormat("log", console.TableFormat, console.JSONFormat))
+
 		if deleteAllLogsFlag {
 			exitOn(database.Execute(func(db *database.DB) error {
 				return db.DeleteTemplates()
@@ -68,30 +72,48 @@ var logCmd = &cobra.Command{
 		})
 		exitOn(err)
 
-		var printer template.Printer
-		if logsAsRawJSONFlag {
-			printer = template.NewJSONPrinter(os.Stdout)
-		} else {
-			logPrinter := template.NewLogPrinter(os.Stdout)
-			logPrinter.RenderKO = renderRedFn
-			logPrinter.RenderOK = renderGreenFn
-			printer = logPrinter
-		}
+		exitOn(printLogs(os.Stdout, all, logsAsRawJSONFlag))
+		return nil
+	},
+}
 
+// printLogs displays the logs in a single JSON list in json format, otherwise one
+// after the other as raw json documents or human readable. Logs in error are reported
+func printLogs(w io.Writer, all []*database.LoadedTemplate, rawJSON bool) error {
+	if console.IsJSONOutput() {
+		var execs []*template.TemplateExecution
 		for _, loaded := range all {
 			if loaded.Err != nil {
 				logger.Errorf("Template '%s' in error: %s", string(loaded.Key), loaded.Err)
-				logger.Verbosef("Template raw content\n%s", loaded.Raw)
-				fmt.Println()
 				continue
 			}
-
-			if err := printer.Print(loaded.TplExec); err != nil {
-				logger.Error(err.Error())
-			}
-			fmt.Println()
+			execs = append(execs, loaded.TplExec)
+		}
+		return console.PrintJSON(w, execs)
+	}
+
+	var printer template.Printer
+	if rawJSON {
+		printer = template.NewJSONPrinter(w)
+	} else {
+		logPrinter := template.NewLogPrinter(w)
+		logPrinter.RenderKO = renderRedFn
+		logPrinter.RenderOK = renderGreenFn
+		printer = logPrinter
+	}
+
+	for _, loaded := range all {
+		if loaded.Err != nil {
+			logger.Errorf("Template '%s' in error: %s", string(loaded.Key), loaded.Err)
+			logger.Verbosef("Template raw content\n%s", loaded.Raw)
+			fmt.Fprintln(w)
+			continue
 		}
 
-		return nil
-	},
+		if err := printer.Print(loaded.TplExec); err != nil {
+			logger.Error(err.Error())
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+var (
+	migrateTypesFlag                                     []string
+	migrateFiltersFlag, migrateTagFiltersFlag            []string
+	migrateTagKeyFiltersFlag, migrateTagValueFiltersFlag []string
+	migrateDryRunFlag                                    bool
+)
+
+// tagMigrationTypes are the EC2 resources supporting tags, on which migrations apply
+var tagMigrationTypes = []string{cloud.Image, cloud.Instance, cloud.InternetGateway, cloud.NetworkInterface, cloud.RouteTable, cloud.SecurityGroup, cloud.Snapshot, cloud.Subnet, cloud.Volume, cloud.Vpc}
+
+func init() {
+	RootCmd.AddCommand(migrateCmd)
+
+	migrateCmd.Flags().StringSliceVar(&migrateTypesFlag, "type", nil, fmt.Sprintf("Resource types to migrate (default: all of %s)", strings.Join(pluralizeAll(tagMigrationTypes), ", ")))
+	migrateCmd.Flags().StringSliceVar(&migrateFiltersFlag, "filter", nil, "Select resources given key/values fields (case insensitive), as in 'awless list'. Ex: --filter state=running")
+	migrateCmd.Flags().StringSliceVar(&migrateTagFiltersFlag, "tag", nil, "Select resources given tags (case sensitive!). Ex: --tag Env=Production")
+	migrateCmd.Flags().StringSliceVar(&migrateTagKeyFiltersFlag, "tag-key", nil, "Select resources given a tag key only (case sensitive!). Ex: --tag-key team")
+	migrateCmd.Flags().StringSliceVar(&migrateTagValueFiltersFlag, "tag-value", nil, "Select resources given a tag value only (case sensitive!). Ex: --tag-value Staging")
+	migrateCmd.Flags().BoolVar(&migrateDryRunFlag, "dry-run", false, "Only display the number of resources matched and changed, and the template the migration would run")
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate TRANSFORMATION",
+	Short: "Add, remove or rename a tag on all the resources matching a query, as a single revertible run",
+	Long:  "Add, remove or rename a tag on all the resources matching a query, as a single revertible run.\n\nThe transformation is one of:\n\n\tadd:KEY=VALUE\n\tremove:KEY\n\trename:KEY=NEWKEY\n\nResources already in the expected state are left untouched. Overwritten tags are deleted beforehand, so that reverting the run restores the tags as they were.",
+	Example: `  awless migrate rename:team=squad --dry-run
+  awless migrate rename:team=squad --type instance,volume
+  awless migrate add:Env=prod --type instance --tag-key app
+  awless migrate remove:tmp --filter state=stopped --local`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, initFetchCacheHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("TRANSFORMATION required. See examples.")
+		}
+		migration, err := template.ParseTagMigration(args[0])
+		exitOn(withExitCode(ExitValidation, err))
+
+		types, err := tagMigrationTypesFor(migrateTypesFlag)
+		exitOn(withExitCode(ExitValidation, err))
+
+		var matched []*graph.Resource
+		matchedCounts := make(map[string]int)
+		for _, l := range fetchConcurrently(types, fetchForListing) {
+			exitOn(l.err)
+			resources, err := selectResources(l.g, l.resType)
+			exitOn(err)
+			matched = append(matched, resources...)
+			matchedCounts[l.resType] = len(resources)
+		}
+
+		tpl, changedCounts, err := migration.Template(matched)
+		exitOn(err)
+
+		printTagMigrationCounts(migration, types, matchedCounts, changedCounts)
+
+		if len(tpl.Statements) == 0 {
+			logger.Info("nothing to migrate")
+			return nil
+		}
+
+		if migrateDryRunFlag {
+			fmt.Println()
+			console.NewPlanSummary(tpl).Print(os.Stdout)
+			fmt.Printf("\n%s\n", tpl)
+			return nil
+		}
+
+		fmt.Println()
+		tplExec := &template.TemplateExecution{
+			Template: tpl,
+			Locale:   config.GetAWSRegion(),
+			Profile:  config.GetAWSProfile(),
+			Source:   tpl.String(),
+			Name:     fmt.Sprintf("migrate %s", args[0]),
+		}
+		exitOn(runTemplate(tplExec, 1, config.Defaults))
+
+		return nil
+	},
+}
+
+// tagMigrationTypesFor returns the resource types given in singular or plural, defaulting to all the taggable ones
+func tagMigrationTypesFor(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return tagMigrationTypes, nil
+	}
+	taggable := make(map[string]bool)
+	for _, t := range tagMigrationTypes {
+		taggable[t] = true
+	}
+	types, err := listAllTypes(args)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range types {
+		if !taggable[t] {
+			return nil, fmt.Errorf("migrate: cannot tag %s, expecting any of: %s", cloud.PluralizeResource(t), strings.Join(pluralizeAll(tagMigrationTypes), ", "))
+		}
+	}
+	return types, nil
+}
+
+// selectResources returns the resources of the given type matching the query flags
+func selectResources(g *graph.Graph, resType string) ([]*graph.Resource, error) {
+	filtered, err := console.BuildOptions(
+		console.WithRdfType(resType),
+		console.WithHeaders(console.DefaultsColumnDefinitions[resType]),
+		console.WithFilters(migrateFiltersFlag),
+		console.WithTagFilters(migrateTagFiltersFlag),
+		console.WithTagKeyFilters(migrateTagKeyFiltersFlag),
+		console.WithTagValueFilters(migrateTagValueFiltersFlag),
+	).Filter(g)
+	if err != nil {
+		return nil, err
+	}
+	return filtered.GetAllResources(resType)
+}
+
+func printTagMigrationCounts(migration *template.TagMigration, types []string, matched, changed map[string]int) {
+	sorted := make([]string, len(types))
+	copy(sorted, types)
+	sort.Strings(sorted)
+	var totalMatched, totalChanged int
+	for _, t := range sorted {
+		totalMatched += matched[t]
+		totalChanged += changed[t]
+		if matched[t] > 0 {
+			fmt.Printf("%s: %d matched, %d to change\n", cloud.PluralizeResource(t), matched[t], changed[t])
+		}
+	}
+	logger.Infof("%s: %d resource(s) matched, %d to change", migration, totalMatched, totalChanged)
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+)
+
+func TestTagMigrationTypesFor(t *testing.T) {
+	types, err := tagMigrationTypesFor(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types, tagMigrationTypes; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	types, err = tagMigrationTypesFor([]string{"instances", "volume"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types, []string{cloud.Instance, cloud.Volume}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err = tagMigrationTypesFor([]string{"instances", "users"}); err == nil || !strings.Contains(err.Error(), "cannot tag users") {
+		t.Fatalf("got %v, want untaggable type error", err)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+// resolveByName resolves the resources named name, restricted by the other resolvers.
+// The name is matched with its exact casing first, then ignoring case unless --exact is given
+func resolveByName(g *graph.Graph, name string, others ...graph.Resolver) ([]*graph.Resource, error) {
+	resolve := func(byName graph.Resolver) ([]*graph.Resource, error) {
+		return g.ResolveResources(&graph.And{Resolvers: append([]graph.Resolver{byName}, others...)})
+	}
+	resources, err := resolve(&graph.ByProperty{Key: properties.Name, Value: name})
+	if err != nil || len(resources) > 0 || exactGlobalFlag {
+		return resources, err
+	}
+	return resolve(&graph.ByPropertyFold{Key: properties.Name, Value: name})
+}
+
+// suggestClosestNames returns, with --fuzzy, a 'did you mean' message for the names
+// of the resources the closest to name, restricted by the other resolvers
+func suggestClosestNames(g *graph.Graph, name string, others ...graph.Resolver) string {
+	if !fuzzyGlobalFlag {
+		return ""
+	}
+	// the closest names are computed last, among the resources the other resolvers kept
+	byClosest := &graph.ByClosestProperty{Key: properties.Name, Value: name, MaxDistance: maxNameDistance(name)}
+	closest, err := g.ResolveResources(&graph.And{Resolvers: append(append([]graph.Resolver{}, others...), byClosest)})
+	if err != nil {
+		logger.Verbosef("cannot suggest names close to '%s': %s", name, err)
+		return ""
+	}
+	return suggestNames(name, closest)
+}
+
+// suggestNamesForRef logs, with --fuzzy, the names close to a reference not found locally
+func suggestNamesForRef(ref string) {
+	if !fuzzyGlobalFlag {
+		return
+	}
+	g, err := sync.LoadAllLocalGraphs()
+	if err != nil {
+		logger.Verbosef("cannot suggest names close to '%s': %s", deprefix(ref), err)
+		return
+	}
+	if suggestion := suggestClosestNames(g, deprefix(ref)); suggestion != "" {
+		logger.Info(suggestion)
+	}
+}
+
+// maxNameDistance tolerates a typo every 3 characters
+func maxNameDistance(name string) int {
+	return len(name)/3 + 1
+}
+
+func suggestNames(name string, closest []*graph.Resource) string {
+	unique := make(map[string]bool)
+	var names []string
+	for _, res := range closest {
+		if n, ok := res.Properties[properties.Name].(string); ok && !unique[n] {
+			unique[n] = true
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("no resource named '%s', did you mean '%s'?", name, names[0])
+	default:
+		return fmt.Sprintf("no resource named '%s', did you mean one of '%s'?", name, strings.Join(names, "', '"))
+	}
+}
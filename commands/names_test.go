@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestResolveByName(t *testing.T) {
+	defer func(exact, fuzzy bool) { exactGlobalFlag, fuzzyGlobalFlag = exact, fuzzy }(exactGlobalFlag, fuzzyGlobalFlag)
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Prop("Name", "Web-Prod").Build(),
+		resourcetest.Instance("inst_2").Prop("Name", "web-dev").Build(),
+		resourcetest.Instance("inst_3").Prop("Name", "web-pro").Build(),
+		resourcetest.Subnet("sub_1").Prop("Name", "web-prod").Build(),
+		resourcetest.Subnet("sub_2").Prop("Name", "web-devel").Build(),
+	)
+
+	t.Run("exact casing first", func(t *testing.T) {
+		exactGlobalFlag = false
+		resources, err := resolveByName(g, "web-prod")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resources) != 1 || resources[0].Id() != "sub_1" {
+			t.Fatalf("got %v, want sub_1", resources)
+		}
+	})
+
+	t.Run("case insensitive", func(t *testing.T) {
+		exactGlobalFlag = false
+		resources, err := resolveByName(g, "WEB-PROD", &graph.ByType{Typ: "instance"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resources) != 1 || resources[0].Id() != "inst_1" {
+			t.Fatalf("got %v, want inst_1", resources)
+		}
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		exactGlobalFlag = true
+		resources, err := resolveByName(g, "WEB-PROD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resources) != 0 {
+			t.Fatalf("got %v, want none", resources)
+		}
+	})
+
+	t.Run("fuzzy suggestions", func(t *testing.T) {
+		fuzzyGlobalFlag = false
+		if got := suggestClosestNames(g, "web-prd"); got != "" {
+			t.Fatalf("got %s, want no suggestion without --fuzzy", got)
+		}
+		fuzzyGlobalFlag = true
+		if got, want := suggestClosestNames(g, "web-prd", &graph.ByType{Typ: "instance"}), "no resource named 'web-prd', did you mean one of 'Web-Prod', 'web-pro'?"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := suggestClosestNames(g, "wb-dev"), "no resource named 'wb-dev', did you mean 'web-dev'?"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := suggestClosestNames(g, "web-dv", &graph.ByType{Typ: "subnet"}), "no resource named 'web-dv', did you mean 'web-devel'?"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got := suggestClosestNames(g, "database"); got != "" {
+			t.Fatalf("got %s, want no suggestion", got)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/doc"
+	"github.com/wallix/awless/console"
+)
+
+func init() {
+	RootCmd.AddCommand(newCmd)
+}
+
+var newCmd = &cobra.Command{
+	Use:       "new SCAFFOLD",
+	Short:     fmt.Sprintf("Print a commented template skeleton to start from: %s", strings.Join(awsdoc.ScaffoldNames(), ", ")),
+	Example:   "  awless new               # list available scaffolds\n  awless new vpc-stack > vpc-stack.awls\n  awless run vpc-stack.awls",
+	ValidArgs: awsdoc.ScaffoldNames(),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		exitOn(console.CheckOutputFormat("new", console.TableFormat, console.JSONFormat))
+
+		if len(args) < 1 {
+			var all []*scaffold
+			for _, name := range awsdoc.ScaffoldNames() {
+				desc, _ := awsdoc.ScaffoldDescription(name)
+				all = append(all, &scaffold{Name: name, Description: desc})
+			}
+			return printScaffolds(os.Stdout, all)
+		}
+
+		text, ok := awsdoc.Scaffold(args[0])
+		if !ok {
+			return fmt.Errorf("unknown scaffold '%s'. Expecting any of: %s", args[0], strings.Join(awsdoc.ScaffoldNames(), ", "))
+		}
+		desc, _ := awsdoc.ScaffoldDescription(args[0])
+		return printScaffold(os.Stdout, &scaffold{Name: args[0], Description: desc, Text: text})
+	},
+}
+
+func printScaffolds(w io.Writer, all []*scaffold) error {
+	return console.PrintOutput(w, all, func(out io.Writer) error {
+		tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		for _, s := range all {
+			fmt.Fprintf(tw, "%s\t%s\n", s.Name, s.Description)
+		}
+		return tw.Flush()
+	})
+}
+
+func printScaffold(w io.Writer, s *scaffold) error {
+	return console.PrintOutput(w, s, func(out io.Writer) error {
+		_, err := fmt.Fprint(out, s.Text)
+		return err
+	})
+}
+
+type scaffold struct {
+	Name, Description string
+	Text              string `json:",omitempty"`
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+)
+
+var printURLFlag bool
+
+func init() {
+	RootCmd.AddCommand(openCmd)
+	openCmd.Flags().BoolVar(&printURLFlag, "print-url", false, "Only print the AWS console URL of the resource instead of opening it")
+}
+
+var openCmd = &cobra.Command{
+	Use:   "open REFERENCE",
+	Short: "Open the AWS web console on a resource given a REFERENCE: id or name",
+	Example: `  awless open i-8d43b21b              # open the console page of an instance
+  awless open @my-bucket              # forcing search by name
+  awless open jsmith --print-url      # only print the console URL of a user`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("REFERENCE required. See examples.")
+		}
+
+		resource, _ := findResourceInLocalGraphs(args[0])
+		if resource == nil {
+			suggestNamesForRef(args[0])
+			return fmt.Errorf("resource with reference '%s' not found locally (sync it with `awless sync`)", deprefix(args[0]))
+		}
+
+		link, err := resourceConsoleURL(resource, config.GetAWSRegion())
+		exitOn(err)
+
+		if printURLFlag {
+			fmt.Println(link)
+			return nil
+		}
+		exitOn(openInBrowser(link))
+		return nil
+	},
+}
+
+func resourceConsoleURL(res *graph.Resource, region string) (string, error) {
+	values := map[string]string{"id": res.Id()}
+	if name, ok := res.Properties[properties.Name].(string); ok {
+		values["name"] = name
+	}
+	if arn, ok := res.Properties[properties.Arn].(string); ok {
+		values["arn"] = arn
+	}
+	return awsconfig.ConsoleURL(res.Type(), region, values)
+}
+
+func openInBrowser(link string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", link)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", link)
+	default:
+		cmd = exec.Command("xdg-open", link)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("cannot open browser (print the URL with --print-url): %s", err)
+	}
+	return nil
+}
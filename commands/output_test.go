@@ -0,0 +1,385 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless-scheduler/model"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+func TestReadCommandsHonorOutputFlags(t *testing.T) {
+	defer resetOutputFlags()
+	defer func(l *logger.Logger) { logger.DefaultLogger = l }(logger.DefaultLogger)
+
+	readCommands := [][]string{
+		{"list", "instances"}, {"list", "infra"}, {"show"}, {"whoami"}, {"inspect"}, {"log"},
+		{"config"}, {"version"}, {"search", "images"}, {"new"}, {"history"}, {"scheduler"},
+	}
+
+	tcases := []struct {
+		flags     []string
+		expFormat string
+		expQuiet  bool
+	}{
+		{flags: []string{}, expFormat: "table"},
+		{flags: []string{"--json"}, expFormat: "json"},
+		{flags: []string{"--format", "json"}, expFormat: "json"},
+		{flags: []string{"--format", "csv", "--quiet"}, expFormat: "csv", expQuiet: true},
+		{flags: []string{"-q", "--json"}, expFormat: "json", expQuiet: true},
+		{flags: []string{"--silent"}, expFormat: "table", expQuiet: true},
+	}
+
+	for _, path := range readCommands {
+		cmd, _, err := RootCmd.Find(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"format", "json", "quiet"} {
+			if cmd.Flag(name) == nil {
+				t.Fatalf("%s: missing --%s flag", cmd.CommandPath(), name)
+			}
+		}
+		for _, tcase := range tcases {
+			resetOutputFlags()
+			if err := cmd.ParseFlags(tcase.flags); err != nil {
+				t.Fatalf("%s %v: %s", cmd.CommandPath(), tcase.flags, err)
+			}
+			initOutput()
+			if got, want := console.OutputFormat(), tcase.expFormat; got != want {
+				t.Fatalf("%s %v: format: got %s, want %s", cmd.CommandPath(), tcase.flags, got, want)
+			}
+			if got, want := console.IsQuiet(), tcase.expQuiet; got != want {
+				t.Fatalf("%s %v: quiet: got %t, want %t", cmd.CommandPath(), tcase.flags, got, want)
+			}
+		}
+	}
+}
+
+func TestPrintWhoami(t *testing.T) {
+	defer resetOutputFlags()
+
+	me := &whoami{
+		Identity:    &awsservices.Identity{Account: "0123456789", UserId: "AIDA", ResourceType: "user", Resource: "jsmith"},
+		Credentials: "shared credentials file",
+		Profile:     "default",
+		Policies:    &awsservices.UserPolicies{Username: "jsmith", Attached: []string{"ReadOnlyAccess"}},
+	}
+
+	var w bytes.Buffer
+	if err := printWhoami(&w, me); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Username: jsmith, Id: AIDA, Account: 0123456789\nCredentials: shared credentials file, Profile: default\n" +
+		"\nAttached policies (i.e. managed):\n\t- ReadOnlyAccess\n\nInlined policies: none\n"
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+
+	jsonGlobalFlag = true
+	initOutput()
+	w.Reset()
+	if err := printWhoami(&w, me); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := decoded["Resource"], "jsmith"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := decoded["Profile"], "default"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := decoded["Policies"].(map[string]interface{})["Attached"], []interface{}{"ReadOnlyAccess"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPrintConfig(t *testing.T) {
+	defer resetOutputFlags()
+	defer func(c, d map[string]interface{}) { config.Config, config.Defaults = c, d }(config.Config, config.Defaults)
+	config.Config = map[string]interface{}{"aws.region": "eu-west-1"}
+	config.Defaults = map[string]interface{}{}
+
+	var w bytes.Buffer
+	if err := printConfig(&w, true); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "aws.region\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	jsonGlobalFlag = true
+	initOutput()
+
+	w.Reset()
+	if err := printConfig(&w, true); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "[\n \"aws.region\"\n]\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	w.Reset()
+	if err := printConfig(&w, false); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]map[string]interface{}
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := decoded["config"]["aws.region"], "eu-west-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPrintBuildInfo(t *testing.T) {
+	defer resetOutputFlags()
+	info := config.BuildInfo{Version: "v0.1.2"}
+
+	var w bytes.Buffer
+	if err := printBuildInfo(&w, info); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "awless version=v0.1.2\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	formatGlobalFlag = "json"
+	initOutput()
+	w.Reset()
+	if err := printBuildInfo(&w, info); err != nil {
+		t.Fatal(err)
+	}
+	var decoded config.BuildInfo
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := decoded, info; got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestPrintScaffolds(t *testing.T) {
+	defer resetOutputFlags()
+	all := []*scaffold{{Name: "vpc-stack", Description: "A VPC with its subnets"}, {Name: "web", Description: "Web servers"}}
+
+	var w bytes.Buffer
+	if err := printScaffolds(&w, all); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "vpc-stack  A VPC with its subnets\nweb        Web servers\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	w.Reset()
+	if err := printScaffold(&w, &scaffold{Name: "web", Description: "Web servers", Text: "create instance name={name}\n"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "create instance name={name}\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	jsonGlobalFlag = true
+	initOutput()
+	w.Reset()
+	if err := printScaffolds(&w, all); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := decoded, []map[string]interface{}{{"Name": "vpc-stack", "Description": "A VPC with its subnets"}, {"Name": "web", "Description": "Web servers"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	w.Reset()
+	if err := printScaffold(&w, &scaffold{Name: "web", Description: "Web servers", Text: "create instance name={name}\n"}); err != nil {
+		t.Fatal(err)
+	}
+	var single map[string]interface{}
+	if err := json.Unmarshal(w.Bytes(), &single); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := single["Text"], "create instance name={name}\n"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPrintLogs(t *testing.T) {
+	defer resetOutputFlags()
+	defer func(l *logger.Logger) { logger.DefaultLogger = l }(logger.DefaultLogger)
+	logger.DefaultLogger = logger.DiscardLogger
+
+	tpl := template.MustParse("create vpc cidr=10.0.0.0/16")
+	tpl.ID = template.NewRunID()
+	all := []*database.LoadedTemplate{
+		{TplExec: &template.TemplateExecution{Template: tpl, Author: "jsmith", Locale: "eu-west-1"}},
+		{Key: "broken", Err: errors.New("cannot unmarshal")},
+	}
+
+	var w bytes.Buffer
+	if err := printLogs(&w, all, false); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	for _, exp := range []string{"ID: " + tpl.ID, "Author: jsmith", "Region: eu-west-1", "create vpc cidr=10.0.0.0/16"} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("got %q, want it to contain %q", out, exp)
+		}
+	}
+
+	w.Reset()
+	if err := printLogs(&w, all, true); err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]interface{}
+	if err := json.NewDecoder(&w).Decode(&raw); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := raw["id"], tpl.ID; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	jsonGlobalFlag = true
+	initOutput()
+	w.Reset()
+	if err := printLogs(&w, all, false); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := len(decoded), 1; got != want {
+		t.Fatalf("got %d, want %d (logs in error skipped)", got, want)
+	}
+	if got, want := decoded[0]["author"], "jsmith"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPrintSchedulerTasks(t *testing.T) {
+	defer resetOutputFlags()
+	runAt := time.Date(2017, 5, 12, 10, 0, 0, 0, time.UTC)
+	tasks := []*model.Task{
+		{Region: "eu-west-1", RunAt: runAt, Content: "create vpc cidr=10.0.0.0/16"},
+		{Region: "us-east-1", RunAt: runAt, RevertAt: runAt.Add(time.Hour), Content: "create subnet cidr=10.0.0.0/24"},
+	}
+
+	var w bytes.Buffer
+	if err := printTasks(&w, tasks); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Region: eu-west-1, RunAt: 2017-05-12 10:00:00 +0000 UTC\nContent: create vpc cidr=10.0.0.0/16\n\n" +
+		"Region: us-east-1, RunAt: 2017-05-12 10:00:00 +0000 UTC, RevertAt: 2017-05-12 11:00:00 +0000 UTC\nContent: create subnet cidr=10.0.0.0/24\n\n"
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+
+	formatGlobalFlag = "json"
+	initOutput()
+	w.Reset()
+	if err := printTasks(&w, tasks); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []*model.Task
+	if err := json.Unmarshal(w.Bytes(), &decoded); err != nil {
+		t.Fatalf("%s: %s", err, w.String())
+	}
+	if got, want := decoded, tasks; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestRejectUnsupportedOutputFormats(t *testing.T) {
+	defer resetOutputFlags()
+
+	tcases := []struct {
+		format string
+		check  func() error
+		expErr bool
+	}{
+		{format: "table", check: func() error { return checkHistoryOutputFormat(nil) }},
+		{format: "json", check: func() error { return checkHistoryOutputFormat(nil) }, expErr: true},
+		{format: "json", check: func() error { return checkHistoryOutputFormat([]string{"sg-1234"}) }},
+		{format: "csv", check: func() error { return checkHistoryOutputFormat([]string{"sg-1234"}) }, expErr: true},
+		{format: "sarif", check: checkInspectOutputFormat},
+		{format: "json", check: checkInspectOutputFormat},
+		{format: "ttl", check: checkInspectOutputFormat, expErr: true},
+		{format: "csv", check: checkInspectOutputFormat, expErr: true},
+	}
+	for i, tcase := range tcases {
+		resetOutputFlags()
+		formatGlobalFlag = tcase.format
+		initOutput()
+		err := tcase.check()
+		if tcase.expErr && (err == nil || !strings.Contains(err.Error(), "format '"+tcase.format+"' not supported")) {
+			t.Fatalf("%d: got %v, want format not supported error", i+1, err)
+		}
+		if !tcase.expErr && err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+	}
+}
+
+func TestQuietDisablesLogging(t *testing.T) {
+	defer resetOutputFlags()
+	defer func(l *logger.Logger) { logger.DefaultLogger = l }(logger.DefaultLogger)
+	defer func(v bool) { verboseGlobalFlag = v }(verboseGlobalFlag)
+	verbose := logger.New("", 0)
+
+	logger.DefaultLogger = verbose
+	initOutput()
+	if err := initLoggerHook(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if logger.DefaultLogger != verbose {
+		t.Fatal("got logging disabled, want it enabled without --quiet")
+	}
+
+	for _, quiet := range []*bool{&quietGlobalFlag, &silentGlobalFlag} {
+		resetOutputFlags()
+		logger.DefaultLogger = verbose
+		*quiet, verboseGlobalFlag = true, true
+		initOutput()
+		if err := initLoggerHook(nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if logger.DefaultLogger != logger.DiscardLogger {
+			t.Fatal("got logging enabled, want it disabled with --quiet or --silent, even when verbose")
+		}
+	}
+}
+
+func resetOutputFlags() {
+	formatGlobalFlag, jsonGlobalFlag, quietGlobalFlag, silentGlobalFlag = console.TableFormat, false, false, false
+	console.SetOutput(console.TableFormat, false, false)
+}
@@ -26,14 +26,18 @@ import (
 	"github.com/wallix/awless/template"
 )
 
+var revertConcurrencyFlag int
+
 func init() {
 	RootCmd.AddCommand(revertCmd)
+
+	revertCmd.Flags().IntVar(&revertConcurrencyFlag, "concurrency", 1, "Number of resources reverted in parallel, a resource being reverted only once the ones depending on it are")
 }
 
 var revertCmd = &cobra.Command{
 	Use:               "revert REVERTID",
 	Short:             "Revert a template execution given a revert ID (see `awless log` to list revert ids)",
-	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ",
+	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ\n  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ --concurrency 8",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
@@ -42,6 +46,10 @@ var revertCmd = &cobra.Command{
 			return errors.New("REVERTID required (see `awless log` to list revert ids)")
 		}
 
+		if revertConcurrencyFlag < 1 {
+			return errors.New("--concurrency must be at least 1")
+		}
+
 		revertId := args[0]
 
 		var loaded *template.TemplateExecution
@@ -65,7 +73,7 @@ var revertCmd = &cobra.Command{
 			Profile:  config.GetAWSProfile(),
 			Source:   reverted.String(),
 		}
-		exitOn(runTemplate(tplExec))
+		exitOn(runTemplate(tplExec, revertConcurrencyFlag))
 
 		return nil
 	},
@@ -17,19 +17,30 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/console"
 )
 
 var (
 	verboseGlobalFlag      bool
 	extraVerboseGlobalFlag bool
 	silentGlobalFlag       bool
+	quietGlobalFlag        bool
+	jsonGlobalFlag         bool
+	formatGlobalFlag       string
 	localGlobalFlag        bool
 	forceGlobalFlag        bool
 	versionGlobalFlag      bool
 	awsRegionGlobalFlag    string
 	awsProfileGlobalFlag   string
+	caBundleGlobalFlag     string
+	unredactGlobalFlag     bool
+	exactGlobalFlag        bool
+	fuzzyGlobalFlag        bool
 
 	renderGreenFn    = color.New(color.FgGreen).SprintFunc()
 	renderRedFn      = color.New(color.FgRed).SprintFunc()
@@ -40,12 +51,26 @@ func init() {
 	RootCmd.PersistentFlags().BoolVarP(&verboseGlobalFlag, "verbose", "v", false, "Turn on verbose mode for all commands")
 	RootCmd.PersistentFlags().BoolVarP(&extraVerboseGlobalFlag, "extra-verbose", "e", false, "Turn on extra verbose mode (including regular verbose) for all commands")
 	RootCmd.PersistentFlags().BoolVar(&silentGlobalFlag, "silent", false, "Turn on silent mode for all commands: disable logging")
+	RootCmd.PersistentFlags().BoolVarP(&quietGlobalFlag, "quiet", "q", false, "Only display results: disable logging and extra human readable sections")
+	RootCmd.PersistentFlags().BoolVar(&jsonGlobalFlag, "json", false, "Display results as JSON (shortcut for --format json)")
+	RootCmd.PersistentFlags().StringVar(&formatGlobalFlag, "format", console.TableFormat, fmt.Sprintf("Output format: %s", strings.Join(console.OutputFormats, ", ")))
 	RootCmd.PersistentFlags().BoolVarP(&localGlobalFlag, "local", "l", false, "Work offline only with synced/local resources")
 	RootCmd.PersistentFlags().BoolVarP(&forceGlobalFlag, "force", "f", false, "Force the command and bypass any confirmation prompt")
 	RootCmd.PersistentFlags().StringVarP(&awsRegionGlobalFlag, "aws-region", "r", "", "Overwrite AWS region")
 	RootCmd.PersistentFlags().StringVarP(&awsProfileGlobalFlag, "aws-profile", "p", "", "Overwrite AWS profile")
+	RootCmd.PersistentFlags().StringVar(&caBundleGlobalFlag, "ca-bundle", "", "Trust the CA certificates of this PEM file to reach AWS (overwrite 'aws.cabundle' config)")
+	RootCmd.PersistentFlags().BoolVar(&unredactGlobalFlag, "unredact", false, "Display and log in clear the values of the properties redacted with 'awless config set display.redact'")
+	RootCmd.PersistentFlags().BoolVar(&exactGlobalFlag, "exact", false, "Resolve resource names with their exact casing only")
+	RootCmd.PersistentFlags().BoolVar(&fuzzyGlobalFlag, "fuzzy", false, "Suggest the closest resource names when a name matches no resource")
 
 	RootCmd.Flags().BoolVar(&versionGlobalFlag, "version", false, "Print awless version")
+	RootCmd.PersistentFlags().MarkDeprecated("silent", "use --quiet instead")
+
+	RootCmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return withExitCode(ExitValidation, err)
+	})
+
+	cobra.OnInitialize(initOutput)
 
 	cobra.AddTemplateFunc("IsCmdAnnotatedOneliner", IsCmdAnnotatedOneliner)
 	cobra.AddTemplateFunc("HasCmdOnelinerChilds", HasCmdOnelinerChilds)
@@ -56,7 +81,7 @@ func init() {
 var RootCmd = &cobra.Command{
 	Use:   "awless COMMAND",
 	Short: "Manage  and explore your cloud",
-	Long:  "awless is a powerful CLI to explore, sync and manage your cloud infrastructure",
+	Long:  "awless is a powerful CLI to explore, sync and manage your cloud infrastructure\n\n" + exitCodesHelp,
 	BashCompletionFunction: bash_completion_func,
 	RunE: func(c *cobra.Command, args []string) error {
 		if versionGlobalFlag {
@@ -31,6 +31,7 @@ import (
 	"strings"
 	stdsync "sync"
 	"text/tabwriter"
+	"time"
 
 	"github.com/chzyer/readline"
 	"github.com/spf13/cobra"
@@ -39,7 +40,9 @@ import (
 	"github.com/wallix/awless/aws/driver"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
@@ -51,12 +54,33 @@ import (
 var scheduleRunInFlag string
 var scheduleRevertInFlag string
 var listRemoteTemplatesFlag bool
+var idempotentFlag bool
+var showDiffFlag bool
+var checkPermissionsFlag bool
+var detectChangesFlag bool
+var varsFileFlag string
+var resultFileFlag string
+var skipStatementsFlag []int
+var skipRefsFlag []string
+var planGraphFlag string
+
+// Holes values loaded from the --vars file
+var varFileParams map[string]interface{}
 
 func init() {
 	RootCmd.AddCommand(runCmd)
 	runCmd.Flags().BoolVar(&listRemoteTemplatesFlag, "list", false, "List templates available at https://github.com/wallix/awless-templates")
 	runCmd.Flags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this template")
 	runCmd.Flags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this template")
+	runCmd.Flags().BoolVar(&idempotentFlag, "idempotent", false, "Skip create statements whose resource already exists (matched on its identifying params) and reference the existing one")
+	runCmd.Flags().BoolVar(&showDiffFlag, "show-diff", false, "Display the property changes of the resources touched by the run")
+	runCmd.Flags().BoolVar(&checkPermissionsFlag, "check-permissions", false, "Only check if the current credentials are allowed to run each statement (EC2 dry run or IAM policy simulation), without running the template")
+	runCmd.Flags().BoolVar(&detectChangesFlag, "detect-changes", false, "Only report the statements that would change resources (creating the ones not found as with --idempotent, updating or deleting), without running the template. Exits with code 6 on changes and 7 when some statements could not be checked")
+	runCmd.Flags().StringVar(&varsFileFlag, "vars", "", "Load the holes values from a flat JSON or YAML file. Extra params given on the command line take precedence")
+	runCmd.Flags().StringVar(&resultFileFlag, "result-file", "", "Write the outcome of the run (statements, results, created ids, errors, timing) as JSON to this file, even when the run fails")
+	runCmd.Flags().IntSliceVar(&skipStatementsFlag, "skip", nil, "Do not run the statements at these positions (starting at 1), nor the statements depending on them. Ex: --skip 3,5")
+	runCmd.Flags().StringSliceVar(&skipRefsFlag, "skip-ref", nil, "Do not run the statements declaring these references, nor the statements depending on them. Ex: --skip-ref temp")
+	runCmd.Flags().StringVar(&planGraphFlag, "plan-graph", "", "Only write the dependencies between the compiled statements as a Graphviz DOT file (- for stdout), without running the template")
 
 	var actions []string
 	for a := range awsdriver.DriverSupportedActions() {
@@ -70,14 +94,20 @@ func init() {
 		cmd := createDriverCommands(action, entities)
 		cmd.PersistentFlags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this command")
 		cmd.PersistentFlags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this command")
+		cmd.PersistentFlags().BoolVar(&showDiffFlag, "show-diff", false, "Display the property changes of the resources touched by this command")
+		cmd.PersistentFlags().BoolVar(&checkPermissionsFlag, "check-permissions", false, "Only check if the current credentials are allowed to run this command, without running it")
+		cmd.PersistentFlags().StringVar(&resultFileFlag, "result-file", "", "Write the outcome of this command as JSON to this file, even when it fails")
+		if action == "create" {
+			cmd.PersistentFlags().BoolVar(&idempotentFlag, "idempotent", false, "Skip creation if the resource already exists (matched on its identifying params)")
+		}
 		RootCmd.AddCommand(cmd)
 	}
 }
 
 var runCmd = &cobra.Command{
 	Use:               "run PATH",
-	Short:             "Run a template given a filepath or a URL (prefixed with http)",
-	Example:           "  awless run ~/templates/my-infra.txt\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.awls\n  awless run repo:create_vpc",
+	Short:             "Run a template given a filepath, a URL (prefixed with http), a command alias (prefixed with @) or stdin (-)",
+	Example:           "  awless run ~/templates/my-infra.txt\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.awls\n  awless run repo:create_vpc\n  awless run @micro name=web\n  generate-template | awless run - --force\n  awless run ~/templates/my-infra.txt --check-permissions\n  awless run ~/templates/my-infra.txt --detect-changes\n  awless run ~/templates/my-infra.txt --vars prod.yml instance.type=t2.small\n  awless run ~/templates/my-infra.txt --skip 3,5 --skip-ref temp\n  awless run ~/templates/my-infra.txt --plan-graph plan.dot && dot -Tpng plan.dot -o plan.png",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
@@ -87,36 +117,94 @@ var runCmd = &cobra.Command{
 			return nil
 		}
 		if len(args) < 1 {
-			return errors.New("missing PATH arg (filepath or url)")
+			return errors.New("missing PATH arg (filepath, url, @alias or - for stdin)")
 		}
 
-		content, err := getTemplateText(args[0])
-		exitOn(err)
+		tplExec := &template.TemplateExecution{
+			Locale:  config.GetAWSRegion(),
+			Profile: config.GetAWSProfile(),
+			Name:    args[0],
+		}
+		var extraParams map[string]interface{}
+		var err error
 
-		logger.Verbosef("Loaded template text:\n\n%s\n", removeComments(content))
+		if isCommandAlias(args[0]) {
+			tplExec.Template, extraParams, err = expandCommandAlias(args[0][1:], args[1:], config.GetCommandAlias)
+			exitOnValidationError(tplExec, err)
+			logger.Verbosef("Expanded command alias %s: %s", args[0], tplExec.Template)
+		} else {
+			content, err := getTemplateText(args[0])
+			exitOn(err)
 
-		templ, err := template.Parse(string(content))
-		exitOn(err)
+			logger.Verbosef("Loaded template text:\n\n%s\n", removeComments(content))
 
-		extraParams, err := template.ParseParams(strings.Join(args[1:], " "))
-		exitOn(err)
+			tplExec.Template, err = template.Parse(string(content))
+			exitOnValidationError(tplExec, err)
 
-		tplExec := &template.TemplateExecution{
-			Template: templ,
-			Locale:   config.GetAWSRegion(),
-			Profile:  config.GetAWSProfile(),
-			Source:   templ.String(),
+			extraParams, err = template.ParseParams(strings.Join(args[1:], " "))
+			exitOnValidationError(tplExec, err)
 		}
 
-		exitOn(runTemplate(tplExec, config.Defaults, extraParams))
+		if len(skipStatementsFlag) > 0 || len(skipRefsFlag) > 0 {
+			tplExec.Template, err = skipStatements(tplExec.Template, skipStatementsFlag, skipRefsFlag)
+			exitOnValidationError(tplExec, err)
+		}
+		tplExec.Source = tplExec.Template.String()
+
+		if varsFileFlag != "" {
+			content, err := ioutil.ReadFile(varsFileFlag)
+			exitOn(err)
+			varFileParams, err = template.ParseVarFile(varsFileFlag, content)
+			exitOnValidationError(tplExec, err)
+			logger.ExtraVerbosef("loaded var file %s: %s", varsFileFlag, sprintProcessedParams(varFileParams))
+		}
+
+		exitOn(runTemplate(tplExec, 1, config.Defaults, varFileParams, extraParams))
 
 		return nil
 	},
 }
 
-func missingHolesStdinFunc() func(string) interface{} {
+func skipStatements(tpl *template.Template, positions []int, refs []string) (*template.Template, error) {
+	kept, skipped, err := tpl.Skip(positions, refs)
+	if err != nil {
+		return tpl, err
+	}
+	for _, s := range skipped {
+		if s.DependsOn != "" {
+			logger.Warningf("skipping statement %d '%s' as it depends on skipped '$%s'", s.Position, s.Statement, s.DependsOn)
+		} else {
+			logger.Infof("skipping statement %d '%s'", s.Position, s.Statement)
+		}
+	}
+	if len(kept.Statements) == 0 {
+		return kept, errors.New("all statements of the template are skipped")
+	}
+	return kept, nil
+}
+
+func writePlanGraph(path string, tpl *template.Template) error {
+	if path == "-" {
+		return tpl.WritePlanGraph(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = tpl.WritePlanGraph(f); err != nil {
+		return err
+	}
+	logger.Infof("plan graph of %d statement(s) written to %s", len(tpl.Statements), path)
+	return nil
+}
+
+func missingHolesStdinFunc(tplExec *template.TemplateExecution) func(string) interface{} {
 	var count int
 	return func(hole string) (response interface{}) {
+		if templateFromStdin {
+			exitOnValidationError(tplExec, fmt.Errorf("missing value for '%s': cannot prompt for it as the template is read from stdin, give it as an extra param (ex: %[1]s=value)", hole))
+		}
 		if count < 1 {
 			fmt.Println("Please specify (Ctrl+C to quit, Tab for completion):")
 		}
@@ -130,6 +218,15 @@ func missingHolesStdinFunc() func(string) interface{} {
 	}
 }
 
+// With a var file, the holes must all be given in the file or as extra params:
+// missing ones fail the run before anything is executed
+func missingHolesVarFileFunc(tplExec *template.TemplateExecution, filename string) func(string) interface{} {
+	return func(hole string) interface{} {
+		exitOnValidationError(tplExec, fmt.Errorf("missing value for '%s': give it in var file %s or as an extra param (ex: %[1]s=value)", hole, filename))
+		return nil
+	}
+}
+
 func askHole(hole string) (interface{}, error) {
 	l, err := readline.NewEx(&readline.Config{
 		Prompt:          fmt.Sprintf("%s? ", hole),
@@ -187,26 +284,44 @@ func (l *onceLoader) mustLoad() *graph.Graph {
 
 var allGraphsOnce = &onceLoader{}
 
-func runTemplate(tplExec *template.TemplateExecution, fillers ...map[string]interface{}) error {
+// runTemplate runs the template, up to concurrency statements at once when they do not depend on each other
+func runTemplate(tplExec *template.TemplateExecution, concurrency int, fillers ...map[string]interface{}) error {
 	env := template.NewEnv()
 	env.Log = logger.DefaultLogger
 	env.AddFillers(fillers...)
 	env.DefLookupFunc = awsdriver.AWSLookupDefinitions
 	env.AliasFunc = resolveAliasFunc
-	env.MissingHolesFunc = missingHolesStdinFunc()
+	env.MissingHolesFunc = missingHolesStdinFunc(tplExec)
+	if len(varFileParams) > 0 {
+		env.VarFileFillers = varFileParams
+		env.MissingHolesFunc = missingHolesVarFileFunc(tplExec, varsFileFlag)
+	}
+	env.Idempotent = idempotentFlag
+	env.IdempotencyKeysFunc = awsdriver.IdempotencyKeysFunc
+	env.ExistingResourcesFunc = existingResourcesFunc
+	env.ResourceExistsFunc = resourceExistsFunc
+	env.ParameterStoreFunc = parameterStoreFunc
+	if config.GetAutoTag() {
+		env.AutoTags = autoTags(tplExec)
+		env.AutoTagEntityFunc = awsdriver.AutoTagEntityFunc
+	}
 
 	if len(env.Fillers) > 0 {
 		logger.ExtraVerbosef("default/given holes fillers: %s", sprintProcessedParams(env.Fillers))
 	}
 
-	var err error
-	tplExec.Template, env, err = template.Compile(tplExec.Template, env)
-	exitOn(err)
+	compiled, env, err := template.Compile(tplExec.Template, env)
+	exitOnValidationError(tplExec, err)
+	tplExec.Template = compiled
 
 	tplExec.Fillers = env.GetProcessedFillers()
 
 	validateTemplate(tplExec.Template)
 
+	if planGraphFlag != "" {
+		return writePlanGraph(planGraphFlag, tplExec.Template)
+	}
+
 	var drivers []driver.Driver
 	for _, s := range cloud.ServiceRegistry {
 		drivers = append(drivers, s.Drivers()...)
@@ -214,8 +329,22 @@ func runTemplate(tplExec *template.TemplateExecution, fillers ...map[string]inte
 	awsDriver := driver.NewMultiDriver(drivers...)
 	awsDriver.SetLogger(logger.DefaultLogger)
 	env.Driver = awsDriver
+	env.RegionDriverFunc = func(region string) (driver.Driver, error) {
+		return awsservices.NewDriver(region, config.GetAWSProfile(), logger.DefaultLogger)
+	}
+
+	if checkPermissionsFlag {
+		return checkTemplatePermissions(os.Stdout, tplExec.Template, env, awsservices.AccessService.(*awsservices.Access).SimulatePermissions)
+	}
 
+	if detectChangesFlag {
+		return detectTemplateChanges(os.Stdout, tplExec.Template, env, fetchTemplateResources)
+	}
+
+	planned := tplExec.Template
+	dryRunStarted := time.Now()
 	if err = tplExec.Template.DryRun(env); err != nil {
+		reportRunResult(template.NewRunResult(tplExec, planned, nil, fmt.Errorf("dry run: %s", err), dryRunStarted, time.Now()))
 		switch t := err.(type) {
 		case *template.Errors:
 			errs, _ := t.Errors()
@@ -223,15 +352,34 @@ func runTemplate(tplExec *template.TemplateExecution, fillers ...map[string]inte
 				logger.Errorf(e.Error())
 			}
 		}
-		exitOn(errors.New("Dryrun failed"))
+		code := ExitValidation
+		if ExitCode(err) == ExitAuth {
+			code = ExitAuth
+		}
+		exitOn(withExitCode(code, errors.New("Dryrun failed")))
 	}
 
-	fmt.Printf("%s\n", renderGreenFn(tplExec.Template))
+	console.NewPlanSummary(tplExec.Template).Print(os.Stdout)
+	fmt.Println()
+	fmt.Printf("%s\n", renderGreenFn(logger.Redact(tplExec.Template.String())))
 
 	var yesorno string
 	if forceGlobalFlag {
 		yesorno = "y"
+	} else if templateFromStdin {
+		exitOnValidationError(tplExec, errors.New("cannot prompt for confirmation as the template is read from stdin: use --force flag"))
 	} else {
+		if hasUpdateStatements(tplExec.Template) {
+			logger.Verbose("update diff: fetching current values of updated resources")
+			changes, err := buildUpdateChanges(tplExec.Template, fetchTemplateResources(tplExec.Template))
+			if err == nil && len(changes) > 0 {
+				fmt.Println()
+				err = printUpdateChanges(os.Stdout, changes)
+			}
+			if err != nil {
+				logger.Warningf("cannot display changes of update statements: %s", err)
+			}
+		}
 		fmt.Println()
 		if isSchedulingMode() {
 			fmt.Print("Confirm scheduling? (y/n): ")
@@ -255,16 +403,35 @@ func runTemplate(tplExec *template.TemplateExecution, fillers ...map[string]inte
 			exitOn(scheduleTemplate(tplExec.Template, scheduleRunInFlag, scheduleRevertInFlag))
 			return nil
 		}
-		tplExec.Template, err = tplExec.Template.Run(env)
-		if err != nil {
-			logger.Errorf("Running template error: %s", err)
+
+		var before *graph.Graph
+		if showDiffFlag {
+			logger.Verbose("show diff: fetching resources before run")
+			before = fetchTemplateResources(tplExec.Template)
+		}
+
+		var runErr error
+		runStarted := time.Now()
+		tplExec.Template, runErr = tplExec.Template.RunConcurrently(env, concurrency)
+		if runErr != nil {
+			logger.Errorf("Running template error: %s", runErr)
 		}
+		reportRunResult(template.NewRunResult(tplExec, planned, tplExec.Template, runErr, runStarted, time.Now()))
 
 		printer := template.NewDefaultPrinter(os.Stdout)
 		printer.RenderKO = renderRedFn
 		printer.RenderOK = renderGreenFn
 		printer.Print(tplExec)
 
+		if showDiffFlag {
+			logger.Verbose("show diff: fetching resources after run")
+			after := fetchTemplateResources(tplExec.Template)
+			fmt.Println()
+			if err := printTouchedResourcesDiff(os.Stdout, tplExec.Template, before, after); err != nil {
+				logger.Errorf("Cannot display changes of touched resources: %s", err)
+			}
+		}
+
 		if err = database.Execute(func(db *database.DB) error {
 			return db.AddTemplate(tplExec)
 		}); err != nil {
@@ -277,11 +444,90 @@ func runTemplate(tplExec *template.TemplateExecution, fillers ...map[string]inte
 		}
 
 		runSyncFor(tplExec.Template)
+
+		if summary := console.NewRunSummary(tplExec); len(summary.Failures) > 0 || summary.Total() > 1 {
+			fmt.Println()
+			summary.Print(os.Stdout)
+		}
+
+		if runErr != nil || tplExec.Template.HasErrors() {
+			code := runFailureExitCode(tplExec.Template, runErr)
+			if code == ExitFailedDirty {
+				return withExitCode(code, errors.New("template run failed after applying changes"))
+			}
+			return withExitCode(code, errors.New("template run failed, no change applied"))
+		}
 	}
 
 	return nil
 }
 
+// exitOnValidationError reports a template failing before being run (parse, compile, missing
+// holes...) as a failed run, then exits with the validation exit code
+func exitOnValidationError(tplExec *template.TemplateExecution, err error) {
+	if err == nil {
+		return
+	}
+	now := time.Now()
+	reportRunResult(template.NewRunResult(tplExec, tplExec.Template, nil, err, now, now))
+	exitOn(withExitCode(ExitValidation, err))
+}
+
+// reportRunResult writes the run outcome to the --result-file and posts it to the
+// configured webhook. Neither failure fails the run
+func reportRunResult(result *template.RunResult) {
+	writeRunResultFile(result)
+	notifyRunWebhook(result)
+}
+
+const webhookTimeout = 10 * time.Second
+
+// notifyRunWebhook posts the run outcome to the 'notify.webhook.url' config, if set
+func notifyRunWebhook(result *template.RunResult) {
+	url := config.GetWebhookURL()
+	if url == "" {
+		return
+	}
+	client := &http.Client{Timeout: webhookTimeout}
+	if err := template.NotifyWebhook(client, url, config.GetWebhookSecret(), result); err != nil {
+		logger.Warningf("cannot notify run to webhook: %s", err)
+		return
+	}
+	logger.Verbose("run notified to webhook")
+}
+
+// writeRunResultFile writes the run outcome to the --result-file, if given
+func writeRunResultFile(result *template.RunResult) {
+	if resultFileFlag == "" {
+		return
+	}
+	b, err := json.MarshalIndent(result, "", " ")
+	if err == nil {
+		err = ioutil.WriteFile(resultFileFlag, b, 0600)
+	}
+	if err != nil {
+		logger.Errorf("Cannot write run result to %s: %s", resultFileFlag, err)
+		return
+	}
+	logger.Verbosef("run result written to %s", resultFileFlag)
+}
+
+// autoTags returns the tags of the resources created by the template, giving
+// the template its run id beforehand so that the tags carry it
+func autoTags(tplExec *template.TemplateExecution) map[string]string {
+	if tplExec.ID == "" {
+		tplExec.ID = template.NewRunID()
+	}
+	tags := map[string]string{
+		template.AutoTagRunIDKey:   tplExec.ID,
+		template.AutoTagCreatedKey: time.Now().UTC().Format(time.RFC3339),
+	}
+	if tplExec.Name != "" {
+		tags[template.AutoTagTemplateKey] = tplExec.Name
+	}
+	return tags
+}
+
 func validateTemplate(tpl *template.Template) {
 	unicityRule := &template.UniqueNameValidator{LookupGraph: func(key string) (*graph.Graph, bool) {
 		g := sync.LoadLocalGraphForService(awsservices.ServicePerResourceType[key], config.GetAWSRegion())
@@ -322,17 +568,17 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 			if !ok {
 				return invalidEntityErr
 			}
-			templ, err := suggestFixParsingError(templDef, args, invalidEntityErr)
-			exitOn(err)
-
 			tplExec := &template.TemplateExecution{
-				Template: templ,
-				Locale:   config.GetAWSRegion(),
-				Profile:  config.GetAWSProfile(),
-				Source:   templ.String(),
+				Locale:  config.GetAWSRegion(),
+				Profile: config.GetAWSProfile(),
+				Name:    fmt.Sprintf("%s %s", action, templDef.Entity),
 			}
+			var err error
+			tplExec.Template, err = suggestFixParsingError(templDef, args, invalidEntityErr)
+			exitOnValidationError(tplExec, err)
+			tplExec.Source = tplExec.Template.String()
 
-			exitOn(runTemplate(tplExec, config.Defaults))
+			exitOn(runTemplate(tplExec, 1, config.Defaults))
 			return nil
 		},
 	}
@@ -346,20 +592,20 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 			return func(cmd *cobra.Command, args []string) error {
 				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(args, " "))
 
-				templ, err := template.Parse(text)
-				if err != nil {
-					templ, err = suggestFixParsingError(def, args, err)
-					exitOn(err)
-				}
-
 				tplExec := &template.TemplateExecution{
-					Template: templ,
-					Locale:   config.GetAWSRegion(),
-					Profile:  config.GetAWSProfile(),
-					Source:   templ.String(),
+					Locale:  config.GetAWSRegion(),
+					Profile: config.GetAWSProfile(),
+					Name:    fmt.Sprintf("%s %s", def.Action, def.Entity),
+				}
+				var err error
+				tplExec.Template, err = template.Parse(text)
+				if err != nil {
+					tplExec.Template, err = suggestFixParsingError(def, args, err)
+					exitOnValidationError(tplExec, err)
 				}
+				tplExec.Source = tplExec.Template.String()
 
-				exitOn(runTemplate(tplExec, config.Defaults))
+				exitOn(runTemplate(tplExec, 1, config.Defaults))
 				return nil
 			}
 		}
@@ -414,16 +660,19 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 }
 
 func runSyncFor(tpl *template.Template) {
-	if !config.GetAutosync() {
-		return
-	}
-
 	defs := tpl.UniqueDefinitions(awsdriver.AWSLookupDefinitions)
 
 	services := awsservices.GetCloudServicesForAPIs(defs.Map(
 		func(d template.Definition) string { return d.Api },
 	)...)
 
+	if !config.GetAutosync() {
+		for _, srv := range services {
+			sync.DefaultFetchCache.Invalidate(srv)
+		}
+		return
+	}
+
 	if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
 		logger.ExtraVerbosef(err.Error())
 	} else {
@@ -442,26 +691,112 @@ func resolveAliasFunc(entity, key, alias string) string {
 		resType = entity
 	}
 
-	resources, err := gph.ResolveResources(&graph.And{Resolvers: []graph.Resolver{&graph.ByProperty{Key: "Name", Value: alias}, &graph.ByType{Typ: resType}}})
+	byType := &graph.ByType{Typ: resType}
+	resources, err := resolveByName(gph, alias, byType)
 	if err != nil {
 		return ""
 	}
 	switch len(resources) {
 	case 1:
 		return resources[0].Id()
-	default:
-		resources, err := gph.ResolveResources(&graph.And{Resolvers: []graph.Resolver{&graph.ByProperty{Key: "Name", Value: alias}}})
+	case 0:
+		resources, err := resolveByName(gph, alias)
 		if err != nil {
 			return ""
 		}
 		if len(resources) > 0 {
 			return resources[0].Id()
 		}
+		if suggestion := suggestClosestNames(gph, alias, byType); suggestion != "" {
+			logger.Info(suggestion)
+		}
+	default:
+		var candidates []string
+		for _, res := range resources {
+			candidates = append(candidates, fmt.Sprintf("%s (%s)", res.Id(), res.Properties[properties.Name]))
+		}
+		sort.Strings(candidates)
+		logger.Errorf("resolve alias '%s': %d %ss match, use one of their ids: %s", alias, len(resources), resType, strings.Join(candidates, ", "))
 	}
 
 	return ""
 }
 
+// Fetches live resources of the entity rather than using the local graph, which might be outdated
+func existingResourcesFunc(entity string, params map[string]interface{}) ([]string, error) {
+	srvName, ok := awsservices.ServicePerResourceType[entity]
+	if !ok {
+		return nil, fmt.Errorf("no service for entity '%s'", entity)
+	}
+	srv, ok := cloud.ServiceRegistry[srvName]
+	if !ok {
+		return nil, fmt.Errorf("service '%s' not initialized", srvName)
+	}
+	g, err := srv.FetchByType(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := []graph.Resolver{&graph.ByType{Typ: entity}}
+	for k, v := range params {
+		prop, ok := awsdriver.IdempotencyProperties[k]
+		if !ok {
+			return nil, fmt.Errorf("no resource property known to match param '%s'", k)
+		}
+		resolvers = append(resolvers, &graph.ByProperty{Key: prop, Value: fmt.Sprint(v)})
+	}
+	resources, err := g.ResolveResources(&graph.And{Resolvers: resolvers})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, r := range resources {
+		switch r.Properties[properties.State] {
+		case "terminated", "shutting-down", "deleting", "deleted":
+			continue
+		}
+		ids = append(ids, r.Id())
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func resourceExistsFunc(entity, id string) (bool, error) {
+	srvName, ok := awsservices.ServicePerResourceType[entity]
+	if !ok {
+		return false, fmt.Errorf("no service for entity '%s'", entity)
+	}
+	srv, ok := cloud.ServiceRegistry[srvName]
+	if !ok {
+		return false, fmt.Errorf("service '%s' not initialized", srvName)
+	}
+	g, err := srv.FetchByType(entity)
+	if err != nil {
+		return false, err
+	}
+	resources, err := g.ResolveResources(&graph.And{Resolvers: []graph.Resolver{&graph.ByType{Typ: entity}, &graph.ById{Id: id}}})
+	if err != nil {
+		return false, err
+	}
+	for _, r := range resources {
+		switch r.Properties[properties.State] {
+		case "terminated", "shutting-down", "deleting", "deleted":
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func parameterStoreFunc(names []string) (map[string]string, error) {
+	infra, ok := awsservices.InfraService.(*awsservices.Infra)
+	if !ok {
+		return nil, errors.New("infra service not initialized")
+	}
+	return infra.GetParameterValues(names)
+}
+
 func sprintProcessedParams(processed map[string]interface{}) string {
 	if len(processed) == 0 {
 		return "<none>"
@@ -489,16 +824,29 @@ const (
 
 type templateMetadata struct {
 	Title, Name, MinimalVersion string
-	Tags                        []string
+	// Description given with the '@description' header directive of the template
+	Description string
+	Tags        []string
 }
 
+// stdinTemplatePath is the PATH arg to read a template from stdin
+const stdinTemplatePath = "-"
+
+// templateFromStdin is set once stdin has been consumed reading the template,
+// after which nothing can be prompted to the user
+var templateFromStdin bool
+
 func getTemplateText(path string) (content []byte, err error) {
 	if strings.HasPrefix(path, "repo:") {
 		path = fmt.Sprintf("%s/%s", DEFAULT_REPO_PREFIX, strings.TrimPrefix(path[5:], "/"))
 		path = fmt.Sprintf("%s%s", strings.TrimSuffix(path, FILE_EXT), FILE_EXT)
 	}
 
-	if strings.HasPrefix(path, "http") {
+	if path == stdinTemplatePath {
+		logger.ExtraVerbosef("reading template from stdin")
+		templateFromStdin = true
+		content, err = ioutil.ReadAll(os.Stdin)
+	} else if strings.HasPrefix(path, "http") {
 		logger.ExtraVerbosef("fetching remote template at '%s'", path)
 		content, err = readHttpContent(path)
 	} else {
@@ -560,21 +908,49 @@ func listRemoteTemplates() error {
 	if err = json.Unmarshal(manifestFile, &remoteTemplates); err != nil {
 		return err
 	}
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "Title\tTags\tRun it with")
-	fmt.Fprintln(w, "-----\t----\t-----------")
+	var runnable []*templateMetadata
 	for _, tpl := range remoteTemplates {
 		if tpl.MinimalVersion == "" {
 			tpl.MinimalVersion = config.Version
 		}
 		if comp, err := config.CompareSemver(tpl.MinimalVersion, config.Version); comp < 1 && err == nil {
-			fmt.Fprintln(w, fmt.Sprintf("%s\t%s\tawless run repo:%s -v", tpl.Title, strings.Join(tpl.Tags, ","), tpl.Name))
+			runnable = append(runnable, tpl)
 		}
 	}
+	describeRemoteTemplates(runnable, readHttpContent)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Title\tDescription\tTags\tRun it with")
+	fmt.Fprintln(w, "-----\t-----------\t----\t-----------")
+	for _, tpl := range runnable {
+		fmt.Fprintln(w, fmt.Sprintf("%s\t%s\t%s\tawless run repo:%s -v", tpl.Title, tpl.Description, strings.Join(tpl.Tags, ","), tpl.Name))
+	}
 	w.Flush()
 	return nil
 }
 
+// describeRemoteTemplates fetches the remote templates to describe them with their '@description' header
+// directive. The description of the manifest is kept for the templates without one or failing to be fetched
+func describeRemoteTemplates(tpls []*templateMetadata, readContent func(path string) ([]byte, error)) {
+	var wg stdsync.WaitGroup
+	for _, tpl := range tpls {
+		wg.Add(1)
+		go func(tpl *templateMetadata) {
+			defer wg.Done()
+			path := fmt.Sprintf("%s/%s%s", DEFAULT_REPO_PREFIX, strings.TrimSuffix(tpl.Name, FILE_EXT), FILE_EXT)
+			content, err := readContent(path)
+			if err != nil {
+				logger.ExtraVerbosef("cannot describe template '%s': %s", tpl.Name, err)
+				return
+			}
+			if desc := template.Description(string(content)); desc != "" {
+				tpl.Description = desc
+			}
+		}(tpl)
+	}
+	wg.Wait()
+}
+
 func readHttpContent(path string) ([]byte, error) {
 	resp, err := http.Get(path)
 	if err != nil {
@@ -0,0 +1,192 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+)
+
+const (
+	changeNone     = "unchanged"
+	changeExpected = "change"
+	changeError    = "error"
+)
+
+// Actions not modifying any resource
+var readOnlyActions = map[string]bool{"check": true, "wait": true, "import": true}
+
+type statementChange struct {
+	statement      string
+	status, detail string
+}
+
+// changesDetectionDriver never runs the statements, returning a placeholder
+// result so that the statements referencing them can still be resolved
+type changesDetectionDriver struct {
+	driver.Driver
+}
+
+func (d *changesDetectionDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	if _, err := d.Driver.Lookup(lookups...); err != nil {
+		return nil, err
+	}
+	return func(driver.Context, map[string]interface{}) (interface{}, error) {
+		return fmt.Sprintf("unresolved-%s", lookups[len(lookups)-1]), nil
+	}, nil
+}
+
+// detectTemplateChanges reports whether running the template would change anything, without
+// running it. Create statements are matched against existing resources as with --idempotent,
+// update statements are compared to the current properties of the fetched resources and delete
+// statements to the existence of their resource. Other statements cannot be verified and count as changes.
+// Statements whose changes could not be detected are reported as errors, with their own exit code
+func detectTemplateChanges(w io.Writer, tpl *template.Template, env *template.Env, fetchResources func(*template.Template) *graph.Graph) error {
+	detector := &changesDetectionDriver{Driver: env.Driver}
+	env.Driver = detector
+	defer func() { env.Driver = detector.Driver }()
+
+	if regionDriverFunc := env.RegionDriverFunc; regionDriverFunc != nil {
+		env.RegionDriverFunc = func(region string) (driver.Driver, error) {
+			d, err := regionDriverFunc(region)
+			if err != nil {
+				return nil, err
+			}
+			return &changesDetectionDriver{Driver: d}, nil
+		}
+		defer func() { env.RegionDriverFunc = regionDriverFunc }()
+	}
+
+	idempotent := env.Idempotent
+	env.Idempotent = true
+	defer func() { env.Idempotent = idempotent }()
+
+	// a failing statement stops the run: it is reported with the statements left unevaluated
+	executed, runErr := tpl.Run(env)
+	if executed == nil {
+		return runErr
+	}
+
+	updates := make(map[string]*updateChange)
+	if hasUpdateStatements(executed) {
+		updateChanges, err := buildUpdateChanges(executed, fetchResources(executed))
+		if err != nil {
+			return err
+		}
+		for _, c := range updateChanges {
+			updates[c.statement] = c
+		}
+	}
+
+	var changes []*statementChange
+	var count, errCount int
+	evaluated := executed.CommandNodesIterator()
+	for i, original := range tpl.CommandNodesIterator() {
+		change := &statementChange{statement: original.String(), status: changeExpected}
+		if i >= len(evaluated) {
+			change.status, change.detail = changeError, "not evaluated after a previous error"
+			errCount++
+			changes = append(changes, change)
+			continue
+		}
+		cmd := evaluated[i]
+		switch {
+		case runErr != nil && i == len(evaluated)-1:
+			change.status, change.detail = changeError, runErr.Error()
+		case cmd.CmdErr != nil:
+			change.status, change.detail = changeError, cmd.CmdErr.Error()
+		case cmd.CmdSkipped:
+			change.status, change.detail = changeNone, fmt.Sprintf("existing %s %v", cmd.Entity, cmd.CmdResult)
+		case readOnlyActions[cmd.Action]:
+			change.status, change.detail = changeNone, "read only"
+		case cmd.Action == "create":
+			change.detail = fmt.Sprintf("would create %s", cmd.Entity)
+		case cmd.Action == "update":
+			change.detail = updateChangeDetail(updates[cmd.String()])
+			if c, ok := updates[cmd.String()]; ok && c.isNoop() {
+				change.status = changeNone
+			}
+		case cmd.Action == "delete":
+			change.detail = fmt.Sprintf("would delete %s", cmd.Entity)
+			if id, ok := cmd.Params["id"].(string); ok && env.ResourceExistsFunc != nil {
+				if exists, err := env.ResourceExistsFunc(cmd.Entity, id); err != nil {
+					change.status, change.detail = changeError, err.Error()
+				} else if !exists {
+					change.status, change.detail = changeNone, fmt.Sprintf("%s %s already deleted", cmd.Entity, id)
+				}
+			}
+		default:
+			change.detail = fmt.Sprintf("would %s %s (cannot be verified)", cmd.Action, cmd.Entity)
+		}
+		switch change.status {
+		case changeExpected:
+			count++
+		case changeError:
+			errCount++
+		}
+		changes = append(changes, change)
+	}
+
+	printStatementChanges(w, changes)
+
+	if errCount > 0 {
+		return withExitCode(ExitUnverified, fmt.Errorf("%d statement(s) could not be checked, %d would change resources", errCount, count))
+	}
+	if count > 0 {
+		return withExitCode(ExitChanges, fmt.Errorf("%d statement(s) would change resources", count))
+	}
+	return nil
+}
+
+func updateChangeDetail(c *updateChange) string {
+	if c == nil {
+		return "would update, current values unknown"
+	}
+	if c.isNoop() {
+		return fmt.Sprintf("%s %s already has the given %s", c.before.Type(), c.before.Id(), strings.Join(c.params, ", "))
+	}
+	var diffs []string
+	for _, p := range c.params {
+		prop, _ := updateParamProperty(c.after.Type(), p)
+		if before, after := fmt.Sprint(c.before.Properties[prop]), fmt.Sprint(c.after.Properties[prop]); before != after {
+			diffs = append(diffs, fmt.Sprintf("%s: %s -> %s", p, before, after))
+		}
+	}
+	return fmt.Sprintf("would update %s", strings.Join(diffs, ", "))
+}
+
+func printStatementChanges(w io.Writer, changes []*statementChange) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, c := range changes {
+		status := c.status
+		switch status {
+		case changeNone:
+			status = renderGreenFn(status)
+		case changeExpected, changeError:
+			status = renderRedFn(status)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t(%s)\n", logger.Redact(c.statement), status, logger.Redact(c.detail))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestDetectTemplateChanges(t *testing.T) {
+	env := template.NewEnv()
+	env.Log = logger.DiscardLogger
+	env.Driver = &permissionsMockDriver{}
+	env.IdempotencyKeysFunc = func(entity string) []string {
+		if entity == "vpc" || entity == "subnet" {
+			return []string{"name"}
+		}
+		return nil
+	}
+	env.ExistingResourcesFunc = func(entity string, params map[string]interface{}) ([]string, error) {
+		if entity == "vpc" && params["name"] == "main" {
+			return []string{"vpc_1"}, nil
+		}
+		if entity == "vpc" && params["name"] == "broken" {
+			return nil, errors.New("cannot list vpcs")
+		}
+		return nil, nil
+	}
+	env.ResourceExistsFunc = func(entity, id string) (bool, error) {
+		return id == "inst_2", nil
+	}
+	fetch := func(*template.Template) *graph.Graph {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Instance("inst_1").Prop(p.Type, "t2.micro").Build())
+		return g
+	}
+
+	t.Run("converged", func(t *testing.T) {
+		tpl := template.MustParse("vpc = create vpc name=main cidr=10.0.0.0/16\nupdate instance id=inst_1 type=t2.micro\ndelete instance id=inst_gone\ncheck instance id=inst_1 state=running timeout=10")
+		var w bytes.Buffer
+		if err := detectTemplateChanges(&w, tpl, env, fetch); err != nil {
+			t.Fatalf("%s\n%s", err, w.String())
+		}
+		for _, exp := range []string{"existing vpc vpc_1", "instance inst_1 already has the given type", "instance inst_gone already deleted", "read only"} {
+			if !strings.Contains(w.String(), exp) {
+				t.Fatalf("expected '%s' in\n%s", exp, w.String())
+			}
+		}
+		if env.Idempotent {
+			t.Fatal("expected idempotent mode restored")
+		}
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		tpl := template.MustParse("vpc = create vpc name=main cidr=10.0.0.0/16\nsub = create subnet name=new vpc=$vpc cidr=10.0.0.0/24\nupdate instance id=inst_1 type=t2.large\ndelete instance id=inst_2\nattach volume id=vol_1 instance=inst_1")
+		var w bytes.Buffer
+		err := detectTemplateChanges(&w, tpl, env, fetch)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if got, want := ExitCode(err), ExitChanges; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := err.Error(), "4 statement(s) would change resources"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+		expected := []struct{ statement, status, detail string }{
+			{"create vpc", changeNone, "existing vpc vpc_1"},
+			{"create subnet", changeExpected, "would create subnet"},
+			{"update instance", changeExpected, "would update type: t2.micro -> t2.large"},
+			{"delete instance", changeExpected, "would delete instance"},
+			{"attach volume", changeExpected, "would attach volume (cannot be verified)"},
+		}
+		if got, want := len(lines), len(expected); got != want {
+			t.Fatalf("got %d, want %d lines in\n%s", got, want, w.String())
+		}
+		for i, exp := range expected {
+			if !strings.Contains(lines[i], exp.statement) || !strings.Contains(lines[i], exp.status) || !strings.Contains(lines[i], exp.detail) {
+				t.Fatalf("line %d: expected '%s' %s (%s), got '%s'", i, exp.statement, exp.status, exp.detail, lines[i])
+			}
+		}
+	})
+	t.Run("errors", func(t *testing.T) {
+		logger.SetRedactedKeys("password")
+		defer logger.SetRedactedKeys()
+
+		tpl := template.MustParse("create user name=bob password=hunter2\nvpc = create vpc name=broken cidr=10.0.0.0/16\ncreate subnet name=new vpc=$vpc cidr=10.0.0.0/24")
+		var w bytes.Buffer
+		err := detectTemplateChanges(&w, tpl, env, fetch)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if got, want := ExitCode(err), ExitUnverified; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := err.Error(), "2 statement(s) could not be checked, 1 would change resources"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+		expected := []struct{ statement, status, detail string }{
+			{"create user", changeExpected, "would create user"},
+			{"create vpc", changeError, "cannot list vpcs"},
+			{"create subnet", changeError, "not evaluated after a previous error"},
+		}
+		if got, want := len(lines), len(expected); got != want {
+			t.Fatalf("got %d, want %d lines in\n%s", got, want, w.String())
+		}
+		for i, exp := range expected {
+			if !strings.Contains(lines[i], exp.statement) || !strings.Contains(lines[i], exp.status) || !strings.Contains(lines[i], exp.detail) {
+				t.Fatalf("line %d: expected '%s' %s (%s), got '%s'", i, exp.statement, exp.status, exp.detail, lines[i])
+			}
+		}
+		if strings.Contains(w.String(), "hunter2") {
+			t.Fatalf("expected password redacted in\n%s", w.String())
+		}
+	})
+	t.Run("imports and other regions", func(t *testing.T) {
+		tpl := template.MustParse("import instance id=inst_2 as @web\ncheck instance id=@web state=running timeout=10\ncreate subnet name=other cidr=10.0.0.0/24 vpc=vpc_1 region=us-east-1")
+		regionDriver := &permissionsMockDriver{}
+		env.RegionDriverFunc = func(string) (driver.Driver, error) { return regionDriver, nil }
+		defer func() { env.RegionDriverFunc = nil }()
+
+		var w bytes.Buffer
+		err := detectTemplateChanges(&w, tpl, env, fetch)
+		if got, want := fmt.Sprint(err), "1 statement(s) would change resources"; got != want {
+			t.Fatalf("got %s, want %s\n%s", got, want, w.String())
+		}
+		if got := regionDriver.realRuns; got != 0 {
+			t.Fatalf("got %d statement(s) run in other region", got)
+		}
+		if !strings.Contains(w.String(), "would create subnet") {
+			t.Fatalf("expected subnet creation in\n%s", w.String())
+		}
+	})
+}
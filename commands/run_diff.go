@@ -0,0 +1,145 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+// fetchTemplateResources fetches from the cloud the resources of the types
+// a template runs against. Unlike a sync, nothing is stored locally
+func fetchTemplateResources(tpl *template.Template) *graph.Graph {
+	g := graph.NewGraph()
+	for _, typ := range templateResourceTypes(tpl) {
+		for _, srv := range awsservices.GetCloudServicesForTypes(typ) {
+			srvGraph, err := srv.FetchByType(typ)
+			if err != nil {
+				logger.Warningf("show diff: fetching %s resources: %s", typ, err)
+			}
+			if srvGraph != nil {
+				g.AddGraph(srvGraph)
+			}
+		}
+	}
+	return g
+}
+
+// templateResourceTypes returns the entities of the template commands
+func templateResourceTypes(tpl *template.Template) (types []string) {
+	unique := make(map[string]struct{})
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if _, done := unique[cmd.Entity]; !done {
+			unique[cmd.Entity] = struct{}{}
+			types = append(types, cmd.Entity)
+		}
+	}
+	sort.Strings(types)
+	return
+}
+
+// touchedResourceIds returns the values of the params and the results of the
+// template commands: the ids of the resources touched by a run are among them
+func touchedResourceIds(tpl *template.Template) (ids []string) {
+	unique := make(map[string]struct{})
+	add := func(v interface{}) {
+		switch vv := v.(type) {
+		case string:
+			if vv != "" {
+				unique[vv] = struct{}{}
+			}
+		case []string:
+			for _, s := range vv {
+				unique[s] = struct{}{}
+			}
+		case []interface{}:
+			for _, s := range vv {
+				if str, ok := s.(string); ok {
+					unique[str] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, cmd := range tpl.CommandNodesIterator() {
+		for _, v := range cmd.Params {
+			add(v)
+		}
+		add(cmd.CmdResult)
+	}
+
+	for id := range unique {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return
+}
+
+// buildTouchedResourcesDiff restricts the before and after graphs
+// to the given resources then diffs them
+func buildTouchedResourcesDiff(root *graph.Resource, before, after *graph.Graph, ids []string) (*graph.Diff, error) {
+	from, to := graph.NewGraph(), graph.NewGraph()
+	from.AddResource(root)
+	to.AddResource(root)
+
+	for _, id := range ids {
+		for _, gs := range [][2]*graph.Graph{{before, from}, {after, to}} {
+			res, err := gs[0].FindResource(id)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				continue
+			}
+			if err = gs[1].AddResource(res); err != nil {
+				return nil, err
+			}
+			if err = gs[1].AddParentRelation(root, res); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return graph.DefaultDiffer.Run(root.Id(), from, to)
+}
+
+func printTouchedResourcesDiff(w io.Writer, tpl *template.Template, before, after *graph.Graph) error {
+	root := graph.InitResource(cloud.Region, config.GetAWSRegion())
+	diff, err := buildTouchedResourcesDiff(root, before, after, touchedResourceIds(tpl))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "▶ changes on resources touched by the run")
+	displayer, err := console.BuildOptions(
+		console.WithFormat("table"),
+		console.WithRootNode(root),
+	).SetSource(diff).Build()
+	if err != nil {
+		return err
+	}
+	return displayer.Print(w)
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+func TestTouchedResourcesDiff(t *testing.T) {
+	tpl := template.MustParse("stop instance id=inst_1\ncreate subnet cidr=10.0.0.0/24 vpc=vpc_1\ndelete keypair id=kp_1")
+	for i, cmd := range tpl.CommandNodesIterator() {
+		if i == 1 {
+			cmd.CmdResult = "sub_1"
+		}
+	}
+
+	if got, want := touchedResourceIds(tpl), []string{"10.0.0.0/24", "inst_1", "kp_1", "sub_1", "vpc_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := templateResourceTypes(tpl), []string{"instance", "keypair", "subnet"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	before := graph.NewGraph()
+	before.AddResource(
+		resourcetest.Instance("inst_1").Prop(p.State, "running").Build(),
+		resourcetest.Instance("inst_2").Prop(p.State, "running").Build(),
+		resourcetest.VPC("vpc_1").Prop(p.Name, "main").Build(),
+		resourcetest.KeyPair("kp_1").Build(),
+	)
+	after := graph.NewGraph()
+	after.AddResource(
+		resourcetest.Instance("inst_1").Prop(p.State, "stopped").Build(),
+		resourcetest.Instance("inst_2").Prop(p.State, "stopped").Build(),
+		resourcetest.VPC("vpc_1").Prop(p.Name, "main").Build(),
+		resourcetest.Subnet("sub_1").Prop(p.CIDR, "10.0.0.0/24").Build(),
+	)
+
+	root := graph.InitResource(cloud.Region, "eu-west-1")
+	diff, err := buildTouchedResourcesDiff(root, before, after, touchedResourceIds(tpl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasDiff() {
+		t.Fatal("expected diff")
+	}
+
+	displayer, err := console.BuildOptions(console.WithFormat("table"), console.WithRootNode(root)).SetSource(diff).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w bytes.Buffer
+	if err = displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	for _, exp := range []string{"+\u00a0sub_1", "-\u00a0kp_1", "+\u00a0stopped", "-\u00a0running"} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("expected '%s' in\n%s", exp, out)
+		}
+	}
+	for _, unexp := range []string{"inst_2", "vpc_1"} {
+		if strings.Contains(out, unexp) {
+			t.Fatalf("unexpected '%s' in\n%s", unexp, out)
+		}
+	}
+}
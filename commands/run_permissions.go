@@ -0,0 +1,197 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+)
+
+const (
+	permissionAllowed   = "allowed"
+	permissionDenied    = "denied"
+	permissionUnchecked = "unchecked"
+	permissionError     = "error"
+)
+
+type statementPermission struct {
+	defName, statement string
+	status, detail     string
+	dryRunErr          error
+}
+
+// permissionsCheckDriver records the dry run result of each statement, keyed by
+// statement (see statementKey), and never fails so that all the statements of a
+// template get checked
+type permissionsCheckDriver struct {
+	driver.Driver
+	checks map[string][]*statementPermission
+}
+
+func (d *permissionsCheckDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	fn, err := d.Driver.Lookup(lookups...)
+	if err != nil {
+		return nil, err
+	}
+	defName := strings.Join(lookups, "")
+	return func(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+		check := &statementPermission{defName: defName}
+		key := statementKey(defName, params)
+		d.checks[key] = append(d.checks[key], check)
+		res, err := fn(ctx, params)
+		if err != nil {
+			check.dryRunErr = err
+			return fmt.Sprintf("unresolved-%s", lookups[len(lookups)-1]), nil
+		}
+		return res, nil
+	}, nil
+}
+
+// statementKey identifies a statement run by a driver with its definition and params,
+// the region meta-param of a statement not being given to the driver
+func statementKey(defName string, params map[string]interface{}) string {
+	var all []string
+	for k, v := range params {
+		if k == template.RegionMetaParam {
+			continue
+		}
+		all = append(all, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(all)
+	return fmt.Sprintf("%s %s", defName, strings.Join(all, " "))
+}
+
+// checkTemplatePermissions dry runs the template statements and reports whether the
+// current credentials are allowed to run them. Statements with an AWS API supporting
+// DryRun are checked against it, others are checked with IAM policy simulation
+func checkTemplatePermissions(w io.Writer, tpl *template.Template, env *template.Env, simulate func([]string) (map[string]bool, error)) error {
+	checker := &permissionsCheckDriver{Driver: env.Driver, checks: make(map[string][]*statementPermission)}
+	env.Driver = checker
+	defer func() { env.Driver = checker.Driver }()
+
+	if regionDriverFunc := env.RegionDriverFunc; regionDriverFunc != nil {
+		env.RegionDriverFunc = func(region string) (driver.Driver, error) {
+			d, err := regionDriverFunc(region)
+			if err != nil {
+				return nil, err
+			}
+			return &permissionsCheckDriver{Driver: d, checks: checker.checks}, nil
+		}
+		defer func() { env.RegionDriverFunc = regionDriverFunc }()
+	}
+
+	env.SetDryRun(true)
+	defer env.SetDryRun(false)
+
+	executed, err := tpl.Run(env)
+	if err != nil {
+		return err
+	}
+
+	var checks []*statementPermission
+	original := tpl.CommandNodesIterator()
+	for i, cmd := range executed.CommandNodesIterator() {
+		// skipped and imported statements are not run by the driver
+		key := statementKey(cmd.Action+cmd.Entity, cmd.Params)
+		recorded := checker.checks[key]
+		if len(recorded) == 0 {
+			continue
+		}
+		check := recorded[0]
+		checker.checks[key] = recorded[1:]
+		check.statement = original[i].String()
+		checks = append(checks, check)
+	}
+
+	var toSimulate []string
+	for _, check := range checks {
+		action, hasAction := awsdriver.IAMActionPerTemplateDefName[check.defName]
+		switch {
+		case awsdriver.APIDryRunTemplateDefNames[check.defName]:
+			switch {
+			case check.dryRunErr == nil:
+				check.status, check.detail = permissionAllowed, "API dry run"
+			case isAuthError(check.dryRunErr):
+				check.status, check.detail = permissionDenied, check.dryRunErr.Error()
+			default:
+				check.status, check.detail = permissionError, check.dryRunErr.Error()
+			}
+		case check.dryRunErr != nil:
+			check.status, check.detail = permissionError, check.dryRunErr.Error()
+		case hasAction:
+			toSimulate = append(toSimulate, action)
+		default:
+			check.status, check.detail = permissionUnchecked, "no API supporting dry run"
+		}
+	}
+
+	var allowed map[string]bool
+	var simulateErr error
+	if len(toSimulate) > 0 {
+		if allowed, simulateErr = simulate(toSimulate); simulateErr != nil {
+			logger.Warningf("cannot simulate IAM policies: %s", simulateErr)
+		}
+	}
+
+	var denied int
+	for _, check := range checks {
+		if check.status == "" {
+			action := awsdriver.IAMActionPerTemplateDefName[check.defName]
+			switch {
+			case simulateErr != nil:
+				check.status, check.detail = permissionUnchecked, fmt.Sprintf("IAM policy simulation of %s failed", action)
+			case allowed[action]:
+				check.status, check.detail = permissionAllowed, fmt.Sprintf("IAM policy simulation of %s", action)
+			default:
+				check.status, check.detail = permissionDenied, fmt.Sprintf("IAM policy simulation of %s", action)
+			}
+		}
+		if check.status == permissionDenied {
+			denied++
+		}
+	}
+
+	printStatementPermissions(w, checks)
+
+	if denied > 0 {
+		return withExitCode(ExitAuth, fmt.Errorf("%d statement(s) would be denied", denied))
+	}
+	return nil
+}
+
+func printStatementPermissions(w io.Writer, checks []*statementPermission) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, check := range checks {
+		status := check.status
+		switch status {
+		case permissionAllowed:
+			status = renderGreenFn(status)
+		case permissionDenied, permissionError:
+			status = renderRedFn(status)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t(%s)\n", check.statement, status, check.detail)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+)
+
+type permissionsMockDriver struct {
+	errs     map[string]error
+	dryRun   bool
+	realRuns int
+}
+
+func (d *permissionsMockDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	name := strings.Join(lookups, "")
+	return func(driver.Context, map[string]interface{}) (interface{}, error) {
+		if !d.dryRun {
+			d.realRuns++
+		}
+		if err, ok := d.errs[name]; ok {
+			return nil, err
+		}
+		return name + "_id", nil
+	}, nil
+}
+func (d *permissionsMockDriver) SetDryRun(dry bool)       { d.dryRun = dry }
+func (d *permissionsMockDriver) SetLogger(*logger.Logger) {}
+
+func TestCheckTemplatePermissions(t *testing.T) {
+	tpl := template.MustParse("inst = create instance name=web image=ami-12 count=1 type=t2.micro subnet=sub_1\ncreate vpc cidr=10.0.0.0/16\ncheck instance id=$inst state=running timeout=10\ncreate bucket name=logs\ncreate user name=bob\ncreate subnet cidr=10.0.0.0/24 vpc=vpc_1")
+
+	env := template.NewEnv()
+	env.Log = logger.DiscardLogger
+	env.Driver = &permissionsMockDriver{errs: map[string]error{
+		"createinstance": errors.New("dry run: create instance: UnauthorizedOperation: You are not authorized to perform this operation"),
+		"createsubnet":   errors.New("dry run: create subnet: InvalidVpcID.Malformed: invalid vpc"),
+	}}
+
+	var simulated []string
+	simulate := func(actions []string) (map[string]bool, error) {
+		simulated = actions
+		return map[string]bool{"s3:CreateBucket": true}, nil
+	}
+
+	var w bytes.Buffer
+	err := checkTemplatePermissions(&w, tpl, env, simulate)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := ExitCode(err), ExitAuth; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := strings.Join(simulated, ","), "s3:CreateBucket,iam:CreateUser"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+	if got, want := len(lines), 6; got != want {
+		t.Fatalf("got %d, want %d lines in\n%s", got, want, w.String())
+	}
+	expected := []struct{ statement, status string }{
+		{"create instance", "denied"},
+		{"create vpc", "allowed"},
+		{"check instance", "unchecked"},
+		{"create bucket", "allowed"},
+		{"create user", "denied"},
+		{"create subnet", "error"},
+	}
+	for i, exp := range expected {
+		if !strings.Contains(lines[i], exp.statement) || !strings.Contains(lines[i], exp.status) {
+			t.Fatalf("line %d: expected '%s' %s, got '%s'", i, exp.statement, exp.status, lines[i])
+		}
+	}
+}
+
+func TestCheckTemplatePermissionsWithImport(t *testing.T) {
+	tpl := template.MustParse("import vpc id=vpc-1 as @vpc\ncreate subnet cidr=10.0.0.0/24 vpc=@vpc\ncreate bucket name=logs")
+
+	env := template.NewEnv()
+	env.Log = logger.DiscardLogger
+	env.ResourceExistsFunc = func(string, string) (bool, error) { return true, nil }
+	env.Driver = &permissionsMockDriver{errs: map[string]error{
+		"createsubnet": errors.New("dry run: create subnet: UnauthorizedOperation: You are not authorized to perform this operation"),
+	}}
+	simulate := func(actions []string) (map[string]bool, error) {
+		return map[string]bool{"s3:CreateBucket": true}, nil
+	}
+
+	var w bytes.Buffer
+	if err := checkTemplatePermissions(&w, tpl, env, simulate); ExitCode(err) != ExitAuth {
+		t.Fatalf("got %v, want auth error", err)
+	}
+	lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d, want %d lines in\n%s", got, want, w.String())
+	}
+	if !strings.Contains(lines[0], "create subnet") || !strings.Contains(lines[0], "denied") {
+		t.Fatalf("got '%s', want create subnet denied", lines[0])
+	}
+	if !strings.Contains(lines[1], "create bucket") || !strings.Contains(lines[1], "allowed") {
+		t.Fatalf("got '%s', want create bucket allowed", lines[1])
+	}
+}
+
+func TestCheckTemplatePermissionsInOtherRegion(t *testing.T) {
+	tpl := template.MustParse("create vpc cidr=10.0.0.0/16\ncreate bucket name=logs region=us-east-1")
+
+	env := template.NewEnv()
+	env.Log = logger.DiscardLogger
+	env.Driver = &permissionsMockDriver{}
+	regionDriver := &permissionsMockDriver{errs: map[string]error{
+		"createbucket": errors.New("dry run: create bucket: AccessDenied: not authorized"),
+	}}
+	env.RegionDriverFunc = func(region string) (driver.Driver, error) { return regionDriver, nil }
+	simulate := func(actions []string) (map[string]bool, error) { return nil, nil }
+
+	var w bytes.Buffer
+	checkTemplatePermissions(&w, tpl, env, simulate)
+
+	if got := env.Driver.(*permissionsMockDriver).realRuns + regionDriver.realRuns; got != 0 {
+		t.Fatalf("got %d statement(s) run out of dry run", got)
+	}
+	lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d, want %d lines in\n%s", got, want, w.String())
+	}
+	if !strings.Contains(lines[1], "create bucket") {
+		t.Fatalf("got '%s', want create bucket", lines[1])
+	}
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGetTemplateTextFromStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := os.Stdin
+	defer func() {
+		os.Stdin = stdin
+		templateFromStdin = false
+	}()
+	os.Stdin = r
+
+	text := "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc\n"
+	if _, err = w.WriteString(text); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	content, err := getTemplateText("-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), text; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if !templateFromStdin {
+		t.Fatal("expected template to be flagged as read from stdin")
+	}
+}
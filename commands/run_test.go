@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestAutoTags(t *testing.T) {
+	tplExec := &template.TemplateExecution{Template: template.MustParse("create vpc cidr=10.0.0.0/16"), Name: "infra.aws"}
+
+	tags := autoTags(tplExec)
+
+	if got := tags[template.AutoTagRunIDKey]; got == "" || got != tplExec.ID {
+		t.Fatalf("got run id tag '%s', want the template run id '%s'", got, tplExec.ID)
+	}
+	if got, want := tags[template.AutoTagTemplateKey], "infra.aws"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestDescribeRemoteTemplates(t *testing.T) {
+	contents := map[string]string{
+		"subnet.aws":   "# @description Create a subnet\n# @description in a VPC\ncreate subnet cidr={subnet.cidr} vpc={subnet.vpc}",
+		"instance.aws": "create instance subnet={instance.subnet}",
+	}
+	readContent := func(path string) ([]byte, error) {
+		content, ok := contents[strings.TrimPrefix(path, DEFAULT_REPO_PREFIX+"/")]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return []byte(content), nil
+	}
+	tpls := []*templateMetadata{
+		{Name: "subnet", Description: "from manifest"},
+		{Name: "instance", Description: "instance from manifest"},
+		{Name: "missing", Description: "missing from manifest"},
+	}
+
+	describeRemoteTemplates(tpls, readContent)
+
+	var descs []string
+	for _, tpl := range tpls {
+		descs = append(descs, tpl.Description)
+	}
+	if got, want := strings.Join(descs, "|"), "Create a subnet in a VPC|instance from manifest|missing from manifest"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
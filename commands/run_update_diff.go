@@ -0,0 +1,198 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template"
+)
+
+// Properties changed by update params whose names differ from them (case and dashes aside)
+var updateParamProperties = map[string]map[string]string{
+	cloud.TargetGroup: {
+		"healthcheckinterval": properties.CheckInterval,
+		"healthcheckpath":     properties.CheckPath,
+		"healthcheckport":     properties.CheckPort,
+		"healthcheckprotocol": properties.CheckProtocol,
+		"healthchecktimeout":  properties.CheckTimeout,
+	},
+	cloud.ScalingGroup: {
+		"cooldown":            properties.DefaultCooldown,
+		"launchconfiguration": properties.LaunchConfigurationName,
+	},
+	cloud.Distribution: {"enable": properties.Enabled},
+}
+
+// updateChange is what an update statement changes on an existing resource
+type updateChange struct {
+	statement     string
+	before, after *graph.Resource
+	params        []string
+}
+
+func (c *updateChange) isNoop() bool {
+	for prop, v := range c.after.Properties {
+		if fmt.Sprint(c.before.Properties[prop]) != fmt.Sprint(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasUpdateStatements(tpl *template.Template) bool {
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if cmd.Action == "update" {
+			return true
+		}
+	}
+	return false
+}
+
+// buildUpdateChanges returns the current and target values of the properties changed by
+// the update statements of the template, on the resources found in the given graph.
+// Params not matching a property are left out
+func buildUpdateChanges(tpl *template.Template, g *graph.Graph) ([]*updateChange, error) {
+	var changes []*updateChange
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if cmd.Action != "update" {
+			continue
+		}
+		res, idParam, err := findUpdatedResource(g, cmd.Entity, cmd.Params)
+		if err != nil {
+			return changes, err
+		}
+		if res == nil {
+			continue
+		}
+		change := &updateChange{
+			statement: cmd.String(),
+			before:    graph.InitResource(res.Type(), res.Id()),
+			after:     graph.InitResource(res.Type(), res.Id()),
+		}
+		for param, value := range cmd.Params {
+			if param == idParam {
+				continue
+			}
+			prop, ok := updateParamProperty(cmd.Entity, param)
+			if !ok {
+				continue
+			}
+			change.params = append(change.params, param)
+			if current, ok := res.Properties[prop]; ok {
+				change.before.Properties[prop] = current
+			}
+			change.after.Properties[prop] = value
+		}
+		if len(change.params) > 0 {
+			sort.Strings(change.params)
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// findUpdatedResource finds the resource of an update statement given its 'id' param,
+// or its 'name' param for the entities identified by name
+func findUpdatedResource(g *graph.Graph, entity string, params map[string]interface{}) (*graph.Resource, string, error) {
+	if id, ok := params["id"].(string); ok {
+		res, err := g.FindResource(id)
+		return res, "id", err
+	}
+	name, ok := params["name"].(string)
+	if !ok {
+		return nil, "", nil
+	}
+	resources, err := g.FindResourcesByProperty(properties.Name, name)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, res := range resources {
+		if res.Type() == entity {
+			return res, "name", nil
+		}
+	}
+	res, err := g.FindResource(name)
+	if res != nil && res.Type() != entity {
+		res = nil
+	}
+	return res, "name", err
+}
+
+func updateParamProperty(entity, param string) (string, bool) {
+	if prop, ok := updateParamProperties[entity][param]; ok {
+		return prop, true
+	}
+	name := strings.Replace(param, "-", "", -1)
+	for label := range rdf.Labels {
+		if strings.EqualFold(label, name) {
+			return label, true
+		}
+	}
+	return "", false
+}
+
+func printUpdateChanges(w io.Writer, changes []*updateChange) error {
+	for _, c := range changes {
+		if c.isNoop() {
+			fmt.Fprintf(w, "▶ no-op '%s': %s %s already has the given %s\n", c.statement, c.before.Type(), c.before.Id(), strings.Join(c.params, ", "))
+			continue
+		}
+		root := graph.InitResource(cloud.Region, config.GetAWSRegion())
+		from, err := regionGraphWith(root, c.before)
+		if err != nil {
+			return err
+		}
+		to, err := regionGraphWith(root, c.after)
+		if err != nil {
+			return err
+		}
+		diff, err := graph.DefaultDiffer.Run(root.Id(), from, to)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "▶ changes of '%s'\n", c.statement)
+		displayer, err := console.BuildOptions(
+			console.WithFormat("table"),
+			console.WithRootNode(root),
+		).SetSource(diff).Build()
+		if err != nil {
+			return err
+		}
+		if err = displayer.Print(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func regionGraphWith(root, res *graph.Resource) (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if err := g.AddResource(root, res); err != nil {
+		return g, err
+	}
+	return g, g.AddParentRelation(root, res)
+}
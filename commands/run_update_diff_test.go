@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/template"
+)
+
+func TestUpdateChanges(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Prop(p.Type, "t2.micro").Build(),
+		resourcetest.Subnet("sub_1").Prop(p.Public, true).Build(),
+		resourcetest.ScalingGroup("asg_arn_1").Prop(p.Name, "web").Prop(p.MaxSize, 2).Prop(p.DefaultCooldown, 300).Build(),
+	)
+	tpl := template.MustParse("update instance id=inst_1 type=t2.large\nupdate subnet id=sub_1 public=true\nupdate scalinggroup name=web max-size=4 cooldown=300\nupdate instance id=inst_unknown type=t2.large\ncreate keypair name=mykey")
+
+	changes, err := buildUpdateChanges(tpl, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(changes), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := strings.Join(changes[2].params, ","), "cooldown,max-size"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	for i, noop := range []bool{false, true, false} {
+		if got, want := changes[i].isNoop(), noop; got != want {
+			t.Fatalf("%d: got %t, want %t", i, got, want)
+		}
+	}
+
+	var w bytes.Buffer
+	if err = printUpdateChanges(&w, changes); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	for _, exp := range []string{"- t2.micro", "+ t2.large", "no-op 'update subnet id=sub_1 public=true': subnet sub_1 already has the given public", "- 2", "+ 4"} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("expected '%s' in\n%s", exp, out)
+		}
+	}
+	if strings.Contains(out, "DefaultCooldown") {
+		t.Fatalf("unexpected unchanged cooldown in\n%s", out)
+	}
+}
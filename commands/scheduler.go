@@ -20,11 +20,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless-scheduler/client"
 	"github.com/wallix/awless-scheduler/model"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 )
 
 var (
@@ -47,6 +50,8 @@ var schedulerCmd = &cobra.Command{
 	Short:             "Accessing the scheduler API (when installed). To schedule templates runs/reverts use `awless run --schedule`",
 
 	Run: func(cmd *cobra.Command, args []string) {
+		exitOn(console.CheckOutputFormat("scheduler", console.TableFormat, console.JSONFormat))
+
 		if config.GetSchedulerURL() == "" {
 			exitOn(errors.New("no scheduler URL in configuration. Set it with `awless config set scheduler.url`"))
 		}
@@ -56,23 +61,29 @@ var schedulerCmd = &cobra.Command{
 		if listSchedulerTasksFlag {
 			tasks, err := cli.ListTasks()
 			exitOn(err)
-			printTasks(tasks)
+			exitOn(printTasks(os.Stdout, tasks))
 			return
 		}
 
 		if listSchedulerFailuresFlag {
 			tasks, err := cli.ListFailures()
 			exitOn(err)
-			printTasks(tasks)
+			exitOn(printTasks(os.Stdout, tasks))
 			return
 		}
 
 		info := cli.ServiceInfo()
-		fmt.Printf("Scheduler up!\nAddress: '%s'\nTickerFrequency: %s\nUptime: %s\n", info.ServiceAddr, info.TickerFrequency, info.Uptime)
+		exitOn(console.PrintOutput(os.Stdout, info, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "Scheduler up!\nAddress: '%s'\nTickerFrequency: %s\nUptime: %s\n", info.ServiceAddr, info.TickerFrequency, info.Uptime)
+			return err
+		}))
 	},
 }
 
-func printTasks(tasks []*model.Task) {
+func printTasks(w io.Writer, tasks []*model.Task) error {
+	if console.IsJSONOutput() {
+		return console.PrintJSON(w, tasks)
+	}
 	for _, t := range tasks {
 		var buf bytes.Buffer
 		buf.WriteString(fmt.Sprintf("Region: %s", t.Region))
@@ -81,6 +92,9 @@ func printTasks(tasks []*model.Task) {
 			buf.WriteString(fmt.Sprintf(", RevertAt: %s", t.RevertAt))
 		}
 		buf.WriteString(fmt.Sprintf("\nContent: %s\n", t.Content))
-		fmt.Println(buf.String())
+		if _, err := fmt.Fprintln(w, buf.String()); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -17,7 +17,6 @@ limitations under the License.
 package commands
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -25,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/logger"
 )
 
@@ -54,6 +54,8 @@ var awsImagesCmd = &cobra.Command{
 	Example:          "  awless search images redhat:rhel:7.2\n  awless search images debian::jessie\n  awless search images canonical --id-only\n  awless search images amazonlinux:::::instance-store --ids-only",
 
 	Run: func(cmd *cobra.Command, args []string) {
+		exitOn(console.CheckOutputFormat("search images", console.TableFormat, console.JSONFormat))
+
 		if len(args) < 1 {
 			exitOn(fmt.Errorf("expecting image query string. Expecting: %s (with everything optional expect for the owner)", awsservices.ImageQuerySpec))
 		}
@@ -89,9 +91,6 @@ var awsImagesCmd = &cobra.Command{
 			return
 		}
 
-		b, err := json.MarshalIndent(imgs, "", " ")
-		exitOn(err)
-
-		fmt.Fprintln(os.Stdout, string(b))
+		exitOn(console.PrintJSON(os.Stdout, imgs))
 	},
 }
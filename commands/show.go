@@ -26,6 +26,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
@@ -35,13 +37,23 @@ import (
 
 var (
 	listAllSiblingsFlag          bool
+	showTopologyFlag             bool
+	showDependentsFlag           bool
 	showPropertiesValuesOnlyFlag []string
 )
 
+// Children drawn per resource with --topology, the others being counted
+const topologyMaxChildren = 10
+
 func init() {
 	RootCmd.AddCommand(showCmd)
 	showCmd.Flags().BoolVar(&listAllSiblingsFlag, "siblings", false, "List all the resource's siblings")
+	showCmd.Flags().BoolVar(&showTopologyFlag, "topology", false, "Draw the tree of the resource's children (ex: subnets and instances of a VPC)")
+	showCmd.Flags().BoolVar(&showDependentsFlag, "dependents", false, "List the resources depending on the resource: the ones it applies on (ex: instances of a security group) and its children")
+	showCmd.Flags().BoolVar(&refreshFlag, "refresh", false, "Sync the resource's service even if it has been synced within the cache TTL (see 'awless config set cache.ttl')")
 	showCmd.Flags().StringSliceVar(&showPropertiesValuesOnlyFlag, "values-for", []string{}, "Output values only for given properties keys")
+	showCmd.Flags().StringVar(&idFormatFlag, "id-format", "", "Display the id in short form (resource of an ARN id) or as ARN, overriding the display.idformat config: short or arn")
+	showCmd.Flags().StringVar(&templateFlag, "template", "", "Format the resource with a Go template (properties as lowercased fields). Ex: --template '{{.name}} ({{.id}})'")
 }
 
 var showCmd = &cobra.Command{
@@ -50,7 +62,11 @@ var showCmd = &cobra.Command{
 	Example: `  awless show i-8d43b21b            # show an instance via its ref
   awless show AIDAJ3Z24GOKHTZO4OIX6 # show a user via its ref
   awless show jsmith                # show a user via its ref,
-  awless show @jsmith               # forcing search by name`,
+  awless show @jsmith               # forcing search by name
+  awless show jsmith --template '{{.name}} created {{.created}}'
+  awless show my-vpc --topology       # tree of subnets, instances, ...
+  awless show sg-1234 --dependents    # resources depending on a security group
+  awless show i-8d43b21b --format ttl # RDF Turtle with relations`,
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
@@ -59,6 +75,8 @@ var showCmd = &cobra.Command{
 			return errors.New("REFERENCE required. See examples.")
 		}
 
+		exitOn(console.CheckOutputFormat("show", console.TableFormat, console.CSVFormat, console.TSVFormat, console.JSONFormat, console.TurtleFormat))
+
 		ref := args[0]
 		notFound := fmt.Sprintf("resource with reference '%s' not found", deprefix(ref))
 
@@ -69,12 +87,14 @@ var showCmd = &cobra.Command{
 
 		if resource == nil && localGlobalFlag {
 			logger.Info(notFound)
+			suggestNamesForRef(ref)
 			return nil
 		} else if resource == nil {
 			runFullSync()
 
 			if resource, gph = findResourceInLocalGraphs(ref); resource == nil {
 				logger.Info(notFound)
+				suggestNamesForRef(ref)
 				return nil
 			}
 		}
@@ -82,11 +102,15 @@ var showCmd = &cobra.Command{
 		if !localGlobalFlag && config.GetAutosync() {
 			srv, err := cloud.GetServiceForType(resource.Type())
 			exitOn(err)
-			logger.Verbosef("syncing service for %s type", resource.Type())
-			if _, err = sync.DefaultSyncer.Sync(srv); err != nil {
-				logger.Verbose(err)
+			if !refreshFlag && sync.DefaultFetchCache.IsServiceFresh(srv) {
+				logger.Verbosef("%s service synced within cache TTL: not syncing it again (force it with --refresh)", srv.Name())
+			} else {
+				logger.Verbosef("syncing service for %s type", resource.Type())
+				if _, err = sync.DefaultSyncer.Sync(srv); err != nil {
+					logger.Verbose(err)
+				}
+				resource, gph = findResourceInLocalGraphs(ref)
 			}
-			resource, gph = findResourceInLocalGraphs(ref)
 		}
 
 		if resource != nil {
@@ -94,6 +118,18 @@ var showCmd = &cobra.Command{
 				showResourceValuesOnlyFor(resource, showPropertiesValuesOnlyFlag)
 				return nil
 			}
+			if templateFlag != "" {
+				showResourceWithTemplate(resource, templateFlag)
+				return nil
+			}
+			if showTopologyFlag {
+				showResourceTopology(resource, gph)
+				return nil
+			}
+			if showDependentsFlag {
+				showResourceDependents(resource, gph)
+				return nil
+			}
 			showResource(resource, gph)
 		}
 
@@ -116,7 +152,7 @@ func showResourceValuesOnlyFor(resource *graph.Resource, propKeys []string) {
 		}
 		return false, ""
 	}
-	for k, v := range resource.Properties {
+	for k, v := range resource.Redacted(console.RedactedValue, redactedProperties()...).Properties {
 		if ok, p := isIncluded(k); ok {
 			valuesForKeys[p] = fmt.Sprint(v)
 		}
@@ -132,16 +168,39 @@ func showResourceValuesOnlyFor(resource *graph.Resource, propKeys []string) {
 	fmt.Println(strings.Join(values, ","))
 }
 
+func showResourceWithTemplate(resource *graph.Resource, tpl string) {
+	displayer, err := console.BuildOptions(
+		console.WithTemplate(tpl),
+		console.WithRedactedProperties(redactedProperties()),
+		console.WithIDFormat(displayIDFormat()),
+	).SetSource(resource).Build()
+	exitOn(err)
+
+	exitOn(displayer.Print(os.Stdout))
+}
+
 func showResource(resource *graph.Resource, gph *graph.Graph) {
+	if console.OutputFormat() == console.TurtleFormat {
+		exitOn(gph.Redacted(console.RedactedValue, redactedProperties()...).MarshalTurtle(os.Stdout, resource))
+		return
+	}
+
 	displayer, err := console.BuildOptions(
 		console.WithHeaders(console.DefaultsColumnDefinitions[resource.Type()]),
-		console.WithFormat(listingFormat),
+		console.WithFormat(console.OutputFormat()),
 		console.WithMaxWidth(console.GetTerminalWidth()),
+		console.WithHeaderAliases(config.GetDisplayAliases()),
+		console.WithRedactedProperties(redactedProperties()),
+		console.WithIDFormat(displayIDFormat()),
 	).SetSource(resource).Build()
 	exitOn(err)
 
 	exitOn(displayer.Print(os.Stdout))
 
+	if console.OutputFormat() != console.TableFormat || console.IsQuiet() {
+		return
+	}
+
 	var parents []*graph.Resource
 	err = gph.Accept(&graph.ParentsVisitor{From: resource, Each: graph.VisitorCollectFunc(&parents)})
 	exitOn(err)
@@ -192,12 +251,110 @@ func showResource(resource *graph.Resource, gph *graph.Graph) {
 	exitOn(err)
 	printResourceList(renderCyanBoldFn("Depending on"), dependingOn)
 
+	if resource.Type() == cloud.Instance {
+		printInstancePermissions(resource, dependingOn, gph)
+	}
+
 	var siblings []*graph.Resource
 	err = gph.Accept(&graph.SiblingsVisitor{From: resource, Each: graph.VisitorCollectFunc(&siblings)})
 	exitOn(err)
 	printResourceList(renderCyanBoldFn("Siblings"), siblings, "display all with flag --siblings")
 }
 
+// printInstancePermissions traces the policies an instance runs with: the roles
+// of its instance profile and the managed and inline policies of these roles
+func printInstancePermissions(instance *graph.Resource, dependingOn []*graph.Resource, gph *graph.Graph) {
+	profiles := filterResourcesByType(dependingOn, cloud.InstanceProfile)
+	if arn, ok := instance.Properties[properties.Profile].(string); ok && len(profiles) == 0 {
+		var err error
+		profiles, err = gph.ResolveResources(&graph.And{Resolvers: []graph.Resolver{&graph.ByType{Typ: cloud.InstanceProfile}, &graph.ByProperty{Key: properties.Arn, Value: arn}}})
+		exitOn(err)
+	}
+
+	var w bytes.Buffer
+	for _, profile := range profiles {
+		fmt.Fprintf(&w, "%s\n", profile)
+		profileDependencies, err := gph.ListResourcesDependingOn(profile)
+		exitOn(err)
+		for _, role := range filterResourcesByType(profileDependencies, cloud.Role) {
+			fmt.Fprintf(&w, "\t↳ %s\n", role)
+			roleDependencies, err := gph.ListResourcesDependingOn(role)
+			exitOn(err)
+			for _, policy := range filterResourcesByType(roleDependencies, cloud.Policy) {
+				fmt.Fprintf(&w, "\t\t↳ %s\n", policy)
+			}
+			if inlines, ok := role.Properties[properties.InlinePolicies].([]string); ok {
+				for _, inline := range inlines {
+					fmt.Fprintf(&w, "\t\t↳ inline policy %s\n", inline)
+				}
+			}
+		}
+	}
+	if w.Len() > 0 {
+		fmt.Println(renderCyanBoldFn("\n# Permissions:"))
+		fmt.Print(w.String())
+	}
+}
+
+func filterResourcesByType(resources []*graph.Resource, resType string) (filtered []*graph.Resource) {
+	for _, r := range resources {
+		if r.Type() == resType {
+			filtered = append(filtered, r)
+		}
+	}
+	return
+}
+
+func showResourceTopology(resource *graph.Resource, gph *graph.Graph) {
+	root, err := buildTopologyTree(resource, gph)
+	exitOn(err)
+	root.Label = renderGreenFn(root.Label)
+	exitOn(console.RenderTree(os.Stdout, root, topologyMaxChildren))
+}
+
+// buildTopologyTree returns the tree of the children of a resource,
+// each resource children being sorted by type then name
+func buildTopologyTree(resource *graph.Resource, gph *graph.Graph) (*console.TreeNode, error) {
+	var stack []*console.TreeNode
+	resources := make(map[*console.TreeNode]*graph.Resource)
+	err := gph.Accept(&graph.ChildrenVisitor{From: resource, IncludeFrom: true, Each: func(r *graph.Resource, depth int) error {
+		node := &console.TreeNode{Label: r.String()}
+		resources[node] = r
+		if depth > len(stack) {
+			depth = len(stack)
+		}
+		stack = stack[:depth]
+		if depth > 0 {
+			parent := stack[depth-1]
+			parent.Children = append(parent.Children, node)
+		}
+		stack = append(stack, node)
+		return nil
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(stack) == 0 {
+		return &console.TreeNode{Label: resource.String()}, nil
+	}
+
+	var sortChildren func(*console.TreeNode)
+	sortChildren = func(node *console.TreeNode) {
+		sort.SliceStable(node.Children, func(i, j int) bool {
+			ri, rj := resources[node.Children[i]], resources[node.Children[j]]
+			if ri.Type() != rj.Type() {
+				return ri.Type() < rj.Type()
+			}
+			return ri.String() < rj.String()
+		})
+		for _, child := range node.Children {
+			sortChildren(child)
+		}
+	}
+	sortChildren(stack[0])
+	return stack[0], nil
+}
+
 func runFullSync() {
 	if !config.GetAutosync() {
 		logger.Info("autosync disabled")
@@ -246,11 +403,10 @@ func resolveResourceFromRef(ref string) (*graph.Graph, []*graph.Resource) {
 	exitOn(err)
 
 	name := deprefix(ref)
-	byName := &graph.ByProperty{Key: "Name", Value: name}
 
 	if strings.HasPrefix(ref, "@") {
 		logger.Verbosef("prefixed with @: forcing research by name '%s'", name)
-		rs, err := g.ResolveResources(byName)
+		rs, err := resolveByName(g, name)
 		exitOn(err)
 		return g, rs
 	} else {
@@ -260,10 +416,12 @@ func resolveResourceFromRef(ref string) (*graph.Graph, []*graph.Resource) {
 		if len(rs) > 0 {
 			return g, rs
 		} else {
-			rs, err := g.ResolveResources(
-				byName,
-				&graph.ByProperty{Key: "Arn", Value: name},
-			)
+			rs, err := g.ResolveResources(&graph.ByProperty{Key: "Arn", Value: name})
+			exitOn(err)
+			if len(rs) > 0 {
+				return g, rs
+			}
+			rs, err = resolveByName(g, name)
 			exitOn(err)
 
 			return g, rs
@@ -275,6 +433,42 @@ func deprefix(s string) string {
 	return strings.TrimPrefix(s, "@")
 }
 
+func showResourceDependents(resource *graph.Resource, gph *graph.Graph) {
+	dependents := gph.Dependents(resource.Id())
+	var count int
+	for _, rel := range graph.DependencyRelations {
+		count += len(dependents[rel])
+	}
+	if count == 0 {
+		fmt.Printf("No resource depending on %s\n", resource)
+		return
+	}
+	fmt.Printf("%d resource(s) depending on %s\n", count, resource)
+	for _, rel := range graph.DependencyRelations {
+		var list []*graph.Resource
+		for _, id := range dependents[rel] {
+			res, err := gph.FindResource(id)
+			exitOn(err)
+			if res == nil {
+				res = graph.NotFoundResource(id)
+			}
+			list = append(list, res)
+		}
+		printResourceList(renderCyanBoldFn(dependencyRelationLabel(rel)), list)
+	}
+}
+
+func dependencyRelationLabel(rel string) string {
+	switch rel {
+	case rdf.ApplyOn:
+		return "Applied on"
+	case rdf.ParentOf:
+		return "Children"
+	default:
+		return rel
+	}
+}
+
 func printResourceList(title string, list []*graph.Resource, shortenListMsg ...string) {
 	sort.Sort(byTypeAndString{list})
 	all := graph.Resources(list).Map(func(r *graph.Resource) string { return r.String() })
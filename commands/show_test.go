@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestBuildTopologyTree(t *testing.T) {
+	vpc := resourcetest.VPC("vpc_1").Prop(p.Name, "main").Build()
+	sub1, sub2 := resourcetest.Subnet("sub_1").Build(), resourcetest.Subnet("sub_2").Prop(p.Name, "private").Build()
+	sg := resourcetest.SecurityGroup("sg_1").Build()
+	inst1, inst2 := resourcetest.Instance("inst_1").Prop(p.Name, "web").Build(), resourcetest.Instance("inst_2").Build()
+
+	g := graph.NewGraph()
+	g.AddResource(vpc, sub1, sub2, sg, inst1, inst2)
+	g.AddParentRelation(vpc, sg)
+	g.AddParentRelation(vpc, sub2)
+	g.AddParentRelation(vpc, sub1)
+	g.AddParentRelation(sub1, inst2)
+	g.AddParentRelation(sub1, inst1)
+
+	root, err := buildTopologyTree(vpc, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w bytes.Buffer
+	if err = console.RenderTree(&w, root, 0); err != nil {
+		t.Fatal(err)
+	}
+	expected := `@main[vpc]
+├── sg_1[securitygroup]
+├── @private[subnet]
+└── sub_1[subnet]
+    ├── @web[instance]
+    └── inst_2[instance]
+`
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if root, err = buildTopologyTree(inst1, g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(root.Children), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
@@ -18,8 +18,10 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
 	"strings"
@@ -29,6 +31,7 @@ import (
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
@@ -37,11 +40,18 @@ import (
 var (
 	servicesToSyncFlags map[string]*bool
 	profileSyncFlag     bool
+	repairSyncCheckFlag bool
+	allAccountsSyncFlag bool
 )
 
 func init() {
 	RootCmd.AddCommand(syncCmd)
 	syncCmd.Flags().BoolVar(&profileSyncFlag, "profile-sync", false, "Will dump a cpu and mem profiling file")
+	syncCmd.Flags().BoolVar(&allAccountsSyncFlag, "all-accounts", false, "Sync the account of each role of 'sync.roles' by assuming it, storing each account apart from the local graphs with resource ids scoped by the account")
+
+	syncCmd.AddCommand(syncCheckCmd)
+	syncCheckCmd.Flags().BoolVar(&repairSyncCheckFlag, "repair", false, "Drop the relations pointing to missing resources from the local graphs")
+	syncCmd.AddCommand(syncMigrateCmd)
 
 	servicesToSyncFlags = make(map[string]*bool)
 	for _, service := range awsservices.ServiceNames {
@@ -53,22 +63,20 @@ func init() {
 var syncCmd = &cobra.Command{
 	Use:               "sync",
 	Short:             "Manual sync of your remote resources to your local rdf store. For example when auto sync unset",
+	Example:           "  awless sync\n  awless sync --infra --access\n  awless sync --all-accounts     # accounts of the roles of 'sync.roles'",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var services []cloud.Service
-		displayAllServices := true
-		for _, srv := range cloud.ServiceRegistry {
-			if *servicesToSyncFlags[srv.Name()] {
-				displayAllServices = false
-			}
+		if allAccountsSyncFlag {
+			return syncAllAccounts()
 		}
+
+		var all []cloud.Service
 		for _, srv := range cloud.ServiceRegistry {
-			if displayAllServices || *servicesToSyncFlags[srv.Name()] {
-				services = append(services, srv)
-			}
+			all = append(all, srv)
 		}
+		services := servicesToSync(all)
 		localGraphs := make(map[string]*graph.Graph)
 		for _, service := range services {
 			localGraphs[service.Name()] = sync.LoadLocalGraphForService(service.Name(), config.GetAWSRegion())
@@ -100,6 +108,122 @@ var syncCmd = &cobra.Command{
 	},
 }
 
+var syncCheckCmd = &cobra.Command{
+	Use:               "check",
+	Short:             "Verify the integrity of your local rdf store for the current region",
+	Long:              "Verify the integrity of your local rdf store for the current region: relations pointing to missing resources, duplicated ids and resources without id. Use --repair to drop the dangling relations",
+	Example:           "  awless sync check\n  awless sync check --repair\n  awless sync check --json",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		region := config.GetAWSRegion()
+		g, err := sync.LoadLocalGraphs(region)
+		exitOn(err)
+
+		report := &syncCheckReport{Region: region, Problems: g.CheckIntegrity()}
+		if report.Problems == nil {
+			report.Problems = []*graph.IntegrityProblem{}
+		}
+		if repairSyncCheckFlag && len(report.Problems) > 0 {
+			report.Repaired, err = repairLocalGraphs(region, report.Problems)
+			exitOn(err)
+		}
+
+		exitOn(console.PrintOutput(os.Stdout, report, func(w io.Writer) error {
+			for _, p := range report.Problems {
+				if _, err := fmt.Fprintf(w, "%s\t%s\n", renderRedFn(p.Kind), p.Message); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+		if len(report.Problems) == 0 {
+			logger.Infof("local graphs of region '%s' are consistent", region)
+		} else {
+			logger.Warningf("%d problem(s) found in local graphs of region '%s'", len(report.Problems), region)
+		}
+		if repairSyncCheckFlag {
+			logger.Infof("%d dangling relation(s) removed", report.Repaired)
+		}
+		return nil
+	},
+}
+
+var syncMigrateCmd = &cobra.Command{
+	Use:               "migrate",
+	Short:             fmt.Sprintf("Rewrite the local graphs of all regions written by older versions of awless in the current format (version %d)", graph.FormatVersion),
+	Long:              fmt.Sprintf("Rewrite the local graphs of all regions written by older versions of awless in the current format (version %d).\n\nOlder graphs are upgraded in memory each time they are loaded: migrating them only saves this upgrade. Graphs written by a newer version of awless cannot be read: upgrade awless or sync again to overwrite them.", graph.FormatVersion),
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var migrated, newer []string
+		for _, path := range sync.AllLocalGraphFiles() {
+			version, err := sync.MigrateLocalGraphFile(path)
+			exitOn(err)
+			switch {
+			case version > graph.FormatVersion:
+				logger.Errorf("%s: %s", path, &graph.FormatError{Version: version})
+				newer = append(newer, path)
+			case version < graph.FormatVersion:
+				logger.Verbosef("migrated %s from format version %d to %d", path, version, graph.FormatVersion)
+				if rel, err := filepath.Rel(sync.DefaultSyncer.BaseDir(), path); err == nil {
+					migrated = append(migrated, rel)
+				}
+			}
+		}
+
+		if len(migrated) > 0 && runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
+			if err := sync.DefaultSyncer.Commit(migrated...); err != nil {
+				exitOn(fmt.Errorf("committing %s: %s", strings.Join(migrated, ", "), err))
+			}
+		}
+		logger.Infof("%d local graph(s) migrated to format version %d", len(migrated), graph.FormatVersion)
+		if len(newer) > 0 {
+			exitOn(fmt.Errorf("%d local graph(s) written by a newer version of awless", len(newer)))
+		}
+		return nil
+	},
+}
+
+type syncCheckReport struct {
+	Region   string                    `json:"region"`
+	Problems []*graph.IntegrityProblem `json:"problems"`
+	Repaired int                       `json:"repaired"`
+}
+
+func repairLocalGraphs(region string, problems []*graph.IntegrityProblem) (int, error) {
+	var removed int
+	var modified []string
+	for _, path := range sync.LocalGraphFiles(region) {
+		g, err := graph.NewGraphFromFile(path)
+		if err != nil {
+			return removed, fmt.Errorf("loading '%s': %s", path, err)
+		}
+		count := g.RemoveDanglingRelations(problems)
+		if count == 0 {
+			continue
+		}
+		if err = sync.WriteLocalGraphFile(path, g); err != nil {
+			return removed, err
+		}
+		logger.Verbosef("removed %d dangling relation(s) from %s", count, path)
+		removed += count
+		if rel, err := filepath.Rel(sync.DefaultSyncer.BaseDir(), path); err == nil {
+			modified = append(modified, rel)
+		}
+	}
+
+	if len(modified) > 0 && runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
+		if err := sync.DefaultSyncer.Commit(modified...); err != nil {
+			return removed, fmt.Errorf("committing %s: %s", strings.Join(modified, ", "), err)
+		}
+	}
+	return removed, nil
+}
+
 func withProfiling(fn func()) {
 	logger.Infof("sync profiling on")
 	mem, err := os.Create("mem-sync.prof")
@@ -128,20 +252,43 @@ func withProfiling(fn func()) {
 }
 
 func displaySyncStats(serviceName string, g *graph.Graph) {
+	srv, ok := cloud.ServiceRegistry[serviceName]
+	if !ok {
+		return
+	}
 	var strs []string
-	for rt, service := range awsservices.ServicePerResourceType {
-		if service == serviceName {
-			res, err := g.GetAllResources(rt)
-			if err != nil {
-				continue
-			}
-			nbRes := len(res)
-			if nbRes > 1 {
-				strs = append(strs, fmt.Sprintf("%d %s", nbRes, cloud.PluralizeResource(rt)))
-			} else {
-				strs = append(strs, fmt.Sprintf("%d %s", nbRes, rt))
-			}
+	for _, rt := range srv.ResourceTypes() {
+		res, err := g.GetAllResources(rt)
+		if err != nil {
+			continue
+		}
+		nbRes := len(res)
+		if nbRes > 1 {
+			strs = append(strs, fmt.Sprintf("%d %s", nbRes, cloud.PluralizeResource(rt)))
+		} else {
+			strs = append(strs, fmt.Sprintf("%d %s", nbRes, rt))
 		}
 	}
 	logger.Infof("-> %s: %s", serviceName, strings.Join(strs, ", "))
 }
+
+// servicesToSync returns the services selected with their flag, all of them without any flag
+func servicesToSync(all []cloud.Service) []cloud.Service {
+	var selected []cloud.Service
+	for _, srv := range all {
+		if isServiceToSync(srv.Name()) {
+			selected = append(selected, srv)
+		}
+	}
+	if len(selected) == 0 {
+		return all
+	}
+	return selected
+}
+
+// isServiceToSync returns whether the service was selected with its flag,
+// services without flag (e.g. fetch plugins) being synced only with all the others
+func isServiceToSync(name string) bool {
+	flag, ok := servicesToSyncFlags[name]
+	return ok && *flag
+}
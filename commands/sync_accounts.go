@@ -0,0 +1,95 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+type accountSyncResult struct {
+	account, roleARN string
+	err              error
+}
+
+func syncAllAccounts() error {
+	roles := config.GetSyncRoleARNs()
+	if len(roles) == 0 {
+		return errors.New("no role to assume: set the roles of the accounts with `awless config set sync.roles arn:aws:iam::123456789012:role/audit,...`")
+	}
+	awsConf := config.GetConfigWithPrefix("aws.")
+	logger.Infof("running sync of %d account(s) for region '%s'", len(roles), config.GetAWSRegion())
+
+	start := time.Now()
+	results := syncAccounts(roles,
+		func(role string) (*awsservices.AccountServices, error) {
+			return awsservices.NewAccountServices(role, awsConf, logger.DefaultLogger)
+		},
+		func(account string) sync.Syncer {
+			return sync.NewAccountSyncer(account, config.GetSyncRetention(account), logger.DefaultLogger)
+		},
+	)
+	logger.Infof("sync of all accounts took %s", time.Since(start))
+
+	var failed []string
+	for _, res := range results {
+		if res.err != nil {
+			failed = append(failed, res.roleARN)
+		}
+	}
+	if len(failed) > 0 {
+		exitOn(fmt.Errorf("%d of %d account(s) failed to sync: %s", len(failed), len(results), strings.Join(failed, ", ")))
+	}
+	return nil
+}
+
+// syncAccounts syncs the accounts of the roles one after the other. An account failing to be
+// reached or synced is reported and does not stop the sync of the others
+func syncAccounts(roles []string, newServices func(role string) (*awsservices.AccountServices, error), newSyncer func(account string) sync.Syncer) []*accountSyncResult {
+	var results []*accountSyncResult
+	for _, role := range roles {
+		res := &accountSyncResult{roleARN: role}
+		results = append(results, res)
+
+		account, err := newServices(role)
+		if err != nil {
+			res.err = err
+			logger.Errorf("account of role %s: %s", role, err)
+			continue
+		}
+		res.account = account.Account
+
+		graphs, err := newSyncer(account.Account).Sync(servicesToSync(account.Services)...)
+		if err != nil {
+			res.err = err
+			logger.Errorf("account %s: %s", account.Account, err)
+		} else {
+			logger.Infof("account %s synced in %s", account.Account, sync.AccountGraphsDir(account.Account))
+		}
+		for name, g := range graphs {
+			displaySyncStats(name, g)
+		}
+	}
+	return results
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync"
+)
+
+type accountSyncerMock struct {
+	sync.Syncer
+	account string
+	synced  map[string][]cloud.Service
+}
+
+func (s *accountSyncerMock) Sync(services ...cloud.Service) (map[string]*graph.Graph, error) {
+	s.synced[s.account] = services
+	if s.account == "333333333333" {
+		return nil, errors.New("syncing infra: throttled")
+	}
+	return nil, nil
+}
+
+func TestSyncAccounts(t *testing.T) {
+	roles := []string{
+		"arn:aws:iam::111111111111:role/audit",
+		"arn:aws:iam::222222222222:role/audit",
+		"arn:aws:iam::333333333333:role/audit",
+		"arn:aws:iam::444444444444:role/audit",
+	}
+	synced := make(map[string][]cloud.Service)
+	results := syncAccounts(roles,
+		func(role string) (*awsservices.AccountServices, error) {
+			if role == roles[1] {
+				return nil, errors.New("access denied")
+			}
+			account, err := awsconfig.AccountOfRoleARN(role)
+			return &awsservices.AccountServices{Account: account, RoleARN: role}, err
+		},
+		func(account string) sync.Syncer {
+			return &accountSyncerMock{account: account, synced: synced}
+		},
+	)
+
+	var accounts []string
+	for account := range synced {
+		accounts = append(accounts, account)
+	}
+	if got, want := len(accounts), 3; got != want {
+		t.Fatalf("got %d accounts synced (%v), want %d", got, accounts, want)
+	}
+	var failed []string
+	for _, res := range results {
+		if res.err != nil {
+			failed = append(failed, res.roleARN)
+		}
+	}
+	if got, want := failed, []string{roles[1], roles[2]}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := results[3].account, "444444444444"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
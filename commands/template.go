@@ -0,0 +1,146 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateInfoCmd)
+	templateCmd.AddCommand(templateImportCSVCmd)
+
+	templateImportCSVCmd.Flags().StringVar(&importCSVEntityFlag, "entity", "", "Type of the listed resources (ex: instance)")
+	templateImportCSVCmd.Flags().StringVar(&importCSVIDColumnFlag, "id-column", "id", "Column of the header row giving the resource ids")
+	templateImportCSVCmd.Flags().StringVar(&importCSVNameColumnFlag, "name-column", "", "Column of the header row naming the references to the resources (default: the id column)")
+	templateImportCSVCmd.Flags().StringVar(&importCSVTemplateFlag, "as-template", "", "Write the generated template to this file instead of stdout")
+}
+
+var (
+	importCSVEntityFlag     string
+	importCSVIDColumnFlag   string
+	importCSVNameColumnFlag string
+	importCSVTemplateFlag   string
+)
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Inspect templates without running them",
+}
+
+var templateInfoCmd = &cobra.Command{
+	Use:               "info PATH",
+	Short:             "Show the description and the params of a template given a filepath, a URL (prefixed with http) or repo:NAME",
+	Example:           "  awless template info ~/templates/my-infra.aws\n  awless template info repo:create_vpc",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg (filepath, url or repo:NAME)")
+		}
+		content, err := getTemplateText(args[0])
+		exitOn(err)
+
+		env := template.NewEnv()
+		env.DefLookupFunc = awsdriver.AWSLookupDefinitions
+		info, err := template.NewInfo(string(content), env)
+		exitOn(withExitCode(ExitValidation, err))
+
+		printTemplateInfo(os.Stdout, info)
+		return nil
+	},
+}
+
+var templateImportCSVCmd = &cobra.Command{
+	Use:   "import-csv CSVFILE",
+	Short: "Generate a template importing the existing resources listed in a CSV file with a header row",
+	Example: `  awless template import-csv resources.csv --entity instance --as-template out.aws
+  awless template import-csv resources.csv --entity volume --id-column VolumeId --name-column Name`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing CSVFILE arg")
+		}
+		if importCSVEntityFlag == "" {
+			return errors.New("missing --entity flag")
+		}
+		entity := cloud.SingularizeResource(importCSVEntityFlag)
+		if _, ok := awsservices.ServicePerResourceType[entity]; !ok {
+			return fmt.Errorf("unknown entity '%s'", importCSVEntityFlag)
+		}
+
+		f, err := os.Open(args[0])
+		exitOn(err)
+		defer f.Close()
+
+		csvImport := &template.CSVImport{Entity: entity, IDColumn: importCSVIDColumnFlag, NameColumn: importCSVNameColumnFlag, Source: filepath.Base(args[0])}
+		text, count, err := csvImport.Generate(f)
+		exitOn(withExitCode(ExitValidation, err))
+
+		if importCSVTemplateFlag == "" {
+			fmt.Print(text)
+			return nil
+		}
+		exitOn(ioutil.WriteFile(importCSVTemplateFlag, []byte(text), 0644))
+		logger.Infof("%d %s(s) imported in %s: run it with `awless run %s`", count, entity, importCSVTemplateFlag, importCSVTemplateFlag)
+		return nil
+	},
+}
+
+func printTemplateInfo(w io.Writer, info *template.Info) {
+	if info.Description != "" {
+		fmt.Fprintf(w, "%s\n\n", info.Description)
+	} else {
+		fmt.Fprintf(w, "No description (document the template with a '# @description ...' header)\n\n")
+	}
+	if len(info.Groups) == 0 {
+		fmt.Fprintln(w, "No params")
+		return
+	}
+	fmt.Fprintln(w, "Params:")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, group := range info.Groups {
+		var params []string
+		for _, p := range group.Params {
+			if p.Optional {
+				params = append(params, p.Name+" (optional)")
+			} else {
+				params = append(params, p.Name)
+			}
+		}
+		fmt.Fprintf(tw, "  %s:\t%s\n", group.Name, strings.Join(params, ", "))
+	}
+	tw.Flush()
+}
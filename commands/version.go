@@ -18,9 +18,12 @@ package commands
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 )
 
 func init() {
@@ -35,5 +38,13 @@ var versionCmd = &cobra.Command{
 }
 
 func printVersion(*cobra.Command, []string) {
-	fmt.Println("awless", config.CurrentBuildInfo.String())
+	exitOn(console.CheckOutputFormat("version", console.TableFormat, console.JSONFormat))
+	exitOn(printBuildInfo(os.Stdout, config.CurrentBuildInfo))
+}
+
+func printBuildInfo(w io.Writer, info config.BuildInfo) error {
+	return console.PrintOutput(w, info, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, "awless", info.String())
+		return err
+	})
 }
@@ -18,18 +18,22 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/logger"
 )
 
-var onlyMyIPFlag, onlyMyNameFlag, onlyMyTypeFlag, onlyMyIDFlag, onlyMyAccountFlag, onlyMyResourcePathFlag bool
+var onlyMyIPFlag, onlyMyNameFlag, onlyMyTypeFlag, onlyMyIDFlag, onlyMyAccountFlag, onlyMyResourcePathFlag, onlyMyCredentialsSourceFlag bool
 
 func init() {
 	RootCmd.AddCommand(whoamiCmd)
@@ -40,6 +44,7 @@ func init() {
 	whoamiCmd.Flags().BoolVar(&onlyMyIDFlag, "id-only", false, "Only returns the ID of the resource")
 	whoamiCmd.Flags().BoolVar(&onlyMyAccountFlag, "account-only", false, "Only returns the AWS account number")
 	whoamiCmd.Flags().BoolVar(&onlyMyResourcePathFlag, "resource-only", false, "Only returns the AWS ARN resource path suffix (ex: user/jsmith)")
+	whoamiCmd.Flags().BoolVar(&onlyMyCredentialsSourceFlag, "credentials-only", false, "Only returns where your credentials were resolved from (ex: credential_process, SSO cached token)")
 }
 
 var whoamiCmd = &cobra.Command{
@@ -50,6 +55,8 @@ var whoamiCmd = &cobra.Command{
 	Short:             "Show your account, attached (i.e. managed) and inlined policies",
 
 	Run: func(cmd *cobra.Command, args []string) {
+		exitOn(console.CheckOutputFormat("whoami", console.TableFormat, console.JSONFormat))
+
 		if onlyMyIPFlag {
 			fmt.Println(getMyIP())
 			return
@@ -85,43 +92,65 @@ var whoamiCmd = &cobra.Command{
 		case onlyMyResourcePathFlag:
 			fmt.Println(me.ResourcePath)
 			return
+		case onlyMyCredentialsSourceFlag:
+			fmt.Println(awsservices.CredentialsSource())
+			return
 		}
 
+		var policies *awsservices.UserPolicies
+		if me.IsUserType() {
+			if policies, err = awsservices.AccessService.(*awsservices.Access).GetUserPolicies(me.Resource); err != nil {
+				logger.Error(err)
+			}
+		}
+
+		exitOn(printWhoami(os.Stdout, &whoami{Identity: me, Credentials: awsservices.CredentialsSource(), Profile: config.GetAWSProfile(), Policies: policies}))
+	},
+}
+
+type whoami struct {
+	*awsservices.Identity
+	Credentials, Profile string
+	Policies             *awsservices.UserPolicies `json:",omitempty"`
+}
+
+func printWhoami(w io.Writer, me *whoami) error {
+	return console.PrintOutput(w, me, func(w io.Writer) error {
 		if !me.IsUserType() {
-			fmt.Printf("ResourceType: %s, Resource: %s, Id: %s, Account: %s\n", me.ResourceType, me.Resource, me.UserId, me.Account)
-			return
+			fmt.Fprintf(w, "ResourceType: %s, Resource: %s, Id: %s, Account: %s\n", me.ResourceType, me.Resource, me.UserId, me.Account)
+			fmt.Fprintf(w, "Credentials: %s, Profile: %s\n", me.Credentials, me.Profile)
+			return nil
 		}
 
-		fmt.Printf("Username: %s, Id: %s, Account: %s\n", me.Resource, me.UserId, me.Account)
+		fmt.Fprintf(w, "Username: %s, Id: %s, Account: %s\n", me.Resource, me.UserId, me.Account)
+		fmt.Fprintf(w, "Credentials: %s, Profile: %s\n", me.Credentials, me.Profile)
 
-		policies, err := awsservices.AccessService.(*awsservices.Access).GetUserPolicies(me.Resource)
-		if err != nil {
-			logger.Error(err)
-			return
+		policies := me.Policies
+		if policies == nil {
+			return nil
 		}
 
 		if attached := policies.Attached; len(attached) > 0 {
-			fmt.Println("\nAttached policies (i.e. managed):")
+			fmt.Fprintln(w, "\nAttached policies (i.e. managed):")
 			for _, name := range attached {
-				fmt.Printf("\t- %s\n", name)
+				fmt.Fprintf(w, "\t- %s\n", name)
 			}
 		} else {
-			fmt.Println("\nAttached policies (i.e. managed): none")
+			fmt.Fprintln(w, "\nAttached policies (i.e. managed): none")
 		}
 		if inlined := policies.Inlined; len(inlined) > 0 {
-			fmt.Println("\nInlined policies:")
+			fmt.Fprintln(w, "\nInlined policies:")
 			for _, name := range inlined {
-				fmt.Printf("\t- %s\n", name)
+				fmt.Fprintf(w, "\t- %s\n", name)
 			}
 		} else {
-			fmt.Println("\nInlined policies: none")
+			fmt.Fprintln(w, "\nInlined policies: none")
 		}
-		if byGroup := policies.ByGroup; len(byGroup) > 0 {
-			for g, pol := range byGroup {
-				fmt.Printf("\nPolicies from group '%s': %s\n", g, strings.Join(pol, ", "))
-			}
+		for g, pol := range policies.ByGroup {
+			fmt.Fprintf(w, "\nPolicies from group '%s': %s\n", g, strings.Join(pol, ", "))
 		}
-	},
+		return nil
+	})
 }
 
 func getMyIP() net.IP {
@@ -3,12 +3,15 @@ package config
 import (
 	"bytes"
 	"fmt"
+	"net/url"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/database"
 )
 
@@ -25,11 +28,28 @@ const (
 	autosyncConfigKey              = "autosync"
 	checkUpgradeFrequencyConfigKey = "upgrade.checkfrequency"
 	schedulerURL                   = "scheduler.url"
+	deprecatedInstanceTypesKey     = "aws.infra.deprecatedtypes"
+	subnetFreeIPsThresholdKey      = "aws.infra.subnet.freeipsthreshold"
+	redactedPropertiesKey          = "display.redact"
+	displayIDFormatKey             = "display.idformat"
+	autoTagConfigKey               = "auto_tag.enabled"
+	syncRetentionConfigKey         = "sync.retention"
+	syncRolesConfigKey             = "sync.roles"
+	cacheTTLConfigKey              = "cache.ttl"
+	webhookURLConfigKey            = "notify.webhook.url"
+	webhookSecretConfigKey         = "notify.webhook.secret"
 	RegionConfigKey                = "aws.region"
 	ProfileConfigKey               = "aws.profile"
+	CABundleConfigKey              = "aws.cabundle"
 
 	//Config prefix
-	awsCloudPrefix = "aws."
+	awsCloudPrefix       = "aws."
+	aliasesPrefix        = "aliases."
+	displayAliasesPrefix = "display.alias."
+	displayColumnsPrefix = "display.columns."
+	syncRetentionPrefix  = "sync.retention."
+	tagPolicyPrefix      = "inspect.tagpolicy."
+	bucketRulesPrefix    = "inspect.bucketcompliance."
 
 	//Defaults
 	instanceImageDefaultsKey = "instance.image"
@@ -39,10 +59,13 @@ var configDefinitions = map[string]*Definition{
 	autosyncConfigKey:              {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
 	RegionConfigKey:                {help: "AWS region", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFns: []onUpdateFunc{awsconfig.WarningChangeRegion, runSyncWithUpdatedRegion}},
 	ProfileConfigKey:               {help: "AWS profile", defaultValue: "default"},
+	CABundleConfigKey:              {help: "PEM file of CA certificates trusted on top of the system ones to reach AWS, ex: behind a TLS intercepting proxy (when empty: AWS_CA_BUNDLE)", parseParamFn: parseCABundle},
 	"aws.infra.sync":               {help: "Sync AWS EC2/ELBv2 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.infra.finding.sync":       {help: "Sync the active AWS GuardDuty findings and relate them to the instances they affect (when empty: false)", defaultValue: "false", parseParamFn: parseBool},
 	"aws.access.sync":              {help: "Sync AWS IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.sync":             {help: "Sync AWS S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.s3object.sync":    {help: "Sync AWS S3/s3object (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.storage.bucket.settings":  {help: "Fetch the versioning status, lifecycle rules, default encryption and public access block of AWS S3 buckets, with 4 more API calls per bucket (when empty: false)", defaultValue: "false", parseParamFn: parseBool},
 	"aws.notification.sync":        {help: "Sync AWS SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.monitoring.sync":          {help: "Sync AWS metric/alarm/cloudwatch service (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
 	"aws.lambda.sync":              {help: "Sync AWS Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
@@ -50,6 +73,16 @@ var configDefinitions = map[string]*Definition{
 	"aws.dns.sync":                 {help: "Sync AWS Route53 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.cdn.sync":                 {help: "Sync AWS CloudFront service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.cloudformation.sync":      {help: "Sync AWS CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	deprecatedInstanceTypesKey:     {help: "Comma separated EC2 instance types reported as deprecated (when empty: previous generation types)", parseParamFn: awsconfig.ParseInstanceTypes},
+	subnetFreeIPsThresholdKey:      {help: "Number of available IP addresses under which listed subnets are reported as about to exhaust (when empty: 16); 0 disables the report", defaultValue: "16", parseParamFn: parseFreeIPsThreshold},
+	redactedPropertiesKey:          {help: "Comma separated properties whose values are displayed and logged as *** (when empty: UserData)", parseParamFn: parseRedactedProperties},
+	displayIDFormatKey:             {help: "Form of the ids displayed by list and show: 'short' (resource of ARN ids) or 'arn' (when empty: ids as synced)", parseParamFn: parseIDFormat},
+	autoTagConfigKey:               {help: "Tag the resources created by templates with the run id, template name and creation time (when empty: false)", defaultValue: "false", parseParamFn: parseBool},
+	syncRetentionConfigKey:         {help: "Days of local sync snapshots kept for the profiles without 'sync.retention.<profile>'; 0 keeps them all", defaultValue: "0", parseParamFn: parseRetentionDays},
+	syncRolesConfigKey:             {help: "Comma separated ARNs of the IAM roles assumed to sync their accounts with `awless sync --all-accounts`, one role per account (ex: arn:aws:iam::123456789012:role/audit)", parseParamFn: awsconfig.ParseRoleARNs},
+	cacheTTLConfigKey:              {help: "Seconds during which list and show serve the resources fetched or synced recently instead of fetching them again (when empty: 60); 0 disables the cache", defaultValue: "60", parseParamFn: parseCacheTTL},
+	webhookURLConfigKey:            {help: "URL receiving a JSON summary (id, status, counts, errors) of each template run; empty disables the notification", parseParamFn: parseWebhookURL},
+	webhookSecretConfigKey:         {help: "Secret signing the webhook notifications with HMAC-SHA256 in the X-Awless-Signature header (when empty: unsigned)"},
 	checkUpgradeFrequencyConfigKey: {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
 	schedulerURL:                   {help: "URL used by awless CLI to interact with pre-installed awless-scheduler", defaultValue: "http://localhost:8082"},
 }
@@ -66,6 +99,24 @@ var defaultsDefinitions = map[string]*Definition{
 	"database.type":          {defaultValue: "db.t2.micro", help: "Default RDS database type"},
 }
 
+// User defined one-liners run with `awless run @name`
+var commandAliasDefinition = &Definition{help: "Command alias run with `awless run @alias`", parseParamFn: parseCommandAlias}
+
+// User defined headers of properties in displayed tables
+var displayAliasDefinition = &Definition{help: "Header displayed in tables for this property (ex: `awless config set display.alias.Type size`)", parseParamFn: parseDisplayAlias}
+
+// User defined columns listed by default for a resource type
+var displayColumnsDefinition = &Definition{help: "Comma separated properties displayed as columns when listing this resource type without --columns (ex: `awless config set display.columns.instance Name,Type,State,PrivateIP,Launched`)", parseParamFn: parseDisplayColumns}
+
+// User defined retention of the local sync snapshots of a profile
+var syncRetentionDefinition = &Definition{help: "Days of local sync snapshots kept for this profile; 0 keeps them all (ex: `awless config set sync.retention.prod 90`)", parseParamFn: parseRetentionDays}
+
+// User defined tags required on the resources of a type, checked by the tagpolicy inspector
+var tagPolicyDefinition = &Definition{help: "Comma separated tag keys required on the resources of this type (ex: `awless config set inspect.tagpolicy.instance owner,cost-center`)", parseParamFn: parseTagKeys}
+
+// User defined controls required on the buckets of a profile, checked by the bucketcompliance inspector
+var bucketComplianceDefinition = &Definition{help: "Comma separated bucket controls (private, versioning, lifecycle, encryption, publicaccessblock) required for this profile, suffixed by ':low' when not blocking (ex: `awless config set inspect.bucketcompliance.prod private,versioning,lifecycle:low`)", parseParamFn: parseBucketControls}
+
 var deprecated = map[string]string{
 	"sync.auto": autosyncConfigKey,
 	"region":    RegionConfigKey,
@@ -219,6 +270,155 @@ func parseInt(a string) (interface{}, error) {
 	return i, nil
 }
 
+func parseCABundle(path string) (interface{}, error) {
+	if _, err := os.Stat(path); err != nil {
+		return path, fmt.Errorf("invalid CA bundle: %s", err)
+	}
+	return path, nil
+}
+
+func parseRetentionDays(a string) (interface{}, error) {
+	days, err := strconv.Atoi(a)
+	if err != nil || days < 0 {
+		return days, fmt.Errorf("invalid value, expected a number of days, got '%s'", a)
+	}
+	return days, nil
+}
+
+func parseCacheTTL(a string) (interface{}, error) {
+	seconds, err := strconv.Atoi(a)
+	if err != nil || seconds < 0 {
+		return seconds, fmt.Errorf("invalid value, expected a number of seconds, got '%s'", a)
+	}
+	return seconds, nil
+}
+
+func parseFreeIPsThreshold(a string) (interface{}, error) {
+	count, err := strconv.Atoi(a)
+	if err != nil || count < 0 {
+		return count, fmt.Errorf("invalid value, expected a number of IP addresses, got '%s'", a)
+	}
+	return count, nil
+}
+
+func parseWebhookURL(s string) (interface{}, error) {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return s, fmt.Errorf("invalid value, expected an http or https URL, got '%s'", s)
+	}
+	return s, nil
+}
+
+func parseTagKeys(s string) (interface{}, error) {
+	var keys []string
+	for _, k := range strings.Split(s, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return s, fmt.Errorf("invalid value, expected comma separated tag keys such as 'owner,cost-center'")
+	}
+	return strings.Join(keys, ","), nil
+}
+
+// BucketControls are the controls the bucketcompliance inspector checks on buckets
+var BucketControls = []string{"private", "versioning", "lifecycle", "encryption", "publicaccessblock"}
+
+func parseBucketControls(s string) (interface{}, error) {
+	var controls []string
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c == "" {
+			continue
+		}
+		control, severity := c, "high"
+		if i := strings.Index(c, ":"); i > -1 {
+			control, severity = strings.TrimSpace(c[:i]), strings.ToLower(strings.TrimSpace(c[i+1:]))
+		}
+		var known bool
+		for _, c := range BucketControls {
+			known = known || c == control
+		}
+		if !known {
+			return s, fmt.Errorf("invalid control '%s', expected one of %s", control, strings.Join(BucketControls, ", "))
+		}
+		if severity != "high" && severity != "low" {
+			return s, fmt.Errorf("invalid severity '%s' for control '%s', expected 'high' or 'low'", severity, control)
+		}
+		controls = append(controls, control+":"+severity)
+	}
+	if len(controls) == 0 {
+		return s, fmt.Errorf("invalid value, expected comma separated controls such as 'private,versioning,lifecycle:low'")
+	}
+	return strings.Join(controls, ","), nil
+}
+
+func parseCommandAlias(a string) (interface{}, error) {
+	if a = strings.TrimSpace(a); a == "" {
+		return a, fmt.Errorf("invalid value, expected a command such as 'create instance type=t2.micro'")
+	}
+	return a, nil
+}
+
+func parseDisplayAlias(a string) (interface{}, error) {
+	if a = strings.TrimSpace(a); a == "" {
+		return a, fmt.Errorf("invalid value, expected the header to display such as 'size'")
+	}
+	return a, nil
+}
+
+func parseDisplayColumns(s string) (interface{}, error) {
+	var props []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		prop, ok := resolvePropertyName(name)
+		if !ok {
+			return s, fmt.Errorf("cannot display column '%s': unknown property", name)
+		}
+		props = append(props, prop)
+	}
+	if len(props) == 0 {
+		return s, fmt.Errorf("invalid value, expected comma separated properties such as 'Name,Type,State'")
+	}
+	return strings.Join(props, ","), nil
+}
+
+func parseRedactedProperties(s string) (interface{}, error) {
+	var props []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		prop, ok := resolvePropertyName(name)
+		if !ok {
+			return s, fmt.Errorf("cannot redact '%s': unknown property", name)
+		}
+		props = append(props, prop)
+	}
+	return strings.Join(props, ","), nil
+}
+
+func parseIDFormat(s string) (interface{}, error) {
+	switch s {
+	case "short", "arn":
+		return s, nil
+	default:
+		return s, fmt.Errorf("invalid id format '%s', expected short or arn", s)
+	}
+}
+
+// resolvePropertyName returns the canonical name of a property given case insensitively
+func resolvePropertyName(name string) (string, bool) {
+	for label := range rdf.Labels {
+		if strings.EqualFold(label, name) {
+			return label, true
+		}
+	}
+	return "", false
+}
+
 func defaultParser(value string) (interface{}, error) {
 	if num, err := strconv.Atoi(value); err == nil {
 		return num, nil
@@ -251,6 +451,28 @@ func setVolatile(key, value string) (interface{}, *Definition, bool, error) {
 		def = confDef
 	case defOk:
 		def = defDef
+	case strings.HasPrefix(key, aliasesPrefix):
+		isConf = true
+		def = commandAliasDefinition
+	case strings.HasPrefix(key, displayAliasesPrefix):
+		prop := strings.TrimPrefix(key, displayAliasesPrefix)
+		if _, ok := resolvePropertyName(prop); !ok {
+			return nil, def, isConf, fmt.Errorf("cannot alias '%s': unknown property", prop)
+		}
+		isConf = true
+		def = displayAliasDefinition
+	case strings.HasPrefix(key, displayColumnsPrefix):
+		isConf = true
+		def = displayColumnsDefinition
+	case strings.HasPrefix(key, syncRetentionPrefix):
+		isConf = true
+		def = syncRetentionDefinition
+	case strings.HasPrefix(key, tagPolicyPrefix):
+		isConf = true
+		def = tagPolicyDefinition
+	case strings.HasPrefix(key, bucketRulesPrefix):
+		isConf = true
+		def = bucketComplianceDefinition
 	default:
 		if strings.Contains(key, awsCloudPrefix) {
 			isConf = true
@@ -285,9 +507,25 @@ func displayConfig() string {
 	}
 	sort.Strings(keys)
 	for _, k := range keys {
-		fmt.Fprintf(t, "\t%s:\t%v\t(%[2]T)", k, Config[k])
+		value := Config[k]
+		if k == webhookSecretConfigKey {
+			value = "***"
+		}
+		fmt.Fprintf(t, "\t%s:\t%v\t(%[2]T)", k, value)
 		if def, ok := configDefinitions[k]; ok && def.help != "" {
 			fmt.Fprintf(t, "\t# %s\n", def.help)
+		} else if strings.HasPrefix(k, aliasesPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", commandAliasDefinition.help)
+		} else if strings.HasPrefix(k, displayAliasesPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", displayAliasDefinition.help)
+		} else if strings.HasPrefix(k, displayColumnsPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", displayColumnsDefinition.help)
+		} else if strings.HasPrefix(k, syncRetentionPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", syncRetentionDefinition.help)
+		} else if strings.HasPrefix(k, tagPolicyPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", tagPolicyDefinition.help)
+		} else if strings.HasPrefix(k, bucketRulesPrefix) {
+			fmt.Fprintf(t, "\t# %s\n", bucketComplianceDefinition.help)
 		} else {
 			fmt.Fprintln(t)
 		}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud/properties"
 )
 
 func GetAWSRegion() string {
@@ -36,6 +39,13 @@ func GetAutosync() bool {
 	return true
 }
 
+func GetAutoTag() bool {
+	if autoTag, ok := Config[autoTagConfigKey].(bool); ok {
+		return autoTag
+	}
+	return false
+}
+
 func GetSchedulerURL() string {
 	if u, ok := Config[schedulerURL].(string); ok {
 		return u
@@ -43,6 +53,144 @@ func GetSchedulerURL() string {
 	return ""
 }
 
+// GetWebhookURL returns the URL notified of template runs, empty when not configured
+func GetWebhookURL() string {
+	if u, ok := Config[webhookURLConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
+// GetWebhookSecret returns the secret signing the webhook notifications, empty when they are not signed
+func GetWebhookSecret() string {
+	if s, ok := Config[webhookSecretConfigKey].(string); ok {
+		return s
+	}
+	return ""
+}
+
+func GetDeprecatedInstanceTypes() []string {
+	if types, ok := Config[deprecatedInstanceTypesKey].(string); ok && types != "" {
+		return strings.Split(types, ",")
+	}
+	return awsconfig.DeprecatedInstanceTypes
+}
+
+// DefaultSubnetFreeIPsThreshold is used when 'aws.infra.subnet.freeipsthreshold' is not set
+const DefaultSubnetFreeIPsThreshold = 16
+
+// GetSubnetFreeIPsThreshold returns the number of available IP addresses under
+// which a subnet is about to exhaust. Zero disables the report
+func GetSubnetFreeIPsThreshold() int {
+	if count, ok := Config[subnetFreeIPsThresholdKey].(int); ok {
+		return count
+	}
+	return DefaultSubnetFreeIPsThreshold
+}
+
+// DefaultRedactedProperties are redacted when no property is configured under 'display.redact'
+var DefaultRedactedProperties = []string{properties.UserData}
+
+// GetRedactedProperties returns the properties whose values must not be displayed nor logged
+func GetRedactedProperties() []string {
+	if props, ok := Config[redactedPropertiesKey].(string); ok && props != "" {
+		return strings.Split(props, ",")
+	}
+	return DefaultRedactedProperties
+}
+
+// GetDisplayIDFormat returns the form of the displayed ids, empty to display them as synced
+func GetDisplayIDFormat() string {
+	format, _ := Config[displayIDFormatKey].(string)
+	return format
+}
+
+func GetCommandAlias(name string) (string, bool) {
+	alias, ok := Config[aliasesPrefix+name].(string)
+	return alias, ok && alias != ""
+}
+
+// GetDisplayAliases returns the user defined headers displayed for properties
+func GetDisplayAliases() map[string]string {
+	aliases := make(map[string]string)
+	for k, v := range Config {
+		if !strings.HasPrefix(k, displayAliasesPrefix) {
+			continue
+		}
+		prop, ok := resolvePropertyName(strings.TrimPrefix(k, displayAliasesPrefix))
+		if alias, isStr := v.(string); ok && isStr && alias != "" {
+			aliases[prop] = alias
+		}
+	}
+	return aliases
+}
+
+// GetDisplayColumns returns the properties to list as columns for the given resource type.
+// Empty when no columns are configured for it
+func GetDisplayColumns(resType string) []string {
+	if props, ok := Config[displayColumnsPrefix+resType].(string); ok && props != "" {
+		return strings.Split(props, ",")
+	}
+	return nil
+}
+
+// GetSyncRetention returns how long the local sync snapshots of the given profile
+// are kept, falling back on the global retention. Zero means they are all kept
+func GetSyncRetention(profile string) time.Duration {
+	days, ok := Config[syncRetentionPrefix+profile].(int)
+	if !ok {
+		days, _ = Config[syncRetentionConfigKey].(int)
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetSyncRoleARNs returns the roles assumed to sync all the accounts
+func GetSyncRoleARNs() []string {
+	if arns, ok := Config[syncRolesConfigKey].(string); ok && arns != "" {
+		return strings.Split(arns, ",")
+	}
+	return nil
+}
+
+// DefaultCacheTTL is how long fetched resources are served from cache when 'cache.ttl' is not set
+const DefaultCacheTTL = 60 * time.Second
+
+// GetCacheTTL returns how long the resources fetched or synced are served
+// from cache to the read commands. Zero disables the cache
+func GetCacheTTL() time.Duration {
+	if seconds, ok := Config[cacheTTLConfigKey].(int); ok {
+		return time.Duration(seconds) * time.Second
+	}
+	return DefaultCacheTTL
+}
+
+// GetTagPolicy returns per resource type the tag keys its resources are required to have
+func GetTagPolicy() map[string][]string {
+	policy := make(map[string][]string)
+	for k, v := range Config {
+		if !strings.HasPrefix(k, tagPolicyPrefix) {
+			continue
+		}
+		if keys, ok := v.(string); ok && keys != "" {
+			policy[strings.TrimPrefix(k, tagPolicyPrefix)] = strings.Split(keys, ",")
+		}
+	}
+	return policy
+}
+
+// GetBucketCompliance returns the severity ('high' or 'low') of each bucket control
+// required for the given profile. Empty when no controls are configured for it
+func GetBucketCompliance(profile string) map[string]string {
+	rules := make(map[string]string)
+	controls, _ := Config[bucketRulesPrefix+profile].(string)
+	for _, c := range strings.Split(controls, ",") {
+		if splits := strings.SplitN(c, ":", 2); len(splits) == 2 {
+			rules[splits[0]] = splits[1]
+		}
+	}
+	return rules
+}
+
 func GetConfigWithPrefix(prefix string) map[string]interface{} {
 	conf := make(map[string]interface{})
 	for k, v := range Config {
@@ -5,6 +5,9 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/wallix/awless/aws/config"
 )
 
 func TestGetSyncEnabled(t *testing.T) {
@@ -46,3 +49,259 @@ func TestGetSyncEnabled(t *testing.T) {
 		}
 	})
 }
+
+func TestGetDeprecatedInstanceTypes(t *testing.T) {
+	defer func(c map[string]interface{}) { Config = c }(Config)
+
+	Config = map[string]interface{}{}
+	if got, want := GetDeprecatedInstanceTypes(), awsconfig.DeprecatedInstanceTypes; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	Config = map[string]interface{}{deprecatedInstanceTypesKey: ""}
+	if got, want := GetDeprecatedInstanceTypes(), awsconfig.DeprecatedInstanceTypes; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	Config = map[string]interface{}{deprecatedInstanceTypesKey: "t2.micro,m4.large"}
+	if got, want := GetDeprecatedInstanceTypes(), []string{"t2.micro", "m4.large"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestGetCommandAlias(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	if err := SetVolatile("aliases.micro", " create instance type=t2.micro count=1 "); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("aliases.one", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(Defaults), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	alias, ok := GetCommandAlias("micro")
+	if !ok {
+		t.Fatal("expected alias to be found")
+	}
+	if got, want := alias, "create instance type=t2.micro count=1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := Config["aliases.one"], "1"; got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if _, ok := GetCommandAlias("unknown"); ok {
+		t.Fatal("expected alias not to be found")
+	}
+	if err := SetVolatile("aliases.empty", " "); err == nil {
+		t.Fatal("expected error for empty alias")
+	}
+}
+
+func TestGetDisplayAliases(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	if err := SetVolatile("display.alias.Type", " size "); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("display.alias.privateip", "ip"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("display.alias.unknownprop", "x"); err == nil {
+		t.Fatal("expected error for unknown property")
+	}
+	if err := SetVolatile("display.alias.Name", " "); err == nil {
+		t.Fatal("expected error for empty header")
+	}
+
+	if got, want := GetDisplayAliases(), map[string]string{"Type": "size", "PrivateIP": "ip"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestGetDisplayColumns(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	if got := GetDisplayColumns("instance"); len(got) != 0 {
+		t.Fatalf("got %#v, want none", got)
+	}
+	if err := SetVolatile("display.columns.instance", "name, type,privateip,"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetDisplayColumns("instance"), []string{"Name", "Type", "PrivateIP"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if err := SetVolatile("display.columns.volume", "name,unknownprop"); err == nil {
+		t.Fatal("expected error for unknown property")
+	}
+	if err := SetVolatile("display.columns.volume", " , "); err == nil {
+		t.Fatal("expected error for empty columns")
+	}
+}
+
+func TestGetRedactedProperties(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+	defer func(defs map[string]*Definition) { configDefinitions = defs }(configDefinitions)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	configDefinitions = map[string]*Definition{
+		redactedPropertiesKey: {parseParamFn: parseRedactedProperties},
+	}
+	if got, want := GetRedactedProperties(), []string{"UserData"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if err := SetVolatile("display.redact", "userdata, privateip"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetRedactedProperties(), []string{"UserData", "PrivateIP"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if err := SetVolatile("display.redact", "userdata,unknownprop"); err == nil {
+		t.Fatal("expected error for unknown property")
+	}
+}
+
+func TestGetSyncRetention(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+	defer func(defs map[string]*Definition) { configDefinitions = defs }(configDefinitions)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	configDefinitions = map[string]*Definition{
+		syncRetentionConfigKey: {parseParamFn: parseRetentionDays},
+	}
+	if got, want := GetSyncRetention("prod"), time.Duration(0); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if err := SetVolatile("sync.retention", "7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("sync.retention.prod", "90"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetSyncRetention("prod"), 90*24*time.Hour; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := GetSyncRetention("dev"), 7*24*time.Hour; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if err := SetVolatile("sync.retention.dev", "-1"); err == nil {
+		t.Fatal("expected error for negative retention")
+	}
+	if err := SetVolatile("sync.retention.dev", "week"); err == nil {
+		t.Fatal("expected error for non numeric retention")
+	}
+}
+
+func TestGetSubnetFreeIPsThreshold(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+	defer func(defs map[string]*Definition) { configDefinitions = defs }(configDefinitions)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	configDefinitions = map[string]*Definition{
+		subnetFreeIPsThresholdKey: {parseParamFn: parseFreeIPsThreshold},
+	}
+	if got, want := GetSubnetFreeIPsThreshold(), DefaultSubnetFreeIPsThreshold; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if err := SetVolatile("aws.infra.subnet.freeipsthreshold", "0"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetSubnetFreeIPsThreshold(), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if err := SetVolatile("aws.infra.subnet.freeipsthreshold", "-5"); err == nil {
+		t.Fatal("expected error for negative threshold")
+	}
+}
+
+func TestGetTagPolicy(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	if got, want := len(GetTagPolicy()), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if err := SetVolatile("inspect.tagpolicy.instance", "owner, cost-center,"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("inspect.tagpolicy.volume", "owner"); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]string{"instance": {"owner", "cost-center"}, "volume": {"owner"}}
+	if got, want := GetTagPolicy(), expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if err := SetVolatile("inspect.tagpolicy.subnet", " , "); err == nil {
+		t.Fatal("expected error for empty tag keys")
+	}
+}
+
+func TestGetBucketCompliance(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	if got, want := len(GetBucketCompliance("prod")), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if err := SetVolatile("inspect.bucketcompliance.prod", "private, versioning,lifecycle:LOW"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("inspect.bucketcompliance.dev", "private:low"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("inspect.bucketcompliance.staging", "encryption,publicaccessblock:low"); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"private": "high", "versioning": "high", "lifecycle": "low"}
+	if got, want := GetBucketCompliance("prod"), expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := GetBucketCompliance("dev"), map[string]string{"private": "low"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := GetBucketCompliance("staging"), map[string]string{"encryption": "high", "publicaccessblock": "low"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if err := SetVolatile("inspect.bucketcompliance.test", "replication"); err == nil {
+		t.Fatal("expected error for unknown control")
+	}
+	if err := SetVolatile("inspect.bucketcompliance.test", "versioning:medium"); err == nil {
+		t.Fatal("expected error for unknown severity")
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	defer func(c, d map[string]interface{}) { Config, Defaults = c, d }(Config, Defaults)
+	defer func(defs map[string]*Definition) { configDefinitions = defs }(configDefinitions)
+
+	Config, Defaults = map[string]interface{}{}, map[string]interface{}{}
+	configDefinitions = map[string]*Definition{
+		webhookURLConfigKey:    {parseParamFn: parseWebhookURL},
+		webhookSecretConfigKey: {},
+	}
+	if got, want := GetWebhookURL(), ""; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if err := SetVolatile("notify.webhook.url", "https://hooks.example.com/awless"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetVolatile("notify.webhook.secret", "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetWebhookURL(), "https://hooks.example.com/awless"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := GetWebhookSecret(), "s3cr3t"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	for _, invalid := range []string{"hooks.example.com", "ftp://hooks.example.com"} {
+		if err := SetVolatile("notify.webhook.url", invalid); err == nil {
+			t.Fatalf("expected error for %s", invalid)
+		}
+	}
+}
@@ -53,6 +53,8 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.CIDR},
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.AvailableIPs},
+		StringColumnDefinition{Prop: properties.TotalIPs},
 		ColoredValueColumnDefinition{
 			StringColumnDefinition: StringColumnDefinition{Prop: properties.Default, Friendly: "Default"},
 			ColoredValues:          map[string]color.Attribute{"true": color.FgGreen},
@@ -137,8 +139,26 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.PublicIP},
 		StringColumnDefinition{Prop: properties.PrivateIP},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.Associated},
+			ColoredValues:          map[string]color.Attribute{"false": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.NetworkInterface, Friendly: "Interface"},
 		StringColumnDefinition{Prop: properties.Association},
 	},
+	cloud.NetworkInterface: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Subnet},
+		StringColumnDefinition{Prop: properties.PrivateIP},
+		StringColumnDefinition{Prop: properties.PublicIP},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.Requester, Friendly: "ManagedBy"},
+		SliceColumnDefinition{StringColumnDefinition{Prop: properties.SecurityGroups}},
+	},
 	cloud.Snapshot: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Volume},
@@ -149,6 +169,48 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		StorageColumnDefinition{Unit: gb, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
 	},
+	cloud.SpotRequest: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.StateMessage, Friendly: "Status"},
+		StringColumnDefinition{Prop: properties.SpotPrice, Friendly: "Price"},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Instance},
+		StringColumnDefinition{Prop: properties.AvailabilityZone},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.ReservedInstance: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.InstanceCount, Friendly: "Count"},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.OfferingType, Friendly: "Offering"},
+		StringColumnDefinition{Prop: properties.Platform},
+		StringColumnDefinition{Prop: properties.Scope},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Expires}, Format: Short},
+	},
+	cloud.PlacementGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Strategy},
+		StringColumnDefinition{Prop: properties.State},
+	},
+	cloud.Host: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.InstanceCount, Friendly: "Instances"},
+		StringColumnDefinition{Prop: properties.AvailableCapacity, Friendly: "Available"},
+		StringColumnDefinition{Prop: properties.Capacity},
+	},
+	cloud.VpcEndpoint: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.ServiceName, Friendly: "Service"},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.RouteTables},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -158,6 +220,15 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
 		StringColumnDefinition{Prop: properties.Scheme},
 	},
+	cloud.ClassicLoadBalancer: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.PublicDNS},
+		StringColumnDefinition{Prop: properties.Listeners},
+		StringColumnDefinition{Prop: properties.HealthCheck},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+		StringColumnDefinition{Prop: properties.Scheme},
+	},
 	cloud.TargetGroup: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Vpc},
@@ -203,6 +274,26 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Subnets},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.CacheCluster: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Engine},
+		StringColumnDefinition{Prop: properties.EngineVersion, Friendly: "Version"},
+		StringColumnDefinition{Prop: properties.Class, Friendly: "NodeType"},
+		StringColumnDefinition{Prop: properties.NodeCount, Friendly: "Nodes"},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		StringColumnDefinition{Prop: properties.Port},
+		StringColumnDefinition{Prop: properties.ReplicationGroup},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+	},
+	cloud.CacheSubnetGroup: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.Subnets},
+		StringColumnDefinition{Prop: properties.Description},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -269,6 +360,98 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		StringColumnDefinition{Prop: properties.AgentConnected},
 	},
+	//Firewall
+	cloud.WebACL: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Scope},
+		StringColumnDefinition{Prop: properties.DefaultAction},
+		StringColumnDefinition{Prop: properties.RuleCount, Friendly: "Rules"},
+		StringColumnDefinition{Prop: properties.MetricName},
+	},
+	// Certificates
+	cloud.Certificate: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.InUse},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Expires}, Format: Short},
+		SliceColumnDefinition{StringColumnDefinition{Prop: properties.AlternateNames}},
+		StringColumnDefinition{Prop: properties.Arn},
+	},
+	// Beanstalk
+	cloud.BeanstalkApplication: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Description},
+		SliceColumnDefinition{StringColumnDefinition{Prop: properties.Versions}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+	cloud.BeanstalkEnvironment: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Application},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"Ready": color.FgGreen, "Terminated": color.FgRed},
+		},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.Health},
+			ColoredValues:          map[string]color.Attribute{"Green": color.FgGreen, "Yellow": color.FgYellow, "Red": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.Version},
+		StringColumnDefinition{Prop: properties.Tier},
+		StringColumnDefinition{Prop: properties.PublicDNS},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+	// API Gateway
+	cloud.RestApi: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Protocol},
+		SliceColumnDefinition{StringColumnDefinition{Prop: properties.Stages}},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.ApiStage: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Deployment},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+	// SSM
+	cloud.Parameter: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.KMSKey},
+		StringColumnDefinition{Prop: properties.Description},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+		StringColumnDefinition{Prop: properties.ModifiedBy},
+	},
+	// KMS
+	cloud.KMSKey: {
+		StringColumnDefinition{Prop: properties.ID},
+		SliceColumnDefinition{StringColumnDefinition{Prop: properties.Aliases}},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.State},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	// GuardDuty
+	cloud.Finding: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Severity},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Instance},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+	// Secrets Manager
+	cloud.Secret: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.KMSKey},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
 	//IAM
 	cloud.User: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -308,6 +491,8 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	cloud.Bucket: {
 		StringColumnDefinition{Prop: properties.ID},
 		GrantsColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Grants}},
+		StringColumnDefinition{Prop: properties.Versioning},
+		StringColumnDefinition{Prop: properties.Encryption},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 	},
 	cloud.S3Object: {
@@ -318,6 +503,17 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
 		StringColumnDefinition{Prop: properties.Class},
 	},
+	// DynamoDB
+	cloud.Table: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		StringColumnDefinition{Prop: properties.BillingMode},
+		StringColumnDefinition{Prop: properties.KeySchema},
+		StringColumnDefinition{Prop: properties.ItemCount},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		StringColumnDefinition{Prop: properties.StreamEnabled},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
 	//Notification
 	cloud.Subscription: {
 		StringColumnDefinition{Prop: properties.Arn},
@@ -328,6 +524,9 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.Topic: {
 		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.DisplayName},
+		StringColumnDefinition{Prop: properties.Owner},
 	},
 	//Queue
 	cloud.Queue: {
@@ -336,6 +535,8 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified, Friendly: "LastModif"}},
 		StringColumnDefinition{Prop: properties.Delay, Friendly: "Delay(s)"},
+		StringColumnDefinition{Prop: properties.VisibilityTimeout, Friendly: "Visibility(s)"},
+		ARNLastValueColumnDefinition{Separator: ":", StringColumnDefinition: StringColumnDefinition{Prop: properties.DeadLetterQueue, Friendly: "DeadLetter"}},
 	},
 	// DNS
 	cloud.Zone: {
@@ -370,6 +571,12 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Namespace},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Dimensions}},
 	},
+	cloud.LogGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		UnsetValueColumnDefinition{ColumnDefinition: StringColumnDefinition{Prop: properties.Retention, Friendly: "Retention (days)"}, Unset: "never expire"},
+		StorageColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.StoredBytes, Friendly: "Stored"}, Unit: b},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
 	cloud.Alarm: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Namespace},
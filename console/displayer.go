@@ -18,13 +18,14 @@ package console
 
 import (
 	"bytes"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/fatih/color"
@@ -60,6 +61,11 @@ type Builder struct {
 	dataSource      interface{}
 	root            *graph.Resource
 	noHeaders       bool
+	template        string
+	headerAliases   map[string]string
+	redacted        []string
+	idFormat        string
+	deprecated      map[string]map[string]bool
 }
 
 func (b *Builder) SetSource(i interface{}) *Builder {
@@ -114,66 +120,123 @@ func (b *Builder) buildGraphTagValueFilters() (funcs []graph.FilterFn) {
 	return
 }
 
+// Filter returns the resources of the graph of the builder rdf type matching
+// its property and tag filters, as the listings display them
+func (b *Builder) Filter(g *graph.Graph) (*graph.Graph, error) {
+	filteredGraph := g
+
+	if filters, err := b.buildGraphFilters(); len(filters) > 0 && err == nil {
+		filteredGraph, err = filteredGraph.Filter(b.rdfType, filters...)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var ferr error
+	if filters := b.buildGraphTagFilters(); len(filters) > 0 {
+		filteredGraph, ferr = filteredGraph.Filter(b.rdfType, filters...)
+		if ferr != nil {
+			return nil, ferr
+		}
+	}
+	if filters := b.buildGraphTagKeyFilters(); len(filters) > 0 {
+		filteredGraph, ferr = filteredGraph.OrFilter(b.rdfType, filters...)
+		if ferr != nil {
+			return nil, ferr
+		}
+	}
+	if filters := b.buildGraphTagValueFilters(); len(filters) > 0 {
+		filteredGraph, ferr = filteredGraph.OrFilter(b.rdfType, filters...)
+		if ferr != nil {
+			return nil, ferr
+		}
+	}
+
+	return filteredGraph, nil
+}
+
 func (b *Builder) Build() (Displayer, error) {
+	if len(b.redacted) > 0 {
+		switch src := b.dataSource.(type) {
+		case *graph.Graph:
+			b.dataSource = src.Redacted(RedactedValue, b.redacted...)
+		case *graph.Resource:
+			b.dataSource = src.Redacted(RedactedValue, b.redacted...)
+		}
+	}
+	if b.idFormat != "" {
+		formatID := func(id, arn string) string { return FormatID(b.idFormat, id, arn) }
+		switch src := b.dataSource.(type) {
+		case *graph.Graph:
+			b.dataSource = src.WithIDProperties(formatID)
+		case *graph.Resource:
+			b.dataSource = src.WithIDProperty(formatID)
+		}
+	}
+
 	base := fromGraphDisplayer{sorter: &defaultSorter{sortBy: b.sort}, rdfType: b.rdfType, headers: b.headers, maxwidth: b.maxwidth, noHeaders: b.noHeaders}
 
+	var tpl *template.Template
+	if b.template != "" {
+		var err error
+		if tpl, err = parseDisplayTemplate(b.template); err != nil {
+			return nil, err
+		}
+		b.format = "template"
+		if res, ok := b.dataSource.(*graph.Resource); ok {
+			dis := &templateResourceDisplayer{tpl: tpl}
+			dis.SetResource(res)
+			return dis, nil
+		}
+	} else if b.format == "template" {
+		return nil, errors.New("template format requires a template string")
+	}
+
 	switch b.dataSource.(type) {
 	case *graph.Graph:
 		if b.rdfType == "" {
 			gph := b.dataSource.(*graph.Graph)
 			switch b.format {
+			case "template":
+				dis := &templateDisplayer{base, tpl}
+				dis.setGraph(gph)
+				return dis, nil
 			case "table":
-				dis := &multiResourcesTableDisplayer{base}
+				dis := &multiResourcesTableDisplayer{fromGraphDisplayer: base, aliases: b.headerAliases}
 				dis.setGraph(gph)
 				return dis, nil
 			case "json":
 				dis := &multiResourcesJSONDisplayer{base}
 				dis.setGraph(gph)
 				return dis, nil
+			case "ttl":
+				dis := &turtleDisplayer{base}
+				dis.setGraph(gph)
+				return dis, nil
 			case "porcelain":
 				dis := &porcelainDisplayer{base}
 				dis.setGraph(gph)
 				return dis, nil
 			default:
 				fmt.Fprintf(os.Stderr, "unknown format '%s', display as 'table'\n", b.format)
-				dis := &multiResourcesTableDisplayer{base}
+				dis := &multiResourcesTableDisplayer{fromGraphDisplayer: base, aliases: b.headerAliases}
 				dis.setGraph(gph)
 				return dis, nil
 			}
 		}
 
-		filteredGraph := b.dataSource.(*graph.Graph)
-
-		if filters, err := b.buildGraphFilters(); len(filters) > 0 && err == nil {
-			filteredGraph, err = filteredGraph.Filter(b.rdfType, filters...)
-			if err != nil {
-				return nil, err
-			}
-		} else if err != nil {
+		filteredGraph, err := b.Filter(b.dataSource.(*graph.Graph))
+		if err != nil {
 			return nil, err
 		}
 
-		var ferr error
-		if filters := b.buildGraphTagFilters(); len(filters) > 0 {
-			filteredGraph, ferr = filteredGraph.Filter(b.rdfType, filters...)
-			if ferr != nil {
-				return nil, ferr
-			}
-		}
-		if filters := b.buildGraphTagKeyFilters(); len(filters) > 0 {
-			filteredGraph, ferr = filteredGraph.OrFilter(b.rdfType, filters...)
-			if ferr != nil {
-				return nil, ferr
-			}
-		}
-		if filters := b.buildGraphTagValueFilters(); len(filters) > 0 {
-			filteredGraph, ferr = filteredGraph.OrFilter(b.rdfType, filters...)
-			if ferr != nil {
-				return nil, ferr
-			}
-		}
-
 		switch b.format {
+		case "template":
+			dis := &templateDisplayer{base, tpl}
+			dis.setGraph(filteredGraph)
+			return dis, nil
 		case "csv":
 			dis := &csvDisplayer{base}
 			dis.setGraph(filteredGraph)
@@ -186,24 +249,42 @@ func (b *Builder) Build() (Displayer, error) {
 			dis := &jsonDisplayer{base}
 			dis.setGraph(filteredGraph)
 			return dis, nil
+		case "ttl":
+			dis := &turtleDisplayer{base}
+			dis.setGraph(filteredGraph)
+			return dis, nil
 		case "porcelain":
 			dis := &porcelainDisplayer{base}
 			dis.setGraph(filteredGraph)
 			return dis, nil
 		case "table":
+			base.headers = aliasColumns(deprecateColumns(base.headers, b.deprecated), b.headerAliases)
 			dis := &tableDisplayer{base}
 			dis.setGraph(filteredGraph)
 			return dis, nil
 		default:
 			fmt.Fprintf(os.Stderr, "unknown format '%s', display as 'table'\n", b.format)
+			base.headers = aliasColumns(deprecateColumns(base.headers, b.deprecated), b.headerAliases)
 			dis := &tableDisplayer{base}
 			dis.setGraph(filteredGraph)
 			return dis, nil
 		}
 	case *graph.Resource:
-		dis := &tableResourceDisplayer{headers: b.headers, maxwidth: b.maxwidth}
-		dis.SetResource(b.dataSource.(*graph.Resource))
-		return dis, nil
+		res := b.dataSource.(*graph.Resource)
+		switch b.format {
+		case "json":
+			return &jsonResourceDisplayer{r: res}, nil
+		case "ttl":
+			return &turtleResourceDisplayer{r: res}, nil
+		case "csv":
+			return &separatedResourceDisplayer{r: res, headers: b.headers, separator: ",", noHeaders: b.noHeaders}, nil
+		case "tsv":
+			return &separatedResourceDisplayer{r: res, headers: b.headers, separator: "\t", noHeaders: b.noHeaders}, nil
+		default:
+			dis := &tableResourceDisplayer{headers: b.headers, maxwidth: b.maxwidth, aliases: b.headerAliases}
+			dis.SetResource(res)
+			return dis, nil
+		}
 	case *graph.Diff:
 		base := fromDiffDisplayer{root: b.root}
 		switch b.format {
@@ -259,6 +340,53 @@ func WithHeaders(h []ColumnDefinition) optsFn {
 	}
 }
 
+// WithDeprecatedValues flags the given values of a column as deprecated (ex: previous generation instance types).
+// Only the table format annotates them, keeping the values of the other formats untouched
+func WithDeprecatedValues(prop string, values []string) optsFn {
+	return func(b *Builder) *Builder {
+		if len(values) == 0 {
+			return b
+		}
+		if b.deprecated == nil {
+			b.deprecated = make(map[string]map[string]bool)
+		}
+		deprecated := make(map[string]bool)
+		for _, v := range values {
+			deprecated[v] = true
+		}
+		b.deprecated[prop] = deprecated
+		return b
+	}
+}
+
+// RedactedValue is displayed instead of the values of redacted properties
+const RedactedValue = "***"
+
+// WithRedactedProperties displays the values of the given properties as RedactedValue in all formats
+func WithRedactedProperties(props []string) optsFn {
+	return func(b *Builder) *Builder {
+		b.redacted = props
+		return b
+	}
+}
+
+// WithIDFormat displays the id of resources in the given form (see FormatID) in all formats
+func WithIDFormat(format string) optsFn {
+	return func(b *Builder) *Builder {
+		b.idFormat = format
+		return b
+	}
+}
+
+// WithHeaderAliases renames the displayed headers of the given properties in tables,
+// leaving machine formats and the keys used in filters and sorting untouched
+func WithHeaderAliases(aliases map[string]string) optsFn {
+	return func(b *Builder) *Builder {
+		b.headerAliases = aliases
+		return b
+	}
+}
+
 func WithFilters(fs []string) optsFn {
 	return func(b *Builder) *Builder {
 		b.filters = fs
@@ -342,6 +470,13 @@ func WithNoHeaders(nh bool) optsFn {
 	}
 }
 
+func WithTemplate(tpl string) optsFn {
+	return func(b *Builder) *Builder {
+		b.template = tpl
+		return b
+	}
+}
+
 type table [][]interface{}
 
 type fromGraphDisplayer struct {
@@ -471,10 +606,27 @@ func (d *jsonDisplayer) Print(w io.Writer) error {
 		props = append(props, res.Properties)
 	}
 
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", " ")
+	return PrintJSON(w, props)
+}
 
-	return enc.Encode(props)
+// turtleDisplayer encodes in RDF Turtle the resources of the given type with their relations,
+// or the whole graph when no type is given
+type turtleDisplayer struct {
+	fromGraphDisplayer
+}
+
+func (d *turtleDisplayer) Print(w io.Writer) error {
+	if d.rdfType == "" {
+		return d.g.MarshalTurtle(w)
+	}
+	resources, err := d.g.GetAllResources(d.rdfType)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	return d.g.MarshalTurtle(w, resources...)
 }
 
 type tableDisplayer struct {
@@ -629,6 +781,7 @@ func (d *porcelainDisplayer) Print(w io.Writer) error {
 
 type multiResourcesTableDisplayer struct {
 	fromGraphDisplayer
+	aliases map[string]string
 }
 
 func (d *multiResourcesTableDisplayer) Print(w io.Writer) error {
@@ -650,6 +803,7 @@ func (d *multiResourcesTableDisplayer) Print(w io.Writer) error {
 				if header == nil {
 					header = &StringColumnDefinition{Prop: prop}
 				}
+				header = aliasColumn(header, d.aliases)
 				var row [4]interface{}
 				row[0] = t
 				row[1] = nameOrID(res)
@@ -707,10 +861,7 @@ func (d *multiResourcesJSONDisplayer) Print(w io.Writer) error {
 		}
 	}
 
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", " ")
-
-	return enc.Encode(all)
+	return PrintJSON(w, all)
 }
 
 type fromDiffDisplayer struct {
@@ -18,6 +18,7 @@ package console
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -884,3 +885,332 @@ func TestNoHeadersDisplay(t *testing.T) {
 		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
 	}
 }
+
+func TestDeprecatedValuesDisplay(t *testing.T) {
+	g := createInfraGraph()
+	headers := []ColumnDefinition{
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Type"},
+	}
+
+	autowrapMaxSize = 35
+	tableColWidth = 30
+	displayer, _ := BuildOptions(
+		WithRdfType("instance"),
+		WithHeaders(headers),
+		WithDeprecatedValues("Type", []string{"t2.medium", "m1.small"}),
+		WithFormat("table"),
+	).SetSource(g).Build()
+
+	expected := `|  ID ▲  |          TYPE          |
+|--------|------------------------|
+| inst_1 | t2.micro               |
+| inst_2 | t2.medium (deprecated) |
+| inst_3 | t2.xlarge              |
+`
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
+	}
+	if _, ok := headers[1].(StringColumnDefinition); !ok {
+		t.Fatalf("given headers should not be modified, got %T", headers[1])
+	}
+
+	for _, format := range []string{"csv", "json"} {
+		displayer, _ = BuildOptions(
+			WithRdfType("instance"),
+			WithHeaders(headers),
+			WithDeprecatedValues("Type", []string{"t2.medium"}),
+			WithFilters([]string{"type=t2.medium"}),
+			WithFormat(format),
+		).SetSource(g).Build()
+
+		w.Reset()
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got := w.String(); !strings.Contains(got, "t2.medium") || strings.Contains(got, "deprecated") {
+			t.Fatalf("%s: got \n%q\n\nwant raw value", format, got)
+		}
+	}
+}
+
+func TestHeaderAliasesDisplay(t *testing.T) {
+	g := createInfraGraph()
+	headers := []ColumnDefinition{
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Type"},
+	}
+	aliases := map[string]string{"Type": "size"}
+
+	autowrapMaxSize = 20
+	tableColWidth = 20
+	displayer, _ := BuildOptions(
+		WithRdfType("instance"),
+		WithHeaders(headers),
+		WithHeaderAliases(aliases),
+		WithFilters([]string{"type=t2.micro"}),
+		WithSortBy("type"),
+		WithFormat("table"),
+	).SetSource(g).Build()
+
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if out := w.String(); !strings.Contains(out, "SIZE") || strings.Contains(out, "TYPE") || !strings.Contains(out, "inst_1") || strings.Contains(out, "inst_2") {
+		t.Fatalf("unexpected table output\n%s", out)
+	}
+
+	displayer, _ = BuildOptions(
+		WithRdfType("instance"),
+		WithHeaders(headers),
+		WithHeaderAliases(aliases),
+		WithFormat("csv"),
+	).SetSource(g).Build()
+
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.SplitN(w.String(), "\n", 2)[0], "ID,Type"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestColumnDefinitionsFor(t *testing.T) {
+	defer func(defs map[string][]ColumnDefinition) { DefaultsColumnDefinitions = defs }(DefaultsColumnDefinitions)
+	DefaultsColumnDefinitions = map[string][]ColumnDefinition{"instance": {
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"running": color.FgGreen}},
+	}}
+
+	columns, err := ColumnDefinitionsFor("instance", []string{"name", " State", "userdata"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var titles []string
+	for _, c := range columns {
+		titles = append(titles, c.title(false))
+	}
+	if got, want := strings.Join(titles, ","), "Name,State,UserData"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, isColored := columns[1].(ColoredValueColumnDefinition); !isColored {
+		t.Fatalf("expected default formatting of State column, got %T", columns[1])
+	}
+	if _, err = ColumnDefinitionsFor("instance", []string{"name", "unknownprop"}); err == nil || !strings.Contains(err.Error(), "unknown column 'unknownprop'") {
+		t.Fatalf("got %v, want unknown column error", err)
+	}
+	if _, err = ColumnDefinitionsFor("instance", []string{" "}); err == nil {
+		t.Fatal("expected error for no columns")
+	}
+}
+
+func TestRedactedPropertiesDisplay(t *testing.T) {
+	g := createInfraGraph()
+	headers := []ColumnDefinition{
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Type"},
+	}
+
+	for _, format := range []string{"csv", "json", "ttl"} {
+		displayer, _ := BuildOptions(
+			WithRdfType("instance"),
+			WithHeaders(headers),
+			WithRedactedProperties([]string{"Type"}),
+			WithFormat(format),
+		).SetSource(g).Build()
+
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if out := w.String(); strings.Contains(out, "t2.micro") || !strings.Contains(out, RedactedValue) || !strings.Contains(out, "inst_1") {
+			t.Fatalf("%s: unexpected output\n%s", format, out)
+		}
+	}
+
+	res, err := g.GetResource("instance", "inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	displayer, _ := BuildOptions(
+		WithHeaders(headers),
+		WithRedactedProperties([]string{"Type"}),
+		WithFormat("json"),
+	).SetSource(res).Build()
+
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if out := w.String(); strings.Contains(out, "t2.micro") || !strings.Contains(out, RedactedValue) {
+		t.Fatalf("unexpected resource output\n%s", out)
+	}
+	if got, want := res.Properties["Type"], "t2.micro"; got != want {
+		t.Fatalf("source resource modified: got %v, want %v", got, want)
+	}
+}
+
+func TestIDFormatDisplay(t *testing.T) {
+	tgArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067"
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.TargetGroup(tgArn).Prop(p.Arn, tgArn).Build(),
+		resourcetest.Function("api").Prop(p.Arn, "arn:aws:lambda:us-east-1:123456789012:function:api").Build(),
+		resourcetest.Policy("arn:aws:iam::aws:policy/ReadOnlyAccess").Build(),
+	)
+	headers := []ColumnDefinition{StringColumnDefinition{Prop: "ID"}}
+
+	tcases := []struct {
+		rdfType, format, expected string
+	}{
+		{"targetgroup", IDFormatShort, "web/73e2d6bc24d8a067"},
+		{"targetgroup", IDFormatARN, tgArn},
+		{"function", IDFormatShort, "api"},
+		{"function", IDFormatARN, "arn:aws:lambda:us-east-1:123456789012:function:api"},
+		{"policy", IDFormatShort, "ReadOnlyAccess"},
+		{"policy", IDFormatARN, "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		{"policy", "", "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+	}
+	for i, tcase := range tcases {
+		displayer, _ := BuildOptions(
+			WithRdfType(tcase.rdfType),
+			WithHeaders(headers),
+			WithIDFormat(tcase.format),
+			WithFormat("csv"),
+			WithNoHeaders(true),
+		).SetSource(g).Build()
+
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := strings.TrimSpace(w.String()), tcase.expected; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+
+	res, err := g.GetResource("function", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	displayer, _ := BuildOptions(WithHeaders(headers), WithIDFormat(IDFormatARN), WithFormat("csv"), WithNoHeaders(true)).SetSource(res).Build()
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "arn:aws:lambda:us-east-1:123456789012:function:api"; !strings.Contains(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := res.Properties["ID"], "api"; got != want {
+		t.Fatalf("source resource modified: got %v, want %v", got, want)
+	}
+}
+
+func TestTurtleDisplay(t *testing.T) {
+	g := createInfraGraph()
+
+	displayer, err := BuildOptions(
+		WithRdfType("instance"),
+		WithHeaders([]ColumnDefinition{StringColumnDefinition{Prop: "ID"}, StringColumnDefinition{Prop: "State"}}),
+		WithFilters([]string{"state=running"}),
+		WithFormat("ttl"),
+	).SetSource(g).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	for _, exp := range []string{"@prefix cloud-owl: <http://awless.io/ontology/cloud-owl#> .", "<http://awless.io/resources/inst_1>\n    a cloud-owl:Instance ;", "<http://awless.io/resources/inst_3>"} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("expected %q in\n%s", exp, out)
+		}
+	}
+	if strings.Contains(out, "<http://awless.io/resources/inst_2>\n") {
+		t.Fatalf("unexpected filtered out instance in\n%s", out)
+	}
+}
+
+func TestTemplateDisplay(t *testing.T) {
+	g := createInfraGraph()
+	headers := []ColumnDefinition{
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Name"},
+	}
+
+	t.Run("Graph", func(t *testing.T) {
+		displayer, err := BuildOptions(
+			WithHeaders(headers),
+			WithRdfType("instance"),
+			WithTemplate("{{.type}} {{.name}} ({{.id}}) {{.State}} {{.publicip}}"),
+			WithSortBy("name"),
+		).SetSource(g).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := "instance apache (inst_3) running \n" +
+			"instance django (inst_2) stopped \n" +
+			"instance redis (inst_1) running 1.2.3.4\n"
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), expected; got != want {
+			t.Fatalf("got \n%q\n\nwant\n\n%q\n", got, want)
+		}
+	})
+
+	t.Run("Resource", func(t *testing.T) {
+		res := resourcetest.Instance("inst_1").Prop(p.Name, "redis").Prop(p.Type, "t2.micro").Build()
+		displayer, err := BuildOptions(
+			WithTemplate("{{.name}}: {{.Type}}\n"),
+		).SetSource(res).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), "redis: t2.micro\n"; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Invalid field", func(t *testing.T) {
+		displayer, err := BuildOptions(
+			WithRdfType("instance"),
+			WithTemplate("{{.nme}}"),
+		).SetSource(g).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var w bytes.Buffer
+		err = displayer.Print(&w)
+		if err == nil {
+			t.Fatal("expected error got none")
+		}
+		if got, want := err.Error(), `unknown field "nme"`; !strings.Contains(got, want) {
+			t.Fatalf("got %q, want to contain %q", got, want)
+		}
+	})
+
+	t.Run("Invalid syntax", func(t *testing.T) {
+		if _, err := BuildOptions(WithRdfType("instance"), WithTemplate("{{.name")).SetSource(g).Build(); err == nil {
+			t.Fatal("expected error got none")
+		}
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/graph"
 )
 
@@ -56,6 +57,41 @@ func (d ColumnDefinitions) resolveKey(name string) string {
 	return ""
 }
 
+// ColumnDefinitionsFor returns the columns displaying the given properties (case insensitive)
+// of a resource type, keeping the formatting of its default columns
+func ColumnDefinitionsFor(rdfType string, names []string) ([]ColumnDefinition, error) {
+	defaults := ColumnDefinitions(DefaultsColumnDefinitions[rdfType])
+	var columns []ColumnDefinition
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if key := defaults.resolveKey(name); key != "" {
+			for _, def := range defaults {
+				if def.propKey() == key {
+					columns = append(columns, def)
+					break
+				}
+			}
+			continue
+		}
+		var found bool
+		for label := range rdf.Labels {
+			if strings.EqualFold(label, name) {
+				columns, found = append(columns, StringColumnDefinition{Prop: label}), true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column '%s' for %s", name, rdfType)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns given for %s", rdfType)
+	}
+	return columns, nil
+}
+
 type StringColumnDefinition struct {
 	Prop, Friendly string
 }
@@ -78,6 +114,38 @@ func (h StringColumnDefinition) title(displayAscSymbol bool) string {
 	return t
 }
 
+// AliasedColumnDefinition displays a column under a user defined title
+type AliasedColumnDefinition struct {
+	ColumnDefinition
+	Alias string
+}
+
+func (h AliasedColumnDefinition) title(displayAscSymbol bool) string {
+	t := h.Alias
+	if displayAscSymbol {
+		t += ascSymbol
+	}
+	return t
+}
+
+func aliasColumn(h ColumnDefinition, aliases map[string]string) ColumnDefinition {
+	if alias, ok := aliases[h.propKey()]; ok && alias != "" {
+		return AliasedColumnDefinition{ColumnDefinition: h, Alias: alias}
+	}
+	return h
+}
+
+func aliasColumns(headers []ColumnDefinition, aliases map[string]string) []ColumnDefinition {
+	if len(aliases) == 0 {
+		return headers
+	}
+	aliased := make([]ColumnDefinition, len(headers))
+	for i, h := range headers {
+		aliased[i] = aliasColumn(h, aliases)
+	}
+	return aliased
+}
+
 type ColoredValueColumnDefinition struct {
 	StringColumnDefinition
 	ColoredValues map[string]color.Attribute
@@ -92,6 +160,48 @@ func (h ColoredValueColumnDefinition) format(i interface{}) string {
 	return str
 }
 
+// UnsetValueColumnDefinition highlights the resources without value for a property
+// (ex: log groups without retention keep their events forever)
+type UnsetValueColumnDefinition struct {
+	ColumnDefinition
+	Unset string
+}
+
+func (h UnsetValueColumnDefinition) format(i interface{}) string {
+	if i == nil {
+		return color.New(color.FgYellow).SprintFunc()(h.Unset)
+	}
+	return h.ColumnDefinition.format(i)
+}
+
+// DeprecatedValueColumnDefinition highlights and annotates the deprecated values of a column in tables
+type DeprecatedValueColumnDefinition struct {
+	ColumnDefinition
+	Deprecated map[string]bool
+}
+
+func (h DeprecatedValueColumnDefinition) format(i interface{}) string {
+	str := h.ColumnDefinition.format(i)
+	if h.Deprecated[fmt.Sprint(i)] {
+		return color.New(color.FgYellow).SprintFunc()(str + " (deprecated)")
+	}
+	return str
+}
+
+func deprecateColumns(headers []ColumnDefinition, deprecated map[string]map[string]bool) []ColumnDefinition {
+	if len(deprecated) == 0 {
+		return headers
+	}
+	columns := make([]ColumnDefinition, len(headers))
+	for i, h := range headers {
+		if values, ok := deprecated[h.propKey()]; ok {
+			h = DeprecatedValueColumnDefinition{ColumnDefinition: h, Deprecated: values}
+		}
+		columns[i] = h
+	}
+	return columns
+}
+
 type ARNLastValueColumnDefinition struct {
 	StringColumnDefinition
 	Separator string
@@ -0,0 +1,41 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import "github.com/wallix/awless/cloud"
+
+// Forms in which the id of resources can be displayed
+const (
+	IDFormatShort = "short"
+	IDFormatARN   = "arn"
+)
+
+// FormatID returns the id of a resource in the given form: its ARN if known, or the short
+// id of the resources identified by an ARN. The id is returned as is for other formats
+func FormatID(format, id, arn string) string {
+	switch format {
+	case IDFormatARN:
+		if arn != "" {
+			return arn
+		}
+	case IDFormatShort:
+		if cloud.IsARN(id) {
+			return cloud.ShortARN(id)
+		}
+	}
+	return id
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Output formats of the global --format flag
+const (
+	TableFormat  = "table"
+	CSVFormat    = "csv"
+	TSVFormat    = "tsv"
+	JSONFormat   = "json"
+	TurtleFormat = "ttl"
+	SARIFFormat  = "sarif"
+)
+
+var OutputFormats = []string{TableFormat, CSVFormat, TSVFormat, JSONFormat, TurtleFormat, SARIFFormat}
+
+var (
+	outputFormat = TableFormat
+	quietOutput  bool
+)
+
+// SetOutput configures the output of all commands from the global
+// --format, --json and --quiet flags. The --json flag is a shortcut for --format json
+func SetOutput(format string, asJSON, quiet bool) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = TableFormat
+	}
+	if !contains(OutputFormats, format) {
+		return fmt.Errorf("unknown format '%s', expecting any of: %s", format, strings.Join(OutputFormats, ", "))
+	}
+	if asJSON {
+		if format != TableFormat && format != JSONFormat {
+			return fmt.Errorf("--json conflicts with --format %s", format)
+		}
+		format = JSONFormat
+	}
+
+	outputFormat = format
+	quietOutput = quiet
+	return nil
+}
+
+// OutputFormat returns the format in which commands display their results
+func OutputFormat() string {
+	return outputFormat
+}
+
+// IsJSONOutput returns true when commands should display their results as JSON
+func IsJSONOutput() bool {
+	return outputFormat == JSONFormat
+}
+
+// IsQuiet returns true when commands should only display their results:
+// no logging and no extra human readable sections
+func IsQuiet() bool {
+	return quietOutput
+}
+
+// CheckOutputFormat returns an error when the current output format
+// is not one of the formats supported by the given command
+func CheckOutputFormat(command string, supported ...string) error {
+	if !contains(supported, outputFormat) {
+		return fmt.Errorf("%s: format '%s' not supported, expecting any of: %s", command, outputFormat, strings.Join(supported, ", "))
+	}
+	return nil
+}
+
+// PrintOutput displays v as JSON in json format,
+// otherwise delegates to the human readable printer
+func PrintOutput(w io.Writer, v interface{}, human func(io.Writer) error) error {
+	if IsJSONOutput() {
+		return PrintJSON(w, v)
+	}
+	return human(w)
+}
+
+func PrintJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(v)
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
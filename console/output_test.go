@@ -0,0 +1,115 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSetOutput(t *testing.T) {
+	defer SetOutput(TableFormat, false, false)
+
+	tcases := []struct {
+		format       string
+		json, quiet  bool
+		expFormat    string
+		expErrSubstr string
+	}{
+		{format: "", expFormat: "table"},
+		{format: "table", expFormat: "table"},
+		{format: "CSV", expFormat: "csv"},
+		{format: "tsv", quiet: true, expFormat: "tsv"},
+		{format: "json", expFormat: "json"},
+		{format: "ttl", expFormat: "ttl"},
+		{format: "table", json: true, expFormat: "json"},
+		{format: "json", json: true, quiet: true, expFormat: "json"},
+		{format: "csv", json: true, expErrSubstr: "--json conflicts with --format csv"},
+		{format: "yaml", expErrSubstr: "unknown format 'yaml'"},
+	}
+
+	for i, tcase := range tcases {
+		SetOutput(TableFormat, false, false)
+		err := SetOutput(tcase.format, tcase.json, tcase.quiet)
+		if tcase.expErrSubstr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.expErrSubstr) {
+				t.Fatalf("%d: got %v, want error containing %q", i+1, err, tcase.expErrSubstr)
+			}
+			if got, want := OutputFormat(), TableFormat; got != want {
+				t.Fatalf("%d: format should be untouched on error: got %s, want %s", i+1, got, want)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := OutputFormat(), tcase.expFormat; got != want {
+			t.Fatalf("%d: format: got %s, want %s", i+1, got, want)
+		}
+		if got, want := IsJSONOutput(), tcase.expFormat == "json"; got != want {
+			t.Fatalf("%d: json: got %t, want %t", i+1, got, want)
+		}
+		if got, want := IsQuiet(), tcase.quiet; got != want {
+			t.Fatalf("%d: quiet: got %t, want %t", i+1, got, want)
+		}
+	}
+}
+
+func TestCheckOutputFormat(t *testing.T) {
+	defer SetOutput(TableFormat, false, false)
+
+	SetOutput("csv", false, false)
+	if err := CheckOutputFormat("list", OutputFormats...); err != nil {
+		t.Fatal(err)
+	}
+	err := CheckOutputFormat("whoami", TableFormat, JSONFormat)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := err.Error(), "whoami: format 'csv' not supported, expecting any of: table, json"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestPrintOutput(t *testing.T) {
+	defer SetOutput(TableFormat, false, false)
+
+	data := map[string]string{"Name": "jsmith"}
+	human := func(w io.Writer) error {
+		_, err := io.WriteString(w, "Name: jsmith\n")
+		return err
+	}
+
+	var w bytes.Buffer
+	if err := PrintOutput(&w, data, human); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "Name: jsmith\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	SetOutput(TableFormat, true, false)
+	w.Reset()
+	if err := PrintOutput(&w, data, human); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "{\n \"Name\": \"jsmith\"\n}\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
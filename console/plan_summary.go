@@ -0,0 +1,120 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template"
+)
+
+// planSummaryActions are always part of a plan summary, even when no statement has them
+var planSummaryActions = []string{"create", "update", "delete"}
+
+// PlanSummary counts the statements of a compiled template by action and entity,
+// to give the magnitude of a run before its detailed plan. The statements
+// checking, waiting for or importing resources change nothing and are not counted
+type PlanSummary struct {
+	Actions []*PlannedAction
+	Skipped int
+}
+
+type PlannedAction struct {
+	Action   string
+	Entities []*PlannedEntity
+}
+
+type PlannedEntity struct {
+	Entity string
+	Count  int
+}
+
+func NewPlanSummary(tpl *template.Template) *PlanSummary {
+	summary := &PlanSummary{}
+	counts := make(map[string]map[string]int)
+	for _, cmd := range tpl.CommandNodesIterator() {
+		switch cmd.Action {
+		case "check", "wait", "import":
+			continue
+		}
+		if cmd.CmdSkipped {
+			summary.Skipped++
+			continue
+		}
+		if counts[cmd.Action] == nil {
+			counts[cmd.Action] = make(map[string]int)
+		}
+		counts[cmd.Action][cmd.Entity]++
+	}
+
+	actions := append([]string{}, planSummaryActions...)
+	var others []string
+	for action := range counts {
+		if !contains(planSummaryActions, action) {
+			others = append(others, action)
+		}
+	}
+	sort.Strings(others)
+	for _, action := range append(actions, others...) {
+		planned := &PlannedAction{Action: action}
+		for entity, count := range counts[action] {
+			planned.Entities = append(planned.Entities, &PlannedEntity{Entity: entity, Count: count})
+		}
+		sort.Slice(planned.Entities, func(i, j int) bool {
+			if planned.Entities[i].Count != planned.Entities[j].Count {
+				return planned.Entities[i].Count > planned.Entities[j].Count
+			}
+			return planned.Entities[i].Entity < planned.Entities[j].Entity
+		})
+		summary.Actions = append(summary.Actions, planned)
+	}
+	return summary
+}
+
+// String returns the summary as a sentence: "Will create 3 instances, 1 vpc; update 2 securitygroups; delete 0."
+func (s *PlanSummary) String() string {
+	var parts []string
+	for _, a := range s.Actions {
+		if len(a.Entities) == 0 {
+			parts = append(parts, fmt.Sprintf("%s 0", a.Action))
+			continue
+		}
+		var entities []string
+		for _, e := range a.Entities {
+			name := e.Entity
+			if e.Count > 1 {
+				name = cloud.PluralizeResource(e.Entity)
+			}
+			entities = append(entities, fmt.Sprintf("%d %s", e.Count, name))
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", a.Action, strings.Join(entities, ", ")))
+	}
+	sentence := fmt.Sprintf("Will %s.", strings.Join(parts, "; "))
+	if s.Skipped > 0 {
+		sentence += fmt.Sprintf(" %d existing resource(s) skipped.", s.Skipped)
+	}
+	return sentence
+}
+
+func (s *PlanSummary) Print(w io.Writer) error {
+	_, err := fmt.Fprintln(w, s.String())
+	return err
+}
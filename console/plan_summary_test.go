@@ -0,0 +1,20 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestPlanSummary(t *testing.T) {
+	tpl := template.MustParse("vpc = create vpc cidr=10.0.0.0/16\ncreate instance name=one\ncreate instance name=two\ncreate instance name=three\nupdate securitygroup id=sg-1 inbound=authorize\nupdate securitygroup id=sg-2 inbound=authorize\ncheck instance id=i-1 state=running timeout=180\nstart instance id=i-4\ncreate keypair name=existing")
+	tpl.CommandNodesIterator()[8].CmdSkipped = true
+
+	if got, want := NewPlanSummary(tpl).String(), "Will create 3 instances, 1 vpc; update 2 securitygroups; delete 0; start 1 instance. 1 existing resource(s) skipped."; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if got, want := NewPlanSummary(template.MustParse("delete subnet id=sub-1")).String(), "Will create 0; update 0; delete 1 subnet."; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/wallix/awless/graph"
@@ -29,6 +30,7 @@ type tableResourceDisplayer struct {
 	maxwidth int
 	r        *graph.Resource
 	headers  []ColumnDefinition
+	aliases  map[string]string
 }
 
 func (d *tableResourceDisplayer) Print(w io.Writer) error {
@@ -46,6 +48,7 @@ func (d *tableResourceDisplayer) Print(w io.Writer) error {
 		if header == nil {
 			header = &StringColumnDefinition{Prop: prop}
 		}
+		header = aliasColumn(header, d.aliases)
 
 		if v := values[i]; v == nil {
 			values[i] = make([]interface{}, 2)
@@ -88,3 +91,56 @@ func (d *tableResourceDisplayer) Print(w io.Writer) error {
 func (d *tableResourceDisplayer) SetResource(r *graph.Resource) {
 	d.r = r
 }
+
+type jsonResourceDisplayer struct {
+	r *graph.Resource
+}
+
+func (d *jsonResourceDisplayer) Print(w io.Writer) error {
+	return PrintJSON(w, d.r.Properties)
+}
+
+type turtleResourceDisplayer struct {
+	r *graph.Resource
+}
+
+func (d *turtleResourceDisplayer) Print(w io.Writer) error {
+	g := graph.NewGraph()
+	if err := g.AddResource(d.r); err != nil {
+		return err
+	}
+	return g.MarshalTurtle(w, d.r)
+}
+
+type separatedResourceDisplayer struct {
+	r         *graph.Resource
+	headers   []ColumnDefinition
+	separator string
+	noHeaders bool
+}
+
+func (d *separatedResourceDisplayer) Print(w io.Writer) error {
+	var values table
+	for prop, val := range d.r.Properties {
+		var header ColumnDefinition
+		for _, h := range d.headers {
+			if h.propKey() == prop {
+				header = h
+			}
+		}
+		if header == nil {
+			header = &StringColumnDefinition{Prop: prop}
+		}
+		values = append(values, []interface{}{header.title(false), header.format(val)})
+	}
+
+	sort.Sort(byCols{table: values, sortBy: []int{0}})
+
+	if !d.noHeaders {
+		fmt.Fprintln(w, strings.Join([]string{"Property", "Value"}, d.separator))
+	}
+	for _, row := range values {
+		fmt.Fprintln(w, strings.Join([]string{fmt.Sprint(row[0]), fmt.Sprint(row[1])}, d.separator))
+	}
+	return nil
+}
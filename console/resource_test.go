@@ -65,3 +65,39 @@ func TestResourceDisplay(t *testing.T) {
 		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
 	}
 }
+
+func TestResourceDisplayFormats(t *testing.T) {
+	res := graph.InitResource("instance", "inst_1")
+	res.Properties = map[string]interface{}{
+		"ID":   "inst_1",
+		"Name": "instance 1",
+	}
+	headers := []ColumnDefinition{
+		StringColumnDefinition{Prop: "ID"},
+		StringColumnDefinition{Prop: "Name"},
+	}
+
+	tcases := []struct {
+		format, expected string
+	}{
+		{format: "json", expected: "{\n \"ID\": \"inst_1\",\n \"Name\": \"instance 1\"\n}\n"},
+		{format: "csv", expected: "Property,Value\nID,inst_1\nName,instance 1\n"},
+		{format: "tsv", expected: "Property\tValue\nID\tinst_1\nName\tinstance 1\n"},
+	}
+	for _, tcase := range tcases {
+		displayer, err := BuildOptions(
+			WithHeaders(headers),
+			WithFormat(tcase.format),
+		).SetSource(res).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var w bytes.Buffer
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), tcase.expected; got != want {
+			t.Fatalf("%s: got \n%q\n\nwant\n\n%q\n", tcase.format, got, want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+// RunSummary aggregates the commands statuses of a template execution,
+// to recap its failures once the run output has scrolled past
+type RunSummary struct {
+	OK, Existing int
+	Failures     []*RunFailure
+}
+
+// RunFailure holds the errors of a statement, each statement being reported once
+// with the number of times it failed and the AWS request ids found in its errors
+type RunFailure struct {
+	Statement  string
+	Count      int
+	Errors     []string
+	RequestIDs []string
+}
+
+var requestIDRegex = regexp.MustCompile(`(?i)request id: ([a-zA-Z0-9-]+)`)
+
+func NewRunSummary(tplExec *template.TemplateExecution) *RunSummary {
+	summary := &RunSummary{}
+	failures := make(map[string]*RunFailure)
+	for _, cmd := range tplExec.CommandNodesIterator() {
+		switch {
+		case cmd.CmdErr != nil:
+			st := logger.Redact(cmd.String())
+			failure, ok := failures[st]
+			if !ok {
+				failure = &RunFailure{Statement: st}
+				failures[st] = failure
+				summary.Failures = append(summary.Failures, failure)
+			}
+			failure.Count++
+			msg := cmd.CmdErr.Error()
+			if !contains(failure.Errors, msg) {
+				failure.Errors = append(failure.Errors, msg)
+			}
+			for _, match := range requestIDRegex.FindAllStringSubmatch(msg, -1) {
+				if !contains(failure.RequestIDs, match[1]) {
+					failure.RequestIDs = append(failure.RequestIDs, match[1])
+				}
+			}
+		case cmd.CmdSkipped:
+			summary.Existing++
+		default:
+			summary.OK++
+		}
+	}
+	return summary
+}
+
+func (s *RunSummary) Total() int {
+	total := s.OK + s.Existing
+	for _, f := range s.Failures {
+		total += f.Count
+	}
+	return total
+}
+
+// Print writes the counts of the run followed by its failures grouped by statement
+func (s *RunSummary) Print(w io.Writer) error {
+	red, green, yellow := color.New(color.FgRed).SprintFunc(), color.New(color.FgGreen).SprintFunc(), color.New(color.FgYellow).SprintFunc()
+
+	buff := bufio.NewWriter(w)
+	var failed int
+	for _, f := range s.Failures {
+		failed += f.Count
+	}
+	counts := []string{green(fmt.Sprintf("%d ok", s.OK))}
+	if s.Existing > 0 {
+		counts = append(counts, yellow(fmt.Sprintf("%d existing", s.Existing)))
+	}
+	if failed > 0 {
+		counts = append(counts, red(fmt.Sprintf("%d failed", failed)))
+	}
+	fmt.Fprintf(buff, "Summary: %d commands run: %s\n", s.Total(), strings.Join(counts, ", "))
+
+	for _, f := range s.Failures {
+		if f.Count > 1 {
+			fmt.Fprintf(buff, "  %s %s (%d times)\n", red("KO"), f.Statement, f.Count)
+		} else {
+			fmt.Fprintf(buff, "  %s %s\n", red("KO"), f.Statement)
+		}
+		for _, msg := range f.Errors {
+			for _, line := range strings.Split(strings.Replace(msg, "\t", "", -1), "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					fmt.Fprintf(buff, "       %s\n", line)
+				}
+			}
+		}
+		if len(f.RequestIDs) > 0 {
+			fmt.Fprintf(buff, "       request id: %s\n", strings.Join(f.RequestIDs, ", "))
+		}
+	}
+
+	return buff.Flush()
+}
@@ -0,0 +1,56 @@
+package console
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+func TestRunSummary(t *testing.T) {
+	color.NoColor = true
+
+	tpl := template.MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1\ncreate keypair name=mykey\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1")
+	cmds := tpl.CommandNodesIterator()
+	cmds[0].CmdResult = "vpc-1"
+	cmds[1].CmdErr = errors.New("create subnet: InvalidSubnet.Conflict: conflicts with another subnet\n\tstatus code: 400, request id: 1a2b-3c4d")
+	cmds[2].CmdSkipped = true
+	cmds[3].CmdErr = errors.New("create subnet: InvalidSubnet.Conflict: conflicts with another subnet\n\tstatus code: 400, request id: 5e6f-7a8b")
+
+	summary := NewRunSummary(&template.TemplateExecution{Template: tpl})
+	if got, want := summary.Total(), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	var w bytes.Buffer
+	if err := summary.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	expected := `Summary: 4 commands run: 1 ok, 1 existing, 2 failed
+  KO create subnet cidr=10.0.0.0/24 vpc=vpc-1 (2 times)
+       create subnet: InvalidSubnet.Conflict: conflicts with another subnet
+       status code: 400, request id: 1a2b-3c4d
+       create subnet: InvalidSubnet.Conflict: conflicts with another subnet
+       status code: 400, request id: 5e6f-7a8b
+       request id: 1a2b-3c4d, 5e6f-7a8b
+`
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRunSummaryRedactsStatements(t *testing.T) {
+	logger.SetRedactedKeys("userdata")
+	defer logger.SetRedactedKeys()
+
+	tpl := template.MustParse("create instance image=ami-1 userdata=secret-script")
+	tpl.CommandNodesIterator()[0].CmdErr = errors.New("create instance: failed")
+
+	summary := NewRunSummary(&template.TemplateExecution{Template: tpl})
+	if got, want := summary.Failures[0].Statement, "create instance image=ami-1 userdata="+logger.RedactedMask; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
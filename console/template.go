@@ -0,0 +1,136 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/graph"
+)
+
+func parseDisplayTemplate(text string) (*template.Template, error) {
+	tpl, err := template.New("display").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid display template: %s", err)
+	}
+	return tpl, nil
+}
+
+// Fields available in a display template are the resource properties
+// keyed by their lowercased name (ex: {{.name}}, {{.publicip}}) or by their
+// original name (ex: {{.Name}}, {{.PublicIP}}), plus {{.type}}
+func templateFields(res *graph.Resource) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for label := range rdf.Labels {
+		fields[label] = ""
+		fields[strings.ToLower(label)] = ""
+	}
+	for k, v := range res.Properties {
+		fields[k] = v
+		fields[strings.ToLower(k)] = v
+	}
+	fields["type"] = res.Type()
+	return fields
+}
+
+func executeDisplayTemplate(w io.Writer, tpl *template.Template, res *graph.Resource) error {
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, templateFields(res)); err != nil {
+		return fmt.Errorf("display template for %s: %s (fields are resource properties, ex: {{.id}}, {{.name}}, {{.type}})", res, trimTemplateErr(err))
+	}
+	if !bytes.HasSuffix(buff.Bytes(), []byte("\n")) {
+		buff.WriteByte('\n')
+	}
+	_, err := w.Write(buff.Bytes())
+	return err
+}
+
+func trimTemplateErr(err error) string {
+	msg := err.Error()
+	if i := strings.Index(msg, "map has no entry for key"); i > -1 {
+		return "unknown field " + strings.TrimPrefix(msg[i:], "map has no entry for key ")
+	}
+	return msg
+}
+
+type templateDisplayer struct {
+	fromGraphDisplayer
+	tpl *template.Template
+}
+
+func (d *templateDisplayer) Print(w io.Writer) error {
+	var types []string
+	if d.rdfType == "" {
+		for t := range DefaultsColumnDefinitions {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+	} else {
+		types = append(types, d.rdfType)
+	}
+
+	for _, t := range types {
+		resources, err := d.g.GetAllResources(t)
+		if err != nil {
+			return err
+		}
+
+		values := make(table, len(resources))
+		for i, res := range resources {
+			values[i] = make([]interface{}, len(d.headers)+1)
+			for j, h := range d.headers {
+				values[i][j] = res.Properties[h.propKey()]
+			}
+			values[i][len(d.headers)] = res
+		}
+
+		if d.rdfType != "" && len(d.headers) > 0 {
+			d.sorter.sort(values)
+		} else {
+			sort.Slice(values, func(i, j int) bool {
+				return values[i][len(d.headers)].(*graph.Resource).Id() < values[j][len(d.headers)].(*graph.Resource).Id()
+			})
+		}
+
+		for _, row := range values {
+			if err := executeDisplayTemplate(w, d.tpl, row[len(row)-1].(*graph.Resource)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type templateResourceDisplayer struct {
+	r   *graph.Resource
+	tpl *template.Template
+}
+
+func (d *templateResourceDisplayer) Print(w io.Writer) error {
+	return executeDisplayTemplate(w, d.tpl, d.r)
+}
+
+func (d *templateResourceDisplayer) SetResource(r *graph.Resource) {
+	d.r = r
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"io"
+)
+
+// TreeNode is a node of the trees drawn by RenderTree
+type TreeNode struct {
+	Label    string
+	Children []*TreeNode
+}
+
+// RenderTree draws the tree with box-drawing characters, one node per line.
+// A node having more than maxChildren children only draws the first ones
+// followed by the count of the others; a zero or negative maxChildren draws them all
+func RenderTree(w io.Writer, root *TreeNode, maxChildren int) error {
+	if _, err := fmt.Fprintln(w, root.Label); err != nil {
+		return err
+	}
+	return renderTreeChildren(w, root, "", maxChildren)
+}
+
+func renderTreeChildren(w io.Writer, node *TreeNode, prefix string, maxChildren int) error {
+	children := node.Children
+	var more int
+	if maxChildren > 0 && len(children) > maxChildren {
+		children, more = children[:maxChildren], len(children)-maxChildren
+	}
+
+	for i, child := range children {
+		branch, indent := "├── ", "│   "
+		if i == len(children)-1 && more == 0 {
+			branch, indent = "└── ", "    "
+		}
+		if _, err := fmt.Fprintf(w, "%s%s%s\n", prefix, branch, child.Label); err != nil {
+			return err
+		}
+		if err := renderTreeChildren(w, child, prefix+indent, maxChildren); err != nil {
+			return err
+		}
+	}
+	if more > 0 {
+		if _, err := fmt.Fprintf(w, "%s└── … %d more\n", prefix, more); err != nil {
+			return err
+		}
+	}
+	return nil
+}
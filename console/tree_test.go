@@ -0,0 +1,66 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRenderTree(t *testing.T) {
+	leaf := func(label string) *TreeNode { return &TreeNode{Label: label} }
+	root := &TreeNode{Label: "vpc_1", Children: []*TreeNode{
+		{Label: "sub_1", Children: []*TreeNode{leaf("inst_1"), leaf("inst_2"), leaf("inst_3"), leaf("inst_4")}},
+		{Label: "sub_2", Children: []*TreeNode{leaf("inst_5")}},
+		leaf("sg_1"),
+	}}
+
+	var buff bytes.Buffer
+	if err := RenderTree(&buff, root, 0); err != nil {
+		t.Fatal(err)
+	}
+	expected := `vpc_1
+├── sub_1
+│   ├── inst_1
+│   ├── inst_2
+│   ├── inst_3
+│   └── inst_4
+├── sub_2
+│   └── inst_5
+└── sg_1
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	buff.Reset()
+	if err := RenderTree(&buff, root, 2); err != nil {
+		t.Fatal(err)
+	}
+	expected = `vpc_1
+├── sub_1
+│   ├── inst_1
+│   ├── inst_2
+│   └── … 2 more
+├── sub_2
+│   └── inst_5
+└── … 1 more
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+package fetch
+
+import "sync"
+
+// Dedup shares the result of identical calls (ex: describing the same security group
+// from several services) while a scope is open, typically for the duration of a sync.
+// Calls made outside of a scope are never shared
+type Dedup struct {
+	mu     sync.Mutex
+	scopes int
+	calls  map[string]*dedupCall
+	issued int
+	shared int
+}
+
+type dedupCall struct {
+	done   chan struct{}
+	result interface{}
+	ok     bool
+}
+
+func NewDedup() *Dedup {
+	return &Dedup{}
+}
+
+// Open starts a scope, or joins the one already open
+func (d *Dedup) Open() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.scopes == 0 {
+		d.calls = make(map[string]*dedupCall)
+		d.issued, d.shared = 0, 0
+	}
+	d.scopes++
+}
+
+// Close leaves the scope and returns the number of calls issued and shared in it so far.
+// Results are dropped once all the scopes are closed
+func (d *Dedup) Close() (issued, shared int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.scopes == 0 {
+		return 0, 0
+	}
+	d.scopes--
+	if d.scopes == 0 {
+		d.calls = nil
+	}
+	return d.issued, d.shared
+}
+
+// Do returns the result of the first call made with this key in the scope, waiting
+// for it if still in flight. Otherwise, or if the first call was not ok, fn is called.
+// Only ok results are shared
+func (d *Dedup) Do(key string, fn func() (interface{}, bool)) (interface{}, bool) {
+	d.mu.Lock()
+	if d.calls == nil {
+		d.mu.Unlock()
+		return fn()
+	}
+	if call, ok := d.calls[key]; ok {
+		d.mu.Unlock()
+		<-call.done
+		if call.ok {
+			d.mu.Lock()
+			d.shared++
+			d.mu.Unlock()
+			return call.result, true
+		}
+		return fn()
+	}
+	call := &dedupCall{done: make(chan struct{})}
+	d.calls[key] = call
+	d.issued++
+	d.mu.Unlock()
+
+	call.result, call.ok = fn()
+	if !call.ok {
+		d.mu.Lock()
+		if d.calls != nil && d.calls[key] == call {
+			delete(d.calls, key)
+		}
+		d.mu.Unlock()
+	}
+	close(call.done)
+	return call.result, call.ok
+}
@@ -0,0 +1,56 @@
+package fetch_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wallix/awless/fetch"
+)
+
+func TestDedup(t *testing.T) {
+	d := fetch.NewDedup()
+	var calls int32
+	call := func(ok bool) func() (interface{}, bool) {
+		return func() (interface{}, bool) {
+			atomic.AddInt32(&calls, 1)
+			return "result", ok
+		}
+	}
+
+	d.Do("key", call(true))
+	d.Do("key", call(true))
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Fatalf("outside of scope: got %d calls, want %d", got, want)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	d.Open()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if res, ok := d.Do("key", call(true)); !ok || res != "result" {
+				t.Errorf("got %v %t", res, ok)
+			}
+		}()
+	}
+	wg.Wait()
+	d.Do("other", call(true))
+	d.Do("failing", call(false))
+	d.Do("failing", call(false))
+	if got, want := atomic.LoadInt32(&calls), int32(4); got != want {
+		t.Fatalf("in scope: got %d calls, want %d", got, want)
+	}
+	issued, shared := d.Close()
+	if issued != 4 || shared != 9 {
+		t.Fatalf("got %d issued, %d shared, want 4, 9", issued, shared)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	d.Do("key", call(true))
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Fatalf("after scope: got %d calls, want %d", got, want)
+	}
+}
@@ -0,0 +1,112 @@
+package fetch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wallix/awless/logger"
+)
+
+// AdaptiveLimiter bounds the number of calls concurrently in flight against a cloud provider.
+// The bound is halved when the provider throttles and grows back one call at a time
+// once a full window of calls succeeded, so that all the services sharing it back off together
+type AdaptiveLimiter struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	min, max, limit int
+	inflight        int
+	successes       int
+	lastDecrease    time.Time
+	cooldown        time.Duration
+
+	log *logger.Logger
+}
+
+// NewAdaptiveLimiter returns a limiter allowing at most max concurrent calls
+// and at least min whatever the throttling.
+func NewAdaptiveLimiter(min, max int) *AdaptiveLimiter {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+	l := &AdaptiveLimiter{
+		min:      min,
+		max:      max,
+		limit:    max,
+		cooldown: 1 * time.Second,
+		log:      logger.DiscardLogger,
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+func (l *AdaptiveLimiter) SetLogger(log *logger.Logger) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.log = log
+}
+
+// Acquire blocks until a call can be made within the current bound.
+func (l *AdaptiveLimiter) Acquire() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.inflight >= l.limit {
+		l.cond.Wait()
+	}
+	l.inflight++
+}
+
+// Release frees the slot taken by a previous Acquire.
+func (l *AdaptiveLimiter) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inflight > 0 {
+		l.inflight--
+	}
+	l.cond.Broadcast()
+}
+
+// Throttled halves the bound. Throttling responses received within the cooldown
+// of a previous decrease belong to the same burst and are not counted again
+func (l *AdaptiveLimiter) Throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.successes = 0
+	if time.Since(l.lastDecrease) < l.cooldown {
+		return
+	}
+	l.lastDecrease = time.Now()
+	if newLimit := l.limit / 2; newLimit >= l.min {
+		l.limit = newLimit
+	} else {
+		l.limit = l.min
+	}
+	l.log.ExtraVerbosef("rate limiter: throttled, concurrency lowered to %d (%d in flight)", l.limit, l.inflight)
+}
+
+// Succeeded raises the bound by one after as many successful calls as the current bound.
+func (l *AdaptiveLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit >= l.max {
+		return
+	}
+	l.successes++
+	if l.successes < l.limit {
+		return
+	}
+	l.successes = 0
+	l.limit++
+	l.log.ExtraVerbosef("rate limiter: concurrency raised to %d (%d in flight)", l.limit, l.inflight)
+	l.cond.Broadcast()
+}
+
+// State returns the current bound and the number of calls in flight.
+func (l *AdaptiveLimiter) State() (limit, inflight int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit, l.inflight
+}
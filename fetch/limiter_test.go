@@ -0,0 +1,69 @@
+package fetch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/fetch"
+)
+
+func TestAdaptiveLimiterBound(t *testing.T) {
+	l := fetch.NewAdaptiveLimiter(2, 8)
+	if got, _ := l.State(); got != 8 {
+		t.Fatalf("got %d, want 8", got)
+	}
+
+	l.Throttled()
+	if got, _ := l.State(); got != 4 {
+		t.Fatalf("got %d, want 4", got)
+	}
+	l.Throttled()
+	if got, _ := l.State(); got != 4 {
+		t.Fatalf("throttling of the same burst: got %d, want 4", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		l.Succeeded()
+	}
+	if got, _ := l.State(); got != 4 {
+		t.Fatalf("got %d, want 4", got)
+	}
+	l.Succeeded()
+	if got, _ := l.State(); got != 5 {
+		t.Fatalf("got %d, want 5", got)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+	if got, _ := l.State(); got != 8 {
+		t.Fatalf("got %d, want max 8", got)
+	}
+}
+
+func TestAdaptiveLimiterBlocksOverBound(t *testing.T) {
+	l := fetch.NewAdaptiveLimiter(1, 1)
+	l.Acquire()
+
+	acquired := make(chan struct{})
+	go func() {
+		l.Acquire()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected acquire to block while the bound is reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	l.Release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected acquire to proceed after release")
+	}
+	if _, inflight := l.State(); inflight != 1 {
+		t.Fatalf("got %d in flight, want 1", inflight)
+	}
+}
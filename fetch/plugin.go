@@ -0,0 +1,71 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/wallix/awless/graph"
+)
+
+// Plugin fetches resources living outside of the built-in cloud services
+// (internal resources, homegrown services, ...) to overlay them in the graph.
+//
+// A plugin is registered from the init function of its package with RegisterPlugin
+// and compiled in a custom awless binary importing it alongside the awless commands.
+// Each registered plugin is synced as a service of its own: its graph is stored
+// under its name in the local region directory and is merged with the other graphs.
+type Plugin interface {
+	// Name of the plugin, also the name of the service its resources belong to
+	Name() string
+	// ResourceTypes returns the types of the resources fetched by the plugin
+	ResourceTypes() []string
+	// Fetch returns the resources of the region in a graph along with their relations
+	// (added with AddParentRelation or AddAppliesOnRelation). Relations can point to
+	// resources of other services by their ids. The resource properties have to be
+	// ones of the graph model (see cloud/properties) to be stored
+	Fetch(ctx context.Context, region string) (*graph.Graph, error)
+}
+
+var (
+	pluginsMu sync.Mutex
+	plugins   = make(map[string]Plugin)
+)
+
+// RegisterPlugin makes a plugin available to the syncs. It panics if the plugin
+// is nil, has no name or resource types, or if a plugin with the same name is registered
+func RegisterPlugin(p Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	if p == nil {
+		panic("fetch: register nil plugin")
+	}
+	name := p.Name()
+	if name == "" {
+		panic("fetch: register plugin with empty name")
+	}
+	if len(p.ResourceTypes()) == 0 {
+		panic(fmt.Sprintf("fetch: register plugin %s without resource types", name))
+	}
+	if _, dup := plugins[name]; dup {
+		panic(fmt.Sprintf("fetch: register plugin %s twice", name))
+	}
+	plugins[name] = p
+}
+
+// Plugins returns the registered plugins sorted by name
+func Plugins() []Plugin {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	var names []string
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var all []Plugin
+	for _, name := range names {
+		all = append(all, plugins[name])
+	}
+	return all
+}
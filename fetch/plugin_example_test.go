@@ -0,0 +1,50 @@
+package fetch_test
+
+import (
+	"context"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+)
+
+// inventory overlays the hosts of an internal inventory on the instances running them
+type inventory struct{}
+
+func (inventory) Name() string { return "inventory" }
+
+func (inventory) ResourceTypes() []string { return []string{"inventoryhost"} }
+
+func (inventory) Fetch(ctx context.Context, region string) (*graph.Graph, error) {
+	g := graph.NewGraph()
+	host := graph.InitResource("inventoryhost", "web-1")
+	host.Properties[properties.ID] = "web-1"
+	host.Properties[properties.Name] = "web-1." + region
+	if err := g.AddResource(host); err != nil {
+		return g, err
+	}
+	// the instance is fetched by the infra service, the relation is resolved once the graphs are merged
+	return g, g.AddAppliesOnRelation(host, graph.InitResource("instance", "i-1234"))
+}
+
+// The plugin registers itself when its package is imported by a custom awless main:
+//
+//	package main
+//
+//	import (
+//		"os"
+//
+//		"github.com/wallix/awless/commands"
+//		_ "example.com/awless-inventory"
+//	)
+//
+//	func main() {
+//		if err := commands.RootCmd.Execute(); err != nil {
+//			os.Exit(commands.ExitCode(err))
+//		}
+//	}
+//
+// `awless sync` then stores the hosts in the local inventory graph of the region
+func ExampleRegisterPlugin() {
+	fetch.RegisterPlugin(inventory{})
+}
@@ -0,0 +1,57 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+)
+
+type namedPlugin struct {
+	name  string
+	types []string
+}
+
+func (p namedPlugin) Name() string            { return p.name }
+func (p namedPlugin) ResourceTypes() []string { return p.types }
+func (p namedPlugin) Fetch(context.Context, string) (*graph.Graph, error) {
+	return graph.NewGraph(), nil
+}
+
+func TestRegisterPlugin(t *testing.T) {
+	defer func(registered map[string]Plugin) { plugins = registered }(plugins)
+	plugins = make(map[string]Plugin)
+
+	RegisterPlugin(namedPlugin{name: "zplugin", types: []string{"zresource"}})
+	RegisterPlugin(namedPlugin{name: "aplugin", types: []string{"aresource"}})
+
+	var names []string
+	for _, p := range Plugins() {
+		names = append(names, p.Name())
+	}
+	if got, want := strings.Join(names, ","), "aplugin,zplugin"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	tcases := []struct {
+		plugin Plugin
+		panic  string
+	}{
+		{nil, "register nil plugin"},
+		{namedPlugin{types: []string{"any"}}, "empty name"},
+		{namedPlugin{name: "notypes"}, "without resource types"},
+		{namedPlugin{name: "zplugin", types: []string{"zresource"}}, "register plugin zplugin twice"},
+	}
+	for _, tcase := range tcases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), tcase.panic) {
+					t.Fatalf("got %v, want panic with '%s'", r, tcase.panic)
+				}
+			}()
+			RegisterPlugin(tcase.plugin)
+		}()
+	}
+}
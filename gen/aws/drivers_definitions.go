@@ -26,6 +26,12 @@ type param struct {
 	AwsField, AwsType string
 	TemplateName      string
 	AsAwsTag          bool
+	Values            []string // only values allowed for the param
+}
+
+type paramType struct {
+	Kind   string
+	Values []string
 }
 
 type driver struct {
@@ -55,6 +61,46 @@ func (d *driver) ExtraKeys() []string {
 	return sortUnique(keys)
 }
 
+// ParamTypes returns the types of the params values checked when compiling a template.
+// Params untyped or given with conflicting types on their fields are left unchecked
+func (d *driver) ParamTypes() map[string]paramType {
+	types := make(map[string]paramType)
+	untyped := make(map[string]bool)
+	for _, p := range append(append([]param{}, d.RequiredParams...), d.ExtraParams...) {
+		var kind string
+		switch {
+		case len(p.Values) > 0:
+			kind = "enum"
+		case p.AwsType == "awsint64" || p.AwsType == "awsint":
+			kind = "int"
+		case p.AwsType == "awsbool" || p.AwsType == "awsboolattribute":
+			kind = "bool"
+		case p.TemplateName == "cidr":
+			kind = "cidr"
+		}
+		if existing, ok := types[p.TemplateName]; kind == "" || (ok && existing.Kind != kind) {
+			untyped[p.TemplateName] = true
+		}
+		types[p.TemplateName] = paramType{Kind: kind, Values: p.Values}
+	}
+	for name := range untyped {
+		delete(types, name)
+	}
+	return types
+}
+
+// ApiToIAMServicePrefix returns the prefix of the IAM actions of an API
+func ApiToIAMServicePrefix(api string) string {
+	switch api {
+	case "elbv2", "elb":
+		return "elasticloadbalancing"
+	case "applicationautoscaling":
+		return "application-autoscaling"
+	default:
+		return api
+	}
+}
+
 type driversDef struct {
 	Api     string
 	Drivers []driver
@@ -179,6 +225,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.Instance, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			// InstanceProfile
 			{
 				Action: "attach", Entity: cloud.InstanceProfile, ManualFuncDefinition: true,
@@ -218,8 +274,8 @@ var DriversDefs = []driversDef{
 					{TemplateName: "protocol"},
 				},
 				ExtraParams: []param{
-					{TemplateName: "inbound"}, // either inbound or outbound = either authorize or revoke
-					{TemplateName: "outbound"},
+					{TemplateName: "inbound", Values: []string{"authorize", "revoke"}}, // either inbound or outbound = either authorize or revoke
+					{TemplateName: "outbound", Values: []string{"authorize", "revoke"}},
 					{TemplateName: "portrange"},
 				},
 			},
@@ -237,6 +293,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.SecurityGroup, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			{
 				Action: "attach", Entity: cloud.SecurityGroup, ManualFuncDefinition: true,
 				RequiredParams: []param{
@@ -307,6 +373,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.Volume, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			{
 				Action: "delete", Entity: cloud.Volume, ApiMethod: "DeleteVolume", Input: "DeleteVolumeInput", Output: "DeleteVolumeOutput",
 				RequiredParams: []param{
@@ -405,6 +481,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.NatGateway, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			// ROUTE TABLES
 			{
 				Action: "create", Entity: cloud.RouteTable, ApiMethod: "CreateRouteTable", Input: "CreateRouteTableInput", Output: "CreateRouteTableOutput", OutputExtractor: "aws.StringValue(output.RouteTable.RouteTableId)",
@@ -546,6 +632,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.LoadBalancer, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			// Listener
 			{
 				Action: "create", Entity: cloud.Listener, ApiMethod: "CreateListener", Input: "CreateListenerInput", Output: "CreateListenerOutput", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.Listeners[0].ListenerArn)",
@@ -776,7 +872,7 @@ var DriversDefs = []driversDef{
 				},
 				ExtraParams: []param{
 					{AwsField: "SkipFinalSnapshot", TemplateName: "skip-snapshot", AwsType: "awsbool"},
-					{AwsField: "FinalDBSnapshotIdentifier", TemplateName: "snapshot", AwsType: "awsbool"},
+					{AwsField: "FinalDBSnapshotIdentifier", TemplateName: "snapshot", AwsType: "awsstr"},
 				},
 			},
 			{
@@ -787,6 +883,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.Database, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			{
 				Action: "create", Entity: cloud.DbSubnetGroup, ApiMethod: "CreateDBSubnetGroup", Input: "CreateDBSubnetGroupInput", Output: "CreateDBSubnetGroupOutput", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.DBSubnetGroup.DBSubnetGroupName)",
 				RequiredParams: []param{
@@ -927,6 +1033,50 @@ var DriversDefs = []driversDef{
 		Api:     "sts",
 		Drivers: []driver{},
 	},
+	{
+		Api:     "wafv2",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "acm",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "elasticbeanstalk",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "apigateway",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "apigatewayv2",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "elb",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "elasticache",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "ssm",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "kms",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "guardduty",
+		Drivers: []driver{},
+	},
+	{
+		Api:     "secretsmanager",
+		Drivers: []driver{},
+	},
 	{
 		Api: "iam",
 		Drivers: []driver{
@@ -1173,6 +1323,10 @@ var DriversDefs = []driversDef{
 			},
 		},
 	},
+	{
+		Api:     "dynamodb",
+		Drivers: []driver{},
+	},
 	{
 		Api: "sns",
 		Drivers: []driver{
@@ -1383,6 +1537,10 @@ var DriversDefs = []driversDef{
 			},
 		},
 	},
+	{
+		Api:     "cloudwatchlogs",
+		Drivers: []driver{},
+	},
 	{
 		Api: "cloudfront",
 		Drivers: []driver{
@@ -1413,6 +1571,16 @@ var DriversDefs = []driversDef{
 					{TemplateName: "timeout"},
 				},
 			},
+			{
+				Action: "wait", Entity: cloud.Distribution, ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id"},
+					{TemplateName: "state"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "timeout"},
+				},
+			},
 			{
 				Action: "update", Entity: cloud.Distribution, ManualFuncDefinition: true,
 				RequiredParams: []param{
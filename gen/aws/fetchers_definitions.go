@@ -28,12 +28,28 @@ func ApiToInterface(api string) string {
 		return "AutoScalingAPI"
 	case "cloudwatch":
 		return "CloudWatchAPI"
+	case "cloudwatchlogs":
+		return "CloudWatchLogsAPI"
 	case "cloudfront":
 		return "CloudFrontAPI"
 	case "applicationautoscaling":
 		return "ApplicationAutoScalingAPI"
 	case "cloudformation":
 		return "CloudFormationAPI"
+	case "dynamodb":
+		return "DynamoDBAPI"
+	case "elasticbeanstalk":
+		return "ElasticBeanstalkAPI"
+	case "elasticache":
+		return "ElastiCacheAPI"
+	case "apigateway":
+		return "APIGatewayAPI"
+	case "apigatewayv2":
+		return "ApiGatewayV2API"
+	case "guardduty":
+		return "GuardDutyAPI"
+	case "secretsmanager":
+		return "SecretsManagerAPI"
 	case "route53", "lambda":
 		return strings.Title(api) + "API"
 	default:
@@ -41,11 +57,24 @@ func ApiToInterface(api string) string {
 	}
 }
 
+// ObjectType returns the type of the objects a fetcher lists. Resources fetched
+// from several AWS APIs have objects of distinct types, listed as interface{}
+func ObjectType(awsType string) string {
+	if awsType == "interface{}" {
+		return awsType
+	}
+	return "*" + awsType
+}
+
 type fetchersDef struct {
-	Name     string
-	Global   bool
-	Api      []string
-	Fetchers []fetcher
+	Name   string
+	Global bool
+	Api    []string
+	// FetchApi are the APIs of other services only called by the fetchers of this service
+	FetchApi []string
+	// CloudFrontApi are the APIs of this service also called in the CloudFront region
+	CloudFrontApi []string
+	Fetchers      []fetcher
 }
 
 type fetcher struct {
@@ -62,7 +91,11 @@ type fetcher struct {
 var FetchersDefs = []fetchersDef{
 	{
 		Name: "infra",
-		Api:  []string{"ec2", "elbv2", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling"},
+		Api:  []string{"ec2", "elbv2", "elb", "rds", "elasticache", "autoscaling", "ecr", "ecs", "applicationautoscaling", "wafv2", "acm", "elasticbeanstalk", "apigateway", "apigatewayv2", "ssm", "kms", "guardduty", "secretsmanager"},
+		// the account of the caller builds the ARN of cache clusters
+		FetchApi: []string{"sts"},
+		// web ACLs of CloudFront distributions are only served by the CloudFront region
+		CloudFrontApi: []string{"wafv2"},
 		Fetchers: []fetcher{
 			{Api: "ec2", ResourceType: cloud.Instance, AWSType: "ec2.Instance", ApiMethod: "DescribeInstancesPages", Input: "ec2.DescribeInstancesInput{}", Output: "ec2.DescribeInstancesOutput", OutputsExtractor: "Instances", OutputsContainers: "Reservations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.Subnet, AWSType: "ec2.Subnet", ApiMethod: "DescribeSubnets", Input: "ec2.DescribeSubnetsInput{}", Output: "ec2.DescribeSubnetsOutput", OutputsExtractor: "Subnets"},
@@ -77,12 +110,21 @@ var FetchersDefs = []fetchersDef{
 			{Api: "ec2", ResourceType: cloud.Image, AWSType: "ec2.Image", ApiMethod: "DescribeImages", Input: "ec2.DescribeImagesInput{Owners: []*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeImagesOutput", OutputsExtractor: "Images"},
 			{Api: "ec2", ResourceType: cloud.ImportImageTask, AWSType: "ec2.ImportImageTask", ApiMethod: "DescribeImportImageTasks", Input: "ec2.DescribeImportImageTasksInput{}", Output: "ec2.DescribeImportImageTasksOutput", OutputsExtractor: "ImportImageTasks"},
 			{Api: "ec2", ResourceType: cloud.ElasticIP, AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput{}", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
+			{Api: "ec2", ResourceType: cloud.NetworkInterface, AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput{}", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
 			{Api: "ec2", ResourceType: cloud.Snapshot, AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput{OwnerIds:[]*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "ec2", ResourceType: cloud.SpotRequest, AWSType: "ec2.SpotInstanceRequest", ApiMethod: "DescribeSpotInstanceRequests", Input: "ec2.DescribeSpotInstanceRequestsInput{}", Output: "ec2.DescribeSpotInstanceRequestsOutput", OutputsExtractor: "SpotInstanceRequests"},
+			{Api: "ec2", ResourceType: cloud.ReservedInstance, AWSType: "ec2.ReservedInstances", ApiMethod: "DescribeReservedInstances", Input: "ec2.DescribeReservedInstancesInput{}", Output: "ec2.DescribeReservedInstancesOutput", OutputsExtractor: "ReservedInstances"},
+			{Api: "ec2", ResourceType: cloud.PlacementGroup, AWSType: "ec2.PlacementGroup", ApiMethod: "DescribePlacementGroups", Input: "ec2.DescribePlacementGroupsInput{}", Output: "ec2.DescribePlacementGroupsOutput", OutputsExtractor: "PlacementGroups"},
+			{Api: "ec2", ResourceType: cloud.Host, AWSType: "ec2.Host", ManualFetcher: true},
+			{Api: "ec2", ResourceType: cloud.VpcEndpoint, AWSType: "ec2.VpcEndpoint", ManualFetcher: true},
 			{Api: "elbv2", ResourceType: cloud.LoadBalancer, AWSType: "elbv2.LoadBalancer", ApiMethod: "DescribeLoadBalancersPages", Input: "elbv2.DescribeLoadBalancersInput{}", Output: "elbv2.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancers", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "elbv2", ResourceType: cloud.TargetGroup, AWSType: "elbv2.TargetGroup", ApiMethod: "DescribeTargetGroups", Input: "elbv2.DescribeTargetGroupsInput{}", Output: "elbv2.DescribeTargetGroupsOutput", OutputsExtractor: "TargetGroups"},
 			{Api: "elbv2", ResourceType: cloud.Listener, AWSType: "elbv2.Listener", ManualFetcher: true},
+			{Api: "elb", ResourceType: cloud.ClassicLoadBalancer, AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput{}", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "rds", ResourceType: cloud.Database, AWSType: "rds.DBInstance", ApiMethod: "DescribeDBInstancesPages", Input: "rds.DescribeDBInstancesInput{}", Output: "rds.DescribeDBInstancesOutput", OutputsExtractor: "DBInstances", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "rds", ResourceType: cloud.DbSubnetGroup, AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput{}", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "elasticache", ResourceType: cloud.CacheCluster, AWSType: "elasticache.CacheCluster", ManualFetcher: true},
+			{Api: "elasticache", ResourceType: cloud.CacheSubnetGroup, AWSType: "elasticache.CacheSubnetGroup", ApiMethod: "DescribeCacheSubnetGroupsPages", Input: "elasticache.DescribeCacheSubnetGroupsInput{}", Output: "elasticache.DescribeCacheSubnetGroupsOutput", OutputsExtractor: "CacheSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "autoscaling", ResourceType: cloud.LaunchConfiguration, AWSType: "autoscaling.LaunchConfiguration", ApiMethod: "DescribeLaunchConfigurationsPages", Input: "autoscaling.DescribeLaunchConfigurationsInput{}", Output: "autoscaling.DescribeLaunchConfigurationsOutput", OutputsExtractor: "LaunchConfigurations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingGroup, AWSType: "autoscaling.Group", ApiMethod: "DescribeAutoScalingGroupsPages", Input: "autoscaling.DescribeAutoScalingGroupsInput{}", Output: "autoscaling.DescribeAutoScalingGroupsOutput", OutputsExtractor: "AutoScalingGroups", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingPolicy, AWSType: "autoscaling.ScalingPolicy", ApiMethod: "DescribePoliciesPages", Input: "autoscaling.DescribePoliciesInput{}", Output: "autoscaling.DescribePoliciesOutput", OutputsExtractor: "ScalingPolicies", Multipage: true, NextPageMarker: "NextToken"},
@@ -91,6 +133,19 @@ var FetchersDefs = []fetchersDef{
 			{Api: "ecs", ResourceType: cloud.ContainerTask, AWSType: "ecs.TaskDefinition", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.Container, AWSType: "ecs.Container", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.ContainerInstance, AWSType: "ecs.ContainerInstance", ManualFetcher: true},
+			{Api: "wafv2", ResourceType: cloud.WebACL, AWSType: "wafv2.WebACL", ManualFetcher: true},
+			{Api: "acm", ResourceType: cloud.Certificate, AWSType: "acm.CertificateDetail", ManualFetcher: true},
+			{Api: "elasticbeanstalk", ResourceType: cloud.BeanstalkApplication, AWSType: "elasticbeanstalk.ApplicationDescription", ApiMethod: "DescribeApplications", Input: "elasticbeanstalk.DescribeApplicationsInput{}", Output: "elasticbeanstalk.DescribeApplicationsOutput", OutputsExtractor: "Applications"},
+			{Api: "elasticbeanstalk", ResourceType: cloud.BeanstalkEnvironment, AWSType: "elasticbeanstalk.EnvironmentDescription", ApiMethod: "DescribeEnvironments", Input: "elasticbeanstalk.DescribeEnvironmentsInput{}", Output: "elasticbeanstalk.EnvironmentDescriptionsMessage", OutputsExtractor: "Environments"},
+			// REST APIs (apigateway.RestApi) and HTTP or WebSocket APIs (apigatewayv2.Api) are both listed as restapi
+			{Api: "apigateway", ResourceType: cloud.RestApi, AWSType: "interface{}", ManualFetcher: true},
+			{Api: "apigateway", ResourceType: cloud.ApiStage, AWSType: "apigateway.Stage", ManualFetcher: true},
+			{Api: "ssm", ResourceType: cloud.Parameter, AWSType: "ssm.ParameterMetadata", ManualFetcher: true},
+			{Api: "kms", ResourceType: cloud.KMSKey, AWSType: "kms.KeyMetadata", ManualFetcher: true},
+			// Security Hub findings are not fetched: Security Hub imports the GuardDuty findings, which would be listed twice
+			{Api: "guardduty", ResourceType: cloud.Finding, AWSType: "guardduty.Finding", ManualFetcher: true},
+			// Only the secrets metadata is listed: secret values are never retrieved
+			{Api: "secretsmanager", ResourceType: cloud.Secret, AWSType: "secretsmanager.SecretListEntry", ManualFetcher: true},
 		},
 	},
 	{
@@ -108,10 +163,11 @@ var FetchersDefs = []fetchersDef{
 	},
 	{
 		Name: "storage",
-		Api:  []string{"s3"},
+		Api:  []string{"s3", "dynamodb"},
 		Fetchers: []fetcher{
 			{Api: "s3", ResourceType: cloud.Bucket, AWSType: "s3.Bucket", ManualFetcher: true},
 			{Api: "s3", ResourceType: cloud.S3Object, AWSType: "s3.Object", ManualFetcher: true},
+			{Api: "dynamodb", ResourceType: cloud.Table, AWSType: "dynamodb.TableDescription", ManualFetcher: true},
 		},
 	},
 	{
@@ -119,7 +175,7 @@ var FetchersDefs = []fetchersDef{
 		Api:  []string{"sns", "sqs"},
 		Fetchers: []fetcher{
 			{Api: "sns", ResourceType: cloud.Subscription, AWSType: "sns.Subscription", ApiMethod: "ListSubscriptionsPages", Input: "sns.ListSubscriptionsInput{}", Output: "sns.ListSubscriptionsOutput", OutputsExtractor: "Subscriptions", Multipage: true, NextPageMarker: "NextToken"},
-			{Api: "sns", ResourceType: cloud.Topic, AWSType: "sns.Topic", ApiMethod: "ListTopicsPages", Input: "sns.ListTopicsInput{}", Output: "sns.ListTopicsOutput", OutputsExtractor: "Topics", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "sns", ResourceType: cloud.Topic, AWSType: "sns.Topic", ManualFetcher: true},
 			{Api: "sqs", ResourceType: cloud.Queue, AWSType: "string", ManualFetcher: true},
 		},
 	},
@@ -142,10 +198,11 @@ var FetchersDefs = []fetchersDef{
 	},
 	{
 		Name: "monitoring",
-		Api:  []string{"cloudwatch"},
+		Api:  []string{"cloudwatch", "cloudwatchlogs"},
 		Fetchers: []fetcher{
 			{Api: "cloudwatch", ResourceType: cloud.Metric, AWSType: "cloudwatch.Metric", ApiMethod: "ListMetricsPages", Input: "cloudwatch.ListMetricsInput{}", Output: "cloudwatch.ListMetricsOutput", OutputsExtractor: "Metrics", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "cloudwatch", ResourceType: cloud.Alarm, AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput{}", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "cloudwatchlogs", ResourceType: cloud.LogGroup, AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput{}", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
 	{
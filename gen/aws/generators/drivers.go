@@ -27,7 +27,9 @@ import (
 )
 
 func generateTemplateTemplates() {
-	templ, err := template.New("templates_definitions").Parse(templateDefinitions)
+	templ, err := template.New("templates_definitions").Funcs(template.FuncMap{
+		"ApiToIAMServicePrefix": aws.ApiToIAMServicePrefix,
+	}).Parse(templateDefinitions)
 	if err != nil {
 		panic(err)
 	}
@@ -115,6 +117,31 @@ var APIPerTemplateDefName = map[string]string {
 {{- end }}
 }
 
+// IAM actions invoked by the template definitions not manually defined
+var IAMActionPerTemplateDefName = map[string]string {
+{{- range $, $service := . }}
+  {{- range $, $def := $service.Drivers }}
+  {{- if and $def.ApiMethod (not $def.ManualFuncDefinition) }}
+  "{{ $def.Action }}{{ $def.Entity }}": "{{ ApiToIAMServicePrefix $service.Api }}:{{ $def.ApiMethod }}",
+  {{- end }}
+  {{- end }}
+{{- end }}
+}
+
+// Template definitions whose dry run invokes the AWS API with DryRun,
+// hence checking the permissions of the caller
+var APIDryRunTemplateDefNames = map[string]bool {
+{{- range $, $service := . }}
+  {{- if eq $service.Api "ec2" }}
+  {{- range $, $def := $service.Drivers }}
+  {{- if and $def.ApiMethod (not $def.ManualFuncDefinition) (not $def.DryRunUnsupported) }}
+  "{{ $def.Action }}{{ $def.Entity }}": true,
+  {{- end }}
+  {{- end }}
+  {{- end }}
+{{- end }}
+}
+
 var AWSTemplatesDefinitions = map[string]template.Definition{
 {{- range $, $service := . }}
 {{- range $index, $def := $service.Drivers }}
@@ -124,6 +151,9 @@ var AWSTemplatesDefinitions = map[string]template.Definition{
 			Api: "{{ $service.Api }}",
 			RequiredParams: []string{ {{- range $key := $def.RequiredKeys }}"{{ $key }}", {{- end}} },
 			ExtraParams: []string{ {{- range $key := $def.ExtraKeys }}"{{ $key }}", {{- end}} },
+			{{- with $def.ParamTypes }}
+			ParamTypes: map[string]template.ParamType{ {{- range $key, $typ := . }}"{{ $key }}": {Kind: "{{ $typ.Kind }}"{{ with $typ.Values }}, Values: []string{ {{- range $v := . }}"{{ $v }}", {{- end }} }{{ end }}}, {{- end }} },
+			{{- end }}
 		},
 {{- end }}
 {{- end }}
@@ -32,6 +32,7 @@ func generateServicesFuncs() {
 		"ToUpper":        strings.ToUpper,
 		"Join":           strings.Join,
 		"ApiToInterface": aws.ApiToInterface,
+		"ObjectType":     aws.ObjectType,
 	}).Parse(servicesTempl)
 
 	if err != nil {
@@ -169,6 +170,12 @@ func New{{ Title $service.Name }}(sess *session.Session, awsconf config, log *lo
 		{{- range $, $api := $service.Api }}
 			{{$api }}API,
 		{{- end }}
+		{{- range $, $api := $service.FetchApi }}
+			{{ $api }}.New(sess),
+		{{- end }}
+		{{- range $, $api := $service.CloudFrontApi }}
+			awsfetch.CloudFront{{ Title $api }}{ {{ ApiToInterface $api }}: {{ $api }}.New(sess, awssdk.NewConfig().WithRegion(awsfetch.CloudFrontRegion)) },
+		{{- end }}
 	)
 	fetchConfig.Extra = awsconf
 	fetchConfig.Log = log
@@ -248,15 +255,15 @@ func (s *{{ Title $service.Name }}) FetchResources() (*graph.Graph, error) {
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*{{ $fetcher.AWSType }}); !ok {
-			return gph, errors.New("cannot cast to '[]*{{ $fetcher.AWSType }}' type from fetch context")
+		if _, ok := list.([]{{ ObjectType $fetcher.AWSType }}); !ok {
+			return gph, errors.New("cannot cast to '[]{{ ObjectType $fetcher.AWSType }}' type from fetch context")
 		}
-		for _, r := range list.([]*{{ $fetcher.AWSType }}) {
+		for _, r := range list.([]{{ ObjectType $fetcher.AWSType }}) {
 			for _, fn := range addParentsFns["{{ $fetcher.ResourceType }}"] {
 				wg.Add(1)
-				go func(f addParentFn, region string, res *{{ $fetcher.AWSType }}) {
+				go func(f addParentFn, region string, res {{ ObjectType $fetcher.AWSType }}) {
 					defer wg.Done()
-					err := f(gph, region, res)
+					err := f(gph, s, region, res)
 					if err != nil {
 						errc <- err
 						return
@@ -47,7 +47,13 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "ec2.Image", ApiMethod: "DescribeImages", Input: "ec2.DescribeImagesInput", Output: "ec2.DescribeImagesOutput", OutputsExtractor: "Images"},
 			{FuncType: "list", AWSType: "ec2.ImportImageTask", ApiMethod: "DescribeImportImageTasks", Input: "ec2.DescribeImportImageTasksInput", Output: "ec2.DescribeImportImageTasksOutput", OutputsExtractor: "ImportImageTasks"},
 			{FuncType: "list", AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
+			{FuncType: "list", AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
 			{FuncType: "list", AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", AWSType: "ec2.SpotInstanceRequest", ApiMethod: "DescribeSpotInstanceRequests", Input: "ec2.DescribeSpotInstanceRequestsInput", Output: "ec2.DescribeSpotInstanceRequestsOutput", OutputsExtractor: "SpotInstanceRequests"},
+			{FuncType: "list", AWSType: "ec2.ReservedInstances", ApiMethod: "DescribeReservedInstances", Input: "ec2.DescribeReservedInstancesInput", Output: "ec2.DescribeReservedInstancesOutput", OutputsExtractor: "ReservedInstances"},
+			{FuncType: "list", AWSType: "ec2.PlacementGroup", ApiMethod: "DescribePlacementGroups", Input: "ec2.DescribePlacementGroupsInput", Output: "ec2.DescribePlacementGroupsOutput", OutputsExtractor: "PlacementGroups"},
+			{FuncType: "list", AWSType: "ec2.Host", Manual: true},
+			{FuncType: "list", AWSType: "ec2.VpcEndpoint", Manual: true},
 		},
 	},
 	{
@@ -59,6 +65,12 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "elbv2.TargetHealthDescription", Manual: true, MockFieldType: "mapslice"},
 		},
 	},
+	{
+		Api: "elb",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
+		},
+	},
 	{
 		Api: "rds",
 		Funcs: []*mockFuncDef{
@@ -66,6 +78,15 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
 		},
 	},
+	{
+		Api: "elasticache",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elasticache.CacheCluster", Manual: true},
+			{FuncType: "list", AWSType: "elasticache.CacheSubnetGroup", ApiMethod: "DescribeCacheSubnetGroupsPages", Input: "elasticache.DescribeCacheSubnetGroupsInput", Output: "elasticache.DescribeCacheSubnetGroupsOutput", OutputsExtractor: "CacheSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{FuncType: "list", AWSType: "elasticache.ReplicationGroup", Manual: true},
+			{FuncType: "list", AWSType: "elasticache.Tag", Manual: true, MockFieldType: "mapslice"},
+		},
+	},
 	{
 		Api: "autoscaling",
 		Funcs: []*mockFuncDef{
@@ -95,11 +116,18 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "s3.Grant", Manual: true, MockFieldType: "mapslice"},
 		},
 	},
+	{
+		Api: "dynamodb",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "dynamodb.TableDescription", Manual: true},
+		},
+	},
 	{
 		Api: "sns",
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "sns.Subscription", ApiMethod: "ListSubscriptionsPages", Input: "sns.ListSubscriptionsInput", Output: "sns.ListSubscriptionsOutput", OutputsExtractor: "Subscriptions", Multipage: true, NextPageMarker: "NextToken"},
 			{FuncType: "list", AWSType: "sns.Topic", ApiMethod: "ListTopicsPages", Input: "sns.ListTopicsInput", Output: "sns.ListTopicsOutput", OutputsExtractor: "Topics", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", AWSType: "map[string]*string", Manual: true, MockFieldType: "map"},
 		},
 	},
 	{
@@ -120,6 +148,7 @@ var mocksDefs = []*mockDef{
 		Api: "lambda",
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
+			{FuncType: "list", AWSType: "lambda.EventSourceMappingConfiguration", Manual: true},
 		},
 	},
 	{
@@ -129,6 +158,12 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "cloudwatchlogs",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
 	{
 		Api: "cloudfront",
 		Funcs: []*mockFuncDef{
@@ -139,6 +174,7 @@ var mocksDefs = []*mockDef{
 		Api: "cloudformation",
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", AWSType: "cloudformation.StackResourceSummary", Manual: true, MockFieldType: "mapslice"},
 		},
 	},
 	{
@@ -160,6 +196,69 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", MockFieldType: "mapslice", AWSType: "ecs.ContainerInstance", Manual: true},
 		},
 	},
+	{
+		Api: "wafv2",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "wafv2.WebACL", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "webaclResources", AWSType: "string", Manual: true},
+		},
+	},
+	{
+		Api: "acm",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "acm.CertificateDetail", Manual: true},
+		},
+	},
+	{
+		Api: "elasticbeanstalk",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elasticbeanstalk.ApplicationDescription", ApiMethod: "DescribeApplications", Input: "elasticbeanstalk.DescribeApplicationsInput", Output: "elasticbeanstalk.DescribeApplicationsOutput", OutputsExtractor: "Applications"},
+			{FuncType: "list", AWSType: "elasticbeanstalk.EnvironmentDescription", ApiMethod: "DescribeEnvironments", Input: "elasticbeanstalk.DescribeEnvironmentsInput", Output: "elasticbeanstalk.EnvironmentDescriptionsMessage", OutputsExtractor: "Environments"},
+			{FuncType: "list", AWSType: "elasticbeanstalk.EnvironmentResourceDescription", Manual: true, MockFieldType: "mapslice"},
+		},
+	},
+	{
+		Api: "apigateway",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "apigateway.RestApi", Manual: true},
+			{FuncType: "list", AWSType: "apigateway.Stage", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "apigateway.Resource", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "apigateway.Integration", Manual: true, MockFieldType: "mapslice"},
+		},
+	},
+	{
+		Api: "apigatewayv2",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "apigatewayv2.Api", Manual: true},
+			{FuncType: "list", AWSType: "apigatewayv2.Stage", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "apigatewayv2.Integration", Manual: true, MockFieldType: "mapslice"},
+		},
+	},
+	{
+		Api: "ssm",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "ssm.ParameterMetadata", Manual: true},
+		},
+	},
+	{
+		Api: "kms",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "kms.KeyMetadata", Manual: true},
+			{FuncType: "list", AWSType: "kms.AliasListEntry", Manual: true},
+		},
+	},
+	{
+		Api: "guardduty",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "guardduty.Finding", Manual: true},
+		},
+	},
+	{
+		Api: "secretsmanager",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "secretsmanager.SecretListEntry", Manual: true},
+		},
+	},
 }
 
 func Mocks() []*mockDef {
@@ -0,0 +1,134 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// DependencyRelations are the relations making a resource depend on another:
+// a resource depends on its parent and on the resources applying on it
+// (ex: an instance depends on its subnet and on its security groups)
+var DependencyRelations = []string{rdf.ApplyOn, rdf.ParentOf}
+
+// dependentsIndex maps a resource id to the ids of the resources depending
+// on it by relation, so that they are found without resolving a graph snapshot
+type dependentsIndex struct {
+	mu   sync.RWMutex
+	byID map[string]map[string]map[string]struct{}
+}
+
+func newDependentsIndex() *dependentsIndex {
+	return &dependentsIndex{byID: make(map[string]map[string]map[string]struct{})}
+}
+
+func (idx *dependentsIndex) add(ts ...tstore.Triple) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, t := range ts {
+		dependent, ok := dependencyObject(t)
+		if !ok {
+			continue
+		}
+		byRel, ok := idx.byID[t.Subject()]
+		if !ok {
+			byRel = make(map[string]map[string]struct{})
+			idx.byID[t.Subject()] = byRel
+		}
+		if byRel[t.Predicate()] == nil {
+			byRel[t.Predicate()] = make(map[string]struct{})
+		}
+		byRel[t.Predicate()][dependent] = struct{}{}
+	}
+}
+
+func (idx *dependentsIndex) remove(ts ...tstore.Triple) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, t := range ts {
+		dependent, ok := dependencyObject(t)
+		if !ok {
+			continue
+		}
+		byRel := idx.byID[t.Subject()]
+		delete(byRel[t.Predicate()], dependent)
+		if len(byRel[t.Predicate()]) == 0 {
+			delete(byRel, t.Predicate())
+		}
+		if len(byRel) == 0 {
+			delete(idx.byID, t.Subject())
+		}
+	}
+}
+
+func (idx *dependentsIndex) get(id string) map[string][]string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	out := make(map[string][]string)
+	for rel, ids := range idx.byID[id] {
+		for dependent := range ids {
+			out[rel] = append(out[rel], dependent)
+		}
+		sort.Strings(out[rel])
+	}
+	return out
+}
+
+func dependencyObject(t tstore.Triple) (string, bool) {
+	if t.Predicate() != rdf.ApplyOn && t.Predicate() != rdf.ParentOf {
+		return "", false
+	}
+	return t.Object().Resource()
+}
+
+// Dependents returns the sorted ids of the resources depending on the resource with the given id,
+// by dependency relation. Resources outside of the graph are included, as their relations may
+// point to resources of other graphs
+func (g *Graph) Dependents(id string) map[string][]string {
+	return g.dependents.get(id)
+}
+
+// ListDependents returns the resources depending directly on the given one, whatever the relation
+func (g *Graph) ListDependents(start *Resource) ([]*Resource, error) {
+	var ids []string
+	dependents := g.Dependents(start.Id())
+	for _, rel := range DependencyRelations {
+		ids = append(ids, dependents[rel]...)
+	}
+	snap := g.store.Snapshot()
+	var resources []*Resource
+	for _, id := range ids {
+		rT, err := resolveResourceType(snap, id)
+		if err != nil {
+			if err == errTypeNotFound {
+				resources = append(resources, NotFoundResource(id))
+				continue
+			}
+			return resources, err
+		}
+		res, err := g.GetResource(rT, id)
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, res)
+	}
+	return resources, nil
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+func TestDependentsIndex(t *testing.T) {
+	g := NewGraph()
+	vpc := InitResource("vpc", "vpc_1")
+	g.AddResource(vpc)
+	var sgs, instances []*Resource
+	for i := 0; i < 3; i++ {
+		sg := InitResource("securitygroup", fmt.Sprintf("sg_%d", i))
+		g.AddResource(sg)
+		g.AddParentRelation(vpc, sg)
+		sgs = append(sgs, sg)
+	}
+	for i := 0; i < 5; i++ {
+		sub := InitResource("subnet", fmt.Sprintf("sub_%d", i))
+		g.AddResource(sub)
+		g.AddParentRelation(vpc, sub)
+		for j := 0; j < 4; j++ {
+			inst := InitResource("instance", fmt.Sprintf("inst_%d_%d", i, j))
+			g.AddResource(inst)
+			g.AddParentRelation(sub, inst)
+			for k, sg := range sgs {
+				if (i+j+k)%2 == 0 {
+					g.AddAppliesOnRelation(sg, inst)
+				}
+			}
+			instances = append(instances, inst)
+		}
+	}
+	g.AddAppliesOnRelation(instances[0], InitResource("volume", "vol_outside"))
+
+	checkConsistency := func(t *testing.T, g *Graph) {
+		snap := g.store.Snapshot()
+		for _, tri := range snap.Triples() {
+			if tri.Predicate() != rdf.ApplyOn && tri.Predicate() != rdf.ParentOf {
+				continue
+			}
+			deps := g.Dependents(tri.Subject())
+			var expected []string
+			for _, d := range snap.WithSubjPred(tri.Subject(), tri.Predicate()) {
+				id, _ := d.Object().Resource()
+				expected = append(expected, id)
+			}
+			sort.Strings(expected)
+			if got, want := deps[tri.Predicate()], expected; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s %s: got %v, want %v", tri.Subject(), tri.Predicate(), got, want)
+			}
+		}
+	}
+
+	t.Run("built while adding", func(t *testing.T) {
+		checkConsistency(t, g)
+		if got, want := len(g.Dependents("sg_0")[rdf.ApplyOn]), 10; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := g.Dependents("sub_2")[rdf.ParentOf], []string{"inst_2_0", "inst_2_1", "inst_2_2", "inst_2_3"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(g.Dependents("vpc_1")[rdf.ParentOf]), 8; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got := g.Dependents("inst_4_3"); len(got) != 0 {
+			t.Fatalf("got %v, want no dependents", got)
+		}
+
+		dependents, err := g.ListDependents(instances[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(dependents), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := dependents[0].Type(), notFoundResourceType; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("built when loading", func(t *testing.T) {
+		loaded := NewGraph()
+		if err := loaded.Unmarshal([]byte(g.MustMarshal())); err != nil {
+			t.Fatal(err)
+		}
+		checkConsistency(t, loaded)
+		if got, want := loaded.Dependents("sg_1"), g.Dependents("sg_1"); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+
+		merged := NewGraph()
+		merged.AddGraph(loaded)
+		checkConsistency(t, merged)
+	})
+
+	t.Run("kept on removal", func(t *testing.T) {
+		g.AddAppliesOnRelation(InitResource("securitygroup", "sg_gone"), instances[1])
+		if got, want := g.Dependents("sg_gone")[rdf.ApplyOn], []string{instances[1].Id()}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		g.RemoveDanglingRelations(g.CheckIntegrity())
+		if got := g.Dependents("sg_gone"); len(got) != 0 {
+			t.Fatalf("got %v, want no dependents", got)
+		}
+		checkConsistency(t, g)
+	})
+}
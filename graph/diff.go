@@ -56,15 +56,15 @@ func (d *Diff) ToGraph() *Graph {
 
 func (d *Diff) MergedGraph() *Graph {
 	d.mergedGraph = NewGraph()
-	d.mergedGraph.store.Add(d.toGraph.store.Snapshot().Triples()...)
+	d.mergedGraph.add(d.toGraph.store.Snapshot().Triples()...)
 
 	fromTriples := d.fromGraph.store.Snapshot().Triples()
 
 	for _, fromT := range fromTriples {
 		if MetaPredicate == fromT.Predicate() {
-			d.mergedGraph.store.Add(tstore.SubjPred(fromT.Subject(), MetaPredicate).StringLiteral(missingLit))
+			d.mergedGraph.add(tstore.SubjPred(fromT.Subject(), MetaPredicate).StringLiteral(missingLit))
 		} else {
-			d.mergedGraph.store.Add(fromT)
+			d.mergedGraph.add(fromT)
 		}
 	}
 
@@ -106,7 +106,7 @@ func (d *hierarchicDiffer) Run(root string, from *Graph, to *Graph) (*Diff, erro
 				res, ok := extra.Object().Resource()
 				if ok {
 					diff.hasDiffs = true
-					diff.toGraph.store.Add(tstore.SubjPred(res, MetaPredicate).StringLiteral(extraLit))
+					diff.toGraph.add(tstore.SubjPred(res, MetaPredicate).StringLiteral(extraLit))
 					processing <- res
 				}
 			}
@@ -115,7 +115,7 @@ func (d *hierarchicDiffer) Run(root string, from *Graph, to *Graph) (*Diff, erro
 				res, ok := missing.Object().Resource()
 				if ok {
 					diff.hasDiffs = true
-					diff.fromGraph.store.Add(tstore.SubjPred(res, MetaPredicate).StringLiteral(extraLit))
+					diff.fromGraph.add(tstore.SubjPred(res, MetaPredicate).StringLiteral(extraLit))
 					processing <- res
 				}
 			}
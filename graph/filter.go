@@ -2,7 +2,9 @@ package graph
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type FilterFn func(*Resource) bool
@@ -32,12 +34,48 @@ func (g *Graph) filter(apply func(filters ...FilterFn) FilterFn, entity string,
 	return filtered, nil
 }
 
+// BuildPropertyFilterFunc matches resources whose property contains the given value.
+// On time properties, a value such as '<30d' or '>12h' matches times respectively
+// before or after now plus the given duration (ex: certificates expiring within 30 days)
 func BuildPropertyFilterFunc(key, val string) FilterFn {
 	return func(r *Resource) bool {
+		if t, ok := r.Properties[key].(time.Time); ok {
+			if before, d, isRelative := parseRelativeTime(val); isRelative {
+				limit := time.Now().Add(d)
+				if before {
+					return t.Before(limit)
+				}
+				return t.After(limit)
+			}
+		}
 		return strings.Contains(strings.ToLower(fmt.Sprint(r.Properties[key])), strings.ToLower(val))
 	}
 }
 
+func parseRelativeTime(val string) (before bool, d time.Duration, ok bool) {
+	if len(val) < 2 || (val[0] != '<' && val[0] != '>') {
+		return
+	}
+	before = val[0] == '<'
+	d, err := ParseDaysDuration(strings.TrimSpace(val[1:]))
+	if err != nil {
+		return
+	}
+	return before, d, true
+}
+
+// ParseDaysDuration parses a duration also accepting days as in '30d'
+func ParseDaysDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': expecting days as in '30d'", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
 func BuildTagFilterFunc(key, val string) FilterFn {
 	return func(r *Resource) bool {
 		tags, ok := r.Properties["Tags"].([]string)
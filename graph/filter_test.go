@@ -1,7 +1,10 @@
 package graph_test
 
 import (
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
@@ -156,3 +159,70 @@ func hasResource(arr []*graph.Resource, r *graph.Resource) bool {
 	}
 	return false
 }
+
+func TestFilterOnRelativeTime(t *testing.T) {
+	g := graph.NewGraph()
+	now := time.Now()
+	g.AddResource(
+		resourcetest.Certificate("cert_1").Prop(properties.Expires, now.Add(10*24*time.Hour)).Build(),
+		resourcetest.Certificate("cert_2").Prop(properties.Expires, now.Add(60*24*time.Hour)).Build(),
+		resourcetest.Certificate("cert_3").Prop(properties.Expires, now.Add(-2*time.Hour)).Build(),
+	)
+
+	tcases := []struct {
+		filter string
+		exp    []string
+	}{
+		{filter: "<30d", exp: []string{"cert_1", "cert_3"}},
+		{filter: ">30d", exp: []string{"cert_2"}},
+		{filter: "<0d", exp: []string{"cert_3"}},
+		{filter: "> 1h", exp: []string{"cert_1", "cert_2"}},
+		{filter: "<-1h", exp: []string{"cert_3"}},
+		{filter: "<invalid", exp: nil},
+	}
+	for _, tcase := range tcases {
+		filtered, err := g.Filter("certificate", graph.BuildPropertyFilterFunc(properties.Expires, tcase.filter))
+		if err != nil {
+			t.Fatal(err)
+		}
+		certs, _ := filtered.GetAllResources("certificate")
+		var ids []string
+		for _, c := range certs {
+			ids = append(ids, c.Id())
+		}
+		sort.Strings(ids)
+		if got, want := ids, tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %v, want %v", tcase.filter, got, want)
+		}
+	}
+}
+
+func TestParseDaysDuration(t *testing.T) {
+	tcases := []struct {
+		in     string
+		exp    time.Duration
+		expErr bool
+	}{
+		{in: "30d", exp: 30 * 24 * time.Hour},
+		{in: "0d", exp: 0},
+		{in: "12h", exp: 12 * time.Hour},
+		{in: "1h30m", exp: 90 * time.Minute},
+		{in: "xd", expErr: true},
+		{in: "30", expErr: true},
+	}
+	for _, tcase := range tcases {
+		d, err := graph.ParseDaysDuration(tcase.in)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%s: expected error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.in, err)
+		}
+		if got, want := d, tcase.exp; got != want {
+			t.Fatalf("%s: got %s, want %s", tcase.in, got, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"io"
+
+	tstore "github.com/wallix/triplestore"
+)
+
+// FormatVersion is the version of the format of the graphs written by MarshalTo.
+// Bump it with a migration in formatMigrations whenever the triples written change
+// in a way the previous versions of awless read wrongly.
+const FormatVersion = 2
+
+// The format version is written as a header triple, ignored by the versions of
+// awless prior to it (format 1) and not loaded in the graphs
+const (
+	formatSubject   = "awless:graph"
+	formatPredicate = "awless:formatVersion"
+)
+
+// formatMigrations upgrade the triples of a version to the next one
+var formatMigrations = map[int]func([]tstore.Triple) ([]tstore.Triple, error){
+	// format 1 only lacks the header
+	1: func(ts []tstore.Triple) ([]tstore.Triple, error) { return ts, nil },
+}
+
+// FormatError is returned when loading a graph written by a newer version of awless
+type FormatError struct {
+	Version int
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("graph format version %d is newer than the latest supported (%d): upgrade awless, or sync again to overwrite the local graphs", e.Version, FormatVersion)
+}
+
+func formatHeader() tstore.Triple {
+	return tstore.SubjPred(formatSubject, formatPredicate).IntegerLiteral(FormatVersion)
+}
+
+// ReadFormatVersion returns the format version of the encoded graph
+func ReadFormatVersion(r io.Reader) (int, error) {
+	ts, err := tstore.NewBinaryDecoder(r).Decode()
+	if err != nil {
+		return 0, err
+	}
+	version, _, err := splitFormatHeader(ts)
+	return version, err
+}
+
+// decodeTriples decodes the triples of a graph, upgrading them to the current format
+func decodeTriples(r io.Reader) ([]tstore.Triple, error) {
+	ts, err := tstore.NewBinaryDecoder(r).Decode()
+	if err != nil {
+		return nil, err
+	}
+	return migrateTriples(ts)
+}
+
+func migrateTriples(ts []tstore.Triple) ([]tstore.Triple, error) {
+	version, ts, err := splitFormatHeader(ts)
+	if err != nil {
+		return nil, err
+	}
+	if version > FormatVersion {
+		return nil, &FormatError{Version: version}
+	}
+	for ; version < FormatVersion; version++ {
+		migrate, ok := formatMigrations[version]
+		if !ok {
+			return nil, fmt.Errorf("graph format version %d: no migration to version %d", version, version+1)
+		}
+		if ts, err = migrate(ts); err != nil {
+			return nil, fmt.Errorf("migrating graph format from version %d to %d: %s", version, version+1, err)
+		}
+	}
+	return ts, nil
+}
+
+// splitFormatHeader returns the format version of the triples, 1 without header, and the triples without header
+func splitFormatHeader(ts []tstore.Triple) (int, []tstore.Triple, error) {
+	version := 1
+	triples := make([]tstore.Triple, 0, len(ts))
+	for _, t := range ts {
+		if t.Subject() != formatSubject || t.Predicate() != formatPredicate {
+			triples = append(triples, t)
+			continue
+		}
+		v, err := tstore.ParseInteger(t.Object())
+		if err != nil {
+			return 0, nil, fmt.Errorf("graph format version: %s", err)
+		}
+		version = v
+	}
+	return version, triples, nil
+}
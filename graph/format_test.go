@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	tstore "github.com/wallix/triplestore"
+)
+
+func TestFormatMigration(t *testing.T) {
+	vpc, inst, sg := InitResource("vpc", "vpc_1"), InitResource("instance", "inst_1"), InitResource("securitygroup", "sg_1")
+	vpc.Properties[properties.Name] = "my_vpc"
+	inst.Properties[properties.Name] = "my_instance"
+	inst.Properties[properties.SecurityGroups] = []string{"sg_1", "sg_2"}
+	g := NewGraph()
+	g.AddResource(vpc, inst, sg)
+	g.AddParentRelation(vpc, inst)
+	g.AddAppliesOnRelation(sg, inst)
+
+	// the same graph in format 1, written without header
+	legacy, err := ioutil.ReadFile(filepath.Join("testdata", "format_v1.triples"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version, err := ReadFormatVersion(bytes.NewReader(legacy)); err != nil || version != 1 {
+		t.Fatalf("got version %d (err: %v), want 1", version, err)
+	}
+
+	migrated := NewGraph()
+	if err := migrated.Unmarshal(legacy); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(migrated.store.CopyTriples()), len(g.store.CopyTriples()); got != want {
+		t.Fatalf("got %d triples, want %d", got, want)
+	}
+	res, err := migrated.GetResource("instance", "inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, _ := res.Properties[properties.SecurityGroups].([]string)
+	sort.Strings(groups)
+	if got, want := groups, []string{"sg_1", "sg_2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	applying, err := migrated.ListResourcesDependingOn(inst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applying) != 1 || applying[0].Id() != "sg_1" {
+		t.Fatalf("got %v, want sg_1 applying on inst_1", applying)
+	}
+
+	var current bytes.Buffer
+	if err = migrated.MarshalTo(&current); err != nil {
+		t.Fatal(err)
+	}
+	if version, err := ReadFormatVersion(bytes.NewReader(current.Bytes())); err != nil || version != FormatVersion {
+		t.Fatalf("got version %d (err: %v), want %d", version, err, FormatVersion)
+	}
+	reloaded := NewGraph()
+	if err = reloaded.UnmarshalMultiple(bytes.NewReader(current.Bytes()), bytes.NewReader(legacy)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(reloaded.store.CopyTriples()), len(g.store.CopyTriples()); got != want {
+		t.Fatalf("got %d triples, want %d (header not loaded)", got, want)
+	}
+
+	var newer bytes.Buffer
+	header := tstore.SubjPred(formatSubject, formatPredicate).IntegerLiteral(FormatVersion + 1)
+	if err = tstore.NewBinaryEncoder(&newer).Encode(append([]tstore.Triple{header}, g.store.CopyTriples()...)...); err != nil {
+		t.Fatal(err)
+	}
+	err = NewGraph().Unmarshal(newer.Bytes())
+	if _, ok := err.(*FormatError); !ok || !strings.Contains(err.Error(), "upgrade awless") {
+		t.Fatalf("got %v, want format error", err)
+	}
+}
@@ -21,17 +21,19 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/wallix/awless/cloud/rdf"
 	tstore "github.com/wallix/triplestore"
 )
 
 type Graph struct {
-	store tstore.Source
+	store      tstore.Source
+	dependents *dependentsIndex
 }
 
 func NewGraph() *Graph {
-	return &Graph{tstore.NewSource()}
+	return &Graph{store: tstore.NewSource(), dependents: newDependentsIndex()}
 }
 
 func NewGraphFromFile(filepath string) (*Graph, error) {
@@ -40,11 +42,12 @@ func NewGraphFromFile(filepath string) (*Graph, error) {
 	if err != nil {
 		return g, err
 	}
-	ts, err := tstore.NewBinaryDecoder(f).Decode()
+	defer f.Close()
+	ts, err := decodeTriples(f)
 	if err != nil {
 		return g, err
 	}
-	g.store.Add(ts...)
+	g.add(ts...)
 	return g, nil
 }
 
@@ -76,13 +79,13 @@ func (g *Graph) AddResource(resources ...*Resource) error {
 			}
 		}
 
-		g.store.Add(triples...)
+		g.add(triples...)
 	}
 	return nil
 }
 
 func (g *Graph) AddGraph(other *Graph) {
-	g.store.Add(other.store.CopyTriples()...)
+	g.add(other.store.CopyTriples()...)
 }
 
 func (g *Graph) AddParentRelation(parent, child *Resource) error {
@@ -215,37 +218,67 @@ func (g *Graph) Accept(v Visitor) error {
 }
 
 func (g *Graph) Unmarshal(data []byte) error {
-	ts, err := tstore.NewBinaryDecoder(bytes.NewReader(data)).Decode()
+	ts, err := decodeTriples(bytes.NewReader(data))
 	if err != nil {
 		return err
 	}
-	g.store.Add(ts...)
+	g.add(ts...)
 	return nil
 }
 
+// UnmarshalMultiple decodes the readers concurrently, each in its own format version
 func (g *Graph) UnmarshalMultiple(readers ...io.Reader) error {
-	dec := tstore.NewDatasetDecoder(tstore.NewBinaryDecoder, readers...)
-	ts, err := dec.Decode()
-	if err != nil {
-		return err
+	type decoded struct {
+		ts  []tstore.Triple
+		err error
+	}
+	results := make([]decoded, len(readers))
+	var wg sync.WaitGroup
+	for i, r := range readers {
+		wg.Add(1)
+		go func(i int, r io.Reader) {
+			defer wg.Done()
+			ts, err := decodeTriples(r)
+			results[i] = decoded{ts: ts, err: err}
+		}(i, r)
+	}
+	wg.Wait()
+	for _, res := range results {
+		if res.err != nil {
+			return res.err
+		}
+	}
+	for _, res := range results {
+		g.add(res.ts...)
 	}
-	g.store.Add(ts...)
 	return nil
 }
 
 func (g *Graph) MustMarshal() string {
 	var buff bytes.Buffer
-	if err := tstore.NewBinaryEncoder(&buff).Encode(g.store.CopyTriples()...); err != nil {
+	if err := g.MarshalTo(&buff); err != nil {
 		panic(err)
 	}
 	return string(buff.Bytes())
 }
 
+// MarshalTo encodes the graph preceded by a header triple holding its FormatVersion
 func (g *Graph) MarshalTo(w io.Writer) error {
-	return tstore.NewBinaryEncoder(w).Encode(g.store.CopyTriples()...)
+	return tstore.NewBinaryEncoder(w).Encode(append([]tstore.Triple{formatHeader()}, g.store.CopyTriples()...)...)
+}
+
+// add and remove keep the dependents index consistent with the triples of the store
+func (g *Graph) add(ts ...tstore.Triple) {
+	g.store.Add(ts...)
+	g.dependents.add(ts...)
+}
+
+func (g *Graph) remove(ts ...tstore.Triple) {
+	g.store.Remove(ts...)
+	g.dependents.remove(ts...)
 }
 
 func (g *Graph) addRelation(one, other *Resource, pred string) error {
-	g.store.Add(tstore.SubjPred(one.Id(), pred).Resource(other.Id()))
+	g.add(tstore.SubjPred(one.Id(), pred).Resource(other.Id()))
 	return nil
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// WithIDProperties returns a copy of the graph where the id property of each
+// resource is replaced by the value fn returns given its id and ARN properties
+func (g *Graph) WithIDProperties(fn func(id, arn string) string) *Graph {
+	snap := g.store.Snapshot()
+	formatted := NewGraph()
+	for _, t := range g.store.CopyTriples() {
+		if id, isLit := t.Object().Literal(); isLit && t.Predicate() == rdf.ID {
+			var arn string
+			for _, a := range snap.WithSubjPred(t.Subject(), rdf.Arn) {
+				if lit, ok := a.Object().Literal(); ok {
+					arn = lit.Value()
+				}
+			}
+			t = tstore.SubjPred(t.Subject(), t.Predicate()).StringLiteral(fn(id.Value(), arn))
+		}
+		formatted.add(t)
+	}
+	return formatted
+}
+
+// WithIDProperty returns a copy of the resource where the id property
+// is replaced by the value fn returns given its id and ARN properties
+func (res *Resource) WithIDProperty(fn func(id, arn string) string) *Resource {
+	formatted := &Resource{kind: res.kind, id: res.id, Properties: make(map[string]interface{}), Relations: res.Relations, Meta: res.Meta}
+	for k, v := range res.Properties {
+		formatted.Properties[k] = v
+	}
+	if id, ok := res.Properties[properties.ID].(string); ok {
+		arn, _ := res.Properties[properties.Arn].(string)
+		formatted.Properties[properties.ID] = fn(id, arn)
+	}
+	return formatted
+}
+
+// WithScopedIDs returns a copy of the graph where the resources are identified by
+// their id prefixed with the scope (ex: "123456789012:inst_1"), so that graphs of
+// distinct scopes can be merged without mixing their resources. The references
+// between resources are scoped alike, the id property keeps the id AWS returns
+func (g *Graph) WithScopedIDs(scope string) *Graph {
+	scopeID := func(id string) string { return scope + ScopedIDSeparator + id }
+	scoped := NewGraph()
+	for _, t := range g.store.CopyTriples() {
+		subj := scopeID(t.Subject())
+		if obj, isRes := t.Object().Resource(); isRes && t.Predicate() != rdf.RdfType {
+			t = tstore.SubjPred(subj, t.Predicate()).Resource(scopeID(obj))
+		} else {
+			t = tstore.SubjPred(subj, t.Predicate()).Object(t.Object())
+		}
+		scoped.add(t)
+	}
+	return scoped
+}
+
+// ScopedIDSeparator separates the scope from the id of the resources of a graph WithScopedIDs
+const ScopedIDSeparator = ":"
@@ -0,0 +1,57 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	tstore "github.com/wallix/triplestore"
+)
+
+func TestWithScopedIDs(t *testing.T) {
+	g := NewGraph()
+	inst := InitResource("instance", "inst_1")
+	inst.Properties["ID"] = "inst_1"
+	inst.Properties["Subnet"] = "subnet_1"
+	g.AddResource(inst)
+	g.AddResource(InitResource("subnet", "subnet_1"))
+	g.AddParentRelation(InitResource("subnet", "subnet_1"), inst)
+
+	scoped := g.WithScopedIDs("123456789012")
+
+	expTriples := tstore.Triples([]tstore.Triple{
+		tstore.SubjPred("123456789012:inst_1", "rdf:type").Resource("cloud-owl:Instance"),
+		tstore.SubjPred("123456789012:inst_1", "cloud:id").StringLiteral("inst_1"),
+		tstore.SubjPred("123456789012:inst_1", "cloud:subnet").Resource("123456789012:subnet_1"),
+		tstore.SubjPred("123456789012:subnet_1", "rdf:type").Resource("cloud-owl:Subnet"),
+		tstore.SubjPred("123456789012:subnet_1", "cloud-rel:parentOf").Resource("123456789012:inst_1"),
+	})
+	if got, want := tstore.Triples(scoped.store.Snapshot().Triples()), expTriples; !got.Equal(want) {
+		t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+	}
+
+	res, err := scoped.GetResource("instance", "123456789012:inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Properties["ID"], "inst_1"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	if got, want := len(g.store.Snapshot().WithSubject("inst_1")), 3; got != want {
+		t.Fatalf("got %d, want %d triples of the original graph left unscoped", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+// ImpactNode is a resource of an impact tree with the resources depending on it by relation
+type ImpactNode struct {
+	Resource   *Resource
+	Dependents map[string][]*ImpactNode
+}
+
+// Impact returns the tree of the resources depending on the given one, directly and
+// transitively, along with their count. Each resource appears once, under the resource
+// through which it is reached first walking the dependents breadth first
+func (g *Graph) Impact(start *Resource) (*ImpactNode, int, error) {
+	root := &ImpactNode{Resource: start, Dependents: make(map[string][]*ImpactNode)}
+	visited := map[string]bool{start.Id(): true}
+	queue := []*ImpactNode{root}
+	var count int
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		dependents := g.Dependents(node.Resource.Id())
+		for _, rel := range DependencyRelations {
+			for _, id := range dependents[rel] {
+				if visited[id] {
+					continue
+				}
+				visited[id] = true
+				res, err := g.FindResource(id)
+				if err != nil {
+					return root, count, err
+				}
+				if res == nil {
+					res = NotFoundResource(id)
+				}
+				child := &ImpactNode{Resource: res, Dependents: make(map[string][]*ImpactNode)}
+				node.Dependents[rel] = append(node.Dependents[rel], child)
+				queue = append(queue, child)
+				count++
+			}
+		}
+	}
+	return root, count, nil
+}
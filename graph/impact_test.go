@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+func TestImpact(t *testing.T) {
+	g := NewGraph()
+	vpc, sub, inst, sg, vol := InitResource("vpc", "vpc_1"), InitResource("subnet", "sub_1"), InitResource("instance", "inst_1"), InitResource("securitygroup", "sg_1"), InitResource("volume", "vol_1")
+	g.AddResource(vpc, sub, inst, sg, vol)
+	g.AddParentRelation(vpc, sub)
+	g.AddParentRelation(vpc, sg)
+	g.AddParentRelation(sub, inst)
+	g.AddAppliesOnRelation(sg, inst)
+	g.AddAppliesOnRelation(inst, vol)
+	g.AddAppliesOnRelation(vol, inst)
+	g.AddAppliesOnRelation(inst, InitResource("elasticip", "eip_outside"))
+
+	root, count, err := g.Impact(vpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, 5; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	ids := func(nodes []*ImpactNode) (out []string) {
+		for _, n := range nodes {
+			out = append(out, n.Resource.Id())
+		}
+		return
+	}
+	if got, want := ids(root.Dependents[rdf.ParentOf]), []string{"sg_1", "sub_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	sgNode, subNode := root.Dependents[rdf.ParentOf][0], root.Dependents[rdf.ParentOf][1]
+	if got, want := ids(sgNode.Dependents[rdf.ApplyOn]), []string{"inst_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := subNode.Dependents; len(got) != 0 {
+		t.Fatalf("instance reached first through security group, got %v under subnet", got)
+	}
+	instNode := sgNode.Dependents[rdf.ApplyOn][0]
+	if got, want := ids(instNode.Dependents[rdf.ApplyOn]), []string{"eip_outside", "vol_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := instNode.Dependents[rdf.ApplyOn][0].Resource.Type(), notFoundResourceType; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	_, count, err = g.Impact(vol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, 2; got != want {
+		t.Fatalf("got %d, want %d (cycle back to volume not counted)", got, want)
+	}
+}
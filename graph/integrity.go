@@ -0,0 +1,188 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+const (
+	DanglingRelationProblem = "dangling-relation"
+	DuplicateIDProblem      = "duplicate-id"
+	MissingPropertyProblem  = "missing-property"
+)
+
+// IntegrityProblem describes an inconsistency found in a graph
+type IntegrityProblem struct {
+	Kind      string `json:"kind"`
+	Subject   string `json:"subject"`
+	Predicate string `json:"predicate,omitempty"`
+	Object    string `json:"object,omitempty"`
+	Message   string `json:"message"`
+}
+
+func (p *IntegrityProblem) String() string {
+	return fmt.Sprintf("%s: %s", p.Kind, p.Message)
+}
+
+// Classes of the nodes embedded in resources (rules, routes, grants, ...)
+// that are not cloud resources by themselves
+var embeddedClasses = map[string]bool{
+	rdf.NetFirewallRule:    true,
+	rdf.NetRoute:           true,
+	rdf.Grant:              true,
+	rdf.KeyValue:           true,
+	rdf.DistributionOrigin: true,
+}
+
+// CheckIntegrity verifies that every relation points to existing resources,
+// that resources have a unique type and id and that they have an id property.
+// Problems are sorted by kind and subject
+func (g *Graph) CheckIntegrity() []*IntegrityProblem {
+	snap := g.store.Snapshot()
+	var problems []*IntegrityProblem
+
+	for _, pred := range []string{rdf.ParentOf, rdf.ApplyOn} {
+		for _, t := range snap.WithPredicate(pred) {
+			obj, ok := t.Object().Resource()
+			if !ok {
+				continue
+			}
+			for _, node := range []string{t.Subject(), obj} {
+				if len(snap.WithSubjPred(node, rdf.RdfType)) == 0 {
+					problems = append(problems, &IntegrityProblem{
+						Kind: DanglingRelationProblem, Subject: t.Subject(), Predicate: pred, Object: obj,
+						Message: fmt.Sprintf("%s %s %s: resource '%s' not found", t.Subject(), trimNS(pred), obj, node),
+					})
+					break
+				}
+			}
+		}
+	}
+
+	regionType := namespacedResourceType("region")
+	for _, t := range snap.WithPredicate(rdf.RdfType) {
+		typ, ok := t.Object().Resource()
+		if !ok || embeddedClasses[typ] {
+			continue
+		}
+		id := t.Subject()
+		if types := snap.WithSubjPred(id, rdf.RdfType); len(types) > 1 {
+			if types[0].Object().Equal(t.Object()) {
+				problems = append(problems, &IntegrityProblem{
+					Kind: DuplicateIDProblem, Subject: id,
+					Message: fmt.Sprintf("'%s' is declared with %d types", id, len(types)),
+				})
+			}
+			continue
+		}
+		if typ == regionType {
+			continue
+		}
+		switch ids := snap.WithSubjPred(id, rdf.ID); len(ids) {
+		case 0:
+			problems = append(problems, &IntegrityProblem{
+				Kind: MissingPropertyProblem, Subject: id, Predicate: rdf.ID,
+				Message: fmt.Sprintf("%s '%s' has no id property", lowerFirstLetter(trimNS(typ)), id),
+			})
+		case 1:
+		default:
+			problems = append(problems, &IntegrityProblem{
+				Kind: DuplicateIDProblem, Subject: id, Predicate: rdf.ID,
+				Message: fmt.Sprintf("%s '%s' has %d id properties", lowerFirstLetter(trimNS(typ)), id, len(ids)),
+			})
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Kind != problems[j].Kind {
+			return problems[i].Kind < problems[j].Kind
+		}
+		if problems[i].Subject != problems[j].Subject {
+			return problems[i].Subject < problems[j].Subject
+		}
+		return problems[i].Object < problems[j].Object
+	})
+	return problems
+}
+
+// RemoveDanglingRelations drops from the graph the relations of the given
+// problems and returns the number of relations actually removed
+func (g *Graph) RemoveDanglingRelations(problems []*IntegrityProblem) int {
+	snap := g.store.Snapshot()
+	var triples []tstore.Triple
+	for _, p := range problems {
+		if p.Kind != DanglingRelationProblem {
+			continue
+		}
+		t := tstore.SubjPred(p.Subject, p.Predicate).Resource(p.Object)
+		if snap.Contains(t) {
+			triples = append(triples, t)
+		}
+	}
+	g.remove(triples...)
+	return len(triples)
+}
+
+// UndeclaredRelatedNodes returns the nodes of the parent and applies on relations
+// of the graph that the graph does not declare as resources
+func (g *Graph) UndeclaredRelatedNodes() []string {
+	snap := g.store.Snapshot()
+	seen := make(map[string]bool)
+	var nodes []string
+	for _, pred := range []string{rdf.ParentOf, rdf.ApplyOn} {
+		for _, t := range snap.WithPredicate(pred) {
+			obj, ok := t.Object().Resource()
+			if !ok {
+				continue
+			}
+			for _, node := range []string{t.Subject(), obj} {
+				if !seen[node] && len(snap.WithSubjPred(node, rdf.RdfType)) == 0 {
+					seen[node] = true
+					nodes = append(nodes, node)
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+// Declares returns whether the graph declares the node as a resource
+func (g *Graph) Declares(node string) bool {
+	return len(g.store.Snapshot().WithSubjPred(node, rdf.RdfType)) > 0
+}
+
+// RemoveRelationsWith drops the parent and applies on relations of the graph
+// involving one of the nodes and returns the number of relations removed
+func (g *Graph) RemoveRelationsWith(nodes map[string]bool) int {
+	snap := g.store.Snapshot()
+	var triples []tstore.Triple
+	for _, pred := range []string{rdf.ParentOf, rdf.ApplyOn} {
+		for _, t := range snap.WithPredicate(pred) {
+			obj, ok := t.Object().Resource()
+			if ok && (nodes[t.Subject()] || nodes[obj]) {
+				triples = append(triples, t)
+			}
+		}
+	}
+	g.remove(triples...)
+	return len(triples)
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	newRes := func(typ, id string) *Resource {
+		r := InitResource(typ, id)
+		r.Properties[properties.ID] = id
+		return r
+	}
+	region, vpc, sub := InitResource("region", "eu-west-1"), newRes("vpc", "vpc_1"), newRes("subnet", "sub_1")
+
+	g := NewGraph()
+	g.AddResource(region, vpc, sub, InitResource("instance", "inst_1"))
+	g.AddParentRelation(region, vpc)
+	g.AddParentRelation(vpc, sub)
+	g.AddParentRelation(vpc, InitResource("subnet", "sub_gone"))
+	g.AddAppliesOnRelation(InitResource("securitygroup", "sg_gone"), sub)
+	g.store.Add(
+		tstore.SubjPred("sub_1", rdf.ID).StringLiteral("sub_2"),
+		tstore.SubjPred("vpc_1", rdf.RdfType).Resource(namespacedResourceType("subnet")),
+	)
+
+	problems := g.CheckIntegrity()
+	expected := []IntegrityProblem{
+		{Kind: DanglingRelationProblem, Subject: "sg_gone", Predicate: rdf.ApplyOn, Object: "sub_1"},
+		{Kind: DanglingRelationProblem, Subject: "vpc_1", Predicate: rdf.ParentOf, Object: "sub_gone"},
+		{Kind: DuplicateIDProblem, Subject: "sub_1", Predicate: rdf.ID},
+		{Kind: DuplicateIDProblem, Subject: "vpc_1"},
+		{Kind: MissingPropertyProblem, Subject: "inst_1", Predicate: rdf.ID},
+	}
+	if got, want := len(problems), len(expected); got != want {
+		t.Fatalf("got %d problems, want %d: %v", got, want, problems)
+	}
+	for i, exp := range expected {
+		p := problems[i]
+		if p.Kind != exp.Kind || p.Subject != exp.Subject || p.Predicate != exp.Predicate || p.Object != exp.Object {
+			t.Fatalf("%d: got %#v, want %#v", i+1, p, exp)
+		}
+	}
+
+	if got, want := g.RemoveDanglingRelations(problems), 2; got != want {
+		t.Fatalf("got %d removed, want %d", got, want)
+	}
+	if got, want := g.RemoveDanglingRelations(problems), 0; got != want {
+		t.Fatalf("second repair: got %d removed, want %d", got, want)
+	}
+	for _, p := range g.CheckIntegrity() {
+		if p.Kind == DanglingRelationProblem {
+			t.Fatalf("unexpected dangling relation after repair: %s", p)
+		}
+	}
+	if !g.store.Snapshot().Contains(tstore.SubjPred("vpc_1", rdf.ParentOf).Resource("sub_1")) {
+		t.Fatal("expected repair to keep valid relations")
+	}
+}
+
+func TestRemoveRelationsWithUndeclared(t *testing.T) {
+	vpc, sub, inst := InitResource("vpc", "vpc_1"), InitResource("subnet", "sub_1"), InitResource("instance", "inst_1")
+
+	g := NewGraph()
+	g.AddResource(vpc, sub)
+	g.AddParentRelation(vpc, sub)
+	g.AddParentRelation(sub, inst)
+	g.AddAppliesOnRelation(InitResource("stack", "stack_1"), vpc)
+
+	other := NewGraph()
+	other.AddResource(inst)
+
+	var missing []string
+	for _, n := range g.UndeclaredRelatedNodes() {
+		if !other.Declares(n) {
+			missing = append(missing, n)
+		}
+	}
+	if got, want := missing, []string{"stack_1"}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := g.RemoveRelationsWith(map[string]bool{"stack_1": true}), 1; got != want {
+		t.Fatalf("got %d removed, want %d", got, want)
+	}
+	snap := g.store.Snapshot()
+	if snap.Contains(tstore.SubjPred("stack_1", rdf.ApplyOn).Resource("vpc_1")) {
+		t.Fatal("expected relation with undeclared stack to be removed")
+	}
+	if !snap.Contains(tstore.SubjPred("sub_1", rdf.ParentOf).Resource("inst_1")) {
+		t.Fatal("expected relation with resource declared in other graph to be kept")
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"time"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// Redacted returns a copy of the graph where the plain values
+// of the given properties are replaced by the mask
+func (g *Graph) Redacted(mask string, props ...string) *Graph {
+	preds := make(map[string]bool)
+	for _, p := range props {
+		if id, err := rdf.Properties.GetRDFId(p); err == nil {
+			preds[id] = true
+		}
+	}
+
+	redacted := NewGraph()
+	for _, t := range g.store.CopyTriples() {
+		if _, isLit := t.Object().Literal(); isLit && preds[t.Predicate()] {
+			t = tstore.SubjPred(t.Subject(), t.Predicate()).StringLiteral(mask)
+		}
+		redacted.add(t)
+	}
+	return redacted
+}
+
+// Redacted returns a copy of the resource where the plain values
+// of the given properties are replaced by the mask
+func (res *Resource) Redacted(mask string, props ...string) *Resource {
+	redacted := &Resource{kind: res.kind, id: res.id, Properties: make(map[string]interface{}), Relations: res.Relations, Meta: res.Meta}
+	for k, v := range res.Properties {
+		redacted.Properties[k] = v
+	}
+	for _, p := range props {
+		switch v := redacted.Properties[p].(type) {
+		case nil:
+		case []string:
+			masked := make([]string, len(v))
+			for i := range v {
+				masked[i] = mask
+			}
+			redacted.Properties[p] = masked
+		case string, int, bool, float64, time.Time:
+			redacted.Properties[p] = mask
+		}
+	}
+	return redacted
+}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/cloud/rdf"
@@ -58,6 +59,98 @@ func (r *ByProperty) Resolve(snap tstore.RDFGraph) ([]*Resource, error) {
 	return resources, nil
 }
 
+// ByPropertyFold resolves the resources whose string property equals the value, ignoring case
+type ByPropertyFold struct {
+	Key   string
+	Value string
+}
+
+func (r *ByPropertyFold) Resolve(snap tstore.RDFGraph) ([]*Resource, error) {
+	return resolveByLiteral(snap, r.Key, func(val string) bool { return strings.EqualFold(val, r.Value) })
+}
+
+// ByClosestProperty resolves the resources whose string property is the closest to the value,
+// ignoring case, with at most MaxDistance inserted, deleted or substituted characters.
+// The closest value is computed over the whole snapshot: to restrict it, put it last in an And
+type ByClosestProperty struct {
+	Key         string
+	Value       string
+	MaxDistance int
+}
+
+func (r *ByClosestProperty) Resolve(snap tstore.RDFGraph) ([]*Resource, error) {
+	value := strings.ToLower(r.Value)
+	distances := make(map[string]int)
+	closest := r.MaxDistance
+	if _, err := resolveByLiteral(snap, r.Key, func(val string) bool {
+		d := editDistance(strings.ToLower(val), value)
+		distances[val] = d
+		if d < closest {
+			closest = d
+		}
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	return resolveByLiteral(snap, r.Key, func(val string) bool { return distances[val] <= closest })
+}
+
+func resolveByLiteral(snap tstore.RDFGraph, key string, match func(string) bool) ([]*Resource, error) {
+	var resources []*Resource
+	rdfpropLabel, ok := rdf.Labels[key]
+	if !ok {
+		return resources, fmt.Errorf("resolve by property: undefined property label '%s'", key)
+	}
+	for _, t := range snap.WithPredicate(rdfpropLabel) {
+		lit, ok := t.Object().Literal()
+		if !ok || !match(lit.Value()) {
+			continue
+		}
+		rt, err := resolveResourceType(snap, t.Subject())
+		if err != nil {
+			return resources, err
+		}
+		r := InitResource(rt, t.Subject())
+		if err := r.unmarshalFullRdf(snap); err != nil {
+			return resources, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// editDistance is the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
 type And struct {
 	Resolvers []Resolver
 }
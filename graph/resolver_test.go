@@ -1,6 +1,8 @@
 package graph_test
 
 import (
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/wallix/awless/graph"
@@ -43,3 +45,45 @@ func TestAndResolver(t *testing.T) {
 		t.Fatalf("got %d want %d", got, want)
 	}
 }
+
+func TestNameResolvers(t *testing.T) {
+	t.Parallel()
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Prop("Name", "Redis-Prod").Build(),
+		resourcetest.Instance("inst_2").Prop("Name", "redis-dev").Build(),
+		resourcetest.Instance("inst_3").Prop("Name", "mongo").Build(),
+		resourcetest.Subnet("sub_1").Prop("Name", "redis-prod").Build(),
+	)
+	ids := func(resources []*graph.Resource) (out []string) {
+		for _, r := range resources {
+			out = append(out, r.Id())
+		}
+		sort.Strings(out)
+		return
+	}
+
+	resources, err := g.ResolveResources(&graph.ByPropertyFold{Key: "Name", Value: "REDIS-PROD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ids(resources), []string{"inst_1", "sub_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+
+	resources, err = g.ResolveResources(&graph.ByClosestProperty{Key: "Name", Value: "redis-prd", MaxDistance: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ids(resources), []string{"inst_1", "sub_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+
+	resources, err = g.ResolveResources(&graph.ByClosestProperty{Key: "Name", Value: "postgres", MaxDistance: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(resources); len(got) != 0 {
+		t.Fatalf("got %v want none", got)
+	}
+}
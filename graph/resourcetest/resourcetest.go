@@ -45,10 +45,18 @@ func InternetGw(id string) *rBuilder {
 	return new("internetgateway", id).Prop(properties.ID, id)
 }
 
+func NetworkInterface(id string) *rBuilder {
+	return new("networkinterface", id).Prop(properties.ID, id)
+}
+
 func NatGw(id string) *rBuilder {
 	return new("natgateway", id).Prop(properties.ID, id)
 }
 
+func ElasticIP(id string) *rBuilder {
+	return new("elasticip", id).Prop(properties.ID, id)
+}
+
 func RouteTable(id string) *rBuilder {
 	return new("routetable", id).Prop(properties.ID, id)
 }
@@ -57,6 +65,38 @@ func LoadBalancer(id string) *rBuilder {
 	return new("loadbalancer", id).Prop(properties.ID, id)
 }
 
+func SpotRequest(id string) *rBuilder {
+	return new("spotrequest", id).Prop(properties.ID, id)
+}
+
+func ReservedInstance(id string) *rBuilder {
+	return new("reservedinstance", id).Prop(properties.ID, id)
+}
+
+func PlacementGroup(id string) *rBuilder {
+	return new("placementgroup", id).Prop(properties.ID, id)
+}
+
+func Host(id string) *rBuilder {
+	return new("host", id).Prop(properties.ID, id)
+}
+
+func VpcEndpoint(id string) *rBuilder {
+	return new("vpcendpoint", id).Prop(properties.ID, id)
+}
+
+func ClassicLoadBalancer(id string) *rBuilder {
+	return new("classicloadbalancer", id).Prop(properties.ID, id)
+}
+
+func CacheCluster(id string) *rBuilder {
+	return new("cachecluster", id).Prop(properties.ID, id)
+}
+
+func CacheSubnetGroup(id string) *rBuilder {
+	return new("cachesubnetgroup", id).Prop(properties.ID, id)
+}
+
 func AvailabilityZone(id string) *rBuilder {
 	return new("availabilityzone", id).Prop(properties.ID, id)
 }
@@ -77,6 +117,10 @@ func Role(id string) *rBuilder {
 	return new("role", id).Prop(properties.ID, id)
 }
 
+func InstanceProfile(id string) *rBuilder {
+	return new("instanceprofile", id).Prop(properties.ID, id)
+}
+
 func User(id string) *rBuilder {
 	return new("user", id).Prop(properties.ID, id)
 }
@@ -89,6 +133,10 @@ func Bucket(id string) *rBuilder {
 	return new("bucket", id).Prop(properties.ID, id)
 }
 
+func S3Object(id string) *rBuilder {
+	return new("s3object", id).Prop(properties.ID, id)
+}
+
 func Zone(id string) *rBuilder {
 	return new("zone", id).Prop(properties.ID, id)
 }
@@ -121,10 +169,18 @@ func Function(id string) *rBuilder {
 	return new("function", id).Prop(properties.ID, id)
 }
 
+func Table(id string) *rBuilder {
+	return new("table", id).Prop(properties.ID, id)
+}
+
 func Alarm(id string) *rBuilder {
 	return new("alarm", id).Prop(properties.ID, id)
 }
 
+func LogGroup(id string) *rBuilder {
+	return new("loggroup", id).Prop(properties.ID, id)
+}
+
 func Metric(id string) *rBuilder {
 	return new("metric", id).Prop(properties.ID, id)
 }
@@ -161,6 +217,46 @@ func ContainerInstance(id string) *rBuilder {
 	return new("containerinstance", id).Prop(properties.ID, id)
 }
 
+func WebACL(id string) *rBuilder {
+	return new("webacl", id).Prop(properties.ID, id)
+}
+
+func Certificate(id string) *rBuilder {
+	return new("certificate", id).Prop(properties.ID, id)
+}
+
+func BeanstalkApplication(id string) *rBuilder {
+	return new("beanstalkapplication", id).Prop(properties.ID, id)
+}
+
+func BeanstalkEnvironment(id string) *rBuilder {
+	return new("beanstalkenvironment", id).Prop(properties.ID, id)
+}
+
+func RestApi(id string) *rBuilder {
+	return new("restapi", id).Prop(properties.ID, id)
+}
+
+func ApiStage(id string) *rBuilder {
+	return new("apistage", id).Prop(properties.ID, id)
+}
+
+func Parameter(id string) *rBuilder {
+	return new("parameter", id).Prop(properties.ID, id)
+}
+
+func KMSKey(id string) *rBuilder {
+	return new("kmskey", id).Prop(properties.ID, id)
+}
+
+func Finding(id string) *rBuilder {
+	return new("finding", id).Prop(properties.ID, id)
+}
+
+func Secret(id string) *rBuilder {
+	return new("secret", id).Prop(properties.ID, id)
+}
+
 func (b *rBuilder) Prop(key string, value interface{}) *rBuilder {
 	b.props[key] = value
 	return b
@@ -0,0 +1,207 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// NewGraphFromFileForType loads from a graph file only the resources of the given
+// type and the resources they directly reference (ex: the subnet of an instance).
+// The file is decoded triple by triple and the triples out of this scope are dropped
+// as they are read, so that the full graph of a large account is never held in memory.
+// It takes three passes over the file: one finding the resources of the type, one
+// their references and one keeping the triples of both.
+// The relations pointing to the loaded resources from outside this scope (ex: the
+// parents of an instance) are not loaded: use NewGraphFromFile when they are needed
+func NewGraphFromFileForType(filepath, resType string) (*Graph, error) {
+	g := NewGraph()
+	typeURI := namespacedResourceType(resType)
+
+	version := 1
+	inScope := make(map[string]bool)
+	err := scanTriplesFile(filepath, func(t tstore.Triple) error {
+		if t.Subject() == formatSubject && t.Predicate() == formatPredicate {
+			v, err := tstore.ParseInteger(t.Object())
+			if err != nil {
+				return fmt.Errorf("graph format version: %s", err)
+			}
+			version = v
+			return nil
+		}
+		if t.Predicate() != rdf.RdfType {
+			return nil
+		}
+		if obj, ok := t.Object().Resource(); ok && obj == typeURI {
+			inScope[t.Subject()] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return g, err
+	}
+	if version > FormatVersion {
+		return g, &FormatError{Version: version}
+	}
+	if version < FormatVersion { // the migrations work on all the triples
+		f, err := os.Open(filepath)
+		if err != nil {
+			return g, err
+		}
+		defer f.Close()
+		ts, err := decodeTriples(f)
+		if err != nil {
+			return g, err
+		}
+		g.add(triplesInTypeScope(ts, resType)...)
+		return g, nil
+	}
+
+	referenced := make(map[string]bool)
+	err = scanTriplesFile(filepath, func(t tstore.Triple) error {
+		if !inScope[t.Subject()] || t.Predicate() == rdf.RdfType {
+			return nil
+		}
+		if obj, ok := t.Object().Resource(); ok && !inScope[obj] {
+			referenced[obj] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return g, err
+	}
+
+	err = scanTriplesFile(filepath, func(t tstore.Triple) error {
+		if inScope[t.Subject()] || referenced[t.Subject()] {
+			g.add(t)
+		}
+		return nil
+	})
+	return g, err
+}
+
+// scanTriplesFile decodes the triples of a binary graph file one at a time
+func scanTriplesFile(filepath string, fn func(tstore.Triple) error) error {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var encoded bytes.Buffer
+	for {
+		encoded.Reset()
+		if err := readEncodedTriple(r, &encoded); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("decoding %s: %s", filepath, err)
+		}
+		ts, err := tstore.NewBinaryDecoder(&encoded).Decode()
+		if err != nil {
+			return fmt.Errorf("decoding %s: %s", filepath, err)
+		}
+		for _, t := range ts {
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readEncodedTriple copies to w the bytes of the next triple of a binary encoded graph:
+// a subject and a predicate words, then an object type byte followed by a resource word
+// or by a literal type word and a literal value word. Words are prefixed by their length
+func readEncodedTriple(r io.Reader, w io.Writer) error {
+	copyWord := func() error {
+		var length uint32
+		if err := binary.Read(io.TeeReader(r, w), binary.BigEndian, &length); err != nil {
+			return err
+		}
+		if _, err := io.CopyN(w, r, int64(length)); err != nil {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}
+
+	if err := copyWord(); err != nil { // subject
+		return err
+	}
+	if err := copyWord(); err != nil { // predicate
+		return unexpectedEOF(err)
+	}
+	var objType uint8
+	if err := binary.Read(io.TeeReader(r, w), binary.BigEndian, &objType); err != nil {
+		return unexpectedEOF(err)
+	}
+	if err := copyWord(); err != nil { // resource or literal type
+		return unexpectedEOF(err)
+	}
+	if objType == binaryResourceObject {
+		return nil
+	}
+	return unexpectedEOF(copyWord()) // literal value
+}
+
+// binaryResourceObject is the object type byte of the triples whose object is a resource
+const binaryResourceObject = uint8(0)
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func triplesInTypeScope(ts []tstore.Triple, resType string) []tstore.Triple {
+	typeURI := namespacedResourceType(resType)
+	inScope := make(map[string]bool)
+	for _, t := range ts {
+		if t.Predicate() != rdf.RdfType {
+			continue
+		}
+		if obj, ok := t.Object().Resource(); ok && obj == typeURI {
+			inScope[t.Subject()] = true
+		}
+	}
+
+	referenced := make(map[string]bool)
+	for _, t := range ts {
+		if !inScope[t.Subject()] || t.Predicate() == rdf.RdfType {
+			continue
+		}
+		if obj, ok := t.Object().Resource(); ok && !inScope[obj] {
+			referenced[obj] = true
+		}
+	}
+
+	var scoped []tstore.Triple
+	for _, t := range ts {
+		if inScope[t.Subject()] || referenced[t.Subject()] {
+			scoped = append(scoped, t)
+		}
+	}
+	return scoped
+}
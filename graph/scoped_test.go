@@ -0,0 +1,159 @@
+package graph
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	tstore "github.com/wallix/triplestore"
+)
+
+func TestNewGraphFromFileForType(t *testing.T) {
+	newRes := func(typ, id string) *Resource {
+		r := InitResource(typ, id)
+		r.Properties[properties.ID] = id
+		r.Properties[properties.Name] = id + "_name"
+		return r
+	}
+	region, vpc1, vpc2, sub, inst := InitResource("region", "eu-west-1"), newRes("vpc", "vpc_1"), newRes("vpc", "vpc_2"), newRes("subnet", "sub_1"), newRes("instance", "inst_1")
+
+	full := NewGraph()
+	full.AddResource(region, vpc1, vpc2, sub, inst)
+	full.AddParentRelation(region, vpc1)
+	full.AddParentRelation(region, vpc2)
+	full.AddParentRelation(vpc1, sub)
+	full.AddParentRelation(sub, inst)
+
+	dir, err := ioutil.TempDir("", "awless-scoped-graph")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "infra.triples")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = full.MarshalTo(f); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	g, err := NewGraphFromFileForType(path, "vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vpcs, err := g.GetAllResources("vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(vpcs), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for _, vpc := range vpcs {
+		expected, _ := full.GetResource("vpc", vpc.Id())
+		if got, want := vpc.Properties, expected.Properties; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	subnets, err := g.GetAllResources("subnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(subnets), 1; got != want {
+		t.Fatalf("referenced subnets: got %d, want %d", got, want)
+	}
+	for _, typ := range []string{"instance", "region"} {
+		resources, err := g.GetAllResources(typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resources) != 0 {
+			t.Fatalf("expected no %s out of scope, got %v", typ, resources)
+		}
+	}
+
+	if _, err = NewGraphFromFileForType(filepath.Join(dir, "none.triples"), "vpc"); err == nil {
+		t.Fatal("expected error on missing file")
+	}
+}
+
+func TestNewGraphFromFileForTypeFormats(t *testing.T) {
+	vpc, sub, inst := InitResource("vpc", "vpc_1"), InitResource("subnet", "sub_1"), InitResource("instance", "inst_1")
+	vpc.Properties[properties.Name] = "my_vpc"
+	sub.Properties[properties.CIDR] = "10.0.0.0/24"
+	full := NewGraph()
+	full.AddResource(vpc, sub, inst)
+	full.AddParentRelation(vpc, sub)
+	full.AddParentRelation(sub, inst)
+
+	dir, err := ioutil.TempDir("", "awless-scoped-graph")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name string, ts ...tstore.Triple) string {
+		var buff bytes.Buffer
+		if err := tstore.NewBinaryEncoder(&buff).Encode(ts...); err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, buff.Bytes(), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	triples := full.store.CopyTriples()
+
+	for _, path := range []string{
+		write("current.triples", append([]tstore.Triple{formatHeader()}, triples...)...),
+		write("legacy.triples", triples...), // format 1 had no header
+	} {
+		g, err := NewGraphFromFileForType(path, "vpc")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(g.store.CopyTriples()), len(triplesInTypeScope(triples, "vpc")); got != want {
+			t.Fatalf("%s: got %d triples, want %d", path, got, want)
+		}
+		loaded, err := g.GetResource("vpc", "vpc_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := loaded.Properties[properties.Name], "my_vpc"; got != want {
+			t.Fatalf("%s: got %v, want %v", path, got, want)
+		}
+		loaded, err = g.GetResource("subnet", "sub_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := loaded.Properties[properties.CIDR], "10.0.0.0/24"; got != want {
+			t.Fatalf("%s: referenced subnet: got %v, want %v", path, got, want)
+		}
+	}
+
+	newer := write("newer.triples", append([]tstore.Triple{tstore.SubjPred(formatSubject, formatPredicate).IntegerLiteral(FormatVersion + 1)}, triples...)...)
+	if _, err = NewGraphFromFileForType(newer, "vpc"); err == nil {
+		t.Fatal("expected error on newer format")
+	} else if _, ok := err.(*FormatError); !ok {
+		t.Fatalf("got %v, want format error", err)
+	}
+
+	current, err := ioutil.ReadFile(filepath.Join(dir, "current.triples"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	truncated := filepath.Join(dir, "truncated.triples")
+	if err = ioutil.WriteFile(truncated, current[:len(current)-3], 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewGraphFromFileForType(truncated, "vpc"); err == nil {
+		t.Fatal("expected error on truncated file")
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+var turtleLiteralEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// MarshalTurtle encodes in RDF Turtle the given resources with their relations,
+// or the whole graph when no resource is given. Namespaces are the ones of rdf.NamespaceIRIs
+func (g *Graph) MarshalTurtle(w io.Writer, resources ...*Resource) error {
+	var triples []tstore.Triple
+	if len(resources) == 0 {
+		triples = g.store.CopyTriples()
+	} else {
+		snap := g.store.Snapshot()
+		done := make(map[string]bool)
+		var addSubject func(string)
+		addSubject = func(id string) {
+			if done[id] {
+				return
+			}
+			done[id] = true
+			for _, t := range snap.WithSubject(id) {
+				triples = append(triples, t)
+				if t.Predicate() == rdf.RdfType || t.Predicate() == rdf.ParentOf || t.Predicate() == rdf.ApplyOn {
+					continue
+				}
+				if obj, ok := t.Object().Resource(); ok && isNestedNode(snap, obj) {
+					addSubject(obj)
+				}
+			}
+		}
+		for _, res := range resources {
+			addSubject(res.Id())
+			triples = append(triples, snap.WithPredObj(rdf.ParentOf, tstore.Resource(res.Id()))...)
+			triples = append(triples, snap.WithPredObj(rdf.ApplyOn, tstore.Resource(res.Id()))...)
+		}
+	}
+
+	return encodeTurtle(w, triples)
+}
+
+// isNestedNode returns true for the nodes describing the properties
+// of a resource such as firewall rules or routes
+func isNestedNode(snap tstore.RDFGraph, id string) bool {
+	for _, t := range snap.WithSubjPred(id, rdf.RdfType) {
+		if typ, ok := t.Object().Resource(); ok {
+			switch typ {
+			case rdf.NetFirewallRule, rdf.NetRoute, rdf.Grant, rdf.KeyValue, rdf.DistributionOrigin:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func encodeTurtle(w io.Writer, triples []tstore.Triple) error {
+	bySubject := make(map[string][]tstore.Triple)
+	for _, t := range triples {
+		if t.Predicate() == MetaPredicate {
+			continue
+		}
+		bySubject[t.Subject()] = append(bySubject[t.Subject()], t)
+	}
+
+	var subjects []string
+	for s := range bySubject {
+		subjects = append(subjects, s)
+	}
+	sort.Strings(subjects)
+
+	var prefixes []string
+	for p := range rdf.NamespaceIRIs {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	buff := bufio.NewWriter(w)
+	for _, p := range prefixes {
+		fmt.Fprintf(buff, "@prefix %s: <%s> .\n", p, rdf.NamespaceIRIs[p])
+	}
+
+	for _, s := range subjects {
+		ts := bySubject[s]
+		unique := make(map[string]bool)
+		var lines []string
+		for _, t := range ts {
+			line := fmt.Sprintf("%s %s", turtlePredicate(t.Predicate()), turtleObject(t.Object()))
+			if !unique[line] {
+				unique[line] = true
+				lines = append(lines, line)
+			}
+		}
+		sort.Slice(lines, func(i, j int) bool {
+			if isType, isOtherType := strings.HasPrefix(lines[i], "a "), strings.HasPrefix(lines[j], "a "); isType != isOtherType {
+				return isType
+			}
+			return lines[i] < lines[j]
+		})
+		fmt.Fprintf(buff, "\n%s\n    %s .\n", turtleIRI(s), strings.Join(lines, " ;\n    "))
+	}
+
+	return buff.Flush()
+}
+
+func turtlePredicate(pred string) string {
+	if pred == rdf.RdfType {
+		return "a"
+	}
+	return turtleIRI(pred)
+}
+
+func turtleObject(o tstore.Object) string {
+	if lit, ok := o.Literal(); ok {
+		quoted := fmt.Sprintf(`"%s"`, turtleLiteralEscaper.Replace(lit.Value()))
+		if lit.Type() == tstore.XsdString {
+			return quoted
+		}
+		return fmt.Sprintf("%s^^%s", quoted, lit.Type())
+	}
+	id, _ := o.Resource()
+	return turtleIRI(id)
+}
+
+// turtleIRI keeps terms of known namespaces as prefixed names
+// and builds the IRIs of resources from their ids
+func turtleIRI(id string) string {
+	if splits := strings.SplitN(id, ":", 2); len(splits) == 2 {
+		if _, ok := rdf.NamespaceIRIs[splits[0]]; ok && isTurtleLocalName(splits[1]) {
+			return id
+		}
+	}
+	return fmt.Sprintf("<%s%s>", rdf.ResourcesIRI, url.PathEscape(id))
+}
+
+func isTurtleLocalName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r == '_' || r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
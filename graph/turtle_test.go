@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+)
+
+func TestMarshalTurtle(t *testing.T) {
+	g := NewGraph()
+	inst := InitResource("instance", "inst_1")
+	inst.Properties[properties.Name] = "my \"web\" server"
+	inst.Properties[properties.Launched] = time.Date(2017, 3, 10, 15, 4, 5, 0, time.UTC)
+	sub := InitResource("subnet", "subnet_1")
+	sub.Properties[properties.Default] = true
+	sg := InitResource("securitygroup", "sg-1")
+	other := InitResource("instance", "arn:aws:inst/2")
+	g.AddResource(inst, sub, sg, other)
+	g.AddParentRelation(sub, inst)
+	g.AddParentRelation(sub, other)
+	g.AddAppliesOnRelation(sg, inst)
+
+	var w bytes.Buffer
+	if err := g.MarshalTurtle(&w, inst); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	for _, exp := range []string{
+		"@prefix cloud: <http://awless.io/ontology/cloud#> .\n",
+		"@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .\n",
+		"\n<http://awless.io/resources/inst_1>\n    a cloud-owl:Instance ;\n    cloud:launched \"2017-03-10T15:04:05Z\"^^xsd:dateTime ;\n    cloud:name \"my \\\"web\\\" server\" .\n",
+		"\n<http://awless.io/resources/sg-1>\n    cloud-rel:applyOn <http://awless.io/resources/inst_1> .\n",
+		"\n<http://awless.io/resources/subnet_1>\n    cloud-rel:parentOf <http://awless.io/resources/inst_1> .\n",
+	} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("expected %q in\n%s", exp, out)
+		}
+	}
+	if strings.Contains(out, "inst%2F2") || strings.Contains(out, "cloud:default") {
+		t.Fatalf("unexpected resources in\n%s", out)
+	}
+
+	w.Reset()
+	if err := g.MarshalTurtle(&w); err != nil {
+		t.Fatal(err)
+	}
+	out = w.String()
+	for _, exp := range []string{
+		"\n<http://awless.io/resources/arn:aws:inst%2F2>\n    a cloud-owl:Instance .\n",
+		"    cloud:default \"true\"^^xsd:boolean",
+		"    cloud-rel:parentOf <http://awless.io/resources/arn:aws:inst%2F2> ;\n",
+	} {
+		if !strings.Contains(out, exp) {
+			t.Fatalf("expected %q in\n%s", exp, out)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/inspect/inspectors"
+)
+
+// AccountReport is the outcome of an inspector on the graph of an account
+type AccountReport struct {
+	Account  string
+	Findings []*inspectors.Finding
+	Critical int
+	// Output is what the inspector printed for the account
+	Output []byte
+	Err    error
+}
+
+// AccountsReport is the outcome of an inspector on several accounts
+type AccountsReport struct {
+	inspector Inspector
+	Accounts  []*AccountReport
+}
+
+// InspectAccounts runs the inspector on the graph of each account in turn, the findings
+// carrying the account. An account whose graph cannot be loaded or inspected is
+// reported with its error and does not stop the inspection of the others
+func InspectAccounts(inspector Inspector, accounts []string, loadGraph func(account string) (*graph.Graph, error)) *AccountsReport {
+	report := &AccountsReport{inspector: inspector}
+	for _, account := range accounts {
+		res := &AccountReport{Account: account}
+		report.Accounts = append(report.Accounts, res)
+
+		g, err := loadGraph(account)
+		if err != nil {
+			res.Err = fmt.Errorf("loading graphs: %s", err)
+			continue
+		}
+		if res.Err = inspector.Inspect(g); res.Err != nil {
+			continue
+		}
+
+		var out bytes.Buffer
+		inspector.Print(&out)
+		res.Output = out.Bytes()
+		if reporter, ok := inspector.(FindingsReporter); ok {
+			res.Findings = []*inspectors.Finding{}
+			for _, f := range reporter.Findings() {
+				withAccount := *f
+				withAccount.Account = account
+				res.Findings = append(res.Findings, &withAccount)
+			}
+		}
+		if reporter, ok := inspector.(CriticalReporter); ok {
+			res.Critical = reporter.CriticalCount()
+		}
+	}
+	return report
+}
+
+// CriticalCount returns the number of critical findings of all the accounts
+func (r *AccountsReport) CriticalCount() (count int) {
+	for _, res := range r.Accounts {
+		count += res.Critical
+	}
+	return
+}
+
+// Failed returns the accounts that could not be inspected
+func (r *AccountsReport) Failed() (accounts []string) {
+	for _, res := range r.Accounts {
+		if res.Err != nil {
+			accounts = append(accounts, res.Account)
+		}
+	}
+	return
+}
+
+// Print displays the output of the inspector for each account
+func (r *AccountsReport) Print(w io.Writer) {
+	for _, res := range r.Accounts {
+		fmt.Fprintf(w, "▶ account %s\n", res.Account)
+		if res.Err != nil {
+			fmt.Fprintf(w, "inspection failed: %s\n\n", res.Err)
+			continue
+		}
+		w.Write(res.Output)
+		fmt.Fprintln(w)
+	}
+}
+
+// WriteJSON writes the findings of the inspector keyed by account
+func (r *AccountsReport) WriteJSON(w io.Writer) error {
+	if _, err := getFindings(r.inspector); err != nil {
+		return err
+	}
+	type accountFindings struct {
+		Findings []*inspectors.Finding `json:"findings"`
+		Error    string                `json:"error,omitempty"`
+	}
+	report := struct {
+		Inspector string                      `json:"inspector"`
+		Accounts  map[string]*accountFindings `json:"accounts"`
+	}{r.inspector.Name(), make(map[string]*accountFindings)}
+	for _, res := range r.Accounts {
+		findings := &accountFindings{Findings: res.Findings}
+		if findings.Findings == nil {
+			findings.Findings = []*inspectors.Finding{}
+		}
+		if res.Err != nil {
+			findings.Error = res.Err.Error()
+		}
+		report.Accounts[res.Account] = findings
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(report)
+}
+
+// WriteSARIF writes the findings of the inspector on all the accounts as a single SARIF log
+func (r *AccountsReport) WriteSARIF(w io.Writer, version string) error {
+	if _, err := getFindings(r.inspector); err != nil {
+		return err
+	}
+	var all []*inspectors.Finding
+	for _, res := range r.Accounts {
+		all = append(all, res.Findings...)
+	}
+	return writeSARIF(w, r.inspector.Name(), version, all)
+}
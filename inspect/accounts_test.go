@@ -0,0 +1,125 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/inspect/inspectors"
+)
+
+func TestInspectAccounts(t *testing.T) {
+	inspector := &findingsInspector{findings: []*inspectors.Finding{
+		{RuleID: "fake.open", Severity: inspectors.SeverityHigh, ResourceID: "sg-1", ResourceType: "securitygroup", Message: "sg-1 is open"},
+	}}
+	loadGraph := func(account string) (*graph.Graph, error) {
+		if account == "333333333333" {
+			return nil, errors.New("no graph")
+		}
+		return graph.NewGraph(), nil
+	}
+
+	report := InspectAccounts(inspector, []string{"111111111111", "222222222222", "333333333333"}, loadGraph)
+
+	if got, want := report.Failed(), []string{"333333333333"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := report.Accounts[1].Findings[0].Account, "222222222222"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got := inspector.findings[0].Account; got != "" {
+		t.Fatalf("inspector finding modified with account %s", got)
+	}
+
+	t.Run("print", func(t *testing.T) {
+		var buff bytes.Buffer
+		report.Print(&buff)
+		if got, want := buff.String(), "inspection failed: loading graphs: no graph"; !strings.Contains(got, want) {
+			t.Fatalf("got %s, want it to contain %s", got, want)
+		}
+		if got, want := strings.Count(buff.String(), "▶ account"), 3; got != want {
+			t.Fatalf("got %d accounts, want %d", got, want)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buff bytes.Buffer
+		if err := report.WriteJSON(&buff); err != nil {
+			t.Fatal(err)
+		}
+		var decoded struct {
+			Inspector string
+			Accounts  map[string]struct {
+				Findings []*inspectors.Finding
+				Error    string
+			}
+		}
+		if err := json.Unmarshal(buff.Bytes(), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(decoded.Accounts), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := decoded.Accounts["111111111111"].Findings[0].Account, "111111111111"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := decoded.Accounts["333333333333"].Error, "loading graphs: no graph"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		var buff bytes.Buffer
+		if err := report.WriteSARIF(&buff, "v1.0.0"); err != nil {
+			t.Fatal(err)
+		}
+		var log sarifLog
+		if err := json.Unmarshal(buff.Bytes(), &log); err != nil {
+			t.Fatal(err)
+		}
+		var locations []string
+		for _, r := range log.Runs[0].Results {
+			locations = append(locations, r.Locations[0].LogicalLocations[0].FullyQualifiedName)
+		}
+		if got, want := strings.Join(locations, ","), "111111111111/securitygroup/sg-1,222222222222/securitygroup/sg-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := log.Runs[0].Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI, "222222222222/securitygroup/sg-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := log.Runs[0].Results[1].Properties["account"], "222222222222"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
+func TestInspectAccountsWithStatefulInspector(t *testing.T) {
+	graphs := map[string]*graph.Graph{"111111111111": graph.NewGraph(), "222222222222": graph.NewGraph()}
+	graphs["111111111111"].AddResource(
+		resourcetest.S3Object("obj_1").Prop(properties.Bucket, "bucket_1").Prop(properties.Size, 3000000000).Build(),
+	)
+	graphs["222222222222"].AddResource(
+		resourcetest.S3Object("obj_2").Prop(properties.Bucket, "bucket_2").Prop(properties.Size, 1000000000).Build(),
+	)
+	loadGraph := func(account string) (*graph.Graph, error) {
+		return graphs[account], nil
+	}
+
+	report := InspectAccounts(&inspectors.BucketSizer{}, []string{"111111111111", "222222222222"}, loadGraph)
+
+	for i, want := range []string{"3.000000 Gb", "1.000000 Gb"} {
+		res := report.Accounts[i]
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if got := string(res.Output); strings.Count(got, want) != 2 {
+			t.Fatalf("%s: got %s, want bucket and total of %s", res.Account, got, want)
+		}
+	}
+}
@@ -29,6 +29,9 @@ func init() {
 	all := []Inspector{
 		&inspectors.Pricer{}, &inspectors.BucketSizer{},
 		&inspectors.PortScanner{}, &inspectors.OpenBuckets{},
+		&inspectors.DeprecatedTypes{}, &inspectors.UnusedResources{},
+		&inspectors.CertExpiry{}, &inspectors.TagPolicy{},
+		&inspectors.BucketCompliance{},
 	}
 
 	InspectorsRegister = make(map[string]Inspector)
@@ -43,3 +46,9 @@ type Inspector interface {
 	Inspect(*graph.Graph) error
 	Print(io.Writer)
 }
+
+// CriticalReporter is implemented by inspectors whose findings
+// can be critical enough to make the inspection fail
+type CriticalReporter interface {
+	CriticalCount() int
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+)
+
+type BucketCompliance struct {
+	// Rules are the severity ('high' or 'low') of each control required on buckets.
+	// When empty, the rules configured under 'inspect.bucketcompliance.<profile>'
+	// for the current profile are used, defaulting to private, versioning, encryption
+	// and publicaccessblock as high
+	Rules map[string]string
+
+	findings        []*bucketFinding
+	missingSettings bool
+}
+
+type bucketFinding struct {
+	bucket   string
+	missing  []string
+	severity string
+}
+
+func (*BucketCompliance) Name() string {
+	return "bucketcompliance"
+}
+
+func (c *BucketCompliance) Inspect(g *graph.Graph) error {
+	if len(c.Rules) == 0 {
+		c.Rules = config.GetBucketCompliance(config.GetAWSProfile())
+	}
+	if len(c.Rules) == 0 {
+		c.Rules = map[string]string{"private": "high", "versioning": "high", "encryption": "high", "publicaccessblock": "high"}
+	}
+
+	buckets, err := g.GetAllResources(cloud.Bucket)
+	if err != nil {
+		return err
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Id() < buckets[j].Id() })
+
+	c.findings, c.missingSettings = nil, false
+	for _, buck := range buckets {
+		_, hasSettings := buck.Properties[properties.Versioning]
+		finding := &bucketFinding{bucket: buck.Id(), severity: "low"}
+		for _, control := range config.BucketControls {
+			severity, ok := c.Rules[control]
+			if !ok {
+				continue
+			}
+			var compliant bool
+			switch control {
+			case "private":
+				compliant = !isPublicBucket(buck)
+			case "versioning":
+				if !hasSettings {
+					c.missingSettings = true
+					continue
+				}
+				compliant = fmt.Sprint(buck.Properties[properties.Versioning]) == "Enabled"
+			case "lifecycle":
+				if !hasSettings {
+					c.missingSettings = true
+					continue
+				}
+				compliant = hasEnabledLifecycleRule(buck)
+			case "encryption":
+				encryption, ok := buck.Properties[properties.Encryption]
+				if !ok {
+					c.missingSettings = true
+					continue
+				}
+				compliant = fmt.Sprint(encryption) != "Disabled"
+			case "publicaccessblock":
+				settings, ok := buck.Properties[properties.PublicAccessBlock]
+				if !ok {
+					c.missingSettings = true
+					continue
+				}
+				compliant = blocksAllPublicAccess(fmt.Sprint(settings))
+			}
+			if !compliant {
+				finding.missing = append(finding.missing, control)
+				if severity == "high" {
+					finding.severity = "high"
+				}
+			}
+		}
+		if len(finding.missing) > 0 {
+			c.findings = append(c.findings, finding)
+		}
+	}
+
+	return nil
+}
+
+// CriticalCount returns the number of buckets missing a high severity control
+func (c *BucketCompliance) CriticalCount() int {
+	var count int
+	for _, f := range c.findings {
+		if f.severity == "high" {
+			count++
+		}
+	}
+	return count
+}
+
+// Findings returns a finding per control missing on a bucket, with the severity of the control
+func (c *BucketCompliance) Findings() (findings []*Finding) {
+	for _, f := range c.findings {
+		for _, control := range f.missing {
+			findings = append(findings, &Finding{
+				RuleID:       c.Name() + "." + control,
+				Severity:     c.Rules[control],
+				ResourceID:   f.bucket,
+				ResourceType: cloud.Bucket,
+				Message:      fmt.Sprintf("bucket %s is missing the required control '%s'", f.bucket, control),
+			})
+		}
+	}
+	return
+}
+
+func (c *BucketCompliance) Print(w io.Writer) {
+	if c.missingSettings {
+		fmt.Fprintln(w, "versioning, lifecycle, encryption and public access block not checked on buckets synced without their settings: enable them with `awless config set aws.storage.bucket.settings true` then `awless sync`")
+	}
+	if len(c.findings) == 0 {
+		fmt.Fprintln(w, "all buckets have their required controls")
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Bucket\tMissing controls\tSeverity\t")
+	fmt.Fprintln(tabw, "------\t----------------\t--------\t")
+
+	for _, f := range c.findings {
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t\n", f.bucket, strings.Join(f.missing, ", "), f.severity)
+	}
+
+	tabw.Flush()
+
+	fmt.Fprintf(w, "\n%d bucket(s) missing required controls, %d of high severity\n", len(c.findings), c.CriticalCount())
+}
+
+func isPublicBucket(buck *graph.Resource) bool {
+	grants, _ := buck.Properties[properties.Grants].([]*graph.Grant)
+	for _, g := range grants {
+		if strings.Contains(g.Grantee.GranteeID, "AllUsers") || strings.Contains(g.Grantee.GranteeID, "AuthenticatedUsers") {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEnabledLifecycleRule(buck *graph.Resource) bool {
+	rules, _ := buck.Properties[properties.LifecycleRules].([]string)
+	for _, rule := range rules {
+		for _, token := range strings.Fields(rule) {
+			if token == "Enabled" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func blocksAllPublicAccess(settings string) bool {
+	enabled := make(map[string]bool)
+	for _, s := range strings.Split(settings, ",") {
+		enabled[s] = true
+	}
+	return enabled["BlockPublicAcls"] && enabled["IgnorePublicAcls"] && enabled["BlockPublicPolicy"] && enabled["RestrictPublicBuckets"]
+}
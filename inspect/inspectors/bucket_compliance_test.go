@@ -0,0 +1,45 @@
+package inspectors
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestBucketCompliance(t *testing.T) {
+	allBlocked := "BlockPublicAcls,IgnorePublicAcls,BlockPublicPolicy,RestrictPublicBuckets"
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Bucket("compliant").Prop(properties.Versioning, "Enabled").Prop(properties.Encryption, "AES256").Prop(properties.PublicAccessBlock, allBlocked).Build(),
+		resourcetest.Bucket("unencrypted").Prop(properties.Versioning, "Enabled").Prop(properties.Encryption, "Disabled").Prop(properties.PublicAccessBlock, allBlocked).Build(),
+		resourcetest.Bucket("partially_blocked").Prop(properties.Versioning, "Enabled").Prop(properties.Encryption, "aws:kms").Prop(properties.PublicAccessBlock, "BlockPublicAcls,BlockPublicPolicy").Build(),
+		resourcetest.Bucket("unversioned").Prop(properties.Versioning, "Suspended").Prop(properties.Encryption, "Disabled").Prop(properties.PublicAccessBlock, "Disabled").Build(),
+		resourcetest.Bucket("no_settings").Build(),
+	)
+
+	inspector := &BucketCompliance{Rules: map[string]string{"versioning": "high", "encryption": "high", "publicaccessblock": "low"}}
+	if err := inspector.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+	missing := make(map[string][]string)
+	for _, f := range inspector.findings {
+		missing[f.bucket] = f.missing
+	}
+	expected := map[string][]string{
+		"unencrypted":       {"encryption"},
+		"partially_blocked": {"publicaccessblock"},
+		"unversioned":       {"versioning", "encryption", "publicaccessblock"},
+	}
+	if got, want := missing, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := inspector.CriticalCount(), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := inspector.missingSettings, true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+}
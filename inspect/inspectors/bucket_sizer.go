@@ -39,7 +39,7 @@ func (*BucketSizer) Name() string {
 }
 
 func (i *BucketSizer) Inspect(g *graph.Graph) error {
-	i.buckets = make(map[string]*bucket)
+	i.buckets, i.total = make(map[string]*bucket), 0
 
 	objects, err := g.GetAllResources(cloud.S3Object)
 	if err != nil {
@@ -0,0 +1,157 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+type CertExpiry struct {
+	// Within is the window in which expiring certificates are reported (0 disables the report)
+	Within time.Duration
+	// Critical is the window in which expiring certificates are reported as critical (0 disables it)
+	Critical time.Duration
+
+	now      time.Time
+	expiring []*expiringCert
+}
+
+type expiringCert struct {
+	cert       *graph.Resource
+	expires    time.Time
+	dependents []*graph.Resource
+}
+
+func (*CertExpiry) Name() string {
+	return "certexpiry"
+}
+
+func (c *CertExpiry) Inspect(g *graph.Graph) error {
+	c.now = time.Now()
+	c.expiring = nil
+	if c.Within == 0 {
+		return nil
+	}
+
+	certs, err := g.GetAllResources(cloud.Certificate)
+	if err != nil {
+		return err
+	}
+
+	for _, cert := range certs {
+		expires, ok := cert.Properties[properties.Expires].(time.Time)
+		if !ok || expires.After(c.now.Add(c.Within)) {
+			continue
+		}
+		dependents, err := g.ListResourcesAppliedOn(cert)
+		if err != nil {
+			return err
+		}
+		sort.Slice(dependents, func(i, j int) bool { return dependents[i].Id() < dependents[j].Id() })
+		c.expiring = append(c.expiring, &expiringCert{cert: cert, expires: expires, dependents: dependents})
+	}
+
+	sort.Slice(c.expiring, func(i, j int) bool {
+		if c.expiring[i].expires.Equal(c.expiring[j].expires) {
+			return c.expiring[i].cert.Id() < c.expiring[j].cert.Id()
+		}
+		return c.expiring[i].expires.Before(c.expiring[j].expires)
+	})
+
+	return nil
+}
+
+// CriticalCount returns the number of certificates expiring within the critical window
+func (c *CertExpiry) CriticalCount() (count int) {
+	for _, e := range c.expiring {
+		if c.isCritical(e) {
+			count++
+		}
+	}
+	return
+}
+
+func (c *CertExpiry) isCritical(e *expiringCert) bool {
+	return c.Critical > 0 && e.expires.Before(c.now.Add(c.Critical))
+}
+
+// Findings returns a finding per expiring certificate, of high severity within the critical window
+func (c *CertExpiry) Findings() (findings []*Finding) {
+	for _, e := range c.expiring {
+		severity := SeverityMedium
+		if c.isCritical(e) {
+			severity = SeverityHigh
+		}
+		msg := fmt.Sprintf("certificate %s expires on %s", e.cert.Id(), e.expires.UTC().Format(time.RFC3339))
+		if len(e.dependents) > 0 {
+			var dependents []string
+			for _, d := range e.dependents {
+				dependents = append(dependents, fmt.Sprintf("%s[%s]", d.Type(), d.Id()))
+			}
+			msg += fmt.Sprintf(", used by %s", strings.Join(dependents, ", "))
+		}
+		findings = append(findings, &Finding{RuleID: c.Name(), Severity: severity, ResourceID: e.cert.Id(), ResourceType: cloud.Certificate, Message: msg})
+	}
+	return
+}
+
+func (c *CertExpiry) Print(w io.Writer) {
+	if len(c.expiring) == 0 {
+		fmt.Fprintf(w, "no certificate expiring within %s\n", humanizeDays(c.Within))
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Certificate\tName\tExpires\tIn\tCritical\tUsed by\t")
+	fmt.Fprintln(tabw, "-----------\t----\t-------\t--\t--------\t-------\t")
+
+	for _, e := range c.expiring {
+		var critical string
+		if c.isCritical(e) {
+			critical = "yes"
+		}
+		var dependents []string
+		for _, d := range e.dependents {
+			dependents = append(dependents, fmt.Sprintf("%s[%s]", d.Type(), d.Id()))
+		}
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t%s\t%s\t%s\t\n", e.cert.Id(), valueOrEmpty(e.cert, properties.Name), e.expires.Format("Mon, Jan 2, 2006 15:04"), humanizeDays(e.expires.Sub(c.now)), critical, strings.Join(dependents, ", "))
+	}
+
+	tabw.Flush()
+
+	fmt.Fprintf(w, "\n%d certificate(s) expiring within %s, %d of them within %s (critical)\n", len(c.expiring), humanizeDays(c.Within), c.CriticalCount(), humanizeDays(c.Critical))
+}
+
+func humanizeDays(d time.Duration) string {
+	if d < 0 {
+		return "expired"
+	}
+	if days := int(d.Hours() / 24); days > 0 {
+		return fmt.Sprintf("%dd", days)
+	}
+	return (d - d%time.Minute).String()
+}
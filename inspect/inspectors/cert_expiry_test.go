@@ -0,0 +1,59 @@
+package inspectors
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestCertExpiry(t *testing.T) {
+	now := time.Now()
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Certificate("expired").Prop(properties.Expires, now.Add(-time.Hour)).Build(),
+		resourcetest.Certificate("inside_critical").Prop(properties.Expires, now.Add(7*24*time.Hour-time.Minute)).Build(),
+		resourcetest.Certificate("outside_critical").Prop(properties.Expires, now.Add(7*24*time.Hour+time.Minute)).Build(),
+		resourcetest.Certificate("inside_window").Prop(properties.Expires, now.Add(30*24*time.Hour-time.Minute)).Build(),
+		resourcetest.Certificate("outside_window").Prop(properties.Expires, now.Add(30*24*time.Hour+time.Minute)).Build(),
+		resourcetest.Certificate("no_expiry").Build(),
+	)
+
+	tcases := []struct {
+		within, critical time.Duration
+		expExpiring      []string
+		expCritical      int
+	}{
+		{
+			within: 30 * 24 * time.Hour, critical: 7 * 24 * time.Hour,
+			expExpiring: []string{"expired", "inside_critical", "outside_critical", "inside_window"}, expCritical: 2,
+		},
+		{
+			within: 30 * 24 * time.Hour, critical: 0,
+			expExpiring: []string{"expired", "inside_critical", "outside_critical", "inside_window"}, expCritical: 0,
+		},
+		{within: 0, critical: 7 * 24 * time.Hour, expExpiring: nil, expCritical: 0},
+	}
+	for i, tcase := range tcases {
+		inspector := &CertExpiry{Within: tcase.within, Critical: tcase.critical}
+		if err := inspector.Inspect(g); err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, e := range inspector.expiring {
+			ids = append(ids, e.cert.Id())
+		}
+		if got, want := ids, tcase.expExpiring; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := inspector.CriticalCount(), tcase.expCritical; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+		if got, want := len(inspector.Findings()), len(tcase.expExpiring); got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
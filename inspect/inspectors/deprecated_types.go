@@ -0,0 +1,106 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+)
+
+type DeprecatedTypes struct {
+	instances []*graph.Resource
+}
+
+func (*DeprecatedTypes) Name() string {
+	return "deprecated_types"
+}
+
+func (d *DeprecatedTypes) Inspect(g *graph.Graph) error {
+	instances, err := g.GetAllResources(cloud.Instance)
+	if err != nil {
+		return err
+	}
+
+	deprecated := make(map[string]bool)
+	for _, t := range config.GetDeprecatedInstanceTypes() {
+		deprecated[t] = true
+	}
+
+	d.instances = nil
+	for _, inst := range instances {
+		if deprecated[fmt.Sprint(inst.Properties[properties.Type])] {
+			d.instances = append(d.instances, inst)
+		}
+	}
+
+	sort.Slice(d.instances, func(i, j int) bool {
+		ti, tj := fmt.Sprint(d.instances[i].Properties[properties.Type]), fmt.Sprint(d.instances[j].Properties[properties.Type])
+		if ti == tj {
+			return d.instances[i].Id() < d.instances[j].Id()
+		}
+		return ti < tj
+	})
+
+	return nil
+}
+
+func (d *DeprecatedTypes) Findings() (findings []*Finding) {
+	for _, inst := range d.instances {
+		findings = append(findings, &Finding{
+			RuleID:       d.Name(),
+			Severity:     SeverityLow,
+			ResourceID:   inst.Id(),
+			ResourceType: cloud.Instance,
+			Message:      fmt.Sprintf("instance %s runs on the deprecated type %s", inst.Id(), valueOrEmpty(inst, properties.Type)),
+		})
+	}
+	return
+}
+
+func (d *DeprecatedTypes) Print(w io.Writer) {
+	if len(d.instances) == 0 {
+		fmt.Fprintln(w, "none found")
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Instance\tName\tType\tState\t")
+	fmt.Fprintln(tabw, "--------\t----\t----\t-----\t")
+
+	for _, inst := range d.instances {
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t%s\t\n", inst.Id(), valueOrEmpty(inst, properties.Name), valueOrEmpty(inst, properties.Type), valueOrEmpty(inst, properties.State))
+	}
+
+	tabw.Flush()
+
+	fmt.Fprintf(w, "\n%d instance(s) on deprecated instance types (set your own list with `awless config set aws.infra.deprecatedtypes`)\n", len(d.instances))
+}
+
+func valueOrEmpty(res *graph.Resource, prop string) string {
+	if v, ok := res.Properties[prop]; ok {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+// Severities of the findings reported by inspectors
+const (
+	SeverityHigh   = "high"
+	SeverityMedium = "medium"
+	SeverityLow    = "low"
+)
+
+// Finding is an issue an inspector reports on a resource
+type Finding struct {
+	RuleID       string `json:"ruleId"`
+	Severity     string `json:"severity"`
+	ResourceID   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	Message      string `json:"message"`
+	// Account of the resource, set when inspecting several accounts
+	Account string `json:"account,omitempty"`
+}
@@ -19,6 +19,7 @@ package inspectors
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/wallix/awless/cloud"
@@ -40,6 +41,7 @@ func (a *OpenBuckets) Inspect(g *graph.Graph) error {
 		return err
 	}
 
+	a.openToAny, a.openToAnyAuth = nil, nil
 	openToAuthUsers := make(map[string]bool)
 	openToUsers := make(map[string]bool)
 
@@ -68,6 +70,22 @@ func (a *OpenBuckets) Inspect(g *graph.Graph) error {
 	return nil
 }
 
+// Findings returns a high severity finding per bucket open to anybody,
+// and a medium one per bucket open to anyone with an AWS account
+func (a *OpenBuckets) Findings() (findings []*Finding) {
+	anybody := append([]string{}, a.openToAny...)
+	sort.Strings(anybody)
+	for _, b := range anybody {
+		findings = append(findings, &Finding{RuleID: a.Name() + ".anybody", Severity: SeverityHigh, ResourceID: b, ResourceType: cloud.Bucket, Message: fmt.Sprintf("bucket %s is open to anybody", b)})
+	}
+	authenticated := append([]string{}, a.openToAnyAuth...)
+	sort.Strings(authenticated)
+	for _, b := range authenticated {
+		findings = append(findings, &Finding{RuleID: a.Name() + ".authenticated", Severity: SeverityMedium, ResourceID: b, ResourceType: cloud.Bucket, Message: fmt.Sprintf("bucket %s is open to anyone with an AWS account", b)})
+	}
+	return
+}
+
 func (a *OpenBuckets) Print(w io.Writer) {
 	if len(a.openToAny) > 0 {
 		fmt.Fprintf(w, "Buckets open to anybody: %s\n", strings.Join(a.openToAny, ", "))
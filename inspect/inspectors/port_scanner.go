@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/wallix/awless/cloud"
@@ -64,6 +65,50 @@ func (p *PortScanner) Inspect(g *graph.Graph) error {
 
 var allLocalIPs = net.ParseIP("0.0.0.0")
 
+// Findings returns a finding per inbound rule opening a security group to all IPs,
+// of high severity when opening all ports via any protocol
+func (p *PortScanner) Findings() (findings []*Finding) {
+	var sgs []string
+	for sg := range p.inbounds {
+		sgs = append(sgs, sg)
+	}
+	sort.Strings(sgs)
+	for _, sg := range sgs {
+		targets := "nothing"
+		if len(p.applyingOn[sg]) > 0 {
+			targets = strings.Join(p.applyingOn[sg], ", ")
+		}
+		for _, inbound := range p.inbounds[sg] {
+			var allIps bool
+			for _, n := range inbound.IPRanges {
+				if ones, _ := n.Mask.Size(); ones == 0 {
+					allIps = true
+				}
+			}
+			if !allIps {
+				continue
+			}
+			severity, ports := SeverityMedium, fmt.Sprintf("ports %d-%d via %s", inbound.PortRange.FromPort, inbound.PortRange.ToPort, inbound.Protocol)
+			switch {
+			case inbound.PortRange.Any && inbound.Protocol == "any":
+				severity, ports = SeverityHigh, "all ports via any protocol"
+			case inbound.PortRange.Any:
+				ports = fmt.Sprintf("all ports via %s", inbound.Protocol)
+			case inbound.PortRange.FromPort == inbound.PortRange.ToPort:
+				ports = fmt.Sprintf("port %d via %s", inbound.PortRange.FromPort, inbound.Protocol)
+			}
+			findings = append(findings, &Finding{
+				RuleID:       p.Name(),
+				Severity:     severity,
+				ResourceID:   sg,
+				ResourceType: cloud.SecurityGroup,
+				Message:      fmt.Sprintf("securitygroup %s opens %s to all IPs (applying on %s)", sg, ports, targets),
+			})
+		}
+	}
+	return
+}
+
 func (p *PortScanner) Print(w io.Writer) {
 	for sg, inbounds := range p.inbounds {
 		var targets string
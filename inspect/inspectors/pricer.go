@@ -53,7 +53,7 @@ func (p *Pricer) Inspect(g *graph.Graph) error {
 		return err
 	}
 
-	p.count = make(map[string]int)
+	p.count, p.total = make(map[string]int), 0
 	pricePerType := make(map[string]float64)
 
 	for _, inst := range instances {
@@ -0,0 +1,135 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+)
+
+type TagPolicy struct {
+	// Required are the tag keys the resources must have per resource type.
+	// When empty, the policy configured under 'inspect.tagpolicy.<type>' is used
+	Required map[string][]string
+
+	violations []*tagViolation
+}
+
+type tagViolation struct {
+	res     *graph.Resource
+	missing []string
+}
+
+func (*TagPolicy) Name() string {
+	return "tagpolicy"
+}
+
+func (p *TagPolicy) Inspect(g *graph.Graph) error {
+	if len(p.Required) == 0 {
+		p.Required = config.GetTagPolicy()
+	}
+
+	var types []string
+	for typ := range p.Required {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	p.violations = nil
+	for _, typ := range types {
+		resources, err := g.GetAllResources(typ)
+		if err != nil {
+			return err
+		}
+		sort.Slice(resources, func(i, j int) bool { return resources[i].Id() < resources[j].Id() })
+		for _, res := range resources {
+			tags := make(map[string]bool)
+			if all, ok := res.Properties[properties.Tags].([]string); ok {
+				for _, t := range all {
+					tags[strings.SplitN(t, "=", 2)[0]] = true
+				}
+			}
+			var missing []string
+			for _, key := range p.Required[typ] {
+				if !tags[key] {
+					missing = append(missing, key)
+				}
+			}
+			if len(missing) > 0 {
+				p.violations = append(p.violations, &tagViolation{res: res, missing: missing})
+			}
+		}
+	}
+
+	return nil
+}
+
+// CriticalCount returns the number of resources missing required tags
+func (p *TagPolicy) CriticalCount() int {
+	return len(p.violations)
+}
+
+func (p *TagPolicy) Findings() (findings []*Finding) {
+	for _, v := range p.violations {
+		findings = append(findings, &Finding{
+			RuleID:       p.Name(),
+			Severity:     SeverityHigh,
+			ResourceID:   v.res.Id(),
+			ResourceType: v.res.Type(),
+			Message:      fmt.Sprintf("%s %s is missing the required tags: %s", v.res.Type(), v.res.Id(), strings.Join(v.missing, ", ")),
+		})
+	}
+	return
+}
+
+func (p *TagPolicy) Print(w io.Writer) {
+	if len(p.Required) == 0 {
+		fmt.Fprintln(w, "no tag policy: set the tags required per resource type with `awless config set inspect.tagpolicy.instance owner,cost-center`")
+		return
+	}
+	if len(p.violations) == 0 {
+		fmt.Fprintln(w, "all resources have their required tags")
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Type\tResource\tName\tMissing tags\t")
+	fmt.Fprintln(tabw, "----\t--------\t----\t------------\t")
+
+	perType := make(map[string]int)
+	for _, v := range p.violations {
+		perType[v.res.Type()]++
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t%s\t\n", v.res.Type(), v.res.Id(), valueOrEmpty(v.res, properties.Name), strings.Join(v.missing, ", "))
+	}
+
+	tabw.Flush()
+
+	var counts []string
+	for typ, count := range perType {
+		counts = append(counts, fmt.Sprintf("%d %s(s)", count, typ))
+	}
+	sort.Strings(counts)
+	fmt.Fprintf(w, "\n%d resource(s) missing required tags: %s\n", len(p.violations), strings.Join(counts, ", "))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+type UnusedResources struct {
+	elasticIPs []*graph.Resource
+}
+
+func (*UnusedResources) Name() string {
+	return "unused_resources"
+}
+
+func (u *UnusedResources) Inspect(g *graph.Graph) error {
+	eips, err := g.GetAllResources(cloud.ElasticIP)
+	if err != nil {
+		return err
+	}
+
+	u.elasticIPs = nil
+	for _, eip := range eips {
+		if associated, ok := eip.Properties[properties.Associated].(bool); ok && !associated {
+			u.elasticIPs = append(u.elasticIPs, eip)
+		}
+	}
+
+	sort.Slice(u.elasticIPs, func(i, j int) bool { return u.elasticIPs[i].Id() < u.elasticIPs[j].Id() })
+
+	return nil
+}
+
+func (u *UnusedResources) Findings() (findings []*Finding) {
+	for _, eip := range u.elasticIPs {
+		findings = append(findings, &Finding{
+			RuleID:       u.Name() + "." + cloud.ElasticIP,
+			Severity:     SeverityLow,
+			ResourceID:   eip.Id(),
+			ResourceType: cloud.ElasticIP,
+			Message:      fmt.Sprintf("elastic IP %s is not associated and charged while not in use", eip.Id()),
+		})
+	}
+	return
+}
+
+func (u *UnusedResources) Print(w io.Writer) {
+	if len(u.elasticIPs) == 0 {
+		fmt.Fprintln(w, "none found")
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Type\tId\tPublic IP\t")
+	fmt.Fprintln(tabw, "----\t--\t---------\t")
+
+	for _, eip := range u.elasticIPs {
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t\n", cloud.ElasticIP, eip.Id(), valueOrEmpty(eip, properties.PublicIP))
+	}
+
+	tabw.Flush()
+
+	fmt.Fprintf(w, "\n%d unassociated elastic IP(s): those are charged while not in use\n", len(u.elasticIPs))
+}
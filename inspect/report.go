@@ -0,0 +1,183 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/wallix/awless/inspect/inspectors"
+)
+
+// FindingsReporter is implemented by inspectors reporting their results
+// as findings on resources, that can be exported as JSON or SARIF
+type FindingsReporter interface {
+	Findings() []*inspectors.Finding
+}
+
+func getFindings(inspector Inspector) ([]*inspectors.Finding, error) {
+	reporter, ok := inspector.(FindingsReporter)
+	if !ok {
+		return nil, fmt.Errorf("inspector %s does not report findings", inspector.Name())
+	}
+	findings := reporter.Findings()
+	if findings == nil {
+		findings = []*inspectors.Finding{}
+	}
+	return findings, nil
+}
+
+// WriteJSON writes the findings of the inspector as JSON
+func WriteJSON(w io.Writer, inspector Inspector) error {
+	findings, err := getFindings(inspector)
+	if err != nil {
+		return err
+	}
+	report := struct {
+		Inspector string                `json:"inspector"`
+		Findings  []*inspectors.Finding `json:"findings"`
+	}{inspector.Name(), findings}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(report)
+}
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations"`
+	Properties map[string]string `json:"properties"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+// Resources are not files: their artifact is a synthetic relative URI
+// made of the account, type and id of the resource
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// WriteSARIF writes the findings of the inspector as a SARIF log,
+// the resources of the findings being their locations
+func WriteSARIF(w io.Writer, inspector Inspector, version string) error {
+	findings, err := getFindings(inspector)
+	if err != nil {
+		return err
+	}
+	return writeSARIF(w, inspector.Name(), version, findings)
+}
+
+// writeSARIF writes the findings as a SARIF log, the findings of an account
+// being located under it and carrying it in their properties
+func writeSARIF(w io.Writer, inspectorName, version string, findings []*inspectors.Finding) error {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "awless", Version: version, InformationURI: "https://github.com/wallix/awless", Rules: []sarifRule{}}},
+		Results: []sarifResult{},
+	}
+	rules := make(map[string]bool)
+	for _, f := range findings {
+		if !rules[f.RuleID] {
+			rules[f.RuleID] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: f.RuleID})
+		}
+		qualifiedName := f.ResourceType + "/" + f.ResourceID
+		uri := url.PathEscape(f.ResourceType) + "/" + url.PathEscape(f.ResourceID)
+		props := map[string]string{"inspector": inspectorName, "severity": f.Severity, "resourceType": f.ResourceType}
+		if f.Account != "" {
+			qualifiedName = f.Account + "/" + qualifiedName
+			uri = url.PathEscape(f.Account) + "/" + uri
+			props["account"] = f.Account
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  f.RuleID,
+			Level:   sarifLevel(f.Severity),
+			Message: sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: uri}},
+				LogicalLocations: []sarifLogicalLocation{{Name: f.ResourceID, FullyQualifiedName: qualifiedName, Kind: "resource"}},
+			}},
+			Properties: props,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []sarifRun{run}})
+}
+
+func sarifLevel(severity string) string {
+	switch severity {
+	case inspectors.SeverityHigh:
+		return "error"
+	case inspectors.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,104 @@
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/inspect/inspectors"
+)
+
+type findingsInspector struct {
+	findings []*inspectors.Finding
+}
+
+func (*findingsInspector) Name() string               { return "fake" }
+func (*findingsInspector) Inspect(*graph.Graph) error { return nil }
+func (*findingsInspector) Print(io.Writer)            {}
+func (i *findingsInspector) Findings() []*inspectors.Finding {
+	return i.findings
+}
+
+func TestWriteFindings(t *testing.T) {
+	inspector := &findingsInspector{findings: []*inspectors.Finding{
+		{RuleID: "fake.open", Severity: inspectors.SeverityHigh, ResourceID: "sg-1", ResourceType: "securitygroup", Message: "sg-1 is open"},
+		{RuleID: "fake.open", Severity: inspectors.SeverityMedium, ResourceID: "sg-2", ResourceType: "securitygroup", Message: "sg-2 is open"},
+		{RuleID: "fake.unused", Severity: inspectors.SeverityLow, ResourceID: "arn:aws:acm:eu-west-1:123456789012:certificate/cert-1", ResourceType: "certificate", Message: "cert-1 is unused"},
+	}}
+
+	t.Run("json", func(t *testing.T) {
+		var buff bytes.Buffer
+		if err := WriteJSON(&buff, inspector); err != nil {
+			t.Fatal(err)
+		}
+		var report struct {
+			Inspector string
+			Findings  []*inspectors.Finding
+		}
+		if err := json.Unmarshal(buff.Bytes(), &report); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := report.Inspector, "fake"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := report.Findings, inspector.findings; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		var buff bytes.Buffer
+		if err := WriteSARIF(&buff, inspector, "v1.0.0"); err != nil {
+			t.Fatal(err)
+		}
+		var log sarifLog
+		if err := json.Unmarshal(buff.Bytes(), &log); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := log.Version, "2.1.0"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		driver := log.Runs[0].Tool.Driver
+		if got, want := driver.Rules, []sarifRule{{ID: "fake.open"}, {ID: "fake.unused"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		var levels []string
+		for _, r := range log.Runs[0].Results {
+			levels = append(levels, r.Level)
+		}
+		if got, want := strings.Join(levels, ","), "error,warning,note"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		first := log.Runs[0].Results[0]
+		if got, want := first.Locations[0].LogicalLocations[0], (sarifLogicalLocation{Name: "sg-1", FullyQualifiedName: "securitygroup/sg-1", Kind: "resource"}); got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := first.Message.Text, "sg-1 is open"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		var uris []string
+		for _, r := range log.Runs[0].Results {
+			uris = append(uris, r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		}
+		if got, want := strings.Join(uris, ","), "securitygroup/sg-1,securitygroup/sg-2,certificate/arn:aws:acm:eu-west-1:123456789012:certificate%2Fcert-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("no findings", func(t *testing.T) {
+		var buff bytes.Buffer
+		if err := WriteSARIF(&buff, &findingsInspector{}, ""); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buff.String(), `"results": []`) {
+			t.Fatalf("expected empty results, got %s", buff.String())
+		}
+		if err := WriteJSON(&buff, &inspectors.Pricer{}); err == nil || err.Error() != "inspector pricer does not report findings" {
+			t.Fatalf("got %v", err)
+		}
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"strings"
 	"sync/atomic"
 
 	"github.com/fatih/color"
@@ -53,50 +55,50 @@ func New(prefix string, flag int) *Logger {
 
 func (l *Logger) Verbosef(format string, v ...interface{}) {
 	if l.verbosity() > 0 {
-		l.out.Println(prepend(verbosePrefix, fmt.Sprintf(format, v...))...)
+		l.println(prepend(verbosePrefix, fmt.Sprintf(format, v...))...)
 	}
 }
 
 func (l *Logger) Verbose(v ...interface{}) {
 	if l.verbosity() > 0 {
-		l.out.Println(prepend(verbosePrefix, v...)...)
+		l.println(prepend(verbosePrefix, v...)...)
 	}
 }
 
 func (l *Logger) ExtraVerbosef(format string, v ...interface{}) {
 	if l.verbosity() > 1 {
-		l.out.Println(prepend(extraVerbosePrefix, fmt.Sprintf(format, v...))...)
+		l.println(prepend(extraVerbosePrefix, fmt.Sprintf(format, v...))...)
 	}
 }
 
 func (l *Logger) ExtraVerbose(v ...interface{}) {
 	if l.verbosity() > 1 {
-		l.out.Println(prepend(extraVerbosePrefix, v...)...)
+		l.println(prepend(extraVerbosePrefix, v...)...)
 	}
 }
 
 func (l *Logger) Info(v ...interface{}) {
-	l.out.Println(prepend(infoPrefix, v...)...)
+	l.println(prepend(infoPrefix, v...)...)
 }
 
 func (l *Logger) Infof(format string, v ...interface{}) {
-	l.out.Println(prepend(infoPrefix, fmt.Sprintf(format, v...))...)
+	l.println(prepend(infoPrefix, fmt.Sprintf(format, v...))...)
 }
 
 func (l *Logger) Error(v ...interface{}) {
-	l.out.Println(prepend(errorPrefix, v...)...)
+	l.println(prepend(errorPrefix, v...)...)
 }
 
 func (l *Logger) Errorf(format string, v ...interface{}) {
-	l.out.Println(prepend(errorPrefix, fmt.Sprintf(format, v...))...)
+	l.println(prepend(errorPrefix, fmt.Sprintf(format, v...))...)
 }
 
 func (l *Logger) Warning(v ...interface{}) {
-	l.out.Println(prepend(warningPrefix, v...)...)
+	l.println(prepend(warningPrefix, v...)...)
 }
 
 func (l *Logger) Warningf(format string, v ...interface{}) {
-	l.out.Println(prepend(warningPrefix, fmt.Sprintf(format, v...))...)
+	l.println(prepend(warningPrefix, fmt.Sprintf(format, v...))...)
 }
 
 func (l *Logger) SetVerbose(level int) {
@@ -147,6 +149,40 @@ func Warningf(format string, v ...interface{}) {
 	DefaultLogger.Warningf(format, v...)
 }
 
+// RedactedMask replaces the values of redacted keys in logged messages
+const RedactedMask = "***"
+
+var redactedKeysRegex atomic.Value // *regexp.Regexp
+
+// SetRedactedKeys masks the values given to the keys in all logged messages
+// (ex: 'userdata=...' in a template statement). Keys are case insensitive
+func SetRedactedKeys(keys ...string) {
+	var quoted []string
+	for _, k := range keys {
+		if k = strings.TrimSpace(k); k != "" {
+			quoted = append(quoted, regexp.QuoteMeta(k))
+		}
+	}
+	if len(quoted) == 0 {
+		redactedKeysRegex.Store((*regexp.Regexp)(nil))
+		return
+	}
+	redactedKeysRegex.Store(regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)(\s*[=:]\s*)("[^"]*"|'[^']*'|[^\s,]+)`))
+}
+
+// Redact masks in the text the values of the keys set with SetRedactedKeys
+func Redact(s string) string {
+	reg, _ := redactedKeysRegex.Load().(*regexp.Regexp)
+	if reg == nil {
+		return s
+	}
+	return reg.ReplaceAllString(s, "${1}${2}"+RedactedMask)
+}
+
+func (l *Logger) println(v ...interface{}) {
+	l.out.Print(Redact(fmt.Sprintln(v...)))
+}
+
 func prepend(s interface{}, v ...interface{}) []interface{} {
 	return append([]interface{}{s}, v...)
 }
@@ -16,8 +16,14 @@ limitations under the License.
 
 package main
 
-import "github.com/wallix/awless/commands"
+import (
+	"os"
+
+	"github.com/wallix/awless/commands"
+)
 
 func main() {
-	commands.RootCmd.Execute()
+	if err := commands.RootCmd.Execute(); err != nil {
+		os.Exit(commands.ExitCode(err))
+	}
 }
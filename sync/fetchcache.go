@@ -0,0 +1,170 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	gosync "sync"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+)
+
+// DefaultFetchCache, when set, serves the read commands and is filled by the syncs
+var DefaultFetchCache *FetchCache
+
+// FetchCache keeps the resources recently fetched by type or synced by service,
+// in process and on disk, so that read commands do not fetch them again within the TTL.
+// Entries are keyed by profile, region and resource type or service name
+type FetchCache struct {
+	dir     string
+	profile string
+	ttl     time.Duration
+
+	mu  gosync.Mutex
+	mem map[string]*cachedGraph
+	now func() time.Time
+}
+
+type cachedGraph struct {
+	g       *graph.Graph
+	fetched time.Time
+}
+
+// NewFetchCache returns a cache stored under dir for the given profile. A zero TTL disables it
+func NewFetchCache(dir, profile string, ttl time.Duration) *FetchCache {
+	return &FetchCache{dir: dir, profile: profile, ttl: ttl, mem: make(map[string]*cachedGraph), now: time.Now}
+}
+
+// FetchByType returns the resources of a type, served from the cache when they have
+// been fetched or their service synced within the TTL, unless refresh is given.
+// A nil cache always fetches
+func (c *FetchCache) FetchByType(srv cloud.Service, resType string, refresh bool) (*graph.Graph, error) {
+	if c == nil || c.ttl <= 0 {
+		return srv.FetchByType(resType)
+	}
+
+	typePath := c.path(srv.Region(), "types", resType)
+	if !refresh {
+		if g, ok := c.get(typePath); ok {
+			return g, nil
+		}
+		if g, ok := c.get(c.path(srv.Region(), "services", srv.Name())); ok {
+			return g, nil
+		}
+	}
+
+	g, err := srv.FetchByType(resType)
+	if err != nil {
+		return g, err
+	}
+	return g, c.put(typePath, g)
+}
+
+// IsServiceFresh tells whether the service has been synced within the TTL
+func (c *FetchCache) IsServiceFresh(srv cloud.Service) bool {
+	if c == nil || c.ttl <= 0 {
+		return false
+	}
+	_, ok := c.get(c.path(srv.Region(), "services", srv.Name()))
+	return ok
+}
+
+// PutService caches the graph of a synced service, superseding the resources of its types cached so far
+func (c *FetchCache) PutService(srv cloud.Service, g *graph.Graph) error {
+	if c == nil || c.ttl <= 0 {
+		return nil
+	}
+	c.removeTypes(srv)
+	return c.put(c.path(srv.Region(), "services", srv.Name()), g)
+}
+
+// Invalidate drops what is cached of the service, to be called when its resources have been modified
+func (c *FetchCache) Invalidate(srv cloud.Service) {
+	if c == nil {
+		return
+	}
+	c.removeTypes(srv)
+	c.remove(c.path(srv.Region(), "services", srv.Name()))
+}
+
+func (c *FetchCache) removeTypes(srv cloud.Service) {
+	for _, resType := range srv.ResourceTypes() {
+		c.remove(c.path(srv.Region(), "types", resType))
+	}
+}
+
+func (c *FetchCache) remove(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.mem, path)
+	os.Remove(path)
+}
+
+func (c *FetchCache) path(region, kind, name string) string {
+	profile := c.profile
+	if profile == "" {
+		profile = "default"
+	}
+	return filepath.Join(c.dir, profile, region, kind, name+fileExt)
+}
+
+func (c *FetchCache) get(path string) (*graph.Graph, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.mem[path]; ok && c.isFresh(cached.fetched) {
+		return cached.g, true
+	}
+	info, err := os.Stat(path)
+	if err != nil || !c.isFresh(info.ModTime()) {
+		return nil, false
+	}
+	g, err := graph.NewGraphFromFile(path)
+	if err != nil {
+		return nil, false
+	}
+	c.mem[path] = &cachedGraph{g: g, fetched: info.ModTime()}
+	return g, true
+}
+
+func (c *FetchCache) put(path string, g *graph.Graph) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.mem[path] = &cachedGraph{g: g, fetched: c.now()}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("fetch cache: %s", err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("fetch cache: %s", err)
+	}
+	defer f.Close()
+	if err := g.MarshalTo(f); err != nil {
+		return fmt.Errorf("fetch cache: marshal to %s: %s", path, err)
+	}
+	return nil
+}
+
+func (c *FetchCache) isFresh(t time.Time) bool {
+	return c.now().Sub(t) < c.ttl
+}
@@ -0,0 +1,97 @@
+package sync
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/graph"
+)
+
+func TestFetchCache(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	srv := &fetchCountService{mockService: mockService{name: "infra", region: "eu-west-1"}}
+	now := time.Now()
+	cache := NewFetchCache(tmpDir, "prod", time.Minute)
+	cache.now = func() time.Time { return now }
+
+	fetch := func(c *FetchCache, refresh bool, expCount int) {
+		g, err := c.FetchByType(srv, "instance", refresh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := g.GetResource("instance", "inst_1"); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := srv.count, expCount; got != want {
+			t.Fatalf("got %d fetch(es), want %d", got, want)
+		}
+	}
+
+	fetch(cache, false, 1)
+	fetch(cache, false, 1)
+	fetch(cache, true, 2)
+
+	t.Run("on disk", func(t *testing.T) {
+		fetch(NewFetchCache(tmpDir, "prod", time.Minute), false, 2)
+	})
+	t.Run("keyed by profile and region", func(t *testing.T) {
+		fetch(NewFetchCache(tmpDir, "dev", time.Minute), false, 3)
+		other := &fetchCountService{mockService: mockService{name: "infra", region: "us-east-1"}}
+		if _, err := cache.FetchByType(other, "instance", false); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := other.count, 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+	t.Run("expired", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		fetch(cache, false, 4)
+	})
+	t.Run("synced service", func(t *testing.T) {
+		if cache.IsServiceFresh(srv) {
+			t.Fatal("expected service not to be fresh")
+		}
+		synced := graph.NewGraph()
+		synced.AddResource(graph.InitResource("instance", "inst_1"))
+		if err := cache.PutService(srv, synced); err != nil {
+			t.Fatal(err)
+		}
+		if !cache.IsServiceFresh(srv) {
+			t.Fatal("expected service to be fresh")
+		}
+		fetch(cache, false, 4)
+
+		cache.Invalidate(srv)
+		if cache.IsServiceFresh(srv) {
+			t.Fatal("expected service not to be fresh once invalidated")
+		}
+		fetch(cache, false, 5)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		fetch(NewFetchCache(tmpDir, "prod", 0), false, 6)
+		var nilCache *FetchCache
+		fetch(nilCache, false, 7)
+	})
+}
+
+type fetchCountService struct {
+	mockService
+	count int
+}
+
+func (s *fetchCountService) ResourceTypes() []string { return []string{"instance"} }
+
+func (s *fetchCountService) FetchByType(t string) (*graph.Graph, error) {
+	s.count++
+	g := graph.NewGraph()
+	g.AddResource(graph.InitResource(t, "inst_1"))
+	return g, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync/repo"
+)
+
+// ResourceChange is how a resource changed in a snapshot since the previous one
+type ResourceChange struct {
+	Rev        *repo.Rev         `json:"-"`
+	RevId      string            `json:"revision"`
+	Date       string            `json:"date"`
+	Created    bool              `json:"created,omitempty"`
+	Deleted    bool              `json:"deleted,omitempty"`
+	Properties []*PropertyChange `json:"properties,omitempty"`
+}
+
+// PropertyChange holds the values of a property before and after a snapshot,
+// empty if the property was not set
+type PropertyChange struct {
+	Name   string `json:"name"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// BuildResourceHistory walks the given revisions in chronological order and returns the
+// changes of the resource with the given id found in the graphs committed in the given
+// directories (ex: a region and "global"). Revisions where the resource did not change are left out
+func BuildResourceHistory(r repo.Repo, revs []*repo.Rev, id string, dirs ...string) ([]*ResourceChange, error) {
+	sorted := make([]*repo.Rev, len(revs))
+	copy(sorted, revs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	var changes []*ResourceChange
+	var previous *graph.Resource
+	for _, rev := range sorted {
+		g, err := r.LoadRevGraph(rev.Id, dirs...)
+		if err != nil {
+			return changes, fmt.Errorf("loading revision %s: %s", rev.Id, err)
+		}
+		current, err := g.FindResource(id)
+		if err != nil {
+			return changes, fmt.Errorf("revision %s: %s", rev.Id, err)
+		}
+
+		change := &ResourceChange{Rev: rev, RevId: rev.Id, Date: rev.DateString()}
+		switch {
+		case previous == nil && current == nil:
+			continue
+		case previous == nil:
+			change.Created = true
+		case current == nil:
+			change.Deleted = true
+		default:
+			change.Properties = diffProperties(previous, current)
+			if len(change.Properties) == 0 {
+				previous = current
+				continue
+			}
+		}
+		changes = append(changes, change)
+		previous = current
+	}
+	return changes, nil
+}
+
+func diffProperties(before, after *graph.Resource) (changes []*PropertyChange) {
+	names := make(map[string]struct{})
+	for k := range before.Properties {
+		names[k] = struct{}{}
+	}
+	for k := range after.Properties {
+		names[k] = struct{}{}
+	}
+	var sorted []string
+	for k := range names {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		b, a := propertyValueString(before.Properties[name]), propertyValueString(after.Properties[name])
+		if b != a {
+			changes = append(changes, &PropertyChange{Name: name, Before: b, After: a})
+		}
+	}
+	return
+}
+
+// propertyValueString formats a property value ignoring the order of
+// list values, as their order is not kept in the snapshots
+func propertyValueString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Slice {
+		return fmt.Sprint(v)
+	}
+	var elems []string
+	for i := 0; i < val.Len(); i++ {
+		elems = append(elems, fmt.Sprint(val.Index(i).Interface()))
+	}
+	sort.Strings(elems)
+	return "[" + strings.Join(elems, ", ") + "]"
+}
@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync/repo"
+)
+
+type revGraphsRepo struct {
+	repo.Repo
+	graphs map[string]*graph.Graph
+}
+
+func (r *revGraphsRepo) LoadRevGraph(version string, dirs ...string) (*graph.Graph, error) {
+	return r.graphs[version], nil
+}
+
+func TestBuildResourceHistory(t *testing.T) {
+	snapshot := func(props map[string]interface{}) *graph.Graph {
+		g := graph.NewGraph()
+		if props != nil {
+			res := graph.InitResource(cloud.SecurityGroup, "sg-1234")
+			res.Properties = props
+			g.AddResource(res)
+		}
+		return g
+	}
+	r := &revGraphsRepo{graphs: map[string]*graph.Graph{
+		"1": snapshot(nil),
+		"2": snapshot(map[string]interface{}{properties.ID: "sg-1234", properties.Name: "web", properties.Tags: []string{"a=1", "b=2"}}),
+		"3": snapshot(map[string]interface{}{properties.ID: "sg-1234", properties.Name: "web", properties.Tags: []string{"b=2", "a=1"}}),
+		"4": snapshot(map[string]interface{}{properties.ID: "sg-1234", properties.Name: "public", properties.Description: "open"}),
+		"5": snapshot(nil),
+	}}
+	now := time.Now()
+	var revs []*repo.Rev
+	for i, id := range []string{"5", "4", "3", "2", "1"} {
+		revs = append(revs, &repo.Rev{Id: id, Date: now.Add(-time.Duration(i) * time.Hour)})
+	}
+
+	changes, err := BuildResourceHistory(r, revs, "sg-1234", "eu-west-1", "global")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, c := range changes {
+		ids = append(ids, c.RevId)
+	}
+	if got, want := ids, []string{"2", "4", "5"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if !changes[0].Created || !changes[2].Deleted {
+		t.Fatalf("got %+v, %+v, want created then deleted", changes[0], changes[2])
+	}
+	expected := []*PropertyChange{
+		{Name: properties.Description, Before: "", After: "open"},
+		{Name: properties.Name, Before: "web", After: "public"},
+		{Name: properties.Tags, Before: "[a=1, b=2]", After: ""},
+	}
+	if got, want := changes[1].Properties, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -29,12 +30,17 @@ import (
 
 	git "gopkg.in/src-d/go-git.v4"
 	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/filemode"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
 )
 
+// Trailer of the commit messages recording the profile that synced the snapshot
+const profileTrailer = "profile: "
+
 type Rev struct {
-	Id   string
-	Date time.Time
+	Id      string
+	Date    time.Time
+	Profile string
 
 	Infra  *graph.Graph
 	Access *graph.Graph
@@ -48,6 +54,8 @@ type Repo interface {
 	Commit(files ...string) error
 	List() ([]*Rev, error)
 	LoadRev(version string) (*Rev, error)
+	LoadRevGraph(version string, dirs ...string) (*graph.Graph, error)
+	Prune(before time.Time) (int, error)
 	BaseDir() string
 }
 
@@ -58,19 +66,25 @@ type noRevisionRepo struct {
 type gitRepo struct {
 	repo    *git.Repository
 	basedir string
+	profile string
 }
 
 func BaseDir() string {
 	return filepath.Join(os.Getenv("__AWLESS_HOME"), "aws", "rdf")
 }
 
-func New() (Repo, error) {
+// New opens the local repository, the snapshots committed being attributed to the given profile
+func New(profile string) (Repo, error) {
 	dir := BaseDir()
 	os.MkdirAll(dir, 0700)
-	return newGitRepo(dir)
+	r, err := newGitRepo(dir, profile)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
-func newGitRepo(path string) (Repo, error) {
+func newGitRepo(path, profile string) (*gitRepo, error) {
 	if _, err := os.Stat(filepath.Join(path, ".git")); os.IsNotExist(err) {
 		if _, err := git.PlainInit(path, false); err != nil {
 			return nil, err
@@ -78,7 +92,7 @@ func newGitRepo(path string) (Repo, error) {
 	}
 
 	repo, err := git.PlainOpen(path)
-	return &gitRepo{repo: repo, basedir: path}, err
+	return &gitRepo{repo: repo, basedir: path, profile: profile}, err
 }
 
 func (r *gitRepo) BaseDir() string {
@@ -103,7 +117,7 @@ func (r *gitRepo) List() ([]*Rev, error) {
 			panic(fmt.Sprintf("error listing repo revisions: %s", err))
 		}
 
-		all = append(all, &Rev{Id: commit.Hash.String(), Date: commit.Committer.When})
+		all = append(all, &Rev{Id: commit.Hash.String(), Date: commit.Committer.When, Profile: commitProfile(commit.Message)})
 	}
 
 	sort.Slice(all, func(i, j int) bool { return all[i].Date.Before(all[j].Date) })
@@ -137,6 +151,7 @@ func (r *gitRepo) LoadRev(version string) (*Rev, error) {
 	}
 
 	rev.Date = commit.Committer.When
+	rev.Profile = commitProfile(commit.Message)
 
 	rev.Infra = graph.NewGraph()
 	rev.Access = graph.NewGraph()
@@ -151,6 +166,33 @@ func (r *gitRepo) LoadRev(version string) (*Rev, error) {
 	return rev, nil
 }
 
+// LoadRevGraph returns the graphs committed at a revision in the given
+// directories (ex: a region and "global"), merged in one graph
+func (r *gitRepo) LoadRevGraph(version string, dirs ...string) (*graph.Graph, error) {
+	commit, err := r.repo.CommitObject(plumbing.NewHash(version))
+	if err != nil {
+		return nil, err
+	}
+	files, err := commit.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	g := graph.NewGraph()
+	err = files.ForEach(func(f *object.File) error {
+		if !strings.HasSuffix(f.Name, ".triples") || !contains(dirs, path.Dir(f.Name)) {
+			return nil
+		}
+		contents, err := f.Contents()
+		if err != nil {
+			return err
+		}
+		err = g.Unmarshal([]byte(contents))
+		return err
+	})
+	return g, err
+}
+
 func unmarshalIntoGraph(g *graph.Graph, commit *object.Commit, filename string) error {
 	f, err := commit.File(filename)
 	if err != nil && err != object.ErrFileNotFound {
@@ -178,8 +220,143 @@ func (r *gitRepo) Commit(relativePaths ...string) error {
 	}
 
 	msg := fmt.Sprintf("syncing %s", strings.Join(relativePaths, ", "))
+	if r.profile != "" {
+		msg = fmt.Sprintf("%s\n\n%s%s", msg, profileTrailer, r.profile)
+	}
 	committer := &object.Signature{Name: "awlessCLI", When: time.Now(), Email: "git@awless.io"}
 
 	_, err = wt.Commit(msg, &git.CommitOptions{Author: committer})
 	return err
 }
+
+// Prune drops the snapshots committed by the repo profile before the given time and
+// returns how many were dropped. The last snapshot is always kept as it matches the
+// files on disk. The following snapshots are rewritten on top of the kept ones,
+// then the objects no longer reachable are removed to reclaim disk space
+func (r *gitRepo) Prune(before time.Time) (int, error) {
+	if r.profile == "" {
+		return 0, nil
+	}
+	head, err := r.repo.Head()
+	if err == plumbing.ErrReferenceNotFound {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	var history []*object.Commit
+	commit, err := r.repo.CommitObject(head.Hash())
+	for err == nil {
+		history = append(history, commit)
+		if commit.NumParents() == 0 {
+			break
+		}
+		commit, err = r.repo.CommitObject(commit.ParentHashes[0])
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var pruned int
+	var parent plumbing.Hash
+	for i := len(history) - 1; i >= 0; i-- {
+		c := history[i]
+		if i > 0 && commitProfile(c.Message) == r.profile && c.Committer.When.Before(before) {
+			pruned++
+			continue
+		}
+		if pruned == 0 {
+			parent = c.Hash
+			continue
+		}
+		rewritten := &object.Commit{Author: c.Author, Committer: c.Committer, Message: c.Message, TreeHash: c.TreeHash}
+		if !parent.IsZero() {
+			rewritten.ParentHashes = []plumbing.Hash{parent}
+		}
+		obj := r.repo.Storer.NewEncodedObject()
+		if err = rewritten.Encode(obj); err != nil {
+			return 0, err
+		}
+		if parent, err = r.repo.Storer.SetEncodedObject(obj); err != nil {
+			return 0, err
+		}
+	}
+	if pruned == 0 {
+		return 0, nil
+	}
+
+	if err = r.repo.Storer.SetReference(plumbing.NewHashReference(head.Name(), parent)); err != nil {
+		return 0, err
+	}
+	return pruned, r.removeUnreachableObjects(parent)
+}
+
+func (r *gitRepo) removeUnreachableObjects(head plumbing.Hash) error {
+	reachable := make(map[plumbing.Hash]bool)
+	for h := head; !h.IsZero(); {
+		commit, err := r.repo.CommitObject(h)
+		if err != nil {
+			return err
+		}
+		reachable[commit.Hash] = true
+		if err = r.markTreeReachable(commit.TreeHash, reachable); err != nil {
+			return err
+		}
+		h = plumbing.ZeroHash
+		if commit.NumParents() > 0 {
+			h = commit.ParentHashes[0]
+		}
+	}
+
+	loose, err := filepath.Glob(filepath.Join(r.basedir, ".git", "objects", "[0-9a-f][0-9a-f]", "*"))
+	if err != nil {
+		return err
+	}
+	for _, path := range loose {
+		if h := plumbing.NewHash(filepath.Base(filepath.Dir(path)) + filepath.Base(path)); !reachable[h] {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *gitRepo) markTreeReachable(h plumbing.Hash, reachable map[plumbing.Hash]bool) error {
+	if reachable[h] {
+		return nil
+	}
+	reachable[h] = true
+	tree, err := r.repo.TreeObject(h)
+	if err != nil {
+		return err
+	}
+	for _, entry := range tree.Entries {
+		if entry.Mode == filemode.Dir {
+			if err := r.markTreeReachable(entry.Hash, reachable); err != nil {
+				return err
+			}
+		} else {
+			reachable[entry.Hash] = true
+		}
+	}
+	return nil
+}
+
+func commitProfile(msg string) string {
+	for _, line := range strings.Split(msg, "\n") {
+		if strings.HasPrefix(line, profileTrailer) {
+			return strings.TrimSpace(strings.TrimPrefix(line, profileTrailer))
+		}
+	}
+	return ""
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
@@ -17,11 +17,129 @@ limitations under the License.
 package repo
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"testing"
 	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
 )
 
+func TestPruneProfileSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessrepotest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dev, err := newGitRepo(dir, "dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prod, err := newGitRepo(dir, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range []*gitRepo{dev, prod, dev, dev} {
+		if err = ioutil.WriteFile(filepath.Join(dir, "infra.triples"), []byte{byte('a' + i)}, 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err = r.Commit("infra.triples"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := dev.Prune(time.Now().Add(-time.Hour)); err != nil || n != 0 {
+		t.Fatalf("got %d, %v, want no snapshot pruned", n, err)
+	}
+	n, err := dev.Prune(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := n, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	revs, err := dev.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := make(map[string]int)
+	for _, rev := range revs {
+		profiles[rev.Profile]++
+	}
+	if got, want := profiles, map[string]int{"dev": 1, "prod": 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if content, err := ioutil.ReadFile(filepath.Join(dir, "infra.triples")); err != nil || string(content) != "d" {
+		t.Fatalf("got %q, %v, want last snapshot content", content, err)
+	}
+	if n, err := prod.Prune(time.Now().Add(time.Hour)); err != nil || n != 1 {
+		t.Fatalf("got %d, %v, want prod snapshot pruned", n, err)
+	}
+	if revs, err = prod.List(); err != nil || len(revs) != 1 {
+		t.Fatalf("got %d revs, %v, want 1", len(revs), err)
+	}
+}
+
+func TestLoadRevGraph(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessrepotest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := newGitRepo(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := func(typ, id string) string {
+		g := graph.NewGraph()
+		res := graph.InitResource(typ, id)
+		res.Properties[properties.ID] = id
+		g.AddResource(res)
+		return g.MustMarshal()
+	}
+	files := map[string]string{
+		"eu-west-1/infra.triples": snapshot("instance", "inst_1"),
+		"global/access.triples":   snapshot("user", "user_1"),
+		"us-east-1/infra.triples": snapshot("instance", "inst_2"),
+	}
+	var paths []string
+	for path, content := range files {
+		os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0700)
+		if err = ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	if err = r.Commit(paths...); err != nil {
+		t.Fatal(err)
+	}
+	revs, err := r.List()
+	if err != nil || len(revs) != 1 {
+		t.Fatalf("got %d revs, %v, want 1", len(revs), err)
+	}
+
+	g, err := r.LoadRevGraph(revs[0].Id, "eu-west-1", "global")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, exists := range map[string]bool{"inst_1": true, "user_1": true, "inst_2": false} {
+		res, err := g.FindResource(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res != nil, exists; got != want {
+			t.Fatalf("%s: got %t, want %t", id, got, want)
+		}
+	}
+}
+
 func TestReduceToLastRevOfEachDay(t *testing.T) {
 	revs := []*Rev{
 		{Id: "1", Date: mustParse("2017-01-18 15:05")},
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -46,16 +47,25 @@ type Syncer interface {
 
 type syncer struct {
 	repo.Repo
-	logger *logger.Logger
+	profile   string
+	retention time.Duration
+	logger    *logger.Logger
+	// dir of the graphs relative to the repo base dir, empty for the current profile
+	dir string
+	// account scoping the resource ids, empty for the current profile
+	account string
 }
 
-func NewSyncer(l ...*logger.Logger) Syncer {
-	repo, err := repo.New()
+// NewSyncer returns a syncer committing its snapshots for the given profile.
+// After each sync, the snapshots of this profile older than the retention
+// are pruned; a zero retention keeps them all
+func NewSyncer(profile string, retention time.Duration, l ...*logger.Logger) Syncer {
+	repo, err := repo.New(profile)
 	if err != nil {
 		panic(err)
 	}
 
-	s := &syncer{Repo: repo}
+	s := &syncer{Repo: repo, profile: profile, retention: retention}
 
 	if len(l) > 0 {
 		s.logger = l[0]
@@ -66,6 +76,27 @@ func NewSyncer(l ...*logger.Logger) Syncer {
 	return s
 }
 
+// NewAccountSyncer returns a syncer storing the graphs of an account, synced with
+// an assumed role, apart from the local graphs: under AccountGraphsDir, snapshots being
+// committed and pruned for a profile named after the account id.
+// The fetch cache of the current profile is left untouched.
+// Resource ids are scoped by the account (see graph.WithScopedIDs)
+func NewAccountSyncer(account string, retention time.Duration, l ...*logger.Logger) Syncer {
+	s := NewSyncer(account, retention, l...).(*syncer)
+	s.dir = accountDir(account)
+	s.account = account
+	return s
+}
+
+// AccountGraphsDir returns the directory of the graphs of an account synced with NewAccountSyncer
+func AccountGraphsDir(account string) string {
+	return filepath.Join(repo.BaseDir(), accountDir(account))
+}
+
+func accountDir(account string) string {
+	return filepath.Join("accounts", account)
+}
+
 func (s *syncer) Sync(services ...cloud.Service) (map[string]*graph.Graph, error) {
 	var workers gosync.WaitGroup
 
@@ -78,6 +109,7 @@ func (s *syncer) Sync(services ...cloud.Service) (map[string]*graph.Graph, error
 
 	resultc := make(chan *result, len(services))
 
+	awsservices.DefaultRequestDedup.Open()
 	for _, service := range services {
 		if service.IsSyncDisabled() {
 			s.logger.Verbosef("sync: *disabled* for service %s", service.Name())
@@ -109,23 +141,35 @@ Loop:
 			}
 			if res.err != nil {
 				allErrors = append(allErrors, fmt.Errorf("syncing %s: %s", res.service.Name(), res.err))
+				if s.dir == "" {
+					DefaultFetchCache.Invalidate(res.service)
+				}
 			} else {
 				s.logger.ExtraVerbosef("sync: fetched %s service took %s", res.service.Name(), time.Since(res.start))
 			}
 			if serv := res.service; serv != nil {
 				servicesByName[serv.Name()] = serv
-				if res.gph != nil {
+				if res.gph != nil && s.account != "" {
+					graphs[serv.Name()] = res.gph.WithScopedIDs(s.account)
+				} else if res.gph != nil {
 					graphs[serv.Name()] = res.gph
 				}
 			}
 		}
 	}
 
+	issued, shared := awsservices.DefaultRequestDedup.Close()
+	s.logger.ExtraVerbosef("sync: %d identical read call(s) shared instead of being sent, %d sent", shared, issued)
+
+	if dropped := s.dropRelationsToMissingResources(graphs, servicesByName); dropped > 0 {
+		s.logger.ExtraVerbosef("sync: dropped %d relation(s) to resources not found in any graph", dropped)
+	}
+
 	var filepaths []string
 
 	for name, g := range graphs {
 		serviceRegion := servicesByName[name].Region()
-		serviceDir := filepath.Join(s.BaseDir(), serviceRegion)
+		serviceDir := filepath.Join(s.BaseDir(), s.dir, serviceRegion)
 		os.MkdirAll(serviceDir, 0700)
 
 		filename := fmt.Sprintf("%s%s", name, fileExt)
@@ -139,21 +183,84 @@ Loop:
 			allErrors = append(allErrors, fmt.Errorf("marshal to %s: %s", fullpath, err))
 		}
 
-		filepaths = append(filepaths, filepath.Join(serviceRegion, filename))
+		filepaths = append(filepaths, filepath.Join(s.dir, serviceRegion, filename))
 		if err := f.Close(); err != nil {
 			allErrors = append(allErrors, fmt.Errorf("closing file %s: %s", fullpath, err))
 		}
+
+		if s.dir == "" {
+			if err := DefaultFetchCache.PutService(servicesByName[name], g); err != nil {
+				s.logger.Verbose(err)
+			}
+		}
 	}
 
 	if runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
 		if err := s.Commit(filepaths...); err != nil {
 			allErrors = append(allErrors, fmt.Errorf("committing %s: %s", strings.Join(filepaths, ", "), err))
+		} else if s.retention > 0 {
+			if pruned, err := s.Prune(time.Now().Add(-s.retention)); err != nil {
+				allErrors = append(allErrors, fmt.Errorf("pruning snapshots of profile '%s': %s", s.profile, err))
+			} else {
+				s.logger.Verbosef("sync: pruned %d snapshot(s) of profile '%s' older than %d day(s)", pruned, s.profile, int(s.retention.Hours()/24))
+			}
 		}
 	}
 
 	return graphs, concatErrors(allErrors)
 }
 
+// dropRelationsToMissingResources drops the relations of the synced graphs to resources
+// that neither the synced graphs nor the local graphs of the services not synced declare.
+// Relations across services (ex: a stack and the instances it manages) are added when
+// fetching a service, before knowing whether the other service has the resource
+func (s *syncer) dropRelationsToMissingResources(graphs map[string]*graph.Graph, services map[string]cloud.Service) int {
+	missing := make(map[string]bool)
+	for name, g := range graphs {
+	Nodes:
+		for _, node := range g.UndeclaredRelatedNodes() {
+			for other, og := range graphs {
+				if other != name && og.Declares(node) {
+					continue Nodes
+				}
+			}
+			missing[node] = true
+		}
+	}
+	if len(missing) == 0 {
+		return 0
+	}
+
+	dirs := map[string]bool{"global": true}
+	for name := range graphs {
+		dirs[services[name].Region()] = true
+	}
+	for dir := range dirs {
+		files, _ := filepath.Glob(filepath.Join(s.BaseDir(), s.dir, dir, fmt.Sprintf("*%s", fileExt)))
+		for _, file := range files {
+			if _, synced := graphs[strings.TrimSuffix(filepath.Base(file), fileExt)]; synced {
+				continue
+			}
+			local, err := graph.NewGraphFromFile(file)
+			if err != nil {
+				s.logger.Verbosef("sync: cannot load local graph %s: %s", file, err)
+				continue
+			}
+			for node := range missing {
+				if local.Declares(node) {
+					delete(missing, node)
+				}
+			}
+		}
+	}
+
+	var dropped int
+	for _, g := range graphs {
+		dropped += g.RemoveRelationsWith(missing)
+	}
+	return dropped
+}
+
 func concatErrors(errs []error) error {
 	if len(errs) == 0 {
 		return nil
@@ -168,25 +275,57 @@ func concatErrors(errs []error) error {
 }
 
 func LoadLocalGraphForService(serviceName, region string) *graph.Graph {
-	regionDir := region
-	if awsservices.IsGlobalService(serviceName) {
-		regionDir = "global"
-	}
-	path := filepath.Join(repo.BaseDir(), regionDir, fmt.Sprintf("%s%s", serviceName, fileExt))
+	path := localGraphPath(serviceName, region)
 	g, err := graph.NewGraphFromFile(path)
 	if err != nil {
+		warnUnloadableGraph(path, err)
 		return graph.NewGraph()
 	}
 	return g
 }
 
-func LoadLocalGraphs(region string) (*graph.Graph, error) {
+// LoadLocalGraphForType loads from the local graph of the service only the resources
+// of a type and the ones they reference, for commands not needing the full graph
+func LoadLocalGraphForType(resType, serviceName, region string) *graph.Graph {
+	path := localGraphPath(serviceName, region)
+	g, err := graph.NewGraphFromFileForType(path, resType)
+	if err != nil {
+		warnUnloadableGraph(path, err)
+		return graph.NewGraph()
+	}
+	return g
+}
+
+// warnUnloadableGraph reports the local graphs existing but not loaded, instead of
+// silently working with an empty graph
+func warnUnloadableGraph(path string, err error) {
+	if os.IsNotExist(err) {
+		return
+	}
+	logger.Warningf("cannot load local graph %s: %s", path, err)
+}
+
+func localGraphPath(serviceName, region string) string {
+	regionDir := region
+	if awsservices.IsGlobalService(serviceName) {
+		regionDir = "global"
+	}
+	return filepath.Join(repo.BaseDir(), regionDir, fmt.Sprintf("%s%s", serviceName, fileExt))
+}
+
+// LocalGraphFiles returns the paths of the local graphs of the global services and of the given region
+func LocalGraphFiles(region string) []string {
 	var files []string
 	globalFiles, _ := filepath.Glob(filepath.Join(repo.BaseDir(), "global", fmt.Sprintf("*%s", fileExt)))
 	regionFiles, _ := filepath.Glob(filepath.Join(repo.BaseDir(), region, fmt.Sprintf("*%s", fileExt)))
 
 	files = append(files, globalFiles...)
 	files = append(files, regionFiles...)
+	return files
+}
+
+func LoadLocalGraphs(region string) (*graph.Graph, error) {
+	files := LocalGraphFiles(region)
 
 	g := graph.NewGraph()
 
@@ -203,9 +342,45 @@ func LoadLocalGraphs(region string) (*graph.Graph, error) {
 	return g, err
 }
 
+// AllLocalGraphFiles returns the paths of the local graphs of all the regions
+func AllLocalGraphFiles() []string {
+	files, _ := filepath.Glob(filepath.Join(repo.BaseDir(), "*", fmt.Sprintf("*%s", fileExt)))
+	return files
+}
+
 func LoadAllLocalGraphs() (*graph.Graph, error) {
-	path := filepath.Join(repo.BaseDir(), "*", fmt.Sprintf("*%s", fileExt))
-	files, _ := filepath.Glob(path)
+	files := AllLocalGraphFiles()
+
+	g := graph.NewGraph()
+
+	var readers []io.Reader
+	for _, f := range files {
+		reader, err := os.Open(f)
+		if err != nil {
+			return g, fmt.Errorf("loading '%s': %s", f, err)
+		}
+		readers = append(readers, reader)
+	}
+
+	err := g.UnmarshalMultiple(readers...)
+	return g, err
+}
+
+// SyncedAccounts returns the ids of the accounts synced with NewAccountSyncer
+func SyncedAccounts() []string {
+	var accounts []string
+	dirs, _ := filepath.Glob(filepath.Join(repo.BaseDir(), accountDir("*")))
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			accounts = append(accounts, filepath.Base(dir))
+		}
+	}
+	return accounts
+}
+
+// LoadAccountGraphs loads the graphs of all the regions of an account synced with NewAccountSyncer
+func LoadAccountGraphs(account string) (*graph.Graph, error) {
+	files, _ := filepath.Glob(filepath.Join(AccountGraphsDir(account), "*", fmt.Sprintf("*%s", fileExt)))
 
 	g := graph.NewGraph()
 
@@ -215,9 +390,56 @@ func LoadAllLocalGraphs() (*graph.Graph, error) {
 		if err != nil {
 			return g, fmt.Errorf("loading '%s': %s", f, err)
 		}
+		defer reader.Close()
 		readers = append(readers, reader)
 	}
 
 	err := g.UnmarshalMultiple(readers...)
 	return g, err
 }
+
+// MigrateLocalGraphFile rewrites the graph file in the current format version when
+// written in an older one. It returns the format version the file was written in
+func MigrateLocalGraphFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	version, err := graph.ReadFormatVersion(f)
+	f.Close()
+	if err != nil {
+		return 0, fmt.Errorf("reading '%s': %s", path, err)
+	}
+	if version >= graph.FormatVersion {
+		return version, nil
+	}
+
+	g, err := graph.NewGraphFromFile(path)
+	if err != nil {
+		return version, fmt.Errorf("loading '%s': %s", path, err)
+	}
+	return version, WriteLocalGraphFile(path, g)
+}
+
+// WriteLocalGraphFile replaces the graph file at path. The graph is written aside
+// then renamed, not to lose the previous graph when interrupted
+func WriteLocalGraphFile(path string, g *graph.Graph) error {
+	out, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		return fmt.Errorf("creating temporary file for '%s': %s", path, err)
+	}
+	if err = g.MarshalTo(out); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return fmt.Errorf("writing '%s': %s", out.Name(), err)
+	}
+	if err = out.Close(); err != nil {
+		os.Remove(out.Name())
+		return fmt.Errorf("closing '%s': %s", out.Name(), err)
+	}
+	if err = os.Rename(out.Name(), path); err != nil {
+		os.Remove(out.Name())
+		return fmt.Errorf("replacing '%s': %s", path, err)
+	}
+	return nil
+}
@@ -50,7 +50,7 @@ func BenchmarkSync(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := NewSyncer().Sync(awsservices.InfraService, awsservices.AccessService, awsservices.StorageService)
+		_, err := NewSyncer("", 0).Sync(awsservices.InfraService, awsservices.AccessService, awsservices.StorageService)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -2,9 +2,12 @@ package sync
 
 import (
 	"os"
+	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/template/driver"
 
 	"io/ioutil"
@@ -37,7 +40,7 @@ func TestSyncTripleFiles(t *testing.T) {
 
 	os.Setenv("__AWLESS_HOME", tmpDir)
 
-	if _, err := NewSyncer().Sync(srv1, srv2); err != nil {
+	if _, err := NewSyncer("", 0).Sync(srv1, srv2); err != nil {
 		t.Fatal(err)
 	}
 
@@ -63,6 +66,127 @@ func TestSyncTripleFiles(t *testing.T) {
 	}
 }
 
+func TestAccountSyncer(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	g := graph.NewGraph()
+	inst := graph.InitResource(cloud.Instance, "inst_1")
+	inst.Properties[properties.ID] = "inst_1"
+	g.AddResource(inst)
+	srv := &mockService{g: g, name: "testservice", region: "paris"}
+	s := NewAccountSyncer("123456789012", 0)
+	if _, err := s.Sync(srv); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(AccountGraphsDir("123456789012"), "paris", "testservice"+fileExt)); err != nil {
+		t.Fatalf("cannot find expected file: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "aws", "rdf", "paris", "testservice"+fileExt)); !os.IsNotExist(err) {
+		t.Fatalf("got %v, want no local graph of the current profile", err)
+	}
+	if got := AllLocalGraphFiles(); len(got) != 0 {
+		t.Fatalf("got %v, want account graphs apart from the local graphs", got)
+	}
+	if got, want := SyncedAccounts(), []string{"123456789012"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	loaded, err := LoadAccountGraphs("123456789012")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := loaded.GetResource(cloud.Instance, "123456789012:inst_1")
+	if err != nil || res == nil {
+		t.Fatalf("got %v, %v, want account resource loaded", res, err)
+	}
+	if got, want := res.Properties[properties.ID], "inst_1"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	revs, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 1 || revs[0].Profile != "123456789012" {
+		t.Fatalf("got %+v, want 1 snapshot of the account", revs)
+	}
+}
+
+func TestSyncDropsRelationsToMissingResources(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	infra := graph.NewGraph()
+	infra.AddResource(graph.InitResource(cloud.Instance, "inst_1"))
+	if _, err := NewSyncer("", 0).Sync(&mockService{g: infra, name: "infra", region: "paris"}); err != nil {
+		t.Fatal(err)
+	}
+
+	stack := graph.InitResource(cloud.Stack, "stack_1")
+	stacks := graph.NewGraph()
+	stacks.AddResource(stack)
+	stacks.AddAppliesOnRelation(stack, graph.InitResource(cloud.Instance, "inst_1"))
+	stacks.AddAppliesOnRelation(stack, graph.InitResource(cloud.Instance, "inst_gone"))
+	stacks.AddAppliesOnRelation(stack, graph.InitResource(cloud.Bucket, "bucket_1"))
+	storage := graph.NewGraph()
+	storage.AddResource(graph.InitResource(cloud.Bucket, "bucket_1"))
+
+	graphs, err := NewSyncer("", 0).Sync(&mockService{g: stacks, name: "cloudformation", region: "paris"}, &mockService{g: storage, name: "storage", region: "global"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied, err := graphs["cloudformation"].ListResourcesAppliedOn(stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, r := range applied {
+		ids = append(ids, r.Id())
+	}
+	sort.Strings(ids)
+	if got, want := ids, []string{"bucket_1", "inst_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestSyncDropsLogGroupRelationsToDeletedFunctions(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	fnArn, goneArn := "arn:aws:lambda:eu-west-1:123456789012:function:api", "arn:aws:lambda:eu-west-1:123456789012:function:gone"
+	lambda := graph.NewGraph()
+	lambda.AddResource(graph.InitResource(cloud.Function, fnArn))
+
+	group, goneGroup := graph.InitResource(cloud.LogGroup, "/aws/lambda/api"), graph.InitResource(cloud.LogGroup, "/aws/lambda/gone")
+	monitoring := graph.NewGraph()
+	monitoring.AddResource(group, goneGroup)
+	monitoring.AddAppliesOnRelation(graph.InitResource(cloud.Function, fnArn), group)
+	monitoring.AddAppliesOnRelation(graph.InitResource(cloud.Function, goneArn), goneGroup)
+
+	graphs, err := NewSyncer("", 0).Sync(&mockService{g: lambda, name: "lambda", region: "eu-west-1"}, &mockService{g: monitoring, name: "monitoring", region: "eu-west-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := graphs["monitoring"].UndeclaredRelatedNodes(), []string{fnArn}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if graphs["monitoring"].Declares(goneArn) || graphs["monitoring"].Declares(fnArn) {
+		t.Fatal("expected no function declared in the monitoring graph")
+	}
+}
+
 type mockService struct {
 	name, region string
 	g            *graph.Graph
@@ -75,3 +199,42 @@ func (s *mockService) ResourceTypes() []string                    { return []str
 func (s *mockService) FetchResources() (*graph.Graph, error)      { return s.g, nil }
 func (s *mockService) IsSyncDisabled() bool                       { return false }
 func (s *mockService) FetchByType(t string) (*graph.Graph, error) { return nil, nil }
+
+func TestMigrateLocalGraphFile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// graph of an instance written in format 1, without header triple
+	legacy, err := ioutil.ReadFile(filepath.Join("testdata", "infra_v1.triples"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(tmpDir, "infra.triples")
+	if err = ioutil.WriteFile(path, legacy, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := MigrateLocalGraphFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := version, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if version, err = MigrateLocalGraphFile(path); err != nil || version != graph.FormatVersion {
+		t.Fatalf("got version %d (err: %v), want %d", version, err, graph.FormatVersion)
+	}
+	migrated, err := graph.NewGraphFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = migrated.GetResource(cloud.Instance, "inst_1"); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(tmpDir, "*")); len(files) != 1 {
+		t.Fatalf("got %v, want only the migrated file", files)
+	}
+}
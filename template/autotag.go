@@ -0,0 +1,115 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Keys of the tags injected on created resources. They are namespaced
+// so that they never clash with the tags given by users
+const (
+	AutoTagRunIDKey    = "awless:run-id"
+	AutoTagTemplateKey = "awless:template"
+	AutoTagCreatedKey  = "awless:created"
+)
+
+// Marks the injected tag statements with the reference of the tagged resource,
+// so that they are skipped when the resource already exists
+const autoTagAnnotation = "autotag"
+
+// injectAutoTagsPass adds after each create statement of a taggable entity
+// a 'create tag' statement per auto tag, declaring the created resource when needed
+// to reference it. Tags already given in the template for this resource are left untouched
+func injectAutoTagsPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	if len(env.AutoTags) == 0 || env.AutoTagEntityFunc == nil {
+		return tpl, env, nil
+	}
+
+	var keys []string
+	for k := range env.AutoTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	declared := make(map[string]bool)
+	userTags := make(map[string]map[string]bool)
+	for _, st := range tpl.Statements {
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			declared[decl.Ident] = true
+		}
+		if cmd, ok := statementCommand(st); ok && cmd.Action == "create" && cmd.Entity == "tag" {
+			if ref, hasRef := cmd.Refs["resource"]; hasRef {
+				if userTags[ref] == nil {
+					userTags[ref] = make(map[string]bool)
+				}
+				userTags[ref][fmt.Sprint(cmd.Params["key"])] = true
+			}
+		}
+	}
+
+	var count int
+	var statements []*ast.Statement
+	for _, st := range tpl.Statements {
+		statements = append(statements, st)
+		cmd, ok := statementCommand(st)
+		if !ok || cmd.Action != "create" || !env.AutoTagEntityFunc(cmd.Entity) {
+			continue
+		}
+
+		var ident string
+		if decl, isDecl := st.Node.(*ast.DeclarationNode); isDecl {
+			ident = decl.Ident
+		} else {
+			for ident == "" || declared[ident] {
+				count++
+				ident = fmt.Sprintf("%s_autotag%d", cmd.Entity, count)
+			}
+			declared[ident] = true
+			st.Node = &ast.DeclarationNode{Ident: ident, Expr: cmd}
+		}
+
+		for _, k := range keys {
+			if userTags[ident][k] {
+				env.Log.Verbosef("auto tag: '%s' already set on $%s in template", k, ident)
+				continue
+			}
+			tag := &ast.CommandNode{
+				Action: "create", Entity: "tag",
+				Refs:   map[string]string{"resource": ident},
+				Params: map[string]interface{}{"key": k, "value": env.AutoTags[k]},
+				Holes:  make(map[string]string),
+			}
+			if region, ok := cmd.Params[RegionMetaParam]; ok {
+				tag.Params[RegionMetaParam] = region
+			}
+			if hole, ok := cmd.Holes[RegionMetaParam]; ok {
+				tag.Holes[RegionMetaParam] = hole
+			}
+			statements = append(statements, &ast.Statement{
+				Node:        tag,
+				Annotations: map[string]string{autoTagAnnotation: ident},
+			})
+		}
+	}
+	tpl.Statements = statements
+
+	return tpl, env, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
@@ -21,7 +22,41 @@ type Env struct {
 	MissingHolesFunc func(string) interface{}
 	Log              *logger.Logger
 
+	// When Idempotent, create statements without '@idempotent' annotation are
+	// matched on the params given by IdempotencyKeysFunc for their entity.
+	// ExistingResourcesFunc returns the ids of the resources of an entity matching all given params
+	Idempotent            bool
+	IdempotencyKeysFunc   func(entity string) []string
+	ExistingResourcesFunc func(entity string, params map[string]interface{}) ([]string, error)
+
+	// AutoTags are injected as tags on the resources created by the template
+	// when AutoTagEntityFunc returns true for their entity
+	AutoTags          map[string]string
+	AutoTagEntityFunc func(entity string) bool
+
+	// ResourceExistsFunc tells whether the resource of an entity with the given id
+	// exists, to check the resources brought in the template with 'import'
+	ResourceExistsFunc func(entity, id string) (bool, error)
+
+	// VarFileFillers are the holes values loaded from a var file. They are
+	// given in Fillers as well, but each of them must match a hole of the template
+	VarFileFillers map[string]interface{}
+
+	// RegionDriverFunc returns the driver running the statements
+	// given the 'region' meta-parameter in that region
+	RegionDriverFunc func(region string) (driver.Driver, error)
+
+	// ParameterStoreFunc returns the values of the given parameters of the SSM parameter
+	// store, SecureString ones decrypted. It resolves the holes prefixed with 'ssm:'
+	// and leaves the names not found out of the returned values
+	ParameterStoreFunc func(names []string) (map[string]string, error)
+
 	processedFillers map[string]interface{}
+	redactedRefs     map[string]string
+	regionDrivers    map[string]driver.Driver
+	regionDriversMu  sync.Mutex
+	conditionalHoles map[string]bool
+	dryRun           bool
 }
 
 func NewEnv() *Env {
@@ -58,6 +93,14 @@ func (e *Env) addToProcessedFillers(fills ...map[string]interface{}) {
 	}
 }
 
+// redactRef prints the params given the reference as the hole its value was filled from
+func (e *Env) redactRef(ref, hole string) {
+	if e.redactedRefs == nil {
+		e.redactedRefs = make(map[string]string)
+	}
+	e.redactedRefs[ref] = hole
+}
+
 func (e *Env) GetProcessedFillers() (copy map[string]interface{}) {
 	copy = make(map[string]interface{}, 0)
 	for k, v := range e.processedFillers {
@@ -71,12 +114,18 @@ type Mode []compileFunc
 var (
 	LenientCompileMode = []compileFunc{
 		resolveAgainstDefinitions,
+		resolveIfSetPass,
+		checkIdempotentAnnotations,
 		checkInvalidReferenceDeclarations,
+		injectAutoTagsPass,
+		checkVarFileFillersPass,
 		resolveHolesPass,
+		resolveParameterStoreHolesPass,
 		resolveMissingHolesPass,
 		replaceVariableValuePass,
 		removeValueStatementsPass,
 		resolveAliasPass,
+		coerceParamTypesPass,
 	}
 
 	NormalCompileMode = append(
@@ -126,6 +175,9 @@ func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error)
 		return tpl, env, fmt.Errorf("definition lookup function is undefined")
 	}
 	each := func(cmd *ast.CommandNode) error {
+		if cmd.Action == importAction {
+			return checkImportParams(cmd)
+		}
 		tplKey := fmt.Sprintf("%s%s", cmd.Action, cmd.Entity)
 		def, ok := env.DefLookupFunc(tplKey)
 		if !ok {
@@ -133,6 +185,9 @@ func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error)
 		}
 
 		for _, key := range cmd.Keys() {
+			if key == RegionMetaParam {
+				continue
+			}
 			var found bool
 
 			for _, k := range def.Required() {
@@ -168,6 +223,9 @@ func resolveAgainstDefinitions(tpl *Template, env *Env) (*Template, *Env, error)
 	}
 
 	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		if cmd.Action == importAction {
+			return
+		}
 		if cmd.Holes == nil {
 			cmd.Holes = make(map[string]string)
 		}
@@ -256,10 +314,22 @@ func replaceVariableValuePass(tpl *Template, env *Env) (*Template, *Env, error)
 		}
 	})
 	tpl.visitCommandNodes(func(n *ast.CommandNode) {
+		for key, ref := range n.Refs {
+			if hole, ok := env.redactedRefs[ref]; ok {
+				n.RedactParam(key, hole)
+			}
+		}
 		n.ProcessRefs(env.ResolvedReferences)
 	})
 
-	env.Log.ExtraVerbosef("references resolved so far: %v", env.ResolvedReferences)
+	resolved := make(map[string]interface{}, len(env.ResolvedReferences))
+	for ref, val := range env.ResolvedReferences {
+		if hole, ok := env.redactedRefs[ref]; ok {
+			val = fmt.Sprintf("{%s}", hole)
+		}
+		resolved[ref] = val
+	}
+	env.Log.ExtraVerbosef("references resolved so far: %v", resolved)
 
 	return tpl, env, nil
 }
@@ -279,6 +349,33 @@ func removeValueStatementsPass(tpl *Template, env *Env) (*Template, *Env, error)
 	return newTpl, env, nil
 }
 
+func checkVarFileFillersPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	if len(env.VarFileFillers) == 0 {
+		return tpl, env, nil
+	}
+	holes := make(map[string]bool)
+	tpl.visitHoles(func(h ast.WithHoles) {
+		for _, v := range h.GetHoles() {
+			holes[v] = true
+		}
+	})
+	var unknown, expected []string
+	for k := range env.VarFileFillers {
+		if !holes[k] && !env.conditionalHoles[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) == 0 {
+		return tpl, env, nil
+	}
+	for k := range holes {
+		expected = append(expected, k)
+	}
+	sort.Strings(unknown)
+	sort.Strings(expected)
+	return tpl, env, fmt.Errorf("var file: unexpected params %s (template holes: %s)", strings.Join(unknown, ", "), strings.Join(expected, ", "))
+}
+
 func resolveHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	tpl.visitHoles(func(h ast.WithHoles) {
 		processed := h.ProcessHoles(env.Fillers)
@@ -181,6 +181,83 @@ func TestCheckInvalidReferencesDeclarationPass(t *testing.T) {
 	}
 }
 
+func TestCheckIdempotentAnnotationsPass(t *testing.T) {
+	env := NewEnv()
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{"# @idempotent name\ncreate vpc cidr=10.0.0.0/16 name=myvpc", ""},
+		{"vpc = create vpc cidr=10.0.0.0/16 name={vpc.name}\n# @idempotent name,vpc\ncreate subnet vpc=$vpc name=sub", ""},
+		{"# @idempotent id\ndelete vpc id=vpc-1234", "only applies to create statements"},
+		{"# @idempotent\ncreate vpc cidr=10.0.0.0/16", "expects the params identifying an existing resource"},
+		{"# @idempotent name\ncreate vpc cidr=10.0.0.0/16", "identifying param 'name' is not set"},
+	}
+
+	for i, tcase := range tcases {
+		_, _, err := checkIdempotentAnnotations(MustParse(tcase.tpl), env)
+		if tcase.expErr == "" && err != nil {
+			t.Fatalf("%d: %v", i+1, err)
+		}
+		if tcase.expErr != "" && (err == nil || !strings.Contains(err.Error(), tcase.expErr)) {
+			t.Fatalf("%d: got %v, expected %s", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+func TestInjectAutoTagsPass(t *testing.T) {
+	env := NewEnv()
+	env.AutoTags = map[string]string{AutoTagRunIDKey: "run-1", AutoTagTemplateKey: "infra.aws"}
+	env.AutoTagEntityFunc = func(entity string) bool { return entity == "vpc" || entity == "subnet" }
+
+	t.Run("tags declared and undeclared creations", func(t *testing.T) {
+		tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate keypair name=kp\ndelete subnet id=sub-1")
+		compiled, _, err := injectAutoTagsPass(tpl, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := "vpc = create vpc cidr=10.0.0.0/16\n" +
+			"create tag key=awless:run-id resource=$vpc value=run-1\n" +
+			"create tag key=awless:template resource=$vpc value=infra.aws\n" +
+			"subnet_autotag1 = create subnet cidr=10.0.0.0/24 vpc=$vpc\n" +
+			"create tag key=awless:run-id resource=$subnet_autotag1 value=run-1\n" +
+			"create tag key=awless:template resource=$subnet_autotag1 value=infra.aws\n" +
+			"create keypair name=kp\n" +
+			"delete subnet id=sub-1"
+		if got, want := compiled.String(), exp; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("keeps user tags", func(t *testing.T) {
+		tpl := MustParse("subnet_autotag1 = create vpc cidr=10.0.0.0/16\ncreate tag resource=$subnet_autotag1 key=awless:run-id value=mine\ncreate subnet cidr=10.0.0.0/24")
+		compiled, _, err := injectAutoTagsPass(tpl, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := "subnet_autotag1 = create vpc cidr=10.0.0.0/16\n" +
+			"create tag key=awless:template resource=$subnet_autotag1 value=infra.aws\n" +
+			"create tag key=awless:run-id resource=$subnet_autotag1 value=mine\n" +
+			"subnet_autotag2 = create subnet cidr=10.0.0.0/24\n" +
+			"create tag key=awless:run-id resource=$subnet_autotag2 value=run-1\n" +
+			"create tag key=awless:template resource=$subnet_autotag2 value=infra.aws"
+		if got, want := compiled.String(), exp; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		text := "create vpc cidr=10.0.0.0/16"
+		compiled, _, err := injectAutoTagsPass(MustParse(text), NewEnv())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := compiled.String(), text; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
 func TestResolveAgainstDefinitionsPass(t *testing.T) {
 	env := NewEnv()
 	env.DefLookupFunc = func(in string) (Definition, bool) {
@@ -292,6 +369,26 @@ func TestResolveMissingHolesPass(t *testing.T) {
 	)
 }
 
+func TestCheckVarFileFillersPass(t *testing.T) {
+	tpl := MustParse("ip = {instance.elasticip}\ncreate instance type={instance.type} ip=$ip")
+
+	env := NewEnv()
+	env.VarFileFillers = map[string]interface{}{"instance.type": "t2.micro", "instance.elasticip": "1.2.3.4"}
+	if _, _, err := checkVarFileFillersPass(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+
+	env.VarFileFillers["instance.count"] = 2
+	env.VarFileFillers["vpc.cidr"] = "10.0.0.0/16"
+	_, _, err := checkVarFileFillersPass(tpl, env)
+	if err == nil {
+		t.Fatal("expected error got none")
+	}
+	if got, want := err.Error(), "var file: unexpected params instance.count, vpc.cidr (template holes: instance.elasticip, instance.type)"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
 func TestResolveAliasPass(t *testing.T) {
 	tpl := MustParse("create instance subnet=@my-subnet ami={instance.ami} count=3")
 
@@ -333,6 +430,65 @@ func TestResolveHolesPass(t *testing.T) {
 	assertCmdParams(t, tpl, map[string]interface{}{"type": "t2.micro", "count": 3})
 }
 
+func TestCoerceParamTypesPass(t *testing.T) {
+	env := NewEnv()
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		t, ok := DefsExample[in]
+		return t, ok
+	}
+
+	t.Run("coerce holes values", func(t *testing.T) {
+		tpl := MustParse("create instance count={instance.count} lock={instance.lock} type=t2.micro")
+		env.Fillers = map[string]interface{}{"instance.count": "3", "instance.lock": "true"}
+
+		tpl, _, err := newMultiPass(resolveHolesPass, coerceParamTypesPass).compile(tpl, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCmdParams(t, tpl, map[string]interface{}{"count": 3, "lock": true, "type": "t2.micro"})
+	})
+
+	t.Run("fail on invalid values", func(t *testing.T) {
+		tcases := []struct {
+			tpl     string
+			fillers map[string]interface{}
+			expErr  string
+		}{
+			{tpl: "create instance count=abc", expErr: "create instance: param 'count': expected an integer, got 'abc'"},
+			{tpl: "create instance count=1.5", expErr: "create instance: param 'count': expected an integer, got '1.5'"},
+			{tpl: "update subnet id=sub-1 public=yes", expErr: "update subnet: param 'public': expected a boolean (true or false), got 'yes'"},
+			{tpl: "create subnet cidr={subnet.cidr} vpc=vpc-1", fillers: map[string]interface{}{"subnet.cidr": "10.0.0.300/24"}, expErr: "create subnet: param 'cidr': invalid CIDR '10.0.0.300/24'"},
+			{tpl: "create subnet cidr={subnet.cidr} vpc=vpc-1", fillers: map[string]interface{}{"subnet.cidr": "10.0.0.0"}, expErr: "create subnet: param 'cidr': invalid CIDR '10.0.0.0'"},
+		}
+		for _, tcase := range tcases {
+			env.Fillers = tcase.fillers
+			_, _, err := newMultiPass(resolveHolesPass, coerceParamTypesPass).compile(MustParse(tcase.tpl), env)
+			if err == nil {
+				t.Fatalf("%s: expected error", tcase.tpl)
+			}
+			if got, want := err.Error(), tcase.expErr; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+		}
+	})
+
+	t.Run("enum", func(t *testing.T) {
+		typ := ParamType{Kind: EnumParam, Values: []string{"authorize", "revoke"}}
+		if v, err := typ.coerce("revoke"); err != nil || v != "revoke" {
+			t.Fatalf("got %v, %v", v, err)
+		}
+		if _, err := typ.coerce("allow"); err == nil || err.Error() != "expected one of authorize, revoke, got 'allow'" {
+			t.Fatalf("got %v", err)
+		}
+	})
+
+	t.Run("aliases left to alias resolution", func(t *testing.T) {
+		if _, _, err := coerceParamTypesPass(MustParse("create instance count=@my-count"), env); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 type params map[string]interface{}
 type holes map[string]string
 type refs map[string]string
@@ -0,0 +1,141 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Statements of a reverting template are grouped in steps: the statements undoing
+// one original statement, run in sequence. A step starts once the steps given as
+// comma separated numbers in its 'after' annotation are done
+const (
+	revertStepAnnotation  = "revert-step"
+	revertAfterAnnotation = "revert-after"
+)
+
+type concurrentStep struct {
+	tpl   *Template
+	after []int
+}
+
+// RunConcurrently runs the template like Run with up to concurrency steps in flight,
+// steps being taken in template order. Once a statement fails, no step is started
+// anymore but the ones in flight complete. The ran statements are returned in
+// template order. Templates without steps or a concurrency of 1 run with Run
+func (s *Template) RunConcurrently(env *Env, concurrency int) (*Template, error) {
+	steps, ok := s.concurrentSteps()
+	if !ok || concurrency <= 1 {
+		return s.Run(env)
+	}
+
+	ran := make([]*Template, len(steps))
+	errs := make([]error, len(steps))
+	done := make([]chan struct{}, len(steps))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var mu sync.Mutex
+	var halted bool
+	isHalted := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return halted
+	}
+
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, step := range steps {
+		for _, after := range step.after {
+			<-done[after]
+		}
+		slots <- struct{}{}
+		if isHalted() {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(i int, step *concurrentStep) {
+			defer func() {
+				<-slots
+				close(done[i])
+				wg.Done()
+			}()
+			ran[i], errs[i] = step.tpl.Run(env)
+			if errs[i] != nil || ran[i].HasErrors() {
+				mu.Lock()
+				halted = true
+				mu.Unlock()
+			}
+		}(i, step)
+	}
+	wg.Wait()
+
+	current := &Template{ID: s.ID, AST: &ast.AST{}}
+	if current.ID == "" {
+		current.ID = NewRunID()
+	}
+	for _, r := range ran {
+		if r != nil {
+			current.Statements = append(current.Statements, r.Statements...)
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return current, err
+		}
+	}
+	return current, nil
+}
+
+// concurrentSteps groups the statements in steps from their annotations. The statements
+// without annotation, ex: added at compile time, belong to the step of the previous one
+func (s *Template) concurrentSteps() ([]*concurrentStep, bool) {
+	var steps []*concurrentStep
+	indexes := make(map[string]int)
+	var current string
+	for _, st := range s.Statements {
+		if _, isCmd := st.Node.(*ast.CommandNode); !isCmd {
+			return nil, false
+		}
+		id, ok := st.Annotations[revertStepAnnotation]
+		if !ok && len(steps) == 0 {
+			return nil, false
+		}
+		if ok && id != current {
+			if _, seen := indexes[id]; seen {
+				return nil, false
+			}
+			step := &concurrentStep{tpl: &Template{AST: &ast.AST{}}}
+			for _, after := range strings.Split(st.Annotations[revertAfterAnnotation], ",") {
+				if index, known := indexes[after]; known {
+					step.after = append(step.after, index)
+				}
+			}
+			indexes[id] = len(steps)
+			steps = append(steps, step)
+			current = id
+		}
+		last := steps[len(steps)-1]
+		last.tpl.Statements = append(last.tpl.Statements, st)
+	}
+	return steps, len(steps) > 0
+}
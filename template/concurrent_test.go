@@ -0,0 +1,143 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
+)
+
+type recordingDriver struct {
+	mu        sync.Mutex
+	calls     []string
+	finished  map[string]time.Time
+	started   map[string]time.Time
+	inFlight  int
+	maxFlight int
+	failOn    string
+}
+
+func (d *recordingDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	return func(ctx driver.Context, params map[string]interface{}) (interface{}, error) {
+		id, ok := params["id"].(string)
+		if !ok {
+			id, _ = params["name"].(string)
+		}
+		d.mu.Lock()
+		d.calls = append(d.calls, strings.Join(lookups, "")+" "+id)
+		d.started[id] = time.Now()
+		d.inFlight++
+		if d.inFlight > d.maxFlight {
+			d.maxFlight = d.inFlight
+		}
+		d.mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.inFlight--
+		d.finished[id] = time.Now()
+		if id == d.failOn {
+			return nil, errors.New("cannot delete " + id)
+		}
+		return nil, nil
+	}, nil
+}
+func (d *recordingDriver) SetLogger(*logger.Logger) {}
+func (d *recordingDriver) SetDryRun(bool)           {}
+
+func newRecordingDriver(failOn string) *recordingDriver {
+	return &recordingDriver{failOn: failOn, started: make(map[string]time.Time), finished: make(map[string]time.Time)}
+}
+
+func revertedForConcurrency(t *testing.T) *Template {
+	tpl := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet vpc=vpc-1\ncreate keypair name=mykey\ncreate volume size=1\ncreate securitygroup vpc=vpc-1\ncreate volume size=2")
+	for i, cmd := range tpl.CommandNodesIterator() {
+		cmd.CmdResult = []string{"vpc-1", "sub-1", "mykey", "vol-1", "sg-1", "vol-2"}[i]
+	}
+	reverted, err := tpl.Revert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reverted
+}
+
+func TestRunConcurrently(t *testing.T) {
+	t.Run("dependents first and independents in parallel", func(t *testing.T) {
+		d := newRecordingDriver("")
+		ran, err := revertedForConcurrency(t).RunConcurrently(&Env{Driver: d, Log: logger.DiscardLogger}, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(d.calls), 7; got != want {
+			t.Fatalf("got %d calls, want %d", got, want)
+		}
+		for _, id := range []string{"sub-1", "sg-1"} {
+			if !d.started["vpc-1"].After(d.finished[id]) {
+				t.Fatalf("vpc deleted before %s", id)
+			}
+		}
+		if d.maxFlight < 2 {
+			t.Fatalf("got %d deletion(s) in parallel, want at least 2", d.maxFlight)
+		}
+		var ids []string
+		for _, cmd := range ran.CommandNodesIterator() {
+			ids = append(ids, cmd.Action+" "+cmd.Entity)
+		}
+		if got, want := strings.Join(ids, ","), "delete volume,check securitygroup,delete securitygroup,delete volume,delete keypair,delete subnet,delete vpc"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("failure halts scheduling", func(t *testing.T) {
+		d := newRecordingDriver("sg-1")
+		ran, err := revertedForConcurrency(t).RunConcurrently(&Env{Driver: d, Log: logger.DiscardLogger}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := d.started["vpc-1"]; ok {
+			t.Fatal("vpc deleted after its security group failed to be")
+		}
+		if !ran.HasErrors() {
+			t.Fatal("expected errors in ran template")
+		}
+		res := NewRunResult(&TemplateExecution{}, revertedForConcurrency(t), ran, err, time.Now(), time.Now())
+		var statuses []string
+		for _, st := range res.Statements {
+			statuses = append(statuses, st.Status)
+		}
+		if got, want := statuses[len(statuses)-1], "not_run"; got != want {
+			t.Fatalf("got %s, want %s (statuses %v)", got, want, statuses)
+		}
+	})
+
+	t.Run("sequential without concurrency", func(t *testing.T) {
+		d := newRecordingDriver("")
+		if _, err := revertedForConcurrency(t).RunConcurrently(&Env{Driver: d, Log: logger.DiscardLogger}, 1); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := d.maxFlight, 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
+
+func TestRevertSteps(t *testing.T) {
+	reverted := revertedForConcurrency(t)
+	var steps, after []string
+	for _, st := range reverted.Statements {
+		steps = append(steps, st.Annotations[revertStepAnnotation])
+		after = append(after, st.Annotations[revertAfterAnnotation])
+	}
+	if got, want := strings.Join(steps, "|"), "0|1|1|2|3|4|5"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := strings.Join(after, "|"), "||||||1,4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -7,6 +7,7 @@ var DefsExample = map[string]Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"cidr", "vpc"},
 		ExtraParams:    []string{"availabilityzone", "name"},
+		ParamTypes:     map[string]ParamType{"cidr": {Kind: CIDRParam}},
 	},
 	"updatesubnet": {
 		Action:         "update",
@@ -14,6 +15,7 @@ var DefsExample = map[string]Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"public"},
+		ParamTypes:     map[string]ParamType{"public": {Kind: BoolParam}},
 	},
 	"createinstance": {
 		Action:         "create",
@@ -21,6 +23,7 @@ var DefsExample = map[string]Definition{
 		Api:            "ec2",
 		RequiredParams: []string{"image", "count", "count", "type", "subnet"},
 		ExtraParams:    []string{"keypair", "ip", "userdata", "securitygroup", "lock", "name"},
+		ParamTypes:     map[string]ParamType{"count": {Kind: IntParam}, "lock": {Kind: BoolParam}},
 	},
 	"createkeypair": {
 		Action:         "create",
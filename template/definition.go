@@ -35,6 +35,7 @@ func (defs Definitions) Map(fn func(Definition) string) (reduced []string) {
 type Definition struct {
 	Action, Entity, Api         string
 	RequiredParams, ExtraParams []string
+	ParamTypes                  map[string]ParamType
 }
 
 func (def Definition) Name() string {
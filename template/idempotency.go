@@ -0,0 +1,138 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+const idempotentAnnotation = "idempotent"
+
+// Identifying params are given explicitly with a comment annotation
+// on the line preceding the create statement (ex: '# @idempotent name,vpc')
+func annotatedIdempotencyKeys(st *ast.Statement) ([]string, bool) {
+	val, ok := st.Annotations[idempotentAnnotation]
+	if !ok {
+		return nil, false
+	}
+	var keys []string
+	for _, k := range strings.Split(val, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys, true
+}
+
+func statementCommand(st *ast.Statement) (*ast.CommandNode, bool) {
+	switch n := st.Node.(type) {
+	case *ast.CommandNode:
+		return n, true
+	case *ast.DeclarationNode:
+		cmd, ok := n.Expr.(*ast.CommandNode)
+		return cmd, ok
+	}
+	return nil, false
+}
+
+func checkIdempotentAnnotations(tpl *Template, env *Env) (*Template, *Env, error) {
+	for _, st := range tpl.Statements {
+		keys, ok := annotatedIdempotencyKeys(st)
+		if !ok {
+			continue
+		}
+		cmd, isCmd := statementCommand(st)
+		if !isCmd || cmd.Action != "create" {
+			return tpl, env, fmt.Errorf("'@%s' annotation only applies to create statements", idempotentAnnotation)
+		}
+		if len(keys) == 0 {
+			return tpl, env, fmt.Errorf("create %s: '@%s' annotation expects the params identifying an existing resource (ex: @%s name)", cmd.Entity, idempotentAnnotation, idempotentAnnotation)
+		}
+		for _, k := range keys {
+			var found bool
+			for _, key := range cmd.Keys() {
+				if key == k {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return tpl, env, fmt.Errorf("create %s: '@%s' identifying param '%s' is not set in statement", cmd.Entity, idempotentAnnotation, k)
+			}
+		}
+	}
+
+	return tpl, env, nil
+}
+
+// Returns the id of the existing resource a create statement should bind to instead of creating it
+func findExistingResource(env *Env, st *ast.Statement, cmd *ast.CommandNode) (string, bool, error) {
+	if cmd.Action != "create" {
+		return "", false, nil
+	}
+	keys, annotated := annotatedIdempotencyKeys(st)
+	if !annotated {
+		if !env.Idempotent {
+			return "", false, nil
+		}
+		if env.IdempotencyKeysFunc != nil {
+			keys = env.IdempotencyKeysFunc(cmd.Entity)
+		}
+		if len(keys) == 0 {
+			env.Log.Warningf("idempotent: no identifying params known for %s (use '@%s' annotation): creating it", cmd.Entity, idempotentAnnotation)
+			return "", false, nil
+		}
+	}
+	if env.ExistingResourcesFunc == nil {
+		return "", false, fmt.Errorf("create %s: cannot check for existing resource: no lookup available", cmd.Entity)
+	}
+
+	params := make(map[string]interface{})
+	for _, k := range keys {
+		v, ok := cmd.Params[k]
+		if !ok {
+			return "", false, fmt.Errorf("create %s: cannot check for existing resource: identifying param '%s' is not set", cmd.Entity, k)
+		}
+		params[k] = v
+	}
+
+	ids, err := env.ExistingResourcesFunc(cmd.Entity, params)
+	if err != nil {
+		return "", false, fmt.Errorf("create %s: checking for existing resource: %s", cmd.Entity, err)
+	}
+	switch len(ids) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return ids[0], true, nil
+	default:
+		return "", false, fmt.Errorf("create %s: %d existing resources match %s: %s", cmd.Entity, len(ids), printParams(params), strings.Join(ids, ", "))
+	}
+}
+
+func printParams(params map[string]interface{}) string {
+	var all []string
+	for k, v := range params {
+		all = append(all, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(all)
+	return strings.Join(all, " ")
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Statements are included only when a hole is given with:
+//
+//	ifset {key} {
+//	  create keypair name={key}
+//	}
+//
+// Before parsing, the statements of the block are annotated with '@ifset key'
+// (keys of nested blocks being all required). At compile time, the annotated
+// statements are removed when one of their keys is not given in the fillers,
+// so that their holes are not prompted and their declarations are not referenced
+const ifsetAnnotation = "ifset"
+
+var (
+	ifsetOpenRegex  = regexp.MustCompile(`^\s*ifset\s+\{\s*([a-zA-Z0-9-_.]+)\s*\}\s*\{\s*$`)
+	ifsetCloseRegex = regexp.MustCompile(`^\s*}\s*$`)
+	ifsetBlankRegex = regexp.MustCompile(`^\s*((#|//).*)?$`)
+)
+
+// expandIfSetBlocks returns the lines without the ifset block delimiters, each statement
+// of a block following its annotation. Annotations keep the number of their statement line
+func expandIfSetBlocks(lines []sourceLine) ([]sourceLine, error) {
+	var out []sourceLine
+	var keys []string
+	var openedAt []int
+	for _, line := range lines {
+		if matches := ifsetOpenRegex.FindStringSubmatch(line.text); matches != nil {
+			keys = append(keys, matches[1])
+			openedAt = append(openedAt, line.num)
+			continue
+		}
+		if len(keys) > 0 && ifsetCloseRegex.MatchString(line.text) {
+			keys, openedAt = keys[:len(keys)-1], openedAt[:len(openedAt)-1]
+			continue
+		}
+		if len(keys) > 0 && !ifsetBlankRegex.MatchString(line.text) {
+			out = append(out, sourceLine{text: fmt.Sprintf("# @%s %s", ifsetAnnotation, strings.Join(keys, ",")), num: line.num})
+		}
+		out = append(out, line)
+	}
+	if len(keys) > 0 {
+		return nil, fmt.Errorf("ifset {%s} at line %d: missing closing '}'", keys[len(keys)-1], openedAt[len(openedAt)-1])
+	}
+	return out, nil
+}
+
+func ifsetKeys(st *ast.Statement) []string {
+	v, ok := st.Annotations[ifsetAnnotation]
+	if !ok {
+		return nil
+	}
+	var keys []string
+	for _, k := range strings.Split(v, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func resolveIfSetPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	var statements []*ast.Statement
+	for _, st := range tpl.Statements {
+		keys := ifsetKeys(st)
+		active := true
+		for _, k := range keys {
+			env.addConditionalHoles(k)
+			if _, given := env.Fillers[k]; !given {
+				active = false
+			}
+		}
+		if active {
+			statements = append(statements, st)
+			continue
+		}
+		if h, ok := st.Node.(ast.WithHoles); ok {
+			env.addConditionalHoles(h.GetHoles()...)
+		} else if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			if h, ok := decl.Expr.(ast.WithHoles); ok {
+				env.addConditionalHoles(h.GetHoles()...)
+			}
+		}
+		env.Log.Verbosef("ifset: {%s} not given, skipping '%s'", strings.Join(keys, "}, {"), st)
+	}
+	tpl.Statements = statements
+
+	return tpl, env, nil
+}
+
+func (e *Env) addConditionalHoles(holes ...string) {
+	if e.conditionalHoles == nil {
+		e.conditionalHoles = make(map[string]bool)
+	}
+	for _, h := range holes {
+		e.conditionalHoles[h] = true
+	}
+}
@@ -0,0 +1,134 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpandIfSetBlocks(t *testing.T) {
+	text := "create vpc cidr=10.0.0.0/16\nifset {key} {\n  # the keypair\n  create keypair name={key}\n  ifset {subnet.cidr} {\n    create subnet cidr={subnet.cidr} vpc=vpc-1\n  }\n}\ncreate instance name=web"
+	expanded, err := expandIfSetBlocks(splitSourceLines(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "create vpc cidr=10.0.0.0/16\n  # the keypair\n# @ifset key\n  create keypair name={key}\n# @ifset key,subnet.cidr\n    create subnet cidr={subnet.cidr} vpc=vpc-1\ncreate instance name=web"
+	if got, want := joinSourceLines(expanded), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	var nums []int
+	for _, l := range expanded {
+		nums = append(nums, l.num)
+	}
+	if got, want := nums, []int{1, 3, 4, 4, 6, 6, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	tpl := MustParse(text)
+	if got, want := len(tpl.Statements), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := tpl.Statements[2].Annotations[ifsetAnnotation], "key,subnet.cidr"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	_, err = Parse("ifset {key} {\ncreate keypair name={key}")
+	if err == nil || !strings.Contains(err.Error(), "ifset {key} at line 1: missing closing '}'") {
+		t.Fatalf("got %v, want missing closing error", err)
+	}
+
+	_, err = Parse("create vpc cidr=10.0.0.0/16\nifset {key} {\n  create keypair name= wrong=\n}")
+	exp = "error parsing template at line 3 (char 24):\n\t   create vpc cidr=10.0.0.0/16\n\t   ifset {key} {\n\t->   create keypair name= w\n\t   }"
+	if err == nil || err.Error() != exp {
+		t.Fatalf("got\n%v\nwant\n%s", err, exp)
+	}
+}
+
+func TestIfSetInMacro(t *testing.T) {
+	tpl, err := Parse("def web(name) {\n  ifset {key} {\n    create keypair name={key}\n  }\n  create instance name={name}\n}\nweb(name=a)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tpl.Statements), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := tpl.Statements[0].Annotations[ifsetAnnotation], "key"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := tpl.Statements[1].String(), "create instance name=a"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, ok := tpl.Statements[1].Annotations[ifsetAnnotation]; ok {
+		t.Fatalf("got ifset annotation on statement out of the block")
+	}
+
+	_, err = Parse("def web(name) {\n  ifset {key} {\n    create keypair name={key}\n}\nweb(name=a)")
+	if err == nil || !strings.Contains(err.Error(), "macro 'web': missing closing '}'") {
+		t.Fatalf("got %v, want missing closing error", err)
+	}
+}
+
+func TestCompileIfSet(t *testing.T) {
+	text := "ifset {key} {\n  keypair = create keypair name={key}\n}\ncreate instance count=1 image=ami-1 name=web keypair=@mykey subnet=sub-1 type=t2.micro"
+
+	compile := func(fillers map[string]interface{}) (*Template, error) {
+		env := NewEnv()
+		env.DefLookupFunc = func(in string) (Definition, bool) {
+			t, ok := DefsExample[in]
+			return t, ok
+		}
+		env.AliasFunc = func(e, k, v string) string { return "key-" + v }
+		env.AddFillers(fillers)
+		tpl, _, err := Compile(MustParse(text), env)
+		return tpl, err
+	}
+
+	t.Run("statements are kept when hole is given", func(t *testing.T) {
+		tpl, err := compile(map[string]interface{}{"key": "mykey"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "keypair = create keypair name=mykey\ncreate instance count=1 image=ami-1 keypair=key-mykey name=web subnet=sub-1 type=t2.micro"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("statements are removed without prompting when hole is missing", func(t *testing.T) {
+		tpl, err := compile(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "create instance count=1 image=ami-1 keypair=key-mykey name=web subnet=sub-1 type=t2.micro"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("references of removed statements are undefined", func(t *testing.T) {
+		env := NewEnv()
+		env.DefLookupFunc = func(in string) (Definition, bool) {
+			t, ok := DefsExample[in]
+			return t, ok
+		}
+		_, _, err := Compile(MustParse("ifset {key} {\n  kp = create keypair name={key}\n}\ncreate instance count=1 image=ami-1 name=web keypair=$kp subnet=sub-1 type=t2.micro"), env)
+		if err == nil || !strings.Contains(err.Error(), "using reference '$kp' but 'kp' is undefined") {
+			t.Fatalf("got %v, want undefined reference error", err)
+		}
+	})
+
+	t.Run("var file may give holes of removed statements", func(t *testing.T) {
+		env := NewEnv()
+		env.DefLookupFunc = func(in string) (Definition, bool) {
+			t, ok := DefsExample[in]
+			return t, ok
+		}
+		env.VarFileFillers = map[string]interface{}{"subnet.cidr": "10.0.0.0/24", "instance.name": "web"}
+		env.AddFillers(env.VarFileFillers)
+		tpl, _, err := Compile(MustParse("ifset {vpc} {\n  create subnet cidr={subnet.cidr} vpc={vpc}\n}\ncreate instance count=1 image=ami-1 name={instance.name} subnet=sub-1 type=t2.micro"), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "create instance count=1 image=ami-1 name=web subnet=sub-1 type=t2.micro"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// An existing resource is brought in the template with:
+//
+//	import vpc id=vpc-123 as @myvpc
+//
+// which stands for the declaration 'myvpc = import vpc id=vpc-123'. The following
+// statements reference it either with '@myvpc' or '$myvpc'. The resource is checked
+// to exist at run time and is never reverted, as it has not been created by the template
+const importAction = "import"
+
+var importShorthandRegex = regexp.MustCompile(`(?m)^([ \t]*)import([ \t]+[a-z0-9]+[ \t]+.*?)[ \t]+as[ \t]+@([a-zA-Z0-9-_.]+)[ \t]*$`)
+
+func expandImportShorthand(text string) string {
+	return importShorthandRegex.ReplaceAllString(text, "${1}${3} = import${2}")
+}
+
+// resolveImportAliases turns the aliases naming imported resources into
+// references, so that they are not looked up among the resources by name
+func resolveImportAliases(tpl *Template) {
+	imported := make(map[string]bool)
+	for _, decl := range tpl.declarationNodesIterator() {
+		if cmd, ok := decl.Expr.(*ast.CommandNode); ok && cmd.Action == importAction {
+			imported[decl.Ident] = true
+		}
+	}
+	if len(imported) == 0 {
+		return
+	}
+	tpl.visitCommandNodes(func(cmd *ast.CommandNode) {
+		for k, v := range cmd.Params {
+			if s, ok := v.(string); ok && strings.HasPrefix(s, "@") && imported[s[1:]] {
+				cmd.Refs[k] = s[1:]
+				delete(cmd.Params, k)
+			}
+		}
+	})
+}
+
+func checkImportParams(cmd *ast.CommandNode) error {
+	for _, key := range cmd.Keys() {
+		if key != "id" {
+			return fmt.Errorf("import %s: unexpected param key '%s'\n\t- required params: id\n", cmd.Entity, key)
+		}
+	}
+	if cmd.Holes == nil {
+		cmd.Holes = make(map[string]string)
+	}
+	_, isParam := cmd.Params["id"]
+	_, isRef := cmd.Refs["id"]
+	if _, isHole := cmd.Holes["id"]; !isParam && !isRef && !isHole {
+		cmd.Holes["id"] = cmd.Entity + ".id"
+	}
+	return nil
+}
+
+// importResource returns the id of the imported resource, failing when it does not exist
+func importResource(env *Env, cmd *ast.CommandNode) (interface{}, error) {
+	id := fmt.Sprint(cmd.Params["id"])
+	if env.ResourceExistsFunc == nil {
+		return nil, fmt.Errorf("import %s: cannot check resource '%s': no lookup available", cmd.Entity, id)
+	}
+	exists, err := env.ResourceExistsFunc(cmd.Entity, id)
+	if err != nil {
+		return nil, fmt.Errorf("import %s: checking resource '%s': %s", cmd.Entity, id, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("import %s: resource '%s' not found", cmd.Entity, id)
+	}
+	env.Log.Verbosef("import %s: referencing existing '%s'", cmd.Entity, id)
+	return id, nil
+}
@@ -0,0 +1,96 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImport(t *testing.T) {
+	tpl, err := Parse("import vpc id=vpc-123 as @myvpc\ncreate subnet cidr=10.0.0.0/24 vpc=@myvpc name=@other\nimport subnet id=sub-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "myvpc = import vpc id=vpc-123\ncreate subnet cidr=10.0.0.0/24 name=@other vpc=$myvpc\nimport subnet id=sub-1"
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCompileImport(t *testing.T) {
+	env := NewEnv()
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		t, ok := DefsExample[in]
+		return t, ok
+	}
+
+	tpl := MustParse("import vpc id=vpc-123 as @myvpc\nimport subnet")
+	if _, _, err := resolveAgainstDefinitions(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+	cmds := tpl.CommandNodesIterator()
+	if got, want := len(cmds[0].Holes), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := cmds[1].Holes, map[string]string{"id": "subnet.id"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	_, _, err := resolveAgainstDefinitions(MustParse("import vpc id=vpc-123 name=myvpc"), env)
+	if err == nil || !strings.Contains(err.Error(), "unexpected param key 'name'") {
+		t.Fatalf("got %v, want unexpected param error", err)
+	}
+}
+
+func TestRunImport(t *testing.T) {
+	exists := func(entity, id string) (bool, error) {
+		return entity == "vpc" && id == "vpc-123", nil
+	}
+
+	t.Run("imported resource is referenced", func(t *testing.T) {
+		env := NewEnv()
+		env.ResourceExistsFunc = exists
+		env.Driver = &mockDriver{prefix: "mynew", expects: []*expectation{{
+			action: "create", entity: "subnet",
+			expectedParams: map[string]interface{}{"cidr": "10.0.0.0/24", "vpc": "vpc-123"},
+		}}}
+
+		ran, err := MustParse("import vpc id=vpc-123 as @myvpc\ncreate subnet cidr=10.0.0.0/24 vpc=@myvpc").Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds := ran.CommandNodesIterator()
+		for _, cmd := range cmds {
+			if cmd.CmdErr != nil {
+				t.Fatal(cmd.CmdErr)
+			}
+		}
+		if got, want := cmds[0].CmdResult, "vpc-123"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if isRevertible(cmds[0]) {
+			t.Fatal("expected imported resource not to be revertible")
+		}
+		if !isRevertible(cmds[1]) {
+			t.Fatal("expected created resource to be revertible")
+		}
+	})
+
+	t.Run("fails on unknown resource", func(t *testing.T) {
+		env := NewEnv()
+		env.ResourceExistsFunc = exists
+		env.Driver = &noopDriver{}
+
+		ran, err := MustParse("import vpc id=vpc-404 as @myvpc\ncreate subnet cidr=10.0.0.0/24 vpc=@myvpc").Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds := ran.CommandNodesIterator()
+		if got, want := len(cmds), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if cmds[0].CmdErr == nil || cmds[0].CmdErr.Error() != "import vpc: resource 'vpc-404' not found" {
+			t.Fatalf("got %v, want not found error", cmds[0].CmdErr)
+		}
+	})
+}
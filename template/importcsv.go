@@ -0,0 +1,118 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// CSVImport generates a template importing the resources listed in a CSV with a header row.
+// Each row gives the id of a resource in IDColumn and the name of its reference in NameColumn,
+// the id naming the reference when NameColumn is empty
+type CSVImport struct {
+	Entity     string
+	IDColumn   string
+	NameColumn string
+	Source     string
+}
+
+var invalidReferenceChars = regexp.MustCompile(`[^a-zA-Z0-9-_.]+`)
+
+// Generate returns the text of the template, one 'import ENTITY id=ID as @NAME' statement per row.
+// Reference names are sanitized and suffixed with '-2', '-3', ... when several rows give the same name
+func (c *CSVImport) Generate(r io.Reader) (string, int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return "", 0, fmt.Errorf("import csv: missing header row")
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("import csv: %s", err)
+	}
+	idIndex, err := csvColumnIndex(header, c.IDColumn)
+	if err != nil {
+		return "", 0, err
+	}
+	nameIndex := idIndex
+	if c.NameColumn != "" {
+		if nameIndex, err = csvColumnIndex(header, c.NameColumn); err != nil {
+			return "", 0, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if c.Source != "" {
+		fmt.Fprintf(&buf, "# @description Import the %ss listed in %s\n", c.Entity, c.Source)
+	} else {
+		fmt.Fprintf(&buf, "# @description Import %ss\n", c.Entity)
+	}
+	taken := make(map[string]bool)
+	var count int
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, fmt.Errorf("import csv: %s", err)
+		}
+		id := strings.TrimSpace(record[idIndex])
+		if id == "" {
+			return "", 0, fmt.Errorf("import csv: row %d: empty '%s' column", row, header[idIndex])
+		}
+		name := referenceName(record[nameIndex])
+		if name == "" {
+			name = referenceName(id)
+		}
+		unique := name
+		for i := 2; taken[unique]; i++ {
+			unique = fmt.Sprintf("%s-%d", name, i)
+		}
+		taken[unique] = true
+		fmt.Fprintf(&buf, "import %s id=%s as @%s\n", c.Entity, quoteParamIfNeeded(id), unique)
+		count++
+	}
+	if count == 0 {
+		return "", 0, fmt.Errorf("import csv: no resource listed after the header row")
+	}
+
+	text := buf.String()
+	if _, err := Parse(text); err != nil {
+		return "", 0, fmt.Errorf("import csv: generated template: %s", err)
+	}
+	return text, count, nil
+}
+
+func csvColumnIndex(header []string, column string) (int, error) {
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), column) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("import csv: no column '%s' in header %s", column, strings.Join(header, ","))
+}
+
+func referenceName(s string) string {
+	return strings.Trim(invalidReferenceChars.ReplaceAllString(strings.TrimSpace(s), "-"), "-")
+}
@@ -0,0 +1,64 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCSVImport(t *testing.T) {
+	t.Run("names from column", func(t *testing.T) {
+		csv := "InstanceId,Name,Owner\ni-1,web,ops\ni-2,\"web\",ops\n i-3 ,\"db, primary\",dba\ni-4,,dev\ni-5,web-2,ops\n"
+		text, count, err := (&CSVImport{Entity: "instance", IDColumn: "instanceid", NameColumn: "Name", Source: "fleet.csv"}).Generate(strings.NewReader(csv))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := count, 5; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		expected := `# @description Import the instances listed in fleet.csv
+import instance id=i-1 as @web
+import instance id=i-2 as @web-2
+import instance id=i-3 as @db-primary
+import instance id=i-4 as @i-4
+import instance id=i-5 as @web-2-2
+`
+		if got, want := text, expected; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+		tpl, err := Parse(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "web = import instance id=i-1\nweb-2 = import instance id=i-2\ndb-primary = import instance id=i-3\ni-4 = import instance id=i-4\nweb-2-2 = import instance id=i-5"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("names from ids", func(t *testing.T) {
+		text, _, err := (&CSVImport{Entity: "volume", IDColumn: "id"}).Generate(strings.NewReader("id\nvol-1\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := text, "# @description Import volumes\nimport volume id=vol-1 as @vol-1\n"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tcases := []struct {
+			csv, column, expect string
+		}{
+			{csv: "", column: "id", expect: "missing header row"},
+			{csv: "name\nweb\n", column: "id", expect: "no column 'id'"},
+			{csv: "id\n", column: "id", expect: "no resource listed"},
+			{csv: "id,name\ni-1,web\n,db\n", column: "id", expect: "row 3: empty 'id' column"},
+			{csv: "id,name\ni-1\n", column: "id", expect: "wrong number of fields"},
+		}
+		for _, tcase := range tcases {
+			_, _, err := (&CSVImport{Entity: "instance", IDColumn: tcase.column}).Generate(strings.NewReader(tcase.csv))
+			if err == nil || !strings.Contains(err.Error(), tcase.expect) {
+				t.Fatalf("%q: got %v, want error containing %s", tcase.csv, err, tcase.expect)
+			}
+		}
+	})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bufio"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// A template documents itself with a directive in its header, the comments before its first statement:
+//
+//	// @description Create a VPC with a public subnet
+//
+// Several description directives are joined in a single description
+const descriptionAnnotation = "description"
+
+var (
+	descriptionRegex = regexp.MustCompile(`^\s*(?:#|//)\s*@` + descriptionAnnotation + `\b\s*(.*)$`)
+	headerLineRegex  = regexp.MustCompile(`^\s*((#|//).*)?$`)
+)
+
+// Info documents a template with its description and the params to give when running it
+type Info struct {
+	Description string
+	Groups      []*ParamGroup
+}
+
+// ParamGroup gathers the params of a template sharing the same prefix (ex: 'instance' for 'instance.type')
+type ParamGroup struct {
+	Name   string
+	Params []*InfoParam
+}
+
+// InfoParam is a hole of a template, optional when only filling statements included with 'ifset'
+type InfoParam struct {
+	Name     string
+	Optional bool
+}
+
+// Description returns the description given in the header of a template text
+func Description(text string) string {
+	var descs []string
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !headerLineRegex.MatchString(line) {
+			break
+		}
+		if m := descriptionRegex.FindStringSubmatch(line); m != nil && strings.TrimSpace(m[1]) != "" {
+			descs = append(descs, strings.TrimSpace(m[1]))
+		}
+	}
+	return strings.Join(descs, " ")
+}
+
+// NewInfo describes a template text, resolving its params against the definitions of the env
+func NewInfo(text string, env *Env) (*Info, error) {
+	tpl, err := Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, env, err = Compile(tpl, env, Mode{resolveAgainstDefinitions, resolveIfSetPass}); err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool)
+	tpl.visitHoles(func(h ast.WithHoles) {
+		for _, v := range h.GetHoles() {
+			required[v] = true
+		}
+	})
+	params := make(map[string]bool)
+	for h := range required {
+		params[h] = false
+	}
+	for h := range env.conditionalHoles {
+		if !required[h] {
+			params[h] = true
+		}
+	}
+
+	info := &Info{Description: Description(text)}
+	groups := make(map[string]*ParamGroup)
+	var names []string
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		groupName := name
+		if i := strings.Index(name, "."); i > 0 {
+			groupName = name[:i]
+		}
+		group, ok := groups[groupName]
+		if !ok {
+			group = &ParamGroup{Name: groupName}
+			groups[groupName] = group
+			info.Groups = append(info.Groups, group)
+		}
+		group.Params = append(group.Params, &InfoParam{Name: name, Optional: params[name]})
+	}
+	sort.Slice(info.Groups, func(i, j int) bool { return info.Groups[i].Name < info.Groups[j].Name })
+	return info, nil
+}
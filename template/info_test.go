@@ -0,0 +1,60 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescription(t *testing.T) {
+	tcases := []struct {
+		text, exp string
+	}{
+		{text: "// @description Create a subnet\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1", exp: "Create a subnet"},
+		{text: "# Title: subnet\n\n# @description Create a subnet\n# @description in a VPC\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1", exp: "Create a subnet in a VPC"},
+		{text: "create subnet cidr=10.0.0.0/24 vpc=vpc-1\n# @description not in header\ncreate subnet cidr=10.0.1.0/24 vpc=vpc-1", exp: ""},
+		{text: "# @descriptions typo\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1", exp: ""},
+	}
+	for i, tcase := range tcases {
+		if got, want := Description(tcase.text), tcase.exp; got != want {
+			t.Fatalf("%d: got %q, want %q", i+1, got, want)
+		}
+	}
+}
+
+func TestNewInfo(t *testing.T) {
+	env := NewEnv()
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		t, ok := DefsExample[in]
+		return t, ok
+	}
+	text := `// @description Create an instance in a subnet
+create subnet cidr={subnet.cidr} vpc=vpc-1
+create instance image=ami-1 count=1 type={instance.type} subnet=sub-1
+ifset {keypair.name} {
+  create keypair name={keypair.name}
+  create tag resource=sub-1 key=keypair value={keypair.name}
+}`
+
+	info, err := NewInfo(text, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := info.Description, "Create an instance in a subnet"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	exp := []*ParamGroup{
+		{Name: "instance", Params: []*InfoParam{{Name: "instance.type"}}},
+		{Name: "keypair", Params: []*InfoParam{{Name: "keypair.name", Optional: true}}},
+		{Name: "subnet", Params: []*InfoParam{{Name: "subnet.cidr"}}},
+	}
+	if got, want := info.Groups, exp; !reflect.DeepEqual(got, want) {
+		for _, g := range got {
+			t.Logf("%s: %+v", g.Name, g.Params)
+		}
+		t.Fatal("unexpected groups")
+	}
+
+	if _, err := NewInfo("create subnet cidr=10.0.0.0/24 unknown=1", env); err == nil {
+		t.Fatal("expected error on unexpected param")
+	}
+}
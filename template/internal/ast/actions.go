@@ -11,6 +11,7 @@ const (
 	Update Action = "update"
 
 	Check Action = "check"
+	Wait  Action = "wait"
 
 	Start Action = "start"
 	Stop  Action = "stop"
@@ -30,6 +31,7 @@ var actions = map[Action]struct{}{
 	Delete:       {},
 	Update:       {},
 	Check:        {},
+	Wait:         {},
 	Start:        {},
 	Stop:         {},
 	Attach:       {},
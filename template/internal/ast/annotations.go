@@ -0,0 +1,74 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ast
+
+import (
+	"regexp"
+	"strings"
+)
+
+var annotationRegex = regexp.MustCompile(`^(?:#|//)\s*@([a-zA-Z-]+)\s*(.*)$`)
+
+// AddAnnotations walks the parsed tokens to attach comment directives
+// (ex: '# @idempotent name,vpc') to the statement following them,
+// or to the statement ending the line for trailing comments
+func (p *Peg) AddAnnotations() {
+	var index int
+	var nodeEnd uint32
+	var hasNode bool
+	var comments []token32
+	pending := make(map[string]string)
+
+	annotate := func(st *Statement, key, val string) {
+		if st.Annotations == nil {
+			st.Annotations = make(map[string]string)
+		}
+		st.Annotations[key] = val
+	}
+
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+		case ruleComment:
+			comments = append(comments, token)
+		case ruleCmdExpr, ruleDeclaration:
+			hasNode = true
+			nodeEnd = token.end
+		case ruleStatement:
+			for _, c := range comments {
+				m := annotationRegex.FindStringSubmatch(strings.TrimSpace(string(p.buffer[c.begin:c.end])))
+				if m == nil {
+					continue
+				}
+				trailing := index > 0 && !strings.ContainsAny(string(p.buffer[nodeEnd:c.begin]), "\r\n")
+				if trailing && !hasNode {
+					annotate(p.Statements[index-1], m[1], strings.TrimSpace(m[2]))
+				} else {
+					pending[m[1]] = strings.TrimSpace(m[2])
+				}
+			}
+			comments = nil
+			if hasNode && index < len(p.Statements) {
+				for k, v := range pending {
+					annotate(p.Statements[index], k, v)
+				}
+				pending = make(map[string]string)
+				index++
+				hasNode = false
+			}
+		}
+	}
+}
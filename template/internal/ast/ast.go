@@ -39,6 +39,12 @@ type AST struct {
 
 type Statement struct {
 	Node
+
+	// from '@key value' comment directives preceding the statement
+	Annotations map[string]string
+
+	// rendered as '# ' prefixed lines above the statement
+	Comments []string
 }
 
 type DeclarationNode struct {
@@ -58,13 +64,18 @@ type WithHoles interface {
 }
 
 type CommandNode struct {
-	CmdResult interface{}
-	CmdErr    error
+	CmdResult  interface{}
+	CmdErr     error
+	CmdSkipped bool // creation skipped, CmdResult being an existing resource
 
 	Action, Entity string
 	Refs           map[string]string
 	Params         map[string]interface{}
 	Holes          map[string]string
+
+	// RedactedParams are params whose values are not to be displayed (ex: secrets),
+	// printed as the hole they were filled from
+	RedactedParams map[string]string
 }
 
 func (n *CommandNode) Result() interface{} { return n.CmdResult }
@@ -133,6 +144,13 @@ func (n *ValueNode) GetHoles() (holes []string) {
 func (s *Statement) Clone() *Statement {
 	newStat := &Statement{}
 	newStat.Node = s.Node.clone()
+	if s.Annotations != nil {
+		newStat.Annotations = make(map[string]string)
+		for k, v := range s.Annotations {
+			newStat.Annotations[k] = v
+		}
+	}
+	newStat.Comments = append(newStat.Comments, s.Comments...)
 
 	return newStat
 }
@@ -140,6 +158,9 @@ func (s *Statement) Clone() *Statement {
 func (a *AST) String() string {
 	var all []string
 	for _, stat := range a.Statements {
+		for _, comment := range stat.Comments {
+			all = append(all, "# "+comment)
+		}
 		all = append(all, stat.String())
 	}
 	return strings.Join(all, "\n")
@@ -173,16 +194,31 @@ func (n *CommandNode) clone() Node {
 	for k, v := range n.Holes {
 		cmd.Holes[k] = v
 	}
+	for k, v := range n.RedactedParams {
+		cmd.RedactParam(k, v)
+	}
 
 	return cmd
 }
 
+// RedactParam prints the param as the given hole instead of its value
+func (n *CommandNode) RedactParam(key, hole string) {
+	if n.RedactedParams == nil {
+		n.RedactedParams = make(map[string]string)
+	}
+	n.RedactedParams[key] = hole
+}
+
 func (n *CommandNode) String() string {
 	var all []string
 	for k, v := range n.Refs {
 		all = append(all, fmt.Sprintf("%s=$%s", k, v))
 	}
 	for k, v := range n.Params {
+		if hole, ok := n.RedactedParams[k]; ok {
+			all = append(all, fmt.Sprintf("%s={%s}", k, hole))
+			continue
+		}
 		all = append(all, fmt.Sprintf("%s=%s", k, printParamValue(v)))
 	}
 	for k, v := range n.Holes {
@@ -21,6 +21,7 @@ const (
 	ruleDeclaration
 	ruleValueExpr
 	ruleCmdExpr
+	ruleEntityValue
 	ruleParams
 	ruleParam
 	ruleIdentifier
@@ -67,6 +68,7 @@ const (
 	ruleAction16
 	ruleAction17
 	ruleAction18
+	ruleAction19
 )
 
 var rul3s = [...]string{
@@ -78,6 +80,7 @@ var rul3s = [...]string{
 	"Declaration",
 	"ValueExpr",
 	"CmdExpr",
+	"EntityValue",
 	"Params",
 	"Param",
 	"Identifier",
@@ -124,6 +127,7 @@ var rul3s = [...]string{
 	"Action16",
 	"Action17",
 	"Action18",
+	"Action19",
 }
 
 type token32 struct {
@@ -240,7 +244,7 @@ type Peg struct {
 
 	Buffer string
 	buffer []rune
-	rules  [54]func() bool
+	rules  [56]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -345,31 +349,33 @@ func (p *Peg) Execute() {
 		case ruleAction5:
 			p.LineDone()
 		case ruleAction6:
-			p.addParamKey(text)
+			p.addEntityValueKey()
 		case ruleAction7:
-			p.addParamHoleValue(text)
+			p.addParamKey(text)
 		case ruleAction8:
-			p.addAliasParam(text)
+			p.addParamHoleValue(text)
 		case ruleAction9:
-			p.addStringValue(text)
+			p.addAliasParam(text)
 		case ruleAction10:
 			p.addStringValue(text)
 		case ruleAction11:
-			p.addParamValue(text)
+			p.addStringValue(text)
 		case ruleAction12:
-			p.addParamRefValue(text)
+			p.addParamValue(text)
 		case ruleAction13:
-			p.addParamCidrValue(text)
+			p.addParamRefValue(text)
 		case ruleAction14:
-			p.addParamIpValue(text)
+			p.addParamCidrValue(text)
 		case ruleAction15:
-			p.addCsvValue(text)
+			p.addParamIpValue(text)
 		case ruleAction16:
-			p.addParamValue(text)
+			p.addCsvValue(text)
 		case ruleAction17:
-			p.LineDone()
+			p.addParamValue(text)
 		case ruleAction18:
 			p.LineDone()
+		case ruleAction19:
+			p.LineDone()
 
 		}
 	}
@@ -573,7 +579,7 @@ func (p *Peg) Init() {
 									position, tokenIndex = position25, tokenIndex25
 								}
 								{
-									add(ruleAction17, position)
+									add(ruleAction18, position)
 								}
 							}
 						l19:
@@ -733,7 +739,7 @@ func (p *Peg) Init() {
 										position, tokenIndex = position53, tokenIndex53
 									}
 									{
-										add(ruleAction17, position)
+										add(ruleAction18, position)
 									}
 								}
 							l47:
@@ -803,7 +809,7 @@ func (p *Peg) Init() {
 		nil,
 		/* 5 ValueExpr <- <(Action1 NoRefValue Action2)> */
 		nil,
-		/* 6 CmdExpr <- <(<Action> Action3 MustWhiteSpacing <Entity> Action4 (MustWhiteSpacing Params)? Action5)> */
+		/* 6 CmdExpr <- <(<Action> Action3 MustWhiteSpacing <Entity> Action4 EntityValue? (MustWhiteSpacing Params)? Action5)> */
 		func() bool {
 			position67, tokenIndex67 := position, tokenIndex
 			{
@@ -887,129 +893,91 @@ func (p *Peg) Init() {
 				}
 				{
 					position83, tokenIndex83 := position, tokenIndex
+					{
+						position85 := position
+						if !_rules[ruleEqual]() {
+							goto l83
+						}
+						{
+							add(ruleAction6, position)
+						}
+						if !_rules[ruleValue]() {
+							goto l83
+						}
+						add(ruleEntityValue, position85)
+					}
+					goto l84
+				l83:
+					position, tokenIndex = position83, tokenIndex83
+				}
+			l84:
+				{
+					position87, tokenIndex87 := position, tokenIndex
 					if !_rules[ruleMustWhiteSpacing]() {
-						goto l83
+						goto l87
 					}
 					{
-						position85 := position
+						position89 := position
 						{
-							position88 := position
+							position92 := position
 							{
-								position89 := position
+								position93 := position
 								if !_rules[ruleIdentifier]() {
-									goto l83
+									goto l87
 								}
-								add(rulePegText, position89)
+								add(rulePegText, position93)
 							}
 							{
-								add(ruleAction6, position)
+								add(ruleAction7, position)
 							}
 							if !_rules[ruleEqual]() {
-								goto l83
+								goto l87
 							}
-							{
-								position91 := position
-								{
-									position92, tokenIndex92 := position, tokenIndex
-									{
-										position94 := position
-										if buffer[position] != rune('$') {
-											goto l93
-										}
-										position++
-										{
-											position95 := position
-											if !_rules[ruleIdentifier]() {
-												goto l93
-											}
-											add(rulePegText, position95)
-										}
-										add(ruleRefValue, position94)
-									}
-									{
-										add(ruleAction12, position)
-									}
-									goto l92
-								l93:
-									position, tokenIndex = position92, tokenIndex92
-									if !_rules[ruleNoRefValue]() {
-										goto l83
-									}
-								}
-							l92:
-								add(ruleValue, position91)
+							if !_rules[ruleValue]() {
+								goto l87
 							}
 							if !_rules[ruleWhiteSpacing]() {
-								goto l83
+								goto l87
 							}
-							add(ruleParam, position88)
+							add(ruleParam, position92)
 						}
-					l86:
+					l90:
 						{
-							position87, tokenIndex87 := position, tokenIndex
+							position91, tokenIndex91 := position, tokenIndex
 							{
-								position97 := position
+								position95 := position
 								{
-									position98 := position
+									position96 := position
 									if !_rules[ruleIdentifier]() {
-										goto l87
+										goto l91
 									}
-									add(rulePegText, position98)
+									add(rulePegText, position96)
 								}
 								{
-									add(ruleAction6, position)
+									add(ruleAction7, position)
 								}
 								if !_rules[ruleEqual]() {
-									goto l87
+									goto l91
 								}
-								{
-									position100 := position
-									{
-										position101, tokenIndex101 := position, tokenIndex
-										{
-											position103 := position
-											if buffer[position] != rune('$') {
-												goto l102
-											}
-											position++
-											{
-												position104 := position
-												if !_rules[ruleIdentifier]() {
-													goto l102
-												}
-												add(rulePegText, position104)
-											}
-											add(ruleRefValue, position103)
-										}
-										{
-											add(ruleAction12, position)
-										}
-										goto l101
-									l102:
-										position, tokenIndex = position101, tokenIndex101
-										if !_rules[ruleNoRefValue]() {
-											goto l87
-										}
-									}
-								l101:
-									add(ruleValue, position100)
+								if !_rules[ruleValue]() {
+									goto l91
 								}
 								if !_rules[ruleWhiteSpacing]() {
-									goto l87
+									goto l91
 								}
-								add(ruleParam, position97)
+								add(ruleParam, position95)
 							}
-							goto l86
-						l87:
-							position, tokenIndex = position87, tokenIndex87
+							goto l90
+						l91:
+							position, tokenIndex = position91, tokenIndex91
 						}
-						add(ruleParams, position85)
+						add(ruleParams, position89)
 					}
-					goto l84
-				l83:
-					position, tokenIndex = position83, tokenIndex83
+					goto l88
+				l87:
+					position, tokenIndex = position87, tokenIndex87
 				}
-			l84:
+			l88:
 				{
 					add(ruleAction5, position)
 				}
@@ -1020,308 +988,366 @@ func (p *Peg) Init() {
 			position, tokenIndex = position67, tokenIndex67
 			return false
 		},
-		/* 7 Params <- <Param+> */
+		/* 7 EntityValue <- <(Equal Action6 Value)> */
 		nil,
-		/* 8 Param <- <(<Identifier> Action6 Equal Value WhiteSpacing)> */
+		/* 8 Params <- <Param+> */
 		nil,
-		/* 9 Identifier <- <((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
+		/* 9 Param <- <(<Identifier> Action7 Equal Value WhiteSpacing)> */
+		nil,
+		/* 10 Identifier <- <((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
 		func() bool {
-			position109, tokenIndex109 := position, tokenIndex
+			position102, tokenIndex102 := position, tokenIndex
 			{
-				position110 := position
+				position103 := position
 				{
 					switch buffer[position] {
 					case '.':
 						if buffer[position] != rune('.') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					case '_':
 						if buffer[position] != rune('_') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					case '-':
 						if buffer[position] != rune('-') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					default:
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l109
+							goto l102
 						}
 						position++
 						break
 					}
 				}
 
-			l111:
+			l104:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position105, tokenIndex105 := position, tokenIndex
 					{
 						switch buffer[position] {
 						case '.':
 							if buffer[position] != rune('.') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l112
+								goto l105
 							}
 							position++
 							break
 						}
 					}
 
-					goto l111
-				l112:
-					position, tokenIndex = position112, tokenIndex112
+					goto l104
+				l105:
+					position, tokenIndex = position105, tokenIndex105
 				}
-				add(ruleIdentifier, position110)
+				add(ruleIdentifier, position103)
 			}
 			return true
-		l109:
-			position, tokenIndex = position109, tokenIndex109
+		l102:
+			position, tokenIndex = position102, tokenIndex102
 			return false
 		},
-		/* 10 NoRefValue <- <((AliasValue Action8) / (DoubleQuote CustomTypedValue DoubleQuote) / (SingleQuote CustomTypedValue SingleQuote) / CustomTypedValue / ((&('\'') (SingleQuote <SingleQuotedValue> Action10 SingleQuote)) | (&('"') (DoubleQuote <DoubleQuotedValue> Action9 DoubleQuote)) | (&('{') (HoleValue Action7)) | (&('*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | ';' | '<' | '>' | '@' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '~') (<OtherParamValue> Action11))))> */
+		/* 11 NoRefValue <- <((AliasValue Action9) / (DoubleQuote CustomTypedValue DoubleQuote) / (SingleQuote CustomTypedValue SingleQuote) / CustomTypedValue / ((&('\'') (SingleQuote <SingleQuotedValue> Action11 SingleQuote)) | (&('"') (DoubleQuote <DoubleQuotedValue> Action10 DoubleQuote)) | (&('{') (HoleValue Action8)) | (&('*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | ';' | '<' | '>' | '@' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '~') (<OtherParamValue> Action12))))> */
 		func() bool {
-			position115, tokenIndex115 := position, tokenIndex
+			position108, tokenIndex108 := position, tokenIndex
 			{
-				position116 := position
+				position109 := position
 				{
-					position117, tokenIndex117 := position, tokenIndex
+					position110, tokenIndex110 := position, tokenIndex
 					{
-						position119 := position
+						position112 := position
 						{
-							position120, tokenIndex120 := position, tokenIndex
+							position113, tokenIndex113 := position, tokenIndex
 							if buffer[position] != rune('@') {
-								goto l121
+								goto l114
 							}
 							position++
 							{
-								position122 := position
+								position115 := position
 								if !_rules[ruleOtherParamValue]() {
-									goto l121
+									goto l114
 								}
-								add(rulePegText, position122)
+								add(rulePegText, position115)
 							}
-							goto l120
-						l121:
-							position, tokenIndex = position120, tokenIndex120
+							goto l113
+						l114:
+							position, tokenIndex = position113, tokenIndex113
 							if buffer[position] != rune('@') {
-								goto l123
+								goto l116
 							}
 							position++
 							if !_rules[ruleDoubleQuote]() {
-								goto l123
+								goto l116
 							}
 							{
-								position124 := position
+								position117 := position
 								if !_rules[ruleDoubleQuotedValue]() {
-									goto l123
+									goto l116
 								}
-								add(rulePegText, position124)
+								add(rulePegText, position117)
 							}
 							if !_rules[ruleDoubleQuote]() {
-								goto l123
+								goto l116
 							}
-							goto l120
-						l123:
-							position, tokenIndex = position120, tokenIndex120
+							goto l113
+						l116:
+							position, tokenIndex = position113, tokenIndex113
 							if buffer[position] != rune('@') {
-								goto l118
+								goto l111
 							}
 							position++
 							if !_rules[ruleSingleQuote]() {
-								goto l118
+								goto l111
 							}
 							{
-								position125 := position
+								position118 := position
 								if !_rules[ruleSingleQuotedValue]() {
-									goto l118
+									goto l111
 								}
-								add(rulePegText, position125)
+								add(rulePegText, position118)
 							}
 							if !_rules[ruleSingleQuote]() {
-								goto l118
+								goto l111
 							}
 						}
-					l120:
-						add(ruleAliasValue, position119)
+					l113:
+						add(ruleAliasValue, position112)
 					}
 					{
-						add(ruleAction8, position)
+						add(ruleAction9, position)
 					}
-					goto l117
-				l118:
-					position, tokenIndex = position117, tokenIndex117
+					goto l110
+				l111:
+					position, tokenIndex = position110, tokenIndex110
 					if !_rules[ruleDoubleQuote]() {
-						goto l127
+						goto l120
 					}
 					if !_rules[ruleCustomTypedValue]() {
-						goto l127
+						goto l120
 					}
 					if !_rules[ruleDoubleQuote]() {
-						goto l127
+						goto l120
 					}
-					goto l117
-				l127:
-					position, tokenIndex = position117, tokenIndex117
+					goto l110
+				l120:
+					position, tokenIndex = position110, tokenIndex110
 					if !_rules[ruleSingleQuote]() {
-						goto l128
+						goto l121
 					}
 					if !_rules[ruleCustomTypedValue]() {
-						goto l128
+						goto l121
 					}
 					if !_rules[ruleSingleQuote]() {
-						goto l128
+						goto l121
 					}
-					goto l117
-				l128:
-					position, tokenIndex = position117, tokenIndex117
+					goto l110
+				l121:
+					position, tokenIndex = position110, tokenIndex110
 					if !_rules[ruleCustomTypedValue]() {
-						goto l129
+						goto l122
 					}
-					goto l117
-				l129:
-					position, tokenIndex = position117, tokenIndex117
+					goto l110
+				l122:
+					position, tokenIndex = position110, tokenIndex110
 					{
 						switch buffer[position] {
 						case '\'':
 							if !_rules[ruleSingleQuote]() {
-								goto l115
+								goto l108
 							}
 							{
-								position131 := position
+								position124 := position
 								if !_rules[ruleSingleQuotedValue]() {
-									goto l115
+									goto l108
 								}
-								add(rulePegText, position131)
+								add(rulePegText, position124)
 							}
 							{
-								add(ruleAction10, position)
+								add(ruleAction11, position)
 							}
 							if !_rules[ruleSingleQuote]() {
-								goto l115
+								goto l108
 							}
 							break
 						case '"':
 							if !_rules[ruleDoubleQuote]() {
-								goto l115
+								goto l108
 							}
 							{
-								position133 := position
+								position126 := position
 								if !_rules[ruleDoubleQuotedValue]() {
-									goto l115
+									goto l108
 								}
-								add(rulePegText, position133)
+								add(rulePegText, position126)
 							}
 							{
-								add(ruleAction9, position)
+								add(ruleAction10, position)
 							}
 							if !_rules[ruleDoubleQuote]() {
-								goto l115
+								goto l108
 							}
 							break
 						case '{':
 							{
-								position135 := position
+								position128 := position
 								if buffer[position] != rune('{') {
-									goto l115
+									goto l108
 								}
 								position++
 								if !_rules[ruleWhiteSpacing]() {
-									goto l115
+									goto l108
 								}
 								{
-									position136 := position
-									if !_rules[ruleIdentifier]() {
-										goto l115
+									position129 := position
+									{
+										position130, tokenIndex130 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											goto l131
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l131
+										}
+										position++
+										if buffer[position] != rune('m') {
+											goto l131
+										}
+										position++
+										if buffer[position] != rune(':') {
+											goto l131
+										}
+										position++
+										{
+											position134, tokenIndex134 := position, tokenIndex
+											if buffer[position] != rune('/') {
+												goto l135
+											}
+											position++
+											goto l134
+										l135:
+											position, tokenIndex = position134, tokenIndex134
+											if !_rules[ruleIdentifier]() {
+												goto l131
+											}
+										}
+									l134:
+									l132:
+										{
+											position133, tokenIndex133 := position, tokenIndex
+											{
+												position136, tokenIndex136 := position, tokenIndex
+												if buffer[position] != rune('/') {
+													goto l137
+												}
+												position++
+												goto l136
+											l137:
+												position, tokenIndex = position136, tokenIndex136
+												if !_rules[ruleIdentifier]() {
+													goto l133
+												}
+											}
+										l136:
+											goto l132
+										l133:
+											position, tokenIndex = position133, tokenIndex133
+										}
+										goto l130
+									l131:
+										position, tokenIndex = position130, tokenIndex130
+										if !_rules[ruleIdentifier]() {
+											goto l108
+										}
 									}
-									add(rulePegText, position136)
+								l130:
+									add(rulePegText, position129)
 								}
 								if !_rules[ruleWhiteSpacing]() {
-									goto l115
+									goto l108
 								}
 								if buffer[position] != rune('}') {
-									goto l115
+									goto l108
 								}
 								position++
-								add(ruleHoleValue, position135)
+								add(ruleHoleValue, position128)
 							}
 							{
-								add(ruleAction7, position)
+								add(ruleAction8, position)
 							}
 							break
 						default:
 							{
-								position138 := position
+								position139 := position
 								if !_rules[ruleOtherParamValue]() {
-									goto l115
+									goto l108
 								}
-								add(rulePegText, position138)
+								add(rulePegText, position139)
 							}
 							{
-								add(ruleAction11, position)
+								add(ruleAction12, position)
 							}
 							break
 						}
 					}
 
 				}
-			l117:
-				add(ruleNoRefValue, position116)
+			l110:
+				add(ruleNoRefValue, position109)
 			}
 			return true
-		l115:
-			position, tokenIndex = position115, tokenIndex115
+		l108:
+			position, tokenIndex = position108, tokenIndex108
 			return false
 		},
-		/* 11 Value <- <((RefValue Action12) / NoRefValue)> */
-		nil,
-		/* 12 CustomTypedValue <- <((<CidrValue> Action13) / (<IpValue> Action14) / (<CSVValue> Action15) / (<IntRangeValue> Action16))> */
+		/* 12 Value <- <((RefValue Action13) / NoRefValue)> */
 		func() bool {
 			position141, tokenIndex141 := position, tokenIndex
 			{
@@ -1330,803 +1356,845 @@ func (p *Peg) Init() {
 					position143, tokenIndex143 := position, tokenIndex
 					{
 						position145 := position
+						if buffer[position] != rune('$') {
+							goto l144
+						}
+						position++
 						{
 							position146 := position
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
+							if !_rules[ruleIdentifier]() {
 								goto l144
 							}
+							add(rulePegText, position146)
+						}
+						add(ruleRefValue, position145)
+					}
+					{
+						add(ruleAction13, position)
+					}
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
+					if !_rules[ruleNoRefValue]() {
+						goto l141
+					}
+				}
+			l143:
+				add(ruleValue, position142)
+			}
+			return true
+		l141:
+			position, tokenIndex = position141, tokenIndex141
+			return false
+		},
+		/* 13 CustomTypedValue <- <((<CidrValue> Action14) / (<IpValue> Action15) / (<CSVValue> Action16) / (<IntRangeValue> Action17))> */
+		func() bool {
+			position148, tokenIndex148 := position, tokenIndex
+			{
+				position149 := position
+				{
+					position150, tokenIndex150 := position, tokenIndex
+					{
+						position152 := position
+						{
+							position153 := position
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l151
+							}
 							position++
-						l147:
+						l154:
 							{
-								position148, tokenIndex148 := position, tokenIndex
+								position155, tokenIndex155 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l148
+									goto l155
 								}
 								position++
-								goto l147
-							l148:
-								position, tokenIndex = position148, tokenIndex148
+								goto l154
+							l155:
+								position, tokenIndex = position155, tokenIndex155
 							}
 							if buffer[position] != rune('.') {
-								goto l144
+								goto l151
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l144
+								goto l151
 							}
 							position++
-						l149:
+						l156:
 							{
-								position150, tokenIndex150 := position, tokenIndex
+								position157, tokenIndex157 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l150
+									goto l157
 								}
 								position++
-								goto l149
-							l150:
-								position, tokenIndex = position150, tokenIndex150
+								goto l156
+							l157:
+								position, tokenIndex = position157, tokenIndex157
 							}
 							if buffer[position] != rune('.') {
-								goto l144
+								goto l151
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l144
+								goto l151
 							}
 							position++
-						l151:
+						l158:
 							{
-								position152, tokenIndex152 := position, tokenIndex
+								position159, tokenIndex159 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l152
+									goto l159
 								}
 								position++
-								goto l151
-							l152:
-								position, tokenIndex = position152, tokenIndex152
+								goto l158
+							l159:
+								position, tokenIndex = position159, tokenIndex159
 							}
 							if buffer[position] != rune('.') {
-								goto l144
+								goto l151
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l144
+								goto l151
 							}
 							position++
-						l153:
+						l160:
 							{
-								position154, tokenIndex154 := position, tokenIndex
+								position161, tokenIndex161 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l154
+									goto l161
 								}
 								position++
-								goto l153
-							l154:
-								position, tokenIndex = position154, tokenIndex154
+								goto l160
+							l161:
+								position, tokenIndex = position161, tokenIndex161
 							}
 							if buffer[position] != rune('/') {
-								goto l144
+								goto l151
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l144
+								goto l151
 							}
 							position++
-						l155:
+						l162:
 							{
-								position156, tokenIndex156 := position, tokenIndex
+								position163, tokenIndex163 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l156
+									goto l163
 								}
 								position++
-								goto l155
-							l156:
-								position, tokenIndex = position156, tokenIndex156
+								goto l162
+							l163:
+								position, tokenIndex = position163, tokenIndex163
 							}
-							add(ruleCidrValue, position146)
+							add(ruleCidrValue, position153)
 						}
-						add(rulePegText, position145)
+						add(rulePegText, position152)
 					}
 					{
-						add(ruleAction13, position)
+						add(ruleAction14, position)
 					}
-					goto l143
-				l144:
-					position, tokenIndex = position143, tokenIndex143
+					goto l150
+				l151:
+					position, tokenIndex = position150, tokenIndex150
 					{
-						position159 := position
+						position166 := position
 						{
-							position160 := position
+							position167 := position
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l158
+								goto l165
 							}
 							position++
-						l161:
+						l168:
 							{
-								position162, tokenIndex162 := position, tokenIndex
+								position169, tokenIndex169 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l162
+									goto l169
 								}
 								position++
-								goto l161
-							l162:
-								position, tokenIndex = position162, tokenIndex162
+								goto l168
+							l169:
+								position, tokenIndex = position169, tokenIndex169
 							}
 							if buffer[position] != rune('.') {
-								goto l158
+								goto l165
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l158
+								goto l165
 							}
 							position++
-						l163:
+						l170:
 							{
-								position164, tokenIndex164 := position, tokenIndex
+								position171, tokenIndex171 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l164
+									goto l171
 								}
 								position++
-								goto l163
-							l164:
-								position, tokenIndex = position164, tokenIndex164
+								goto l170
+							l171:
+								position, tokenIndex = position171, tokenIndex171
 							}
 							if buffer[position] != rune('.') {
-								goto l158
+								goto l165
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l158
+								goto l165
 							}
 							position++
-						l165:
+						l172:
 							{
-								position166, tokenIndex166 := position, tokenIndex
+								position173, tokenIndex173 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l166
+									goto l173
 								}
 								position++
-								goto l165
-							l166:
-								position, tokenIndex = position166, tokenIndex166
+								goto l172
+							l173:
+								position, tokenIndex = position173, tokenIndex173
 							}
 							if buffer[position] != rune('.') {
-								goto l158
+								goto l165
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l158
+								goto l165
 							}
 							position++
-						l167:
+						l174:
 							{
-								position168, tokenIndex168 := position, tokenIndex
+								position175, tokenIndex175 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l168
+									goto l175
 								}
 								position++
-								goto l167
-							l168:
-								position, tokenIndex = position168, tokenIndex168
+								goto l174
+							l175:
+								position, tokenIndex = position175, tokenIndex175
 							}
-							add(ruleIpValue, position160)
+							add(ruleIpValue, position167)
 						}
-						add(rulePegText, position159)
+						add(rulePegText, position166)
 					}
 					{
-						add(ruleAction14, position)
+						add(ruleAction15, position)
 					}
-					goto l143
-				l158:
-					position, tokenIndex = position143, tokenIndex143
+					goto l150
+				l165:
+					position, tokenIndex = position150, tokenIndex150
 					{
-						position171 := position
+						position178 := position
 						{
-							position172 := position
+							position179 := position
 							if !_rules[ruleOtherParamValue]() {
-								goto l170
+								goto l177
 							}
 							if !_rules[ruleWhiteSpacing]() {
-								goto l170
+								goto l177
 							}
 							if buffer[position] != rune(',') {
-								goto l170
+								goto l177
 							}
 							position++
 							if !_rules[ruleWhiteSpacing]() {
-								goto l170
+								goto l177
 							}
-						l173:
+						l180:
 							{
-								position174, tokenIndex174 := position, tokenIndex
+								position181, tokenIndex181 := position, tokenIndex
 								if !_rules[ruleOtherParamValue]() {
-									goto l174
+									goto l181
 								}
 								if !_rules[ruleWhiteSpacing]() {
-									goto l174
+									goto l181
 								}
 								if buffer[position] != rune(',') {
-									goto l174
+									goto l181
 								}
 								position++
 								if !_rules[ruleWhiteSpacing]() {
-									goto l174
+									goto l181
 								}
-								goto l173
-							l174:
-								position, tokenIndex = position174, tokenIndex174
+								goto l180
+							l181:
+								position, tokenIndex = position181, tokenIndex181
 							}
 							if !_rules[ruleOtherParamValue]() {
-								goto l170
+								goto l177
 							}
-							add(ruleCSVValue, position172)
+							add(ruleCSVValue, position179)
 						}
-						add(rulePegText, position171)
+						add(rulePegText, position178)
 					}
 					{
-						add(ruleAction15, position)
+						add(ruleAction16, position)
 					}
-					goto l143
-				l170:
-					position, tokenIndex = position143, tokenIndex143
+					goto l150
+				l177:
+					position, tokenIndex = position150, tokenIndex150
 					{
-						position176 := position
+						position183 := position
 						{
-							position177 := position
+							position184 := position
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l141
+								goto l148
 							}
 							position++
-						l178:
+						l185:
 							{
-								position179, tokenIndex179 := position, tokenIndex
+								position186, tokenIndex186 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l179
+									goto l186
 								}
 								position++
-								goto l178
-							l179:
-								position, tokenIndex = position179, tokenIndex179
+								goto l185
+							l186:
+								position, tokenIndex = position186, tokenIndex186
 							}
 							if buffer[position] != rune('-') {
-								goto l141
+								goto l148
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l141
+								goto l148
 							}
 							position++
-						l180:
+						l187:
 							{
-								position181, tokenIndex181 := position, tokenIndex
+								position188, tokenIndex188 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l181
+									goto l188
 								}
 								position++
-								goto l180
-							l181:
-								position, tokenIndex = position181, tokenIndex181
+								goto l187
+							l188:
+								position, tokenIndex = position188, tokenIndex188
 							}
-							add(ruleIntRangeValue, position177)
+							add(ruleIntRangeValue, position184)
 						}
-						add(rulePegText, position176)
+						add(rulePegText, position183)
 					}
 					{
-						add(ruleAction16, position)
+						add(ruleAction17, position)
 					}
 				}
-			l143:
-				add(ruleCustomTypedValue, position142)
+			l150:
+				add(ruleCustomTypedValue, position149)
 			}
 			return true
-		l141:
-			position, tokenIndex = position141, tokenIndex141
+		l148:
+			position, tokenIndex = position148, tokenIndex148
 			return false
 		},
-		/* 13 OtherParamValue <- <((&('*') '*') | (&('>') '>') | (&('<') '<') | (&('@') '@') | (&('~') '~') | (&(';') ';') | (&('+') '+') | (&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
+		/* 14 OtherParamValue <- <((&('*') '*') | (&('>') '>') | (&('<') '<') | (&('@') '@') | (&('~') '~') | (&(';') ';') | (&('+') '+') | (&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
 		func() bool {
-			position183, tokenIndex183 := position, tokenIndex
+			position190, tokenIndex190 := position, tokenIndex
 			{
-				position184 := position
+				position191 := position
 				{
 					switch buffer[position] {
 					case '*':
 						if buffer[position] != rune('*') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '>':
 						if buffer[position] != rune('>') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '<':
 						if buffer[position] != rune('<') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '@':
 						if buffer[position] != rune('@') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '~':
 						if buffer[position] != rune('~') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case ';':
 						if buffer[position] != rune(';') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '+':
 						if buffer[position] != rune('+') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '/':
 						if buffer[position] != rune('/') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case ':':
 						if buffer[position] != rune(':') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '_':
 						if buffer[position] != rune('_') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '.':
 						if buffer[position] != rune('.') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '-':
 						if buffer[position] != rune('-') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					default:
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l183
+							goto l190
 						}
 						position++
 						break
 					}
 				}
 
-			l185:
+			l192:
 				{
-					position186, tokenIndex186 := position, tokenIndex
+					position193, tokenIndex193 := position, tokenIndex
 					{
 						switch buffer[position] {
 						case '*':
 							if buffer[position] != rune('*') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '>':
 							if buffer[position] != rune('>') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '<':
 							if buffer[position] != rune('<') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '@':
 							if buffer[position] != rune('@') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '~':
 							if buffer[position] != rune('~') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case ';':
 							if buffer[position] != rune(';') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '+':
 							if buffer[position] != rune('+') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '/':
 							if buffer[position] != rune('/') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case ':':
 							if buffer[position] != rune(':') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '.':
 							if buffer[position] != rune('.') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l186
+								goto l193
 							}
 							position++
 							break
 						}
 					}
 
-					goto l185
-				l186:
-					position, tokenIndex = position186, tokenIndex186
+					goto l192
+				l193:
+					position, tokenIndex = position193, tokenIndex193
 				}
-				add(ruleOtherParamValue, position184)
+				add(ruleOtherParamValue, position191)
 			}
 			return true
-		l183:
-			position, tokenIndex = position183, tokenIndex183
+		l190:
+			position, tokenIndex = position190, tokenIndex190
 			return false
 		},
-		/* 14 DoubleQuotedValue <- <(!'"' .)*> */
+		/* 15 DoubleQuotedValue <- <(!'"' .)*> */
 		func() bool {
 			{
-				position190 := position
-			l191:
+				position197 := position
+			l198:
 				{
-					position192, tokenIndex192 := position, tokenIndex
+					position199, tokenIndex199 := position, tokenIndex
 					{
-						position193, tokenIndex193 := position, tokenIndex
+						position200, tokenIndex200 := position, tokenIndex
 						if buffer[position] != rune('"') {
-							goto l193
+							goto l200
 						}
 						position++
-						goto l192
-					l193:
-						position, tokenIndex = position193, tokenIndex193
+						goto l199
+					l200:
+						position, tokenIndex = position200, tokenIndex200
 					}
 					if !matchDot() {
-						goto l192
+						goto l199
 					}
-					goto l191
-				l192:
-					position, tokenIndex = position192, tokenIndex192
+					goto l198
+				l199:
+					position, tokenIndex = position199, tokenIndex199
 				}
-				add(ruleDoubleQuotedValue, position190)
+				add(ruleDoubleQuotedValue, position197)
 			}
 			return true
 		},
-		/* 15 SingleQuotedValue <- <(!'\'' .)*> */
+		/* 16 SingleQuotedValue <- <(!'\'' .)*> */
 		func() bool {
 			{
-				position195 := position
-			l196:
+				position202 := position
+			l203:
 				{
-					position197, tokenIndex197 := position, tokenIndex
+					position204, tokenIndex204 := position, tokenIndex
 					{
-						position198, tokenIndex198 := position, tokenIndex
+						position205, tokenIndex205 := position, tokenIndex
 						if buffer[position] != rune('\'') {
-							goto l198
+							goto l205
 						}
 						position++
-						goto l197
-					l198:
-						position, tokenIndex = position198, tokenIndex198
+						goto l204
+					l205:
+						position, tokenIndex = position205, tokenIndex205
 					}
 					if !matchDot() {
-						goto l197
+						goto l204
 					}
-					goto l196
-				l197:
-					position, tokenIndex = position197, tokenIndex197
+					goto l203
+				l204:
+					position, tokenIndex = position204, tokenIndex204
 				}
-				add(ruleSingleQuotedValue, position195)
+				add(ruleSingleQuotedValue, position202)
 			}
 			return true
 		},
-		/* 16 CSVValue <- <((OtherParamValue WhiteSpacing ',' WhiteSpacing)+ OtherParamValue)> */
+		/* 17 CSVValue <- <((OtherParamValue WhiteSpacing ',' WhiteSpacing)+ OtherParamValue)> */
 		nil,
-		/* 17 CidrValue <- <([0-9]+ '.' [0-9]+ '.' [0-9]+ '.' [0-9]+ '/' [0-9]+)> */
+		/* 18 CidrValue <- <([0-9]+ '.' [0-9]+ '.' [0-9]+ '.' [0-9]+ '/' [0-9]+)> */
 		nil,
-		/* 18 IpValue <- <([0-9]+ '.' [0-9]+ '.' [0-9]+ '.' [0-9]+)> */
+		/* 19 IpValue <- <([0-9]+ '.' [0-9]+ '.' [0-9]+ '.' [0-9]+)> */
 		nil,
-		/* 19 IntRangeValue <- <([0-9]+ '-' [0-9]+)> */
+		/* 20 IntRangeValue <- <([0-9]+ '-' [0-9]+)> */
 		nil,
-		/* 20 RefValue <- <('$' <Identifier>)> */
+		/* 21 RefValue <- <('$' <Identifier>)> */
 		nil,
-		/* 21 AliasValue <- <(('@' <OtherParamValue>) / ('@' DoubleQuote <DoubleQuotedValue> DoubleQuote) / ('@' SingleQuote <SingleQuotedValue> SingleQuote))> */
+		/* 22 AliasValue <- <(('@' <OtherParamValue>) / ('@' DoubleQuote <DoubleQuotedValue> DoubleQuote) / ('@' SingleQuote <SingleQuotedValue> SingleQuote))> */
 		nil,
-		/* 22 HoleValue <- <('{' WhiteSpacing <Identifier> WhiteSpacing '}')> */
+		/* 23 HoleValue <- <('{' WhiteSpacing <(('s' 's' 'm' ':' ('/' / Identifier)+) / Identifier)> WhiteSpacing '}')> */
 		nil,
-		/* 23 Comment <- <(('#' (!EndOfLine .)*) / ('/' '/' (!EndOfLine .)* Action17))> */
+		/* 24 Comment <- <(('#' (!EndOfLine .)*) / ('/' '/' (!EndOfLine .)* Action18))> */
 		nil,
-		/* 24 SingleQuote <- <'\''> */
+		/* 25 SingleQuote <- <'\''> */
 		func() bool {
-			position207, tokenIndex207 := position, tokenIndex
+			position214, tokenIndex214 := position, tokenIndex
 			{
-				position208 := position
+				position215 := position
 				if buffer[position] != rune('\'') {
-					goto l207
+					goto l214
 				}
 				position++
-				add(ruleSingleQuote, position208)
+				add(ruleSingleQuote, position215)
 			}
 			return true
-		l207:
-			position, tokenIndex = position207, tokenIndex207
+		l214:
+			position, tokenIndex = position214, tokenIndex214
 			return false
 		},
-		/* 25 DoubleQuote <- <'"'> */
+		/* 26 DoubleQuote <- <'"'> */
 		func() bool {
-			position209, tokenIndex209 := position, tokenIndex
+			position216, tokenIndex216 := position, tokenIndex
 			{
-				position210 := position
+				position217 := position
 				if buffer[position] != rune('"') {
-					goto l209
+					goto l216
 				}
 				position++
-				add(ruleDoubleQuote, position210)
+				add(ruleDoubleQuote, position217)
 			}
 			return true
-		l209:
-			position, tokenIndex = position209, tokenIndex209
+		l216:
+			position, tokenIndex = position216, tokenIndex216
 			return false
 		},
-		/* 26 WhiteSpacing <- <Whitespace*> */
+		/* 27 WhiteSpacing <- <Whitespace*> */
 		func() bool {
 			{
-				position212 := position
-			l213:
+				position219 := position
+			l220:
 				{
-					position214, tokenIndex214 := position, tokenIndex
+					position221, tokenIndex221 := position, tokenIndex
 					if !_rules[ruleWhitespace]() {
-						goto l214
+						goto l221
 					}
-					goto l213
-				l214:
-					position, tokenIndex = position214, tokenIndex214
+					goto l220
+				l221:
+					position, tokenIndex = position221, tokenIndex221
 				}
-				add(ruleWhiteSpacing, position212)
+				add(ruleWhiteSpacing, position219)
 			}
 			return true
 		},
-		/* 27 MustWhiteSpacing <- <Whitespace+> */
+		/* 28 MustWhiteSpacing <- <Whitespace+> */
 		func() bool {
-			position215, tokenIndex215 := position, tokenIndex
+			position222, tokenIndex222 := position, tokenIndex
 			{
-				position216 := position
+				position223 := position
 				if !_rules[ruleWhitespace]() {
-					goto l215
+					goto l222
 				}
-			l217:
+			l224:
 				{
-					position218, tokenIndex218 := position, tokenIndex
+					position225, tokenIndex225 := position, tokenIndex
 					if !_rules[ruleWhitespace]() {
-						goto l218
+						goto l225
 					}
-					goto l217
-				l218:
-					position, tokenIndex = position218, tokenIndex218
+					goto l224
+				l225:
+					position, tokenIndex = position225, tokenIndex225
 				}
-				add(ruleMustWhiteSpacing, position216)
+				add(ruleMustWhiteSpacing, position223)
 			}
 			return true
-		l215:
-			position, tokenIndex = position215, tokenIndex215
+		l222:
+			position, tokenIndex = position222, tokenIndex222
 			return false
 		},
-		/* 28 Equal <- <(WhiteSpacing '=' WhiteSpacing)> */
+		/* 29 Equal <- <(WhiteSpacing '=' WhiteSpacing)> */
 		func() bool {
-			position219, tokenIndex219 := position, tokenIndex
+			position226, tokenIndex226 := position, tokenIndex
 			{
-				position220 := position
+				position227 := position
 				if !_rules[ruleWhiteSpacing]() {
-					goto l219
+					goto l226
 				}
 				if buffer[position] != rune('=') {
-					goto l219
+					goto l226
 				}
 				position++
 				if !_rules[ruleWhiteSpacing]() {
-					goto l219
+					goto l226
 				}
-				add(ruleEqual, position220)
+				add(ruleEqual, position227)
 			}
 			return true
-		l219:
-			position, tokenIndex = position219, tokenIndex219
+		l226:
+			position, tokenIndex = position226, tokenIndex226
 			return false
 		},
-		/* 29 BlankLine <- <(WhiteSpacing EndOfLine Action18)> */
+		/* 30 BlankLine <- <(WhiteSpacing EndOfLine Action19)> */
 		func() bool {
-			position221, tokenIndex221 := position, tokenIndex
+			position228, tokenIndex228 := position, tokenIndex
 			{
-				position222 := position
+				position229 := position
 				if !_rules[ruleWhiteSpacing]() {
-					goto l221
+					goto l228
 				}
 				if !_rules[ruleEndOfLine]() {
-					goto l221
+					goto l228
 				}
 				{
-					add(ruleAction18, position)
+					add(ruleAction19, position)
 				}
-				add(ruleBlankLine, position222)
+				add(ruleBlankLine, position229)
 			}
 			return true
-		l221:
-			position, tokenIndex = position221, tokenIndex221
+		l228:
+			position, tokenIndex = position228, tokenIndex228
 			return false
 		},
-		/* 30 Whitespace <- <(' ' / '\t')> */
+		/* 31 Whitespace <- <(' ' / '\t')> */
 		func() bool {
-			position224, tokenIndex224 := position, tokenIndex
+			position231, tokenIndex231 := position, tokenIndex
 			{
-				position225 := position
+				position232 := position
 				{
-					position226, tokenIndex226 := position, tokenIndex
+					position233, tokenIndex233 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l227
+						goto l234
 					}
 					position++
-					goto l226
-				l227:
-					position, tokenIndex = position226, tokenIndex226
+					goto l233
+				l234:
+					position, tokenIndex = position233, tokenIndex233
 					if buffer[position] != rune('\t') {
-						goto l224
+						goto l231
 					}
 					position++
 				}
-			l226:
-				add(ruleWhitespace, position225)
+			l233:
+				add(ruleWhitespace, position232)
 			}
 			return true
-		l224:
-			position, tokenIndex = position224, tokenIndex224
+		l231:
+			position, tokenIndex = position231, tokenIndex231
 			return false
 		},
-		/* 31 EndOfLine <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 32 EndOfLine <- <(('\r' '\n') / '\n' / '\r')> */
 		func() bool {
-			position228, tokenIndex228 := position, tokenIndex
+			position235, tokenIndex235 := position, tokenIndex
 			{
-				position229 := position
+				position236 := position
 				{
-					position230, tokenIndex230 := position, tokenIndex
+					position237, tokenIndex237 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l231
+						goto l238
 					}
 					position++
 					if buffer[position] != rune('\n') {
-						goto l231
+						goto l238
 					}
 					position++
-					goto l230
-				l231:
-					position, tokenIndex = position230, tokenIndex230
+					goto l237
+				l238:
+					position, tokenIndex = position237, tokenIndex237
 					if buffer[position] != rune('\n') {
-						goto l232
+						goto l239
 					}
 					position++
-					goto l230
-				l232:
-					position, tokenIndex = position230, tokenIndex230
+					goto l237
+				l239:
+					position, tokenIndex = position237, tokenIndex237
 					if buffer[position] != rune('\r') {
-						goto l228
+						goto l235
 					}
 					position++
 				}
-			l230:
-				add(ruleEndOfLine, position229)
+			l237:
+				add(ruleEndOfLine, position236)
 			}
 			return true
-		l228:
-			position, tokenIndex = position228, tokenIndex228
+		l235:
+			position, tokenIndex = position235, tokenIndex235
 			return false
 		},
-		/* 32 EndOfFile <- <!.> */
+		/* 33 EndOfFile <- <!.> */
+		nil,
 		nil,
+		/* 36 Action0 <- <{ p.addDeclarationIdentifier(text) }> */
 		nil,
-		/* 35 Action0 <- <{ p.addDeclarationIdentifier(text) }> */
+		/* 37 Action1 <- <{ p.addValue() }> */
 		nil,
-		/* 36 Action1 <- <{ p.addValue() }> */
+		/* 38 Action2 <- <{ p.LineDone() }> */
 		nil,
-		/* 37 Action2 <- <{ p.LineDone() }> */
+		/* 39 Action3 <- <{ p.addAction(text) }> */
 		nil,
-		/* 38 Action3 <- <{ p.addAction(text) }> */
+		/* 40 Action4 <- <{ p.addEntity(text) }> */
 		nil,
-		/* 39 Action4 <- <{ p.addEntity(text) }> */
+		/* 41 Action5 <- <{ p.LineDone() }> */
 		nil,
-		/* 40 Action5 <- <{ p.LineDone() }> */
+		/* 42 Action6 <- <{ p.addEntityValueKey() }> */
 		nil,
-		/* 41 Action6 <- <{ p.addParamKey(text) }> */
+		/* 43 Action7 <- <{ p.addParamKey(text) }> */
 		nil,
-		/* 42 Action7 <- <{  p.addParamHoleValue(text) }> */
+		/* 44 Action8 <- <{  p.addParamHoleValue(text) }> */
 		nil,
-		/* 43 Action8 <- <{  p.addAliasParam(text) }> */
+		/* 45 Action9 <- <{  p.addAliasParam(text) }> */
 		nil,
-		/* 44 Action9 <- <{ p.addStringValue(text) }> */
+		/* 46 Action10 <- <{ p.addStringValue(text) }> */
 		nil,
-		/* 45 Action10 <- <{ p.addStringValue(text) }> */
+		/* 47 Action11 <- <{ p.addStringValue(text) }> */
 		nil,
-		/* 46 Action11 <- <{ p.addParamValue(text) }> */
+		/* 48 Action12 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 47 Action12 <- <{  p.addParamRefValue(text) }> */
+		/* 49 Action13 <- <{  p.addParamRefValue(text) }> */
 		nil,
-		/* 48 Action13 <- <{ p.addParamCidrValue(text) }> */
+		/* 50 Action14 <- <{ p.addParamCidrValue(text) }> */
 		nil,
-		/* 49 Action14 <- <{ p.addParamIpValue(text) }> */
+		/* 51 Action15 <- <{ p.addParamIpValue(text) }> */
 		nil,
-		/* 50 Action15 <- <{p.addCsvValue(text)}> */
+		/* 52 Action16 <- <{p.addCsvValue(text)}> */
 		nil,
-		/* 51 Action16 <- <{ p.addParamValue(text) }> */
+		/* 53 Action17 <- <{ p.addParamValue(text) }> */
 		nil,
-		/* 52 Action17 <- <{ p.LineDone() }> */
+		/* 54 Action18 <- <{ p.LineDone() }> */
 		nil,
-		/* 53 Action18 <- <{ p.LineDone() }> */
+		/* 55 Action19 <- <{ p.LineDone() }> */
 		nil,
 	}
 	p.rules = _rules
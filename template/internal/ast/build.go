@@ -35,6 +35,14 @@ func (a *AST) addEntity(text string) {
 	node.Entity = text
 }
 
+func (a *AST) addEntityValueKey() {
+	node := a.currentCommand()
+	if node.Action != string(Wait) {
+		panic(fmt.Errorf("%s %s: only wait statements accept a value for their entity", node.Action, node.Entity))
+	}
+	a.addParamKey("id")
+}
+
 func (a *AST) addValue() {
 	val := &ValueNode{}
 
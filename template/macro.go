@@ -0,0 +1,300 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// A macro is defined with:
+//
+//	def webtier(name, subnet:alias, count:int) {
+//	  inst = create instance name={name} subnet={subnet} count={count}
+//	  ...
+//	}
+//
+// and invoked on its own line with 'webtier(name=web1, subnet=@s1, count=2)'.
+// Each invocation is expanded before parsing: the holes named after the macro
+// params are filled with the given values and the variables declared in the body
+// are prefixed with the macro name and invocation number to remain unique
+var (
+	macroDefRegex         = regexp.MustCompile(`^\s*def\s+([a-zA-Z][a-zA-Z0-9_]*)\s*\(([^)]*)\)\s*\{\s*$`)
+	macroInvocationRegex  = regexp.MustCompile(`^\s*([a-zA-Z][a-zA-Z0-9_]*)\((.*)\)\s*$`)
+	macroDeclarationRegex = regexp.MustCompile(`^(\s*)([a-zA-Z0-9-_.]+)(\s*=)`)
+	macroRefRegex         = regexp.MustCompile(`\$([a-zA-Z0-9-_.]+)`)
+	macroHoleRegex        = regexp.MustCompile(`\{\s*([a-zA-Z0-9-_.]+)\s*\}`)
+)
+
+var macroParamTypeChecks = map[string]func(string) bool{
+	"string": func(s string) bool { return true },
+	"int":    regexp.MustCompile(`^-?[0-9]+$`).MatchString,
+	"bool":   func(s string) bool { return s == "true" || s == "false" },
+	"cidr": func(s string) bool {
+		_, _, err := net.ParseCIDR(s)
+		return err == nil
+	},
+	"ip":    func(s string) bool { return net.ParseIP(s) != nil },
+	"ref":   regexp.MustCompile(`^\$[a-zA-Z0-9-_.]+$`).MatchString,
+	"alias": func(s string) bool { return strings.HasPrefix(s, "@") && len(s) > 1 },
+}
+
+type macroParam struct {
+	name, typ string
+}
+
+type macro struct {
+	name   string
+	params []macroParam
+	body   []string
+}
+
+type macroExpander struct {
+	macros      map[string]*macro
+	invocations map[string]int
+}
+
+// expandMacros returns the lines with the macro definitions removed and the invocations
+// replaced by the macro bodies, the expanded lines keeping the number of the invocation line
+func expandMacros(lines []sourceLine) ([]sourceLine, error) {
+	exp := &macroExpander{macros: make(map[string]*macro), invocations: make(map[string]int)}
+	lines, err := exp.collectDefinitions(lines)
+	if err != nil {
+		return nil, err
+	}
+	return exp.expand(lines, nil)
+}
+
+func (e *macroExpander) collectDefinitions(lines []sourceLine) ([]sourceLine, error) {
+	var out []sourceLine
+	var current *macro
+	// ifset blocks opened in the body, whose closing brace does not end the definition
+	var depth int
+	for _, line := range lines {
+		if current != nil {
+			if strings.TrimSpace(line.text) == "}" {
+				if depth == 0 {
+					e.macros[current.name] = current
+					current = nil
+					continue
+				}
+				depth--
+			}
+			if macroDefRegex.MatchString(line.text) {
+				return nil, fmt.Errorf("macro '%s': nested definition at line %d", current.name, line.num)
+			}
+			if ifsetOpenRegex.MatchString(line.text) {
+				depth++
+			}
+			current.body = append(current.body, line.text)
+			continue
+		}
+
+		matches := macroDefRegex.FindStringSubmatch(line.text)
+		if matches == nil {
+			out = append(out, line)
+			continue
+		}
+		name := matches[1]
+		if _, exists := e.macros[name]; exists {
+			return nil, fmt.Errorf("macro '%s': defined twice", name)
+		}
+		params, err := parseMacroParams(name, matches[2])
+		if err != nil {
+			return nil, err
+		}
+		current = &macro{name: name, params: params}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("macro '%s': missing closing '}'", current.name)
+	}
+	return out, nil
+}
+
+func parseMacroParams(name, text string) ([]macroParam, error) {
+	var params []macroParam
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(text, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		param := macroParam{name: s, typ: "string"}
+		if splits := strings.SplitN(s, ":", 2); len(splits) == 2 {
+			param.name, param.typ = strings.TrimSpace(splits[0]), strings.TrimSpace(splits[1])
+		}
+		if _, ok := macroParamTypeChecks[param.typ]; !ok {
+			return nil, fmt.Errorf("macro '%s': param '%s' has unknown type '%s'", name, param.name, param.typ)
+		}
+		if seen[param.name] {
+			return nil, fmt.Errorf("macro '%s': duplicate param '%s'", name, param.name)
+		}
+		seen[param.name] = true
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func (e *macroExpander) expand(lines []sourceLine, stack []string) ([]sourceLine, error) {
+	var out []sourceLine
+	for _, line := range lines {
+		matches := macroInvocationRegex.FindStringSubmatch(line.text)
+		if matches == nil {
+			out = append(out, line)
+			continue
+		}
+		m, ok := e.macros[matches[1]]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro '%s'", matches[1])
+		}
+		for _, s := range stack {
+			if s == m.name {
+				return nil, fmt.Errorf("macro '%s': recursive invocation (%s -> %s)", m.name, strings.Join(stack, " -> "), m.name)
+			}
+		}
+		body, err := e.instantiate(m, matches[2])
+		if err != nil {
+			return nil, err
+		}
+		bodyLines := make([]sourceLine, len(body))
+		for i, l := range body {
+			bodyLines[i] = sourceLine{text: l, num: line.num}
+		}
+		expanded, err := e.expand(bodyLines, append(stack, m.name))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, expanded...)
+	}
+	return out, nil
+}
+
+func (e *macroExpander) instantiate(m *macro, args string) ([]string, error) {
+	values, err := parseMacroArgs(m.name, args)
+	if err != nil {
+		return nil, err
+	}
+
+	isParam := make(map[string]bool)
+	for _, p := range m.params {
+		isParam[p.name] = true
+		v, ok := values[p.name]
+		if !ok {
+			return nil, fmt.Errorf("macro '%s': missing param '%s'", m.name, p.name)
+		}
+		if !macroParamTypeChecks[p.typ](unquoteMacroArg(v)) {
+			return nil, fmt.Errorf("macro '%s': param '%s' expects %s, got '%s'", m.name, p.name, p.typ, v)
+		}
+	}
+	var unknown []string
+	for k := range values {
+		if !isParam[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("macro '%s': unknown param(s) %s", m.name, strings.Join(unknown, ", "))
+	}
+
+	e.invocations[m.name]++
+	prefix := fmt.Sprintf("%s%d_", m.name, e.invocations[m.name])
+
+	declared := make(map[string]bool)
+	for _, line := range m.body {
+		if matches := macroDeclarationRegex.FindStringSubmatch(line); matches != nil {
+			declared[matches[2]] = true
+		}
+	}
+
+	var body []string
+	for _, line := range m.body {
+		line = macroDeclarationRegex.ReplaceAllStringFunc(line, func(s string) string {
+			matches := macroDeclarationRegex.FindStringSubmatch(s)
+			return matches[1] + prefix + matches[2] + matches[3]
+		})
+		line = macroRefRegex.ReplaceAllStringFunc(line, func(s string) string {
+			if name := s[1:]; declared[name] {
+				return "$" + prefix + name
+			}
+			return s
+		})
+		line = macroHoleRegex.ReplaceAllStringFunc(line, func(s string) string {
+			if v, ok := values[macroHoleRegex.FindStringSubmatch(s)[1]]; ok {
+				return v
+			}
+			return s
+		})
+		body = append(body, line)
+	}
+	return body, nil
+}
+
+// parseMacroArgs splits 'key=value, key=value' on the commas outside quotes
+func parseMacroArgs(name, text string) (map[string]string, error) {
+	var args []string
+	var quote rune
+	var current bytes.Buffer
+	for _, r := range text {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ',':
+			args = append(args, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("macro '%s': unterminated quote in '%s'", name, text)
+	}
+	args = append(args, current.String())
+
+	values := make(map[string]string)
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			continue
+		}
+		splits := strings.SplitN(arg, "=", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return nil, fmt.Errorf("macro '%s': invalid param '%s', expecting key=value", name, arg)
+		}
+		key := strings.TrimSpace(splits[0])
+		if _, dup := values[key]; dup {
+			return nil, fmt.Errorf("macro '%s': param '%s' given twice", name, key)
+		}
+		values[key] = strings.TrimSpace(splits[1])
+	}
+	return values, nil
+}
+
+func unquoteMacroArg(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
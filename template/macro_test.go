@@ -0,0 +1,86 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandMacros(t *testing.T) {
+	text := `def webtier(name, subnet:alias, count:int) {
+inst = create instance name={name} subnet={subnet} count={count} type={type}
+create tag resource=$inst key=Tier value=web
+}
+webtier(name=web1, subnet=@s1, count=1)
+webtier(name="web 2", subnet=@s2, count=2)
+create keypair name=kp`
+
+	tpl, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `webtier1_inst = create instance count=1 name=web1 subnet=@s1 type={type}
+create tag key=Tier resource=$webtier1_inst value=web
+webtier2_inst = create instance count=2 name='web 2' subnet=@s2 type={type}
+create tag key=Tier resource=$webtier2_inst value=web
+create keypair name=kp`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExpandNestedMacros(t *testing.T) {
+	text := `def sg(vpc) {
+group = create securitygroup vpc={vpc} name=web description=web
+}
+def stack(cidr:cidr) {
+vpc = create vpc cidr={cidr}
+sg(vpc=$vpc)
+}
+stack(cidr=10.0.0.0/16)`
+
+	tpl, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `stack1_vpc = create vpc cidr=10.0.0.0/16
+sg1_group = create securitygroup description=web name=web vpc=$stack1_vpc`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMacrosErrors(t *testing.T) {
+	tcases := []struct {
+		text   string
+		expErr string
+	}{
+		{"def a(x) {\ncreate vpc cidr={x}\n}\nb(x=1)", "unknown macro 'b'"},
+		{"def a(x) {\ncreate vpc cidr={x}\n}\na()", "missing param 'x'"},
+		{"def a(x) {\ncreate vpc cidr={x}\n}\na(x=1, y=2)", "unknown param(s) y"},
+		{"def a(x:cidr) {\ncreate vpc cidr={x}\n}\na(x=notacidr)", "param 'x' expects cidr"},
+		{"def a(x:int) {\ncreate instance count={x}\n}\na(x=one)", "param 'x' expects int"},
+		{"def a(x:float) {\ncreate vpc cidr={x}\n}", "unknown type 'float'"},
+		{"def a(x) {\ncreate vpc cidr={x}\n", "missing closing '}'"},
+		{"def a(x) {\na(x={x})\n}\na(x=1)", "recursive invocation (a -> a)"},
+		{"def a(x) {\nb(x={x})\n}\ndef b(x) {\na(x={x})\n}\na(x=1)", "recursive invocation (a -> b -> a)"},
+	}
+
+	for i, tcase := range tcases {
+		_, err := Parse(tcase.text)
+		if err == nil {
+			t.Fatalf("%d: expected error", i+1)
+		}
+		if !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got '%s', want '%s'", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+func TestMacrosParseErrorLine(t *testing.T) {
+	text := "def kp(name) {\ncreate keypair name={name} wrong=\n}\ncreate vpc cidr=10.0.0.0/16\nkp(name=k1)"
+	_, err := Parse(text)
+	exp := "error parsing template at line 5 (char 29):\n\t   def kp(name) {\n\t   create keypair name={name} wrong=\n\t   }\n\t   create vpc cidr=10.0.0.0/16\n\t-> kp(name=k1)\n\t   expanded to: create keypair name=k1 wrong="
+	if err == nil || err.Error() != exp {
+		t.Fatalf("got\n%v\nwant\n%s", err, exp)
+	}
+}
@@ -12,7 +12,9 @@ import (
 type TemplateExecution struct {
 	*Template
 	Author, Source, Locale, Profile string
-	Fillers                         map[string]interface{}
+	// Name of the template file or command run, as given on the command line
+	Name    string
+	Fillers map[string]interface{}
 }
 
 func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
@@ -39,6 +41,7 @@ func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 				newCmd.Results = append(newCmd.Results, s)
 			}
 		}
+		newCmd.Skipped = cmd.CmdSkipped
 		out.Commands = append(out.Commands, newCmd)
 	}
 
@@ -85,6 +88,7 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 			if len(c.Errors) > 0 {
 				n.CmdErr = errors.New(c.Errors[0])
 			}
+			n.CmdSkipped = c.Skipped
 			tpl.Statements = append(tpl.Statements, &ast.Statement{Node: n})
 		}
 	}
@@ -108,4 +112,5 @@ type command struct {
 	Line    string   `json:"line"`
 	Errors  []string `json:"errors,omitempty"`
 	Results []string `json:"results,omitempty"`
+	Skipped bool     `json:"skipped,omitempty"`
 }
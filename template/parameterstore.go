@@ -0,0 +1,92 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// ParameterStoreHolePrefix prefixes the holes filled with the value of a parameter
+// of the AWS SSM parameter store, ex: {ssm:/prod/vpc/cidr}
+const ParameterStoreHolePrefix = "ssm:"
+
+// resolveParameterStoreHolesPass fills the parameter store holes with the values returned
+// by ParameterStoreFunc, fetched all at once. Without ParameterStoreFunc or parameter
+// store holes in the template, nothing is fetched
+func resolveParameterStoreHolesPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	unique := make(map[string]bool)
+	tpl.visitHoles(func(h ast.WithHoles) {
+		for _, hole := range h.GetHoles() {
+			if strings.HasPrefix(hole, ParameterStoreHolePrefix) {
+				unique[strings.TrimPrefix(hole, ParameterStoreHolePrefix)] = true
+			}
+		}
+	})
+	if len(unique) == 0 || env.ParameterStoreFunc == nil {
+		return tpl, env, nil
+	}
+	var names []string
+	for name := range unique {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values, err := env.ParameterStoreFunc(names)
+	if err != nil {
+		return tpl, env, fmt.Errorf("ssm parameter store: %s", err)
+	}
+	var missing []string
+	fillers := make(map[string]interface{})
+	for _, name := range names {
+		val, ok := values[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		env.Log.ExtraVerbosef("ssm parameter store: resolved hole %s%s", ParameterStoreHolePrefix, name)
+		fillers[ParameterStoreHolePrefix+name] = val
+	}
+	if len(missing) > 0 {
+		return tpl, env, fmt.Errorf("ssm parameter store: parameter(s) not found: %s", strings.Join(missing, ", "))
+	}
+
+	// values can be secrets: they are not added to the processed fillers and the params
+	// they fill are printed as their hole, in the displayed and the logged templates
+	tpl.visitDeclarationNodes(func(decl *ast.DeclarationNode) {
+		if value, ok := decl.Expr.(*ast.ValueNode); ok {
+			if _, filled := fillers[value.Hole]; filled {
+				env.redactRef(decl.Ident, value.Hole)
+			}
+		}
+	})
+	tpl.visitCommandNodes(func(n *ast.CommandNode) {
+		for key, hole := range n.Holes {
+			if _, filled := fillers[hole]; filled {
+				n.RedactParam(key, hole)
+			}
+		}
+	})
+	tpl.visitHoles(func(h ast.WithHoles) {
+		h.ProcessHoles(fillers)
+	})
+
+	return tpl, env, nil
+}
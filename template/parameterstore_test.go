@@ -0,0 +1,98 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveParameterStoreHolesPass(t *testing.T) {
+	text := "cidr = {ssm:/prod/vpc/cidr}\ncreate vpc cidr=$cidr name={ssm:/prod/vpc/name}\ncreate subnet cidr={ssm:/prod/vpc/cidr} name={subnet.name}"
+	store := map[string]string{"/prod/vpc/cidr": "10.0.0.0/16", "/prod/vpc/name": "prod"}
+
+	t.Run("resolved", func(t *testing.T) {
+		var calls [][]string
+		env := NewEnv()
+		env.ParameterStoreFunc = func(names []string) (map[string]string, error) {
+			calls = append(calls, names)
+			return store, nil
+		}
+		env.AddFillers(map[string]interface{}{"subnet.name": "sub"})
+		tpl, _, err := newMultiPass(resolveHolesPass, resolveParameterStoreHolesPass, replaceVariableValuePass).compile(MustParse(text), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := calls, [][]string{{"/prod/vpc/cidr", "/prod/vpc/name"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		assertCmdParams(t, tpl,
+			map[string]interface{}{"cidr": "10.0.0.0/16", "name": "prod"},
+			map[string]interface{}{"cidr": "10.0.0.0/16", "name": "sub"},
+		)
+		if _, ok := env.GetProcessedFillers()["ssm:/prod/vpc/name"]; ok {
+			t.Fatal("parameter store values should not be in processed fillers")
+		}
+	})
+
+	t.Run("missing parameters", func(t *testing.T) {
+		env := NewEnv()
+		env.ParameterStoreFunc = func(names []string) (map[string]string, error) {
+			return map[string]string{"/prod/vpc/cidr": "10.0.0.0/16"}, nil
+		}
+		_, _, err := resolveParameterStoreHolesPass(MustParse(text), env)
+		if err == nil || !strings.Contains(err.Error(), "not found: /prod/vpc/name") {
+			t.Fatalf("got %v, want not found error", err)
+		}
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		env := NewEnv()
+		env.ParameterStoreFunc = func(names []string) (map[string]string, error) {
+			return nil, errors.New("access denied")
+		}
+		_, _, err := resolveParameterStoreHolesPass(MustParse(text), env)
+		if err == nil || !strings.Contains(err.Error(), "access denied") {
+			t.Fatalf("got %v, want access denied error", err)
+		}
+	})
+
+	t.Run("not fetched without parameter store holes", func(t *testing.T) {
+		env := NewEnv()
+		env.ParameterStoreFunc = func(names []string) (map[string]string, error) {
+			t.Fatal("unexpected call")
+			return nil, nil
+		}
+		if _, _, err := resolveParameterStoreHolesPass(MustParse("create vpc cidr={vpc.cidr}"), env); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("secrets displayed and logged as their hole", func(t *testing.T) {
+		env := NewEnv()
+		env.ParameterStoreFunc = func(names []string) (map[string]string, error) {
+			return map[string]string{"/prod/db/password": "s3cr3t", "/prod/db/user": "admin"}, nil
+		}
+		tpl, _, err := newMultiPass(resolveParameterStoreHolesPass, replaceVariableValuePass, removeValueStatementsPass).compile(
+			MustParse("pwd = {ssm:/prod/db/password}\ncreate database password=$pwd username={ssm:/prod/db/user} engine=postgres"), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCmdParams(t, tpl, map[string]interface{}{"password": "s3cr3t", "username": "admin", "engine": "postgres"})
+
+		ran, err := tpl.Run(&Env{Driver: &noopDriver{}, Log: env.Log})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := ran.String(), "create database engine=postgres password={ssm:/prod/db/password} username={ssm:/prod/db/user}"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		stored, err := json.Marshal(&TemplateExecution{Template: ran})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(stored), "s3cr3t") || strings.Contains(string(stored), "admin") {
+			t.Fatalf("got secrets in stored template %s", stored)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Kinds of the params values checked when compiling a template
+const (
+	IntParam  = "int"
+	BoolParam = "bool"
+	CIDRParam = "cidr"
+	EnumParam = "enum"
+)
+
+// ParamType is the type of the value of a param.
+// Values lists the only values allowed for an enum
+type ParamType struct {
+	Kind   string
+	Values []string
+}
+
+// coerce returns the value converted to the type, or an error
+// when the value does not fit it
+func (t ParamType) coerce(v interface{}) (interface{}, error) {
+	switch vv := v.(type) {
+	case []interface{}:
+		var coerced []interface{}
+		for _, e := range vv {
+			c, err := t.coerce(e)
+			if err != nil {
+				return v, err
+			}
+			coerced = append(coerced, c)
+		}
+		return coerced, nil
+	case []string:
+		for _, e := range vv {
+			if _, err := t.coerce(e); err != nil {
+				return v, err
+			}
+		}
+		return v, nil
+	case string:
+		if strings.HasPrefix(vv, "@") { // alias resolved or reported by later passes
+			return v, nil
+		}
+	}
+
+	switch t.Kind {
+	case IntParam:
+		switch vv := v.(type) {
+		case int, int64:
+			return v, nil
+		case float64:
+			if vv == float64(int(vv)) {
+				return int(vv), nil
+			}
+		case string:
+			if i, err := strconv.Atoi(strings.TrimSpace(vv)); err == nil {
+				return i, nil
+			}
+		}
+		return v, fmt.Errorf("expected an integer, got '%v'", v)
+	case BoolParam:
+		switch vv := v.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(vv)); err == nil {
+				return b, nil
+			}
+		}
+		return v, fmt.Errorf("expected a boolean (true or false), got '%v'", v)
+	case CIDRParam:
+		s, ok := v.(string)
+		if !ok {
+			return v, fmt.Errorf("expected a CIDR, got '%v'", v)
+		}
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			return v, fmt.Errorf("invalid CIDR '%s'", s)
+		}
+		return v, nil
+	case EnumParam:
+		s := fmt.Sprint(v)
+		for _, allowed := range t.Values {
+			if s == allowed {
+				return s, nil
+			}
+		}
+		return v, fmt.Errorf("expected one of %s, got '%v'", strings.Join(t.Values, ", "), v)
+	}
+	return v, nil
+}
+
+// coerceParamTypesPass converts the params values to the types given by their definition,
+// failing on the first value not fitting its type
+func coerceParamTypesPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	if env.DefLookupFunc == nil {
+		return tpl, env, nil
+	}
+	each := func(cmd *ast.CommandNode) error {
+		if cmd.Action == importAction {
+			return nil
+		}
+		def, ok := env.DefLookupFunc(cmd.Action + cmd.Entity)
+		if !ok || len(def.ParamTypes) == 0 {
+			return nil
+		}
+		var keys []string
+		for k := range cmd.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			typ, ok := def.ParamTypes[key]
+			if !ok {
+				continue
+			}
+			coerced, err := typ.coerce(cmd.Params[key])
+			if err != nil {
+				return fmt.Errorf("%s %s: param '%s': %s", cmd.Action, cmd.Entity, key, err)
+			}
+			cmd.Params[key] = coerced
+		}
+		return nil
+	}
+
+	return tpl, env, tpl.visitCommandNodesE(each)
+}
@@ -43,18 +43,31 @@ func Parse(text string) (tmpl *Template, err error) {
 		return nil, errors.New("empty template")
 	}
 
+	lines := splitSourceLines(text)
+	if lines, err = expandMacros(lines); err != nil {
+		return nil, err
+	}
+	if lines, err = expandIfSetBlocks(lines); err != nil {
+		return nil, err
+	}
+	expanded := expandImportShorthand(joinSourceLines(lines))
+
 	tmpl = &Template{}
 
-	p := &ast.Peg{AST: &ast.AST{}, Buffer: string(text)}
+	p := &ast.Peg{AST: &ast.AST{}, Buffer: expanded}
 	p.Init()
 
 	if err = p.Parse(); err != nil {
-		err = newParseError(text, err.Error())
+		perr := newParseError(expanded, err.Error())
+		perr.mapToSource(text, lines)
+		err = perr
 		return
 	}
 	p.Execute()
+	p.AddAnnotations()
 
 	tmpl.AST = p.AST
+	resolveImportAliases(tmpl)
 
 	return
 }
@@ -91,10 +104,35 @@ func parseStatement(text string) (ast.Node, error) {
 	return templ.Statements[0].Node, nil
 }
 
+// sourceLine is a line of the text given to the peg parser with the number of the
+// template line it comes from, as expanding macros and ifset blocks adds and removes lines
+type sourceLine struct {
+	text string
+	num  int
+}
+
+func splitSourceLines(text string) []sourceLine {
+	var lines []sourceLine
+	for i, l := range strings.Split(text, "\n") {
+		lines = append(lines, sourceLine{text: l, num: i + 1})
+	}
+	return lines
+}
+
+func joinSourceLines(lines []sourceLine) string {
+	texts := make([]string, len(lines))
+	for i, l := range lines {
+		texts[i] = l.text
+	}
+	return strings.Join(texts, "\n")
+}
+
 type parseError struct {
 	origMsg          string
 	lines            []string
 	line, start, end int
+	// expansion is the parsed line in error when it differs from the template line
+	expansion string
 }
 
 func newParseError(templText, pegErrMsg string) (perr *parseError) {
@@ -104,6 +142,20 @@ func newParseError(templText, pegErrMsg string) (perr *parseError) {
 	return
 }
 
+// mapToSource moves the error from the parsed text to the line of the template it comes
+// from, keeping the parsed line in error when it is an expansion of the template line
+func (pe *parseError) mapToSource(source string, lines []sourceLine) {
+	if pe.invalidIndexes() || pe.line > len(lines) {
+		return
+	}
+	parsed := pe.lines[pe.line-1]
+	pe.line = lines[pe.line-1].num
+	pe.lines = strings.Split(source, "\n")
+	if pe.lines[pe.line-1] != parsed {
+		pe.expansion = parsed
+	}
+}
+
 func (pe *parseError) Error() string {
 	if pe.invalidIndexes() {
 		return pe.origMsg
@@ -114,7 +166,12 @@ func (pe *parseError) Error() string {
 
 	for i, l := range pe.lines {
 		buff.WriteByte('\t')
-		if pe.line == i+1 {
+		if pe.line == i+1 && pe.expansion != "" {
+			buff.WriteString("-> ")
+			buff.WriteString(l)
+			buff.WriteString("\n\t   expanded to: ")
+			buff.WriteString(pe.expansion[0:pe.start])
+		} else if pe.line == i+1 {
 			buff.WriteString("-> ")
 			buff.WriteString(l[0:pe.start])
 		} else {
@@ -136,6 +193,9 @@ func (pe *parseError) invalidIndexes() bool {
 	if pe.line > len(pe.lines) {
 		return true
 	}
+	if pe.expansion != "" {
+		return pe.start > len(pe.expansion)
+	}
 	if pe.start > len(pe.lines[pe.line-1]) {
 		return true
 	}
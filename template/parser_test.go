@@ -37,6 +37,9 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 		{"support wildcard in quote", "create policy action=\"ec2:Get*\"", "create policy action=ec2:Get*"},
 		{"support single wildcard", "create policy resource=*", ""},
 		{"support parameter value beginning with number", "create keypair name=123test", ""},
+		{"wait with id of entity", "wait instance=@web state=running", "wait instance id=@web state=running"},
+		{"wait with reference id of entity", "wait instance = $inst state=running", "wait instance id=$inst state=running"},
+		{"wait with id param", "wait instance id=i-1234 state=stopped timeout=60", ""},
 	}
 
 	for _, tcase := range tcases {
@@ -54,6 +57,42 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 			t.Fatalf("%s: parsing [%s]\ngot  [%s]\nwant [%s]\n", tcase.desc, tcase.text, got, want)
 		}
 	}
+
+	if _, err := Parse("create instance=@web"); err == nil || !strings.Contains(err.Error(), "only wait statements accept a value for their entity") {
+		t.Fatalf("expected error with specific message, got: %v", err)
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	text := `# @idempotent name
+# a regular comment
+vpc = create vpc cidr=10.0.0.0/16 name=myvpc
+
+create subnet cidr=10.0.0.0/24 vpc=$vpc // @idempotent name, vpc
+// @unknown
+
+create internetgateway
+`
+	tpl, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tpl.Statements), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	expected := []map[string]string{
+		{"idempotent": "name"},
+		{"idempotent": "name, vpc"},
+		{"unknown": ""},
+	}
+	for i, st := range tpl.Statements {
+		if got, want := st.Annotations, expected[i]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i, got, want)
+		}
+		if got, want := st.Clone().Annotations, expected[i]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: clone: got %v, want %v", i, got, want)
+		}
+	}
 }
 
 func TestStringWithDigitValues(t *testing.T) {
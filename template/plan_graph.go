@@ -0,0 +1,86 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// WritePlanGraph writes the statements of the template as a Graphviz DOT digraph:
+// one node per statement labeled with its action and entity, and an edge from
+// each statement declaring a reference to the statements using it. Statements
+// without a path between them have no ordering constraint and could run in parallel
+func (s *Template) WritePlanGraph(w io.Writer) error {
+	buff := bufio.NewWriter(w)
+
+	name := s.ID
+	if name == "" {
+		name = "template"
+	}
+	fmt.Fprintf(buff, "digraph %s {\n", dotQuote(name))
+	fmt.Fprintln(buff, "  rankdir=TB;")
+	fmt.Fprintln(buff, "  node [shape=box, style=rounded];")
+
+	declaredBy := make(map[string]int)
+	for i, st := range s.Statements {
+		var lines []string
+		decl, isDecl := st.Node.(*ast.DeclarationNode)
+		if cmd, ok := statementCommand(st); ok {
+			lines = append(lines, fmt.Sprintf("%d. %s %s", i+1, cmd.Action, cmd.Entity))
+		} else if isDecl {
+			lines = append(lines, fmt.Sprintf("%d. value", i+1))
+		} else {
+			lines = append(lines, fmt.Sprintf("%d. %s", i+1, st))
+		}
+		if isDecl {
+			lines = append(lines, "$"+decl.Ident)
+			declaredBy[decl.Ident] = i + 1
+		}
+		fmt.Fprintf(buff, "  s%d [label=%s];\n", i+1, dotQuote(strings.Join(lines, "\n")))
+	}
+
+	for i, st := range s.Statements {
+		cmd, ok := statementCommand(st)
+		if !ok {
+			continue
+		}
+		var keys []string
+		for k := range cmd.Refs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if from, ok := declaredBy[cmd.Refs[k]]; ok && from < i+1 {
+				fmt.Fprintf(buff, "  s%d -> s%d [label=%s];\n", from, i+1, dotQuote(k))
+			}
+		}
+	}
+	fmt.Fprintln(buff, "}")
+
+	return buff.Flush()
+}
+
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
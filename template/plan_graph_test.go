@@ -0,0 +1,32 @@
+package template
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWritePlanGraph(t *testing.T) {
+	tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate keypair name=mykey\ncreate instance subnet=$sub name=web keypair=mykey\ncreate tag resource=$vpc key=Env value=test")
+	tpl.ID = `my "infra"`
+
+	var buff bytes.Buffer
+	if err := tpl.WritePlanGraph(&buff); err != nil {
+		t.Fatal(err)
+	}
+	expected := `digraph "my \"infra\"" {
+  rankdir=TB;
+  node [shape=box, style=rounded];
+  s1 [label="1. create vpc\n$vpc"];
+  s2 [label="2. create subnet\n$sub"];
+  s3 [label="3. create keypair"];
+  s4 [label="4. create instance"];
+  s5 [label="5. create tag"];
+  s1 -> s2 [label="vpc"];
+  s2 -> s4 [label="subnet"];
+  s1 -> s5 [label="resource"];
+}
+`
+	if got, want := buff.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/oklog/ulid"
+	"github.com/wallix/awless/logger"
 )
 
 type renderFunc func(...interface{}) string
@@ -60,6 +61,8 @@ func (p *defaultPrinter) Print(t *TemplateExecution) error {
 
 		if cmd.CmdErr != nil {
 			status = p.RenderKO("KO")
+		} else if cmd.CmdSkipped {
+			status = p.RenderOK("EXISTS")
 		} else {
 			status = p.RenderOK("OK")
 		}
@@ -115,10 +118,12 @@ func (p *logPrinter) Print(t *TemplateExecution) error {
 	for _, cmd := range t.CommandNodesIterator() {
 		var result, status string
 
-		exec := fmt.Sprintf("%s", cmd.String())
+		exec := logger.Redact(cmd.String())
 
 		if cmd.CmdErr != nil {
 			status = p.RenderKO("KO")
+		} else if cmd.CmdSkipped {
+			status = p.RenderOK("EXISTS")
 		} else {
 			status = p.RenderOK("OK")
 		}
@@ -131,7 +136,7 @@ func (p *logPrinter) Print(t *TemplateExecution) error {
 
 		fmt.Fprintln(tabw, line)
 		if cmd.CmdErr != nil {
-			for _, err := range formatMultiLineErrMsg(cmd.CmdErr.Error()) {
+			for _, err := range formatMultiLineErrMsg(logger.Redact(cmd.CmdErr.Error())) {
 				fmt.Fprintf(tabw, "%s\t%s\n", "", err)
 			}
 		}
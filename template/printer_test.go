@@ -0,0 +1,27 @@
+package template
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/logger"
+)
+
+func TestLogPrinterRedactsStatements(t *testing.T) {
+	logger.SetRedactedKeys("userdata")
+	defer logger.SetRedactedKeys()
+
+	tpl := MustParse("create instance name=web userdata=secret")
+	tpl.ID = NewRunID()
+	tpl.CommandNodesIterator()[0].CmdErr = errors.New("invalid userdata=secret")
+
+	var buff bytes.Buffer
+	if err := NewLogPrinter(&buff).Print(&TemplateExecution{Template: tpl}); err != nil {
+		t.Fatal(err)
+	}
+	if out := buff.String(); strings.Contains(out, "secret") || !strings.Contains(out, "userdata="+logger.RedactedMask) {
+		t.Fatalf("got\n%s\nwant redacted userdata", out)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+
+	"github.com/wallix/awless/template/driver"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// A statement is run in another region than the one of the template with
+// the 'region' meta-parameter, which is not given to the resource:
+//
+//	create bucket name=my-backups region=us-east-1
+//
+// The statement runs against the driver returned by RegionDriverFunc for
+// that region, the following statements running against the default driver
+const RegionMetaParam = "region"
+
+func statementRegion(cmd *ast.CommandNode) (string, bool) {
+	v, ok := cmd.Params[RegionMetaParam]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+func withoutMetaParams(params map[string]interface{}) map[string]interface{} {
+	if _, ok := params[RegionMetaParam]; !ok {
+		return params
+	}
+	stripped := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		if k != RegionMetaParam {
+			stripped[k] = v
+		}
+	}
+	return stripped
+}
+
+// lookupDriverFn returns the driver function of the command, in the region of the command when overridden
+func (env *Env) lookupDriverFn(cmd *ast.CommandNode) (driver.DriverFn, error) {
+	region, ok := statementRegion(cmd)
+	if !ok {
+		return env.Driver.Lookup(cmd.Action, cmd.Entity)
+	}
+	d, err := env.regionDriver(region)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: region '%s': %s", cmd.Action, cmd.Entity, region, err)
+	}
+	env.Log.Verbosef("%s %s: running in region '%s'", cmd.Action, cmd.Entity, region)
+	return d.Lookup(cmd.Action, cmd.Entity)
+}
+
+func (env *Env) regionDriver(region string) (driver.Driver, error) {
+	env.regionDriversMu.Lock()
+	defer env.regionDriversMu.Unlock()
+	if d, ok := env.regionDrivers[region]; ok {
+		d.SetDryRun(env.dryRun)
+		return d, nil
+	}
+	if env.RegionDriverFunc == nil {
+		return nil, fmt.Errorf("no driver available for region override")
+	}
+	d, err := env.RegionDriverFunc(region)
+	if err != nil {
+		return nil, err
+	}
+	if env.regionDrivers == nil {
+		env.regionDrivers = make(map[string]driver.Driver)
+	}
+	env.regionDrivers[region] = d
+	d.SetDryRun(env.dryRun)
+	return d, nil
+}
+
+// SetDryRun sets the dry run mode of the driver and of the region drivers,
+// including the ones built afterwards for the statements overriding the region
+func (env *Env) SetDryRun(dry bool) {
+	env.regionDriversMu.Lock()
+	defer env.regionDriversMu.Unlock()
+	env.dryRun = dry
+	env.Driver.SetDryRun(dry)
+	for _, d := range env.regionDrivers {
+		d.SetDryRun(dry)
+	}
+}
@@ -0,0 +1,94 @@
+package template
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestCompileRegionMetaParam(t *testing.T) {
+	env := NewEnv()
+	env.DefLookupFunc = func(in string) (Definition, bool) {
+		t, ok := DefsExample[in]
+		return t, ok
+	}
+
+	if _, _, err := Compile(MustParse("create subnet cidr=10.0.0.0/24 vpc=vpc-1 region=us-east-1"), env); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunRegionMetaParam(t *testing.T) {
+	defaultDriver := &mockDriver{prefix: "default-", expects: []*expectation{{
+		action: "create", entity: "vpc",
+		expectedParams: map[string]interface{}{"cidr": "10.0.0.0/16"},
+	}, {
+		action: "create", entity: "instance",
+		expectedParams: map[string]interface{}{"subnet": "useast-subnet"},
+	}}}
+	regionDriver := &mockDriver{prefix: "useast-", expects: []*expectation{{
+		action: "create", entity: "subnet",
+		expectedParams: map[string]interface{}{"cidr": "10.0.0.0/24", "vpc": "default-vpc"},
+	}}}
+
+	var requested []string
+	env := NewEnv()
+	env.Driver = defaultDriver
+	env.RegionDriverFunc = func(region string) (driver.Driver, error) {
+		requested = append(requested, region)
+		return regionDriver, nil
+	}
+
+	tpl := MustParse("vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc region=us-east-1\ncreate instance subnet=$sub")
+	ran, err := tpl.Run(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range ran.CommandNodesIterator() {
+		if cmd.CmdErr != nil {
+			t.Fatal(cmd.CmdErr)
+		}
+	}
+	if err := defaultDriver.lookupsCalled(); err != nil {
+		t.Fatal(err)
+	}
+	if err := regionDriver.lookupsCalled(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := requested, []string{"us-east-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := ran.Statements[1].String(), "sub = create subnet cidr=10.0.0.0/24 region=us-east-1 vpc=default-vpc"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	t.Run("region driver errors", func(t *testing.T) {
+		env := NewEnv()
+		env.Driver = &noopDriver{}
+		env.RegionDriverFunc = func(region string) (driver.Driver, error) {
+			return nil, errors.New("invalid region")
+		}
+		_, err := MustParse("create subnet cidr=10.0.0.0/24 region=moon-1").Run(env)
+		if err == nil || !strings.Contains(err.Error(), "region 'moon-1': invalid region") {
+			t.Fatalf("got %v, want region error", err)
+		}
+	})
+}
+
+func TestRevertKeepsRegionMetaParam(t *testing.T) {
+	tpl := MustParse("create instance subnet=sub-1 region=us-east-1")
+	for _, cmd := range tpl.CommandNodesIterator() {
+		cmd.CmdResult = "i-123"
+	}
+	reverted, err := tpl.Revert()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, _ := statementCommand(reverted.Statements[0])
+	if got, want := cmd.String(), "delete instance id=i-123 region=us-east-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+const (
+	ResultOK       = "ok"
+	ResultExisting = "existing"
+	ResultFailed   = "failed"
+	ResultNotRun   = "not_run"
+)
+
+// RunResult is the outcome of a run, serialized in JSON for pipelines to archive.
+// Statements are listed in template order, the ones never reached being reported as not run
+type RunResult struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name,omitempty"`
+	Profile    string             `json:"profile,omitempty"`
+	Region     string             `json:"region,omitempty"`
+	Status     string             `json:"status"`
+	Error      string             `json:"error,omitempty"`
+	Started    time.Time          `json:"started"`
+	Ended      time.Time          `json:"ended"`
+	DurationMs int64              `json:"durationMs"`
+	Created    []string           `json:"created"`
+	Statements []*StatementResult `json:"statements"`
+}
+
+type StatementResult struct {
+	Line   string `json:"line"`
+	Action string `json:"action"`
+	Entity string `json:"entity"`
+	Status string `json:"status"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewRunResult builds the result of running the planned template of an execution, ran being nil
+// when the run has not started (ex: failed dry run) and planned being nil when the template could
+// not even be parsed. The error is the one that prevented or aborted the run.
+// The statements and errors are redacted, as the result is written to files and posted to webhooks
+func NewRunResult(tplExec *TemplateExecution, planned, ran *Template, runErr error, started, ended time.Time) *RunResult {
+	res := &RunResult{
+		Name:       tplExec.Name,
+		Profile:    tplExec.Profile,
+		Region:     tplExec.Locale,
+		Status:     ResultOK,
+		Started:    started,
+		Ended:      ended,
+		DurationMs: int64(ended.Sub(started) / time.Millisecond),
+		Created:    []string{},
+		Statements: []*StatementResult{},
+	}
+	if runErr != nil {
+		res.Status, res.Error = ResultFailed, logger.Redact(runErr.Error())
+	}
+	if planned == nil {
+		return res
+	}
+	res.ID = planned.ID
+
+	var ranCmds []*ast.CommandNode
+	if ran != nil {
+		res.ID = ran.ID
+		ranCmds = ran.CommandNodesIterator()
+	}
+
+	// ran statements are in template order, the ones not run missing when run concurrently
+	var next int
+	for _, cmd := range planned.CommandNodesIterator() {
+		st := &StatementResult{Line: logger.Redact(cmd.String()), Action: cmd.Action, Entity: cmd.Entity, Status: ResultNotRun}
+		if next < len(ranCmds) && isRanCommand(cmd, ranCmds[next]) {
+			done := ranCmds[next]
+			next++
+			st.Line = logger.Redact(done.String())
+			if done.CmdResult != nil {
+				st.Result = fmt.Sprint(done.CmdResult)
+			}
+			switch {
+			case done.CmdErr != nil:
+				st.Status, st.Error = ResultFailed, logger.Redact(done.CmdErr.Error())
+				res.Status = ResultFailed
+			case done.CmdSkipped:
+				st.Status = ResultExisting
+			default:
+				st.Status = ResultOK
+				if done.Action == "create" && st.Result != "" {
+					res.Created = append(res.Created, st.Result)
+				}
+			}
+		}
+		res.Statements = append(res.Statements, st)
+	}
+	return res
+}
+
+// isRanCommand tells whether ran is the run of the planned command: same action,
+// entity and params, with the params resolved from references in addition
+func isRanCommand(planned, ran *ast.CommandNode) bool {
+	if planned.Action != ran.Action || planned.Entity != ran.Entity {
+		return false
+	}
+	for k, v := range planned.Params {
+		if rv, ok := ran.Params[k]; !ok || fmt.Sprint(rv) != fmt.Sprint(v) {
+			return false
+		}
+	}
+	return true
+}
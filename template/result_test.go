@@ -0,0 +1,127 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/logger"
+)
+
+func TestNewRunResult(t *testing.T) {
+	text := "create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1\ncreate instance subnet=sub-1"
+	planned := MustParse(text)
+	tplExec := &TemplateExecution{Name: "infra.aws", Profile: "prod", Locale: "eu-west-1"}
+	started := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+	ended := started.Add(1500 * time.Millisecond)
+
+	t.Run("partial run", func(t *testing.T) {
+		ran := MustParse(strings.Join(strings.Split(text, "\n")[:2], "\n"))
+		ran.ID = "run-1"
+		cmds := ran.CommandNodesIterator()
+		cmds[0].CmdResult, cmds[0].CmdSkipped = "vpc-1", true
+		cmds[1].CmdErr = errors.New("subnet quota exceeded")
+
+		res := NewRunResult(tplExec, planned, ran, nil, started, ended)
+		if got, want := res.Status, ResultFailed; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := res.ID, "run-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := res.DurationMs, int64(1500); got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		var statuses []string
+		for _, st := range res.Statements {
+			statuses = append(statuses, st.Status)
+		}
+		if got, want := statuses, []string{ResultExisting, ResultFailed, ResultNotRun}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := res.Statements[1].Error, "subnet quota exceeded"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := res.Statements[2].Entity, "instance"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got := res.Created; len(got) != 0 {
+			t.Fatalf("got %v, want no created resource", got)
+		}
+	})
+
+	t.Run("successful run", func(t *testing.T) {
+		ran := MustParse(text)
+		for i, cmd := range ran.CommandNodesIterator() {
+			cmd.CmdResult = []string{"vpc-1", "sub-1", "i-1"}[i]
+		}
+
+		res := NewRunResult(tplExec, planned, ran, nil, started, ended)
+		if got, want := res.Status, ResultOK; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := res.Created, []string{"vpc-1", "sub-1", "i-1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("not run", func(t *testing.T) {
+		res := NewRunResult(tplExec, planned, nil, errors.New("dry run: invalid cidr"), started, ended)
+		if got, want := res.Status, ResultFailed; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		b, err := json.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded map[string]interface{}
+		if err = json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := decoded["error"], "dry run: invalid cidr"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := decoded["region"], "eu-west-1"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(decoded["statements"].([]interface{})), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+	t.Run("not parsed", func(t *testing.T) {
+		res := NewRunResult(tplExec, nil, nil, errors.New("template: parse error"), started, started)
+		if got, want := res.Status, ResultFailed; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := res.Name, "infra.aws"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got := res.Statements; len(got) != 0 {
+			t.Fatalf("got %v, want no statement", got)
+		}
+	})
+
+	t.Run("redacted", func(t *testing.T) {
+		logger.SetRedactedKeys("password")
+		defer logger.SetRedactedKeys()
+
+		planned := MustParse("create database password=secret123 engine=postgres")
+		ran := MustParse("create database password=secret123 engine=postgres")
+		ran.CommandNodesIterator()[0].CmdErr = errors.New("invalid password=secret123")
+
+		res := NewRunResult(tplExec, planned, ran, errors.New("run failed: password=secret123"), started, ended)
+		b, err := json.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "secret123") {
+			t.Fatalf("got %s, want redacted password", b)
+		}
+		if got, want := res.Statements[0].Line, "create database engine=postgres password="+logger.RedactedMask; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
@@ -2,17 +2,35 @@ package template
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/wallix/awless/template/internal/ast"
 )
 
+// Revert returns the template undoing the given executed template.
+// Each group of revert statements is commented with the original
+// statement it undoes, preceded by a header naming the original run.
+// Groups are annotated as steps for RunConcurrently, the step undoing
+// a statement coming after the steps undoing the statements using its result
 func (te *Template) Revert() (*Template, error) {
 	var lines []string
-	cmdsReverseIterator := te.CmdNodesReverseIterator()
-	for i, cmd := range cmdsReverseIterator {
-		notLastCommand := (i != len(cmdsReverseIterator)-1)
+	comments := make(map[int][]string)
+	var lineSteps []int
+	stepsAfter := make(map[int][]string)
+	statementsReverseIterator := te.cmdStatementsReverseIterator()
+	for i, st := range statementsReverseIterator {
+		cmd, _ := statementCommand(st)
+		notLastCommand := (i != len(statementsReverseIterator)-1)
 		if isRevertible(cmd) {
+			cmdLines := len(lines)
+			for j := 0; j < i; j++ {
+				if user, _ := statementCommand(statementsReverseIterator[j]); isRevertible(user) && usesResultOf(user, cmd) {
+					stepsAfter[i] = append(stepsAfter[i], strconv.Itoa(j))
+				}
+			}
+			comments[len(lines)] = append(comments[len(lines)], fmt.Sprintf("reverts: %s", st))
+
 			var revertAction string
 			var params []string
 
@@ -134,7 +152,7 @@ func (te *Template) Revert() (*Template, error) {
 				}
 			case "delete":
 				switch cmd.Entity {
-				case "record":
+				case "record", "tag":
 					for k, v := range cmd.Params {
 						params = append(params, fmt.Sprintf("%s=%v", k, quoteParamIfNeeded(v)))
 					}
@@ -189,6 +207,19 @@ func (te *Template) Revert() (*Template, error) {
 					lines = append(lines, fmt.Sprintf("check natgateway id=%s state=deleted timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
 				}
 			}
+
+			// Reverting statements run in the region of the reverted one
+			if region, ok := statementRegion(cmd); ok {
+				regionParam := fmt.Sprintf("%s=%s", RegionMetaParam, quoteParamIfNeeded(region))
+				for j := cmdLines; j < len(lines); j++ {
+					if !strings.Contains(lines[j], " "+regionParam) {
+						lines[j] = lines[j] + " " + regionParam
+					}
+				}
+			}
+			for j := cmdLines; j < len(lines); j++ {
+				lineSteps = append(lineSteps, i)
+			}
 		}
 	}
 
@@ -198,9 +229,60 @@ func (te *Template) Revert() (*Template, error) {
 		return nil, fmt.Errorf("revert: \n%s\n%s", text, err)
 	}
 
+	// one statement per line
+	for i, st := range tpl.Statements {
+		st.Comments = comments[i]
+		step := lineSteps[i]
+		st.Annotations = map[string]string{revertStepAnnotation: strconv.Itoa(step)}
+		if after, ok := stepsAfter[step]; ok {
+			st.Annotations[revertAfterAnnotation] = strings.Join(after, ",")
+		}
+	}
+	if te.ID != "" && len(tpl.Statements) > 0 {
+		tpl.Statements[0].Comments = append([]string{fmt.Sprintf("revert of template %s", te.ID)}, tpl.Statements[0].Comments...)
+	}
+
 	return tpl, nil
 }
 
+// usesResultOf tells whether a param of the user command is the resource created or touched by cmd
+func usesResultOf(user, cmd *ast.CommandNode) bool {
+	result, ok := cmd.CmdResult.(string)
+	if !ok || result == "" {
+		return false
+	}
+	for _, v := range user.Params {
+		switch vv := v.(type) {
+		case []interface{}:
+			for _, e := range vv {
+				if fmt.Sprint(e) == result {
+					return true
+				}
+			}
+		case []string:
+			for _, e := range vv {
+				if e == result {
+					return true
+				}
+			}
+		default:
+			if fmt.Sprint(v) == result {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (te *Template) cmdStatementsReverseIterator() (statements []*ast.Statement) {
+	for i := len(te.Statements) - 1; i >= 0; i-- {
+		if _, ok := statementCommand(te.Statements[i]); ok {
+			statements = append(statements, te.Statements[i])
+		}
+	}
+	return
+}
+
 func IsRevertible(t *Template) bool {
 	revertible := false
 	t.visitCommandNodes(func(cmd *ast.CommandNode) {
@@ -212,11 +294,11 @@ func IsRevertible(t *Template) bool {
 }
 
 func isRevertible(cmd *ast.CommandNode) bool {
-	if cmd.CmdErr != nil {
+	if cmd.CmdErr != nil || cmd.CmdSkipped {
 		return false
 	}
 
-	if cmd.Action == "check" {
+	if cmd.Action == "check" || cmd.Action == "wait" || cmd.Action == importAction {
 		return false
 	}
 
@@ -228,6 +310,10 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return true
 	}
 
+	if cmd.Entity == "tag" && cmd.Action == "delete" {
+		return true
+	}
+
 	if cmd.Entity == "instanceprofile" && (cmd.Action == "create" || cmd.Action == "delete") {
 		return true
 	}
@@ -2,6 +2,7 @@ package template
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -24,13 +25,46 @@ func TestRevertOneliner(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if got, want := reverted.String(), tcase.exp; got != want {
+		if got, want := reverted.String(), "# reverts: "+MustParse(tcase.in).String()+"\n"+tcase.exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
 	}
 }
 
 func TestRevertTemplate(t *testing.T) {
+	t.Run("Header comment names the original template", func(t *testing.T) {
+		tpl := MustParse("subnet = create subnet cidr=10.0.0.0/24\ncreate instance subnet=$subnet type=t2.micro")
+		tpl.ID = "01BA7RV6ES86PZYCM3H28WM6KZ"
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = fmt.Sprintf("res-%d", i)
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `# revert of template 01BA7RV6ES86PZYCM3H28WM6KZ
+# reverts: create instance subnet=$subnet type=t2.micro
+delete instance id=res-1
+check instance id=res-1 state=terminated timeout=180
+# reverts: subnet = create subnet cidr=10.0.0.0/24
+delete subnet id=res-0`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+
+		reparsed, err := Parse(reverted.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(reparsed.Statements), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := reverted.Clone().String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Simple template", func(t *testing.T) {
 		tpl := MustParse("create instance type=t2.micro")
 		for _, cmd := range tpl.CommandNodesIterator() {
@@ -41,7 +75,7 @@ func TestRevertTemplate(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		exp := "delete instance id=i-54321"
+		exp := "# reverts: create instance type=t2.micro\ndelete instance id=i-54321"
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -62,7 +96,7 @@ func TestRevertTemplate(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		exp := "delete instance id=i-54321\ncheck instance id=i-54321 state=terminated timeout=180\ndelete subnet id=i-12345"
+		exp := "# reverts: create instance type=t2.micro\ndelete instance id=i-54321\ncheck instance id=i-54321 state=terminated timeout=180\n# reverts: create subnet\ndelete subnet id=i-12345"
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -90,7 +124,7 @@ func TestRevertTemplate(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		exp := "delete tag key=Key resource=myinst value=Value\ncheck instance id=i-54g3hj state=running timeout=180\nstop instance id=i-54g3hj\ndelete subnet id=sub-12345\ndelete vpc id=vpc-12345\ndetach policy arn=stuff user=mrT"
+		exp := "# reverts: create tag key=Key resource=myinst value=Value\ndelete tag key=Key resource=myinst value=Value\n# reverts: start instance id=i-54g3hj\ncheck instance id=i-54g3hj state=running timeout=180\nstop instance id=i-54g3hj\n# reverts: create subnet\ndelete subnet id=sub-12345\n# reverts: create vpc\ndelete vpc id=vpc-12345\n# reverts: attach policy arn=stuff user=mrT\ndetach policy arn=stuff user=mrT"
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -122,11 +156,15 @@ inst1 = create instance
 		if err != nil {
 			t.Fatal(err)
 		}
-		exp := `delete instance id=i-1
+		exp := `# reverts: inst1 = create instance
+delete instance id=i-1
 check instance id=i-1 state=terminated timeout=180
+# reverts: create listener actiontype=forward loadbalancer=$lb
 delete listener id=list-1
+# reverts: lb = create loadbalancer groups=$loadbalancerfw name=loadbalancer
 delete loadbalancer id=lb-1
 check loadbalancer id=lb-1 state=not-found timeout=180
+# reverts: loadbalancerfw = create securitygroup
 check securitygroup id=securitygroup-1 state=unused timeout=300
 delete securitygroup id=securitygroup-1`
 		if got, want := reverted.String(), exp; got != want {
@@ -144,7 +182,8 @@ delete securitygroup id=securitygroup-1`
 			t.Fatal(err)
 		}
 
-		exp := `check securitygroup id=sg-54321 state=unused timeout=300
+		exp := `# reverts: create securitygroup
+check securitygroup id=sg-54321 state=unused timeout=300
 delete securitygroup id=sg-54321`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
@@ -161,7 +200,8 @@ delete securitygroup id=sg-54321`
 			t.Fatal(err)
 		}
 
-		exp := `delete image delete-snapshots=true id=ami-12345678`
+		exp := `# reverts: copy image
+delete image delete-snapshots=true id=ami-12345678`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -174,7 +214,8 @@ delete securitygroup id=sg-54321`
 			t.Fatal(err)
 		}
 
-		exp := `attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
+		exp := `# reverts: detach volume device=/dev/sdh force=true id=vol-12345 instance=i-12345
+attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -187,8 +228,10 @@ delete securitygroup id=sg-54321`
 			t.Fatal(err)
 		}
 
-		exp := `detach volume device=/dev/sdh id=vol-12345 instance=i-12345
+		exp := `# reverts: attach volume device=/dev/sdh id=vol-12345 instance=i-12345
+detach volume device=/dev/sdh id=vol-12345 instance=i-12345
 check volume id=vol-12345 state=available timeout=180
+# reverts: detach volume device=/dev/sdh id=vol-12345 instance=i-12345
 attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
@@ -202,7 +245,8 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 			t.Fatal(err)
 		}
 
-		exp := `delete route cidr=0.0.0.0/0 table=rtb-12345`
+		exp := `# reverts: create route cidr=0.0.0.0/0 gateway=igw-12345 table=rtb-12345
+delete route cidr=0.0.0.0/0 table=rtb-12345`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -215,7 +259,8 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 			t.Fatal(err)
 		}
 
-		exp := `detach instance id=i-123456 targetgroup=mytargetgrouparn`
+		exp := `# reverts: attach instance id=i-123456 port=80 targetgroup=mytargetgrouparn
+detach instance id=i-123456 targetgroup=mytargetgrouparn`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -231,7 +276,8 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 			t.Fatal(err)
 		}
 
-		exp := `update scalinggroup max-size=0 min-size=0 name=my-scalinggroup
+		exp := `# reverts: create scalinggroup
+update scalinggroup max-size=0 min-size=0 name=my-scalinggroup
 check scalinggroup count=0 name=my-scalinggroup timeout=600
 delete scalinggroup force=true name=my-scalinggroup`
 		if got, want := reverted.String(), exp; got != want {
@@ -249,7 +295,8 @@ delete scalinggroup force=true name=my-scalinggroup`
 			t.Fatal(err)
 		}
 
-		exp := `delete accesskey id=my-accesskey user=myuser`
+		exp := `# reverts: create accesskey user=myuser
+delete accesskey id=my-accesskey user=myuser`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -265,7 +312,8 @@ delete scalinggroup force=true name=my-scalinggroup`
 			t.Fatal(err)
 		}
 
-		exp := `delete queue url=my-queue-url`
+		exp := `# reverts: create queue name=my-queue
+delete queue url=my-queue-url`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -281,7 +329,8 @@ delete scalinggroup force=true name=my-scalinggroup`
 			t.Fatal(err)
 		}
 
-		exp := `delete queue url='my queue url'`
+		exp := `# reverts: create queue name=my-queue
+delete queue url='my queue url'`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -294,7 +343,24 @@ delete scalinggroup force=true name=my-scalinggroup`
 			t.Fatal(err)
 		}
 
-		exp := `delete record name=test.awlesstest.io. ttl=60 type=A value=1.2.3.4 zone=/hostedzone/Z29L20HGD4CX07`
+		exp := `# reverts: create record comment='my test record' name=test.awlesstest.io. ttl=60 type=A value=1.2.3.4 zone=/hostedzone/Z29L20HGD4CX07
+delete record name=test.awlesstest.io. ttl=60 type=A value=1.2.3.4 zone=/hostedzone/Z29L20HGD4CX07`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert tag rename", func(t *testing.T) {
+		tpl := MustParse("create tag key=squad resource=i-1 value=web\ndelete tag key=team resource=i-1 value=web")
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `# reverts: delete tag key=team resource=i-1 value=web
+create tag key=team resource=i-1 value=web
+# reverts: create tag key=squad resource=i-1 value=web
+delete tag key=squad resource=i-1 value=web`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -315,8 +381,10 @@ delete scalinggroup force=true name=my-scalinggroup`
 			t.Fatal(err)
 		}
 
-		exp := `delete database id=my-database skip-snapshot=true
+		exp := `# reverts: create database subnetgroup=$dbsubgroup
+delete database id=my-database skip-snapshot=true
 check database id=my-database state=not-found timeout=900
+# reverts: dbsubgroup = create dbsubnetgroup
 delete dbsubnetgroup name=my-dbsubgroup`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
@@ -330,7 +398,8 @@ delete dbsubnetgroup name=my-dbsubgroup`
 			t.Fatal(err)
 		}
 
-		exp := `update containertask cluster=cl deployment-name=dpname desired-count=0
+		exp := `# reverts: start containertask cluster=cl deployment-name=dpname desired-count=2 name=taskname type=service
+update containertask cluster=cl deployment-name=dpname desired-count=0
 stop containertask cluster=cl deployment-name=dpname type=service`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
@@ -345,7 +414,8 @@ stop containertask cluster=cl deployment-name=dpname type=service`
 			t.Fatal(err)
 		}
 
-		exp := `stop containertask cluster=cl run-arn=my-task-arn type=task`
+		exp := `# reverts: start containertask cluster=cl desired-count=2 name=taskname type=task
+stop containertask cluster=cl run-arn=my-task-arn type=task`
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: %s\nwant: %s\n", got, want)
 		}
@@ -370,6 +440,7 @@ func TestCmdNodeIsRevertible(t *testing.T) {
 		{line: "detach policy", revertible: true},
 		{line: "create record", revertible: true},
 		{line: "delete record", revertible: true},
+		{line: "delete tag", revertible: true},
 		{line: "copy image", result: "any", revertible: true},
 		{line: "detach routetable", revertible: false},
 		{line: "start alarm", revertible: true},
@@ -0,0 +1,107 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// SkippedStatement is a statement removed from a template, either explicitly
+// or because it references the variable DependsOn declared by a skipped statement
+type SkippedStatement struct {
+	Position  int
+	Statement *ast.Statement
+	DependsOn string
+}
+
+// Skip returns the template without the statements at the given positions (starting at 1)
+// and the statements declaring the given variables ('$' or '@' prefixed or not).
+// The statements referencing a skipped variable, directly or transitively, are skipped too
+func (s *Template) Skip(positions []int, refs []string) (*Template, []*SkippedStatement, error) {
+	explicit := make(map[int]bool)
+	for _, pos := range positions {
+		if pos < 1 || pos > len(s.Statements) {
+			return s, nil, fmt.Errorf("skip: no statement %d, template has %d statement(s)", pos, len(s.Statements))
+		}
+		explicit[pos] = true
+	}
+
+	declared := make(map[string]bool)
+	for _, st := range s.Statements {
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			declared[decl.Ident] = true
+		}
+	}
+	skipRefs := make(map[string]bool)
+	for _, ref := range refs {
+		ref = strings.TrimLeft(ref, "$@")
+		if !declared[ref] {
+			return s, nil, fmt.Errorf("skip: reference '$%s' is not declared in template", ref)
+		}
+		skipRefs[ref] = true
+	}
+
+	// references only point to previous statements: one pass resolves the transitive dependents
+	kept := &Template{ID: s.ID, AST: &ast.AST{}}
+	skippedIdents := make(map[string]bool)
+	var skipped []*SkippedStatement
+	for i, st := range s.Statements {
+		var ident string
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			ident = decl.Ident
+		}
+		skip := &SkippedStatement{Position: i + 1, Statement: st}
+		isSkipped := explicit[i+1] || (ident != "" && skipRefs[ident])
+		if !isSkipped {
+			if dependsOn, ok := referencedIdent(st, skippedIdents); ok {
+				skip.DependsOn, isSkipped = dependsOn, true
+			}
+		}
+		if !isSkipped {
+			kept.Statements = append(kept.Statements, st)
+			continue
+		}
+		if ident != "" {
+			skippedIdents[ident] = true
+		}
+		skipped = append(skipped, skip)
+	}
+
+	return kept, skipped, nil
+}
+
+func referencedIdent(st *ast.Statement, idents map[string]bool) (string, bool) {
+	cmd, ok := statementCommand(st)
+	if !ok {
+		return "", false
+	}
+	var refs []string
+	for _, ref := range cmd.Refs {
+		if idents[ref] {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == 0 {
+		return "", false
+	}
+	sort.Strings(refs)
+	return refs[0], true
+}
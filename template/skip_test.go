@@ -0,0 +1,56 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSkipStatements(t *testing.T) {
+	text := "vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ntemp = create instance subnet=$sub name=temp\nattach volume instance=$temp id=vol-1\ncreate keypair name=mykey\ncreate tag resource=$vpc key=Env value=test"
+
+	t.Run("by position with dependents", func(t *testing.T) {
+		tpl, skipped, err := MustParse(text).Skip([]int{2}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "vpc = create vpc cidr=10.0.0.0/16\ncreate keypair name=mykey\ncreate tag key=Env resource=$vpc value=test"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+		var statements, dependsOn []string
+		for _, s := range skipped {
+			statements = append(statements, s.Statement.String())
+			dependsOn = append(dependsOn, s.DependsOn)
+		}
+		if got, want := strings.Join(statements, "|"), "sub = create subnet cidr=10.0.0.0/24 vpc=$vpc|temp = create instance name=temp subnet=$sub|attach volume id=vol-1 instance=$temp"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := strings.Join(dependsOn, ","), ",sub,temp"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := skipped[2].Position, 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("by reference", func(t *testing.T) {
+		tpl, skipped, err := MustParse(text).Skip([]int{5}, []string{"@temp"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.String(), "vpc = create vpc cidr=10.0.0.0/16\nsub = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate tag key=Env resource=$vpc value=test"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+		if got, want := len(skipped), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, _, err := MustParse(text).Skip([]int{7}, nil); err == nil || !strings.Contains(err.Error(), "no statement 7") {
+			t.Fatalf("got %v, want out of range error", err)
+		}
+		if _, _, err := MustParse(text).Skip(nil, []string{"$unknown"}); err == nil || !strings.Contains(err.Error(), "'$unknown' is not declared") {
+			t.Fatalf("got %v, want undeclared error", err)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+const (
+	AddTag    = "add"
+	RemoveTag = "remove"
+	RenameTag = "rename"
+)
+
+// TagMigration transforms a tag on resources: add sets the tag Key=Value,
+// remove deletes the tag Key and rename moves the value of the tag Key to NewKey
+type TagMigration struct {
+	Kind   string
+	Key    string
+	Value  string
+	NewKey string
+}
+
+// ParseTagMigration parses a transformation given as 'add:KEY=VALUE', 'remove:KEY' or 'rename:KEY=NEWKEY'
+func ParseTagMigration(s string) (*TagMigration, error) {
+	splits := strings.SplitN(s, ":", 2)
+	if len(splits) != 2 || splits[1] == "" {
+		return nil, fmt.Errorf("invalid tag migration '%s': expecting add:KEY=VALUE, remove:KEY or rename:KEY=NEWKEY", s)
+	}
+	m := &TagMigration{Kind: strings.ToLower(splits[0])}
+	switch m.Kind {
+	case AddTag, RenameTag:
+		kv := strings.SplitN(splits[1], "=", 2)
+		if len(kv) != 2 || kv[0] == "" || (m.Kind == RenameTag && kv[1] == "") {
+			return nil, fmt.Errorf("invalid tag migration '%s': expecting %s:KEY=%s", s, m.Kind, map[string]string{AddTag: "VALUE", RenameTag: "NEWKEY"}[m.Kind])
+		}
+		m.Key = kv[0]
+		if m.Kind == AddTag {
+			m.Value = kv[1]
+		} else {
+			m.NewKey = kv[1]
+		}
+	case RemoveTag:
+		m.Key = splits[1]
+	default:
+		return nil, fmt.Errorf("invalid tag migration '%s': unknown transformation '%s', expecting add, remove or rename", s, splits[0])
+	}
+	return m, nil
+}
+
+func (m *TagMigration) String() string {
+	switch m.Kind {
+	case AddTag:
+		return fmt.Sprintf("add tag %s=%s", m.Key, m.Value)
+	case RenameTag:
+		return fmt.Sprintf("rename tag %s to %s", m.Key, m.NewKey)
+	default:
+		return fmt.Sprintf("remove tag %s", m.Key)
+	}
+}
+
+// Template returns the statements applying the migration on the resources, with the
+// number of resources changed per resource type. Resources already in the expected
+// state are left untouched. A tag overwritten is first deleted with its current value,
+// so that reverting the template restores the tags as they were
+func (m *TagMigration) Template(resources []*graph.Resource) (*Template, map[string]int, error) {
+	sorted := make([]*graph.Resource, len(resources))
+	copy(sorted, resources)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Type() != sorted[j].Type() {
+			return sorted[i].Type() < sorted[j].Type()
+		}
+		return sorted[i].Id() < sorted[j].Id()
+	})
+
+	var lines []string
+	changed := make(map[string]int)
+	for _, res := range sorted {
+		tags := resourceTags(res)
+		current, hasKey := tags[m.Key]
+		var statements []string
+		switch m.Kind {
+		case AddTag:
+			if hasKey && current == m.Value {
+				continue
+			}
+			if hasKey {
+				statements = append(statements, tagStatement("delete", res.Id(), m.Key, current))
+			}
+			statements = append(statements, tagStatement("create", res.Id(), m.Key, m.Value))
+		case RemoveTag:
+			if !hasKey {
+				continue
+			}
+			statements = append(statements, tagStatement("delete", res.Id(), m.Key, current))
+		case RenameTag:
+			if !hasKey || m.Key == m.NewKey {
+				continue
+			}
+			if existing, ok := tags[m.NewKey]; ok && existing != current {
+				statements = append(statements, tagStatement("delete", res.Id(), m.NewKey, existing))
+			}
+			if existing, ok := tags[m.NewKey]; !ok || existing != current {
+				statements = append(statements, tagStatement("create", res.Id(), m.NewKey, current))
+			}
+			statements = append(statements, tagStatement("delete", res.Id(), m.Key, current))
+		default:
+			return nil, nil, fmt.Errorf("tag migration: unknown transformation '%s'", m.Kind)
+		}
+		lines = append(lines, statements...)
+		changed[res.Type()]++
+	}
+
+	if len(lines) == 0 {
+		return &Template{AST: &ast.AST{}}, changed, nil
+	}
+	tpl, err := Parse(strings.Join(lines, "\n"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("tag migration: %s", err)
+	}
+	return tpl, changed, nil
+}
+
+func tagStatement(action, id, key, value string) string {
+	return fmt.Sprintf("%s tag resource=%s key=%s value=%s", action, quoteParamIfNeeded(id), quoteParamIfNeeded(key), quoteParamIfNeeded(value))
+}
+
+func resourceTags(res *graph.Resource) map[string]string {
+	tags := make(map[string]string)
+	if all, ok := res.Properties["Tags"].([]string); ok {
+		for _, t := range all {
+			kv := strings.SplitN(t, "=", 2)
+			if len(kv) == 2 {
+				tags[kv[0]] = kv[1]
+			} else {
+				tags[kv[0]] = ""
+			}
+		}
+	}
+	return tags
+}
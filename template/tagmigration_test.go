@@ -0,0 +1,97 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+)
+
+func TestParseTagMigration(t *testing.T) {
+	tcases := []struct {
+		in     string
+		exp    *TagMigration
+		errMsg string
+	}{
+		{in: "add:Env=prod", exp: &TagMigration{Kind: AddTag, Key: "Env", Value: "prod"}},
+		{in: "add:Env=", exp: &TagMigration{Kind: AddTag, Key: "Env"}},
+		{in: "Rename:team=squad", exp: &TagMigration{Kind: RenameTag, Key: "team", NewKey: "squad"}},
+		{in: "remove:team", exp: &TagMigration{Kind: RemoveTag, Key: "team"}},
+		{in: "rename:team", errMsg: "expecting rename:KEY=NEWKEY"},
+		{in: "add:=prod", errMsg: "expecting add:KEY=VALUE"},
+		{in: "remove:", errMsg: "expecting add:KEY=VALUE, remove:KEY or rename:KEY=NEWKEY"},
+		{in: "move:team=squad", errMsg: "unknown transformation 'move'"},
+	}
+	for _, tc := range tcases {
+		m, err := ParseTagMigration(tc.in)
+		if tc.errMsg != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("%s: got %v, want error containing %q", tc.in, err, tc.errMsg)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tc.in, err)
+		}
+		if !reflect.DeepEqual(m, tc.exp) {
+			t.Fatalf("%s: got %#v, want %#v", tc.in, m, tc.exp)
+		}
+	}
+}
+
+func TestTagMigrationTemplate(t *testing.T) {
+	tagged := func(typ, id string, tags ...string) *graph.Resource {
+		res := graph.InitResource(typ, id)
+		if len(tags) > 0 {
+			res.Properties["Tags"] = tags
+		}
+		return res
+	}
+	resources := []*graph.Resource{
+		tagged("volume", "vol-1", "team=web"),
+		tagged("instance", "i-2", "team=data", "squad=ops"),
+		tagged("instance", "i-1", "team=web"),
+		tagged("instance", "i-3", "team=api", "squad=api"),
+		tagged("instance", "i-4", "Env=prod"),
+	}
+
+	tcases := []struct {
+		migration  *TagMigration
+		exp        string
+		expChanged map[string]int
+	}{
+		{
+			migration:  &TagMigration{Kind: RenameTag, Key: "team", NewKey: "squad"},
+			exp:        "create tag key=squad resource=i-1 value=web\ndelete tag key=team resource=i-1 value=web\ndelete tag key=squad resource=i-2 value=ops\ncreate tag key=squad resource=i-2 value=data\ndelete tag key=team resource=i-2 value=data\ndelete tag key=team resource=i-3 value=api\ncreate tag key=squad resource=vol-1 value=web\ndelete tag key=team resource=vol-1 value=web",
+			expChanged: map[string]int{"instance": 3, "volume": 1},
+		},
+		{
+			migration:  &TagMigration{Kind: AddTag, Key: "team", Value: "web"},
+			exp:        "delete tag key=team resource=i-2 value=data\ncreate tag key=team resource=i-2 value=web\ndelete tag key=team resource=i-3 value=api\ncreate tag key=team resource=i-3 value=web\ncreate tag key=team resource=i-4 value=web",
+			expChanged: map[string]int{"instance": 3},
+		},
+		{
+			migration:  &TagMigration{Kind: RemoveTag, Key: "Env"},
+			exp:        "delete tag key=Env resource=i-4 value=prod",
+			expChanged: map[string]int{"instance": 1},
+		},
+		{
+			migration:  &TagMigration{Kind: RemoveTag, Key: "Owner"},
+			exp:        "",
+			expChanged: map[string]int{},
+		},
+	}
+	for _, tc := range tcases {
+		tpl, changed, err := tc.migration.Template(resources)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.migration, err)
+		}
+		if got, want := tpl.String(), tc.exp; got != want {
+			t.Fatalf("%s: got\n%s\nwant\n%s", tc.migration, got, want)
+		}
+		if got, want := changed, tc.expChanged; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %v, want %v", tc.migration, got, want)
+		}
+	}
+}
@@ -32,26 +32,58 @@ type Template struct {
 	*ast.AST
 }
 
+// NewRunID returns a new unique id for a template run
+func NewRunID() string {
+	return ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+}
+
+// Run runs the template. The ran template keeps the id of the template if it has one
+// (ex: given before compiling to tag the created resources with it)
 func (s *Template) Run(env *Env) (*Template, error) {
 	vars := map[string]interface{}{}
 
-	current := &Template{AST: &ast.AST{}}
-	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+	current := &Template{ID: s.ID, AST: &ast.AST{}}
+	if current.ID == "" {
+		current.ID = NewRunID()
+	}
 
+	skipped := make(map[string]bool)
 	for _, sts := range s.Statements {
 		clone := sts.Clone()
 		current.Statements = append(current.Statements, clone)
 		switch clone.Node.(type) {
 		case *ast.CommandNode:
 			cmd := clone.Node.(*ast.CommandNode)
-			fn, err := env.Driver.Lookup(cmd.Action, cmd.Entity)
+			if cmd.Action == importAction {
+				cmd.ProcessRefs(vars)
+				if cmd.CmdResult, cmd.CmdErr = importResource(env, cmd); cmd.CmdErr != nil {
+					return current, nil
+				}
+				continue
+			}
+			cmd.ProcessRefs(vars)
+			fn, err := env.lookupDriverFn(cmd)
 			if err != nil {
 				return current, err
 			}
-			cmd.ProcessRefs(vars)
+
+			if ref, ok := clone.Annotations[autoTagAnnotation]; ok && skipped[ref] {
+				env.Log.Verbosef("auto tag: skipping '%s' on existing resource", cmd.Params["key"])
+				cmd.CmdSkipped = true
+				continue
+			}
+
+			if existing, found, err := findExistingResource(env, clone, cmd); err != nil {
+				cmd.CmdErr = err
+				return current, nil
+			} else if found {
+				env.Log.Infof("%s %s: '%s' already exists, skipping creation", cmd.Action, cmd.Entity, existing)
+				cmd.CmdResult, cmd.CmdSkipped = existing, true
+				continue
+			}
 
 			ctx := driver.NewContext(env.ResolvedReferences)
-			if cmd.CmdResult, cmd.CmdErr = fn(ctx, cmd.Params); cmd.CmdErr != nil {
+			if cmd.CmdResult, cmd.CmdErr = fn(ctx, withoutMetaParams(cmd.Params)); cmd.CmdErr != nil {
 				return current, nil
 			}
 		case *ast.DeclarationNode:
@@ -60,14 +92,33 @@ func (s *Template) Run(env *Env) (*Template, error) {
 			switch expr.(type) {
 			case *ast.CommandNode:
 				cmd := expr.(*ast.CommandNode)
-				fn, err := env.Driver.Lookup(cmd.Action, cmd.Entity)
+				if cmd.Action == importAction {
+					cmd.ProcessRefs(vars)
+					if cmd.CmdResult, cmd.CmdErr = importResource(env, cmd); cmd.CmdErr != nil {
+						return current, nil
+					}
+					vars[ident] = cmd.CmdResult
+					continue
+				}
+				cmd.ProcessRefs(vars)
+				fn, err := env.lookupDriverFn(cmd)
 				if err != nil {
 					return current, err
 				}
-				cmd.ProcessRefs(vars)
+
+				if existing, found, err := findExistingResource(env, clone, cmd); err != nil {
+					cmd.CmdErr = err
+					return current, nil
+				} else if found {
+					env.Log.Infof("%s %s: '%s' already exists, skipping creation", cmd.Action, cmd.Entity, existing)
+					cmd.CmdResult, cmd.CmdSkipped = existing, true
+					vars[ident] = existing
+					skipped[ident] = true
+					continue
+				}
 
 				ctx := driver.NewContext(env.ResolvedReferences)
-				if cmd.CmdResult, cmd.CmdErr = fn(ctx, cmd.Params); cmd.CmdErr != nil {
+				if cmd.CmdResult, cmd.CmdErr = fn(ctx, withoutMetaParams(cmd.Params)); cmd.CmdErr != nil {
 					return current, nil
 				}
 				vars[ident] = cmd.CmdResult
@@ -79,8 +130,8 @@ func (s *Template) Run(env *Env) (*Template, error) {
 }
 
 func (s *Template) DryRun(env *Env) error {
-	defer env.Driver.SetDryRun(false)
-	env.Driver.SetDryRun(true)
+	defer env.SetDryRun(false)
+	env.SetDryRun(true)
 
 	res, err := s.Run(env)
 	if err != nil {
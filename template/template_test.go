@@ -99,6 +99,37 @@ func TestRunDriverReportsInStatement(t *testing.T) {
 	}
 }
 
+func TestRunTagsCreationsWithRunID(t *testing.T) {
+	tpl := MustParse("create vpc cidr=10.0.0.0/16")
+	tpl.ID = NewRunID()
+
+	env := NewEnv()
+	env.Driver = &noopDriver{}
+	env.AutoTags = map[string]string{AutoTagRunIDKey: tpl.ID}
+	env.AutoTagEntityFunc = func(entity string) bool { return entity == "vpc" }
+	compiled, _, err := injectAutoTagsPass(tpl, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, run := range []func(*Env) (*Template, error){
+		compiled.Run,
+		func(env *Env) (*Template, error) { return compiled.RunConcurrently(env, 2) },
+	} {
+		ran, err := run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := ran.ID, tpl.ID; got != want {
+			t.Fatalf("got run id %s, want %s", got, want)
+		}
+		tag := ran.CommandNodesIterator()[1]
+		if got, want := tag.Params["value"], ran.ID; got == "" || got != want {
+			t.Fatalf("got tag value '%v', want %s", got, want)
+		}
+	}
+}
+
 func TestRunDriverOnTemplate(t *testing.T) {
 	t.Run("Driver run TWICE multiline statement", func(t *testing.T) {
 		s := &Template{AST: &ast.AST{}}
@@ -249,6 +280,141 @@ type expectation struct {
 	expectedParams map[string]interface{}
 }
 
+func TestRunIdempotentCreate(t *testing.T) {
+	existing := func(found map[string][]string, called map[string]map[string]interface{}) func(string, map[string]interface{}) ([]string, error) {
+		return func(entity string, params map[string]interface{}) ([]string, error) {
+			called[entity] = params
+			return found[entity], nil
+		}
+	}
+
+	t.Run("annotated statement binds to existing resource", func(t *testing.T) {
+		tpl := MustParse("# @idempotent name\nvpc = create vpc cidr=10.0.0.0/16 name=myvpc\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc name=mysubnet")
+
+		mDriver := &mockDriver{prefix: "mynew", expects: []*expectation{{
+			action: "create", entity: "vpc",
+		}, {
+			action: "create", entity: "subnet",
+			expectedParams: map[string]interface{}{"cidr": "10.0.0.0/24", "vpc": "vpc-1234", "name": "mysubnet"},
+		}}}
+		called := make(map[string]map[string]interface{})
+		env := NewEnv()
+		env.Driver = mDriver
+		env.ExistingResourcesFunc = existing(map[string][]string{"vpc": {"vpc-1234"}, "subnet": {"subnet-1234"}}, called)
+
+		ran, err := tpl.Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds := ran.CommandNodesIterator()
+		if got, want := cmds[0].CmdResult, "vpc-1234"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if !cmds[0].CmdSkipped || cmds[1].CmdSkipped {
+			t.Fatalf("got skipped %t, %t, want true, false", cmds[0].CmdSkipped, cmds[1].CmdSkipped)
+		}
+		if cmds[1].CmdErr != nil {
+			t.Fatal(cmds[1].CmdErr)
+		}
+		if got, want := called, map[string]map[string]interface{}{"vpc": {"name": "myvpc"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if isRevertible(cmds[0]) {
+			t.Fatal("expected existing resource not to be revertible")
+		}
+	})
+
+	t.Run("idempotent mode uses entity keys", func(t *testing.T) {
+		tpl := MustParse("create subnet cidr=10.0.0.0/24 vpc=vpc-1234 name=mysubnet\ncreate internetgateway")
+
+		mDriver := &mockDriver{prefix: "mynew", expects: []*expectation{{
+			action: "create", entity: "subnet",
+			expectedParams: map[string]interface{}{"cidr": "10.0.0.0/24", "vpc": "vpc-1234", "name": "mysubnet"},
+		}, {
+			action: "create", entity: "internetgateway", expectedParams: map[string]interface{}{},
+		}}}
+		called := make(map[string]map[string]interface{})
+		env := NewEnv()
+		env.Driver = mDriver
+		env.Idempotent = true
+		env.IdempotencyKeysFunc = func(entity string) []string {
+			if entity == "subnet" {
+				return []string{"name", "vpc"}
+			}
+			return nil
+		}
+		env.ExistingResourcesFunc = existing(nil, called)
+
+		ran, err := tpl.Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, cmd := range ran.CommandNodesIterator() {
+			if cmd.CmdErr != nil || cmd.CmdSkipped {
+				t.Fatalf("%s: unexpected err %v or skipped %t", cmd, cmd.CmdErr, cmd.CmdSkipped)
+			}
+		}
+		if got, want := ran.CommandNodesIterator()[0].CmdResult, "mynewsubnet"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := called, map[string]map[string]interface{}{"subnet": {"name": "mysubnet", "vpc": "vpc-1234"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("auto tags of existing resource are skipped", func(t *testing.T) {
+		env := NewEnv()
+		env.AutoTags = map[string]string{AutoTagRunIDKey: "run-1"}
+		env.AutoTagEntityFunc = func(entity string) bool { return true }
+		tpl, _, err := injectAutoTagsPass(MustParse("# @idempotent name\nvpc = create vpc name=myvpc\ncreate subnet name=mysubnet"), env)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		env.Driver = &mockDriver{prefix: "mynew", expects: []*expectation{
+			{action: "create", entity: "vpc"},
+			{action: "create", entity: "subnet", expectedParams: map[string]interface{}{"name": "mysubnet"}},
+			{action: "create", entity: "tag", expectedParams: map[string]interface{}{"key": "awless:run-id", "resource": "mynewsubnet", "value": "run-1"}},
+		}}
+		env.ExistingResourcesFunc = existing(map[string][]string{"vpc": {"vpc-1234"}}, make(map[string]map[string]interface{}))
+
+		ran, err := tpl.Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var skipped []bool
+		for _, cmd := range ran.CommandNodesIterator() {
+			if cmd.CmdErr != nil {
+				t.Fatal(cmd.CmdErr)
+			}
+			skipped = append(skipped, cmd.CmdSkipped)
+		}
+		if got, want := skipped, []bool{true, true, false, false}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("fails on ambiguous match", func(t *testing.T) {
+		tpl := MustParse("create vpc cidr=10.0.0.0/16 name=myvpc // @idempotent name")
+
+		env := NewEnv()
+		env.Driver = &noopDriver{}
+		env.ExistingResourcesFunc = existing(map[string][]string{"vpc": {"vpc-1", "vpc-2"}}, make(map[string]map[string]interface{}))
+
+		ran, err := tpl.Run(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmdErr := ran.CommandNodesIterator()[0].CmdErr
+		if cmdErr == nil {
+			t.Fatal("expected error")
+		}
+		if got, want := cmdErr.Error(), "create vpc: 2 existing resources match name=myvpc: vpc-1, vpc-2"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
 type mockDriver struct {
 	expects []*expectation
 	prefix  string
@@ -0,0 +1,150 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ParseVarFile reads the holes values of a var file: a flat map given either in JSON
+// or in YAML ('key: value' lines). The format is guessed from the file extension,
+// defaulting to YAML unless the content is a JSON object. Values are typed as if they
+// were given as extra params on the command line, lists being given as arrays
+func ParseVarFile(filename string, content []byte) (map[string]interface{}, error) {
+	var raw map[string]interface{}
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(filename)); {
+	case ext == ".json", ext == "" && bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")):
+		raw, err = parseJSONVarFile(content)
+	default:
+		raw, err = parseYAMLVarFile(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("var file %s: %s", filename, err)
+	}
+
+	vars := make(map[string]interface{})
+	for k, v := range raw {
+		if vars[k], err = varFileValue(k, v); err != nil {
+			return nil, fmt.Errorf("var file %s: %s", filename, err)
+		}
+	}
+	return vars, nil
+}
+
+func parseJSONVarFile(content []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+	vars := make(map[string]interface{})
+	if err := dec.Decode(&vars); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %s", err)
+	}
+	return vars, nil
+}
+
+func parseYAMLVarFile(content []byte) (map[string]interface{}, error) {
+	vars := make(map[string]interface{})
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "---" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(scanner.Text(), " ") || strings.HasPrefix(scanner.Text(), "\t") || strings.HasPrefix(line, "-") {
+			return nil, fmt.Errorf("line %d: expected a flat map of 'key: value'", lineNum)
+		}
+		splits := strings.SplitN(line, ":", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return nil, fmt.Errorf("line %d: expected 'key: value'", lineNum)
+		}
+		key, value := strings.TrimSpace(splits[0]), strings.TrimSpace(splits[1])
+		if _, ok := vars[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key '%s'", lineNum, key)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("line %d: missing value for '%s'", lineNum, key)
+		}
+		if !isQuotedYAML(value) {
+			if i := strings.Index(value, " #"); i > 0 {
+				value = strings.TrimSpace(value[:i])
+			}
+		}
+		if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			var list []interface{}
+			for _, elem := range strings.Split(value[1:len(value)-1], ",") {
+				if elem = strings.TrimSpace(elem); elem != "" {
+					list = append(list, strings.Trim(elem, `"'`))
+				}
+			}
+			vars[key] = list
+		} else if isQuotedYAML(value) {
+			vars[key] = quotedValue(value[1 : len(value)-1])
+		} else {
+			vars[key] = value
+		}
+	}
+	return vars, scanner.Err()
+}
+
+func varFileValue(key string, v interface{}) (interface{}, error) {
+	switch vv := v.(type) {
+	case quotedValue:
+		return string(vv), nil
+	case string:
+		return paramValue(vv), nil
+	case json.Number, bool:
+		return paramValue(fmt.Sprint(vv)), nil
+	case []interface{}:
+		var list []string
+		for _, elem := range vv {
+			switch elem.(type) {
+			case string, json.Number, bool:
+				list = append(list, fmt.Sprint(elem))
+			default:
+				return nil, fmt.Errorf("'%s': expected a list of values", key)
+			}
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("'%s': expected a flat map, got nested value", key)
+	}
+}
+
+// paramValue types a value as an extra param would be, keeping it
+// as a raw string when it would have to be quoted on the command line
+func paramValue(s string) interface{} {
+	if s != "" && !strings.ContainsAny(s, " \t\"'$") {
+		if params, err := ParseParams("value=" + s); err == nil && params["value"] != nil {
+			return params["value"]
+		}
+	}
+	return s
+}
+
+// Values quoted in a YAML var file are kept as is
+type quotedValue string
+
+func isQuotedYAML(s string) bool {
+	return len(s) > 1 && ((s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '\'' && s[len(s)-1] == '\''))
+}
@@ -0,0 +1,73 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseVarFile(t *testing.T) {
+	expected := map[string]interface{}{
+		"instance.type":  "t2.micro",
+		"instance.count": 3,
+		"instance.name":  "my web server",
+		"subnet.cidr":    "10.0.0.0/24",
+		"securitygroups": []string{"sg-1", "sg-2"},
+		"keypair":        "@mykey",
+	}
+
+	t.Run("json", func(t *testing.T) {
+		content := `{
+  "instance.type": "t2.micro",
+  "instance.count": 3,
+  "instance.name": "my web server",
+  "subnet.cidr": "10.0.0.0/24",
+  "securitygroups": ["sg-1", "sg-2"],
+  "keypair": "@mykey"
+}`
+		vars, err := ParseVarFile("vars.json", []byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := vars, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		content := `---
+# production values
+instance.type: t2.micro
+instance.count: 3 # web servers
+instance.name: "my web server"
+subnet.cidr: 10.0.0.0/24
+securitygroups: [sg-1, "sg-2"]
+keypair: '@mykey'
+`
+		vars, err := ParseVarFile("vars.yml", []byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := vars, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("not flat", func(t *testing.T) {
+		tcases := []struct {
+			filename, content, expErr string
+		}{
+			{filename: "vars.json", content: `{"instance": {"type": "t2.micro"}}`, expErr: "var file vars.json: 'instance': expected a flat map"},
+			{filename: "vars", content: `{"instance.type": "t2.micro",}`, expErr: "var file vars: invalid JSON"},
+			{filename: "vars.yaml", content: "instance:\n  type: t2.micro", expErr: "var file vars.yaml: line 1: missing value for 'instance'"},
+			{filename: "vars.yaml", content: "securitygroups:\n- sg-1", expErr: "var file vars.yaml: line 1: missing value for 'securitygroups'"},
+			{filename: "vars.yaml", content: "type: t2.micro\ntype: t2.small", expErr: "var file vars.yaml: line 2: duplicate key 'type'"},
+		}
+		for i, tcase := range tcases {
+			_, err := ParseVarFile(tcase.filename, []byte(tcase.content))
+			if err == nil || !strings.HasPrefix(err.Error(), tcase.expErr) {
+				t.Fatalf("%d: got %v, want %q", i+1, err, tcase.expErr)
+			}
+		}
+	})
+}
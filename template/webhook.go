@@ -0,0 +1,112 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// WebhookSignatureHeader holds the hex encoded HMAC-SHA256 of the notification body,
+// keyed with the webhook secret and prefixed with 'sha256='
+const WebhookSignatureHeader = "X-Awless-Signature"
+
+// RunNotification is the summary of a run posted to a webhook
+type RunNotification struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name,omitempty"`
+	Profile    string         `json:"profile,omitempty"`
+	Region     string         `json:"region,omitempty"`
+	Status     string         `json:"status"`
+	Started    time.Time      `json:"started"`
+	Ended      time.Time      `json:"ended"`
+	DurationMs int64          `json:"durationMs"`
+	Counts     map[string]int `json:"counts"`
+	Created    []string       `json:"created"`
+	Errors     []string       `json:"errors"`
+}
+
+// NewRunNotification counts the statements of a run result per status and gathers its errors,
+// the one that prevented or aborted the run first. The failed statements are only identified
+// by their action and entity: their params never leave the machine
+func NewRunNotification(res *RunResult) *RunNotification {
+	notif := &RunNotification{
+		ID:         res.ID,
+		Name:       res.Name,
+		Profile:    res.Profile,
+		Region:     res.Region,
+		Status:     res.Status,
+		Started:    res.Started,
+		Ended:      res.Ended,
+		DurationMs: res.DurationMs,
+		Counts:     map[string]int{ResultOK: 0, ResultExisting: 0, ResultFailed: 0, ResultNotRun: 0},
+		Created:    res.Created,
+		Errors:     []string{},
+	}
+	if res.Error != "" {
+		notif.Errors = append(notif.Errors, res.Error)
+	}
+	for _, st := range res.Statements {
+		notif.Counts[st.Status]++
+		if st.Error != "" {
+			notif.Errors = append(notif.Errors, fmt.Sprintf("%s %s: %s", st.Action, st.Entity, st.Error))
+		}
+	}
+	return notif
+}
+
+// NotifyWebhook posts the notification of a run result in JSON to the URL.
+// When the secret is not empty, the body is signed in the WebhookSignatureHeader
+func NotifyWebhook(client *http.Client, url, secret string, res *RunResult) error {
+	body, err := json.Marshal(NewRunNotification(res))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookBody([]byte(secret), body))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// SignWebhookBody returns the hex encoded HMAC-SHA256 of the body keyed with the secret
+func SignWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,98 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotifyWebhook(t *testing.T) {
+	planned := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1\ncreate instance subnet=sub-1")
+	ran := MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=vpc-1")
+	ran.ID = "run-1"
+	cmds := ran.CommandNodesIterator()
+	cmds[0].CmdResult = "vpc-1"
+	cmds[1].CmdErr = errors.New("subnet quota exceeded")
+	started := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+	res := NewRunResult(&TemplateExecution{Name: "infra.aws"}, planned, ran, errors.New("aborted"), started, started.Add(time.Second))
+
+	var body []byte
+	var signature, contentType string
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		signature, contentType = r.Header.Get(WebhookSignatureHeader), r.Header.Get("Content-Type")
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	t.Run("signed payload", func(t *testing.T) {
+		if err := NotifyWebhook(server.Client(), server.URL, "s3cr3t", res); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := contentType, "application/json"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := signature, "sha256="+SignWebhookBody([]byte("s3cr3t"), body); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		var notif RunNotification
+		if err := json.Unmarshal(body, &notif); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := notif.ID, "run-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := notif.Status, ResultFailed; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := notif.Counts, map[string]int{ResultOK: 1, ResultExisting: 0, ResultFailed: 1, ResultNotRun: 1}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := notif.Errors, []string{"aborted", "create subnet: subnet quota exceeded"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := notif.Created, []string{"vpc-1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("template failing validation", func(t *testing.T) {
+		failed := NewRunResult(&TemplateExecution{Name: "infra.aws"}, nil, nil, errors.New("template: parse error"), started, started)
+		if err := NotifyWebhook(server.Client(), server.URL, "", failed); err != nil {
+			t.Fatal(err)
+		}
+		var notif RunNotification
+		if err := json.Unmarshal(body, &notif); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := notif.Status, ResultFailed; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := notif.Errors, []string{"template: parse error"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unsigned without secret", func(t *testing.T) {
+		if err := NotifyWebhook(server.Client(), server.URL, "", res); err != nil {
+			t.Fatal(err)
+		}
+		if signature != "" {
+			t.Fatalf("got signature %s, want none", signature)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		if err := NotifyWebhook(server.Client(), server.URL, "", res); err == nil || !strings.Contains(err.Error(), "500") {
+			t.Fatalf("got %v, want error status", err)
+		}
+	})
+}
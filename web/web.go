@@ -190,8 +190,11 @@ func loadLocalTriples() ([]tstore.Triple, error) {
 		readers = append(readers, reader)
 	}
 
-	dec := tstore.NewDatasetDecoder(tstore.NewBinaryDecoder, readers...)
-	return dec.Decode()
+	g := graph.NewGraph()
+	if err := g.UnmarshalMultiple(readers...); err != nil {
+		return nil, err
+	}
+	return g.AsRDFGraphSnaphot().Triples(), nil
 }
 
 const homeTpl = `<!DOCTYPE html>